@@ -32,11 +32,13 @@ import (
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/client/resources"
+	"github.com/companyzero/bisonrelay/client/resources/postsfeed"
 	"github.com/companyzero/bisonrelay/client/resources/simplestore"
 	"github.com/companyzero/bisonrelay/client/rpcserver"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/internal/audio"
 	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/internal/mediainfo"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/internal/tlsconn"
 	"github.com/companyzero/bisonrelay/internal/version"
@@ -118,6 +120,7 @@ type appState struct {
 	isRestore   bool
 	rpcServer   *rpcserver.Server
 
+	pc         clientintf.PaymentClient
 	lnPC       *client.DcrlnPaymentClient
 	lnRPC      lnrpc.LightningClient
 	lnRouter   routerrpc.RouterClient
@@ -166,6 +169,7 @@ type appState struct {
 	postSumm    clientdb.PostSummary
 	myComments  map[clientintf.PostID][]string // Unreplicated comments
 	postStatus  []rpc.PostMetadataStatus
+	postRevs    []rpc.PostMetadataStatus
 	unreadPosts map[clientintf.PostID]struct{}
 
 	// If set, filter the feed window by author
@@ -227,10 +231,13 @@ type appState struct {
 
 	externalEditorForComments atomic.Bool
 	releaseTermOnViewEmbed    bool
+	inlineImages              bool
 
 	payReqStatuses *xsync.MapOf[chainhash.Hash, lnrpc.Payment_PaymentStatus]
 
 	sstore       *simplestore.Store
+	postsFeed    *postsfeed.Provider
+	feedHTTPAddr string
 	ssPayType    simpleStorePayType
 	ssAcct       string
 	ssShipCharge float64
@@ -238,6 +245,12 @@ type appState struct {
 	noterec        *audio.NoteRecorder
 	rtAutoHotAudio bool
 
+	// streamPlayback is the playback of a file streamed from a remote
+	// user.
+	streamPlayMtx    sync.Mutex
+	streamPlayback   *audio.OpusFilePlayback
+	streamPlayCancel func()
+
 	rtInvitesMtx sync.Mutex
 	rtInvites    []*rtdtInvite
 
@@ -361,6 +374,18 @@ func (as *appState) run() error {
 		}()
 	}
 
+	// Serve the posts feed over HTTP if set.
+	if as.postsFeed != nil && as.feedHTTPAddr != "" {
+		as.wg.Add(1)
+		go func() {
+			err := as.postsFeed.ListenAndServe(as.ctx, as.feedHTTPAddr)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				as.log.Errorf("Error serving posts feed: %v", err)
+			}
+			as.wg.Done()
+		}()
+	}
+
 	// Setup UI notifications (requires having the nick after client runs).
 	go func() {
 		select {
@@ -1572,6 +1597,47 @@ func (as *appState) payTip(cw *chatWindow, dcrAmount float64) {
 	}
 }
 
+// requestPayment sends a payment request for the given dcr amount to the user.
+func (as *appState) requestPayment(nick, amount string, expiry time.Duration, memo string) error {
+	uid, err := as.c.UIDByNick(nick)
+	if err != nil {
+		return err
+	}
+	dcrAmount, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return err
+	}
+	if dcrAmount <= 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	cw := as.findOrNewChatWindow(uid, nick)
+	go func() {
+		milliAtoms := uint64(dcrAmount * 1e11)
+		pr, err := as.c.RequestPayment(uid, milliAtoms, memo, expiry)
+		if err != nil {
+			as.cwHelpMsg("Unable to request payment from %q: %v",
+				cw.alias, err)
+			return
+		}
+		cw.newInternalMsg("Requested payment of %.8f DCR (request %s, expires %s)",
+			dcrAmount, pr.ID.ShortLogID(), pr.Expires.Format(ISO8601DateTime))
+		as.repaintIfActive(cw)
+	}()
+	return nil
+}
+
+// acceptPaymentRequest pays a payment request received from a remote user.
+func (as *appState) acceptPaymentRequest(pr clientdb.PaymentRequest) {
+	as.diagMsg("Paying %.8f DCR for payment request %s",
+		float64(pr.MilliAtoms)/1e11, pr.ID.ShortLogID())
+	if _, err := as.c.AcceptPaymentRequest(pr.UID, pr.ID); err != nil {
+		as.diagMsg(as.styles.Load().err.Render(fmt.Sprintf(
+			"Unable to pay payment request %s: %v", pr.ID.ShortLogID(), err)))
+		return
+	}
+	as.recheckLNBalance()
+}
+
 func (as *appState) payPayReq(cw *chatWindow, invoice string, payReq *zpay32.Invoice) {
 	if isPayReqExpired(payReq) {
 		return
@@ -1583,7 +1649,7 @@ func (as *appState) payPayReq(cw *chatWindow, invoice string, payReq *zpay32.Inv
 		return
 	}
 
-	fees, err := as.lnPC.PayInvoice(as.ctx, invoice)
+	fees, err := as.pc.PayInvoice(as.ctx, invoice)
 	if err != nil {
 		as.diagMsg(as.styles.Load().err.Render(fmt.Sprintf("Unable to pay invoice: %v", err)))
 		as.payReqStatuses.Store(*payReq.PaymentHash, lnrpc.Payment_FAILED)
@@ -1682,7 +1748,48 @@ func (as *appState) listUserContent(cw *chatWindow, dir, filter string) {
 	as.repaintIfActive(cw)
 }
 
-func (as *appState) getUserContent(cw *chatWindow, filename string) {
+// listGCContent lists the files shared with the GC by its members.
+func (as *appState) listGCContent(gcWin *chatWindow, filter string) {
+	withFilter := ""
+	if filter != "" {
+		withFilter = " with filter " + filter
+	}
+	m := gcWin.newInternalMsg("Listing files shared by GC members%s", withFilter)
+	as.repaintIfActive(gcWin)
+	err := as.c.ListGCContent(gcWin.gc, filter)
+	if err != nil {
+		as.diagMsg("Unable to list GC content: %v", err)
+		return
+	}
+	gcWin.setMsgSent(m)
+	as.repaintIfActive(gcWin)
+}
+
+// storeRemoteFiles stores the list of files shared by a remote user, so that
+// they may be fetched by name.
+func (as *appState) storeRemoteFiles(uid clientintf.UserID, files []clientdb.RemoteFile) {
+	if len(files) == 0 {
+		return
+	}
+
+	as.contentMtx.Lock()
+	userFiles, ok := as.remoteFiles[uid]
+	if !ok {
+		userFiles = make(map[clientdb.FileID]clientdb.RemoteFile, len(files))
+		as.remoteFiles[uid] = userFiles
+	}
+
+	for _, rf := range files {
+		userFiles[rf.FID] = rf
+	}
+
+	as.contentMtx.Unlock()
+}
+
+// findRemoteFile returns the file ID of the given file of a remote user. If
+// filename is not a file ID, the file is looked up in the list of files
+// previously listed by the user.
+func (as *appState) findRemoteFile(uid clientintf.UserID, filename string) (clientdb.FileID, clientdb.RemoteFile) {
 	var rf clientdb.RemoteFile
 	var fid, emptyFID clientdb.FileID
 
@@ -1691,7 +1798,7 @@ func (as *appState) getUserContent(cw *chatWindow, filename string) {
 	if err := fid.FromString(filename); err != nil {
 		fid = emptyFID
 		as.contentMtx.Lock()
-		if userFiles, ok := as.remoteFiles[cw.uid]; ok {
+		if userFiles, ok := as.remoteFiles[uid]; ok {
 			for id, file := range userFiles {
 				if file.Metadata.Filename == filename {
 					fid = id
@@ -1702,7 +1809,12 @@ func (as *appState) getUserContent(cw *chatWindow, filename string) {
 		}
 		as.contentMtx.Unlock()
 	}
+	return fid, rf
+}
 
+func (as *appState) getUserContent(cw *chatWindow, filename string) {
+	var emptyFID clientdb.FileID
+	fid, rf := as.findRemoteFile(cw.uid, filename)
 	if fid == emptyFID {
 		as.cwHelpMsg("Cannot find file ID for file %q. Try `/ft ls <user>` first.",
 			filename)
@@ -1724,6 +1836,68 @@ func (as *appState) getUserContent(cw *chatWindow, filename string) {
 	as.repaintIfActive(cw)
 }
 
+// playUserContent streams the given audio file from the remote user and plays
+// it back while it is downloaded.
+func (as *appState) playUserContent(cw *chatWindow, filename string) {
+	var emptyFID clientdb.FileID
+	fid, _ := as.findRemoteFile(cw.uid, filename)
+	if fid == emptyFID {
+		as.cwHelpMsg("Cannot find file ID for file %q. Try `/ft ls <user>` first.",
+			filename)
+		return
+	}
+
+	fs, err := as.c.StreamUserContent(cw.uid, fid)
+	if err != nil {
+		as.cwHelpMsg("Unable to stream user content: %v", err)
+		return
+	}
+	cw.newInternalMsg("Fetching file %s for playback", filename)
+	as.repaintIfActive(cw)
+
+	// Close the stream as soon as the playback is stopped, so that a
+	// playback blocked waiting for the next chunk is unblocked.
+	ctx, cancel := context.WithCancel(as.ctx)
+	context.AfterFunc(ctx, func() { fs.Close() })
+	md, err := fs.Metadata(ctx)
+	if err != nil {
+		cancel()
+		as.cwHelpMsg("Unable to fetch metadata of file %s: %v", filename, err)
+		return
+	}
+	info := mediainfo.FromAttributes(md.Attributes)
+	if info.MimeType != "" && info.MimeType != "audio/ogg" {
+		cancel()
+		as.cwHelpMsg("Cannot play file %q of type %s", md.Filename,
+			strescape.Content(info.MimeType))
+		return
+	}
+
+	// Stop any previous playback.
+	as.streamPlayMtx.Lock()
+	if as.streamPlayCancel != nil {
+		as.streamPlayCancel()
+	}
+	p := as.noterec.PlaybackOpusFile(ctx, fs, int64(md.Size), info.Duration)
+	as.streamPlayback, as.streamPlayCancel = p, cancel
+	as.streamPlayMtx.Unlock()
+
+	cw.newInternalMsg("Playing %q", strescape.Content(md.Filename))
+	as.repaintIfActive(cw)
+
+	<-p.Done()
+	cancel()
+	as.streamPlayMtx.Lock()
+	if as.streamPlayback == p {
+		as.streamPlayback, as.streamPlayCancel = nil, nil
+	}
+	as.streamPlayMtx.Unlock()
+
+	if err := p.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		as.diagMsg("Error playing back %q: %v", md.Filename, err)
+	}
+}
+
 func (as *appState) subscribeToPosts(uid clientintf.UserID) error {
 	cw := as.findChatWindow(uid)
 	nick, err := as.c.UserNick(uid)
@@ -2089,7 +2263,28 @@ func (as *appState) requestRecv(amount, server, key string, caCert []byte) error
 	}
 }
 
-func (as *appState) createPost(post string, root string) {
+func (as *appState) createPost(post string, root string) error {
+	return as.createPostForAudience(post, root, "")
+}
+
+// createPostForAudience creates a post restricted to the given audience. If
+// audience is empty, the post is shared with all subscribers.
+func (as *appState) createPostForAudience(post string, root string, audience string) error {
+	return as.createTaggedPost(post, root, audience, nil)
+}
+
+// createTaggedPost creates a post with the given tags, restricted to the given
+// audience (if not empty).
+func (as *appState) createTaggedPost(post string, root string, audience string, tags []string) error {
+	return as.createPostWithOpts(post, root, client.CreatePostOpts{
+		Audience: audience,
+		Tags:     tags,
+	})
+}
+
+// createPostWithOpts creates a post with the given options. Errors are also
+// reported in the active window.
+func (as *appState) createPostWithOpts(post string, root string, opts client.CreatePostOpts) error {
 	// Process local data.
 	post = resources.RemoveEndOfPostMarker(post)
 	if root == "" {
@@ -2098,22 +2293,109 @@ func (as *appState) createPost(post string, root string) {
 	post = resources.ProcessEmbeds(post, root, as.log)
 
 	if strings.TrimSpace(post) == "" {
-		return
+		return nil
 	}
 
-	summ, err := as.c.CreatePost(post, "")
+	summ, err := as.c.CreatePostWithOpts(post, "", opts)
 	if err != nil {
 		as.cwHelpMsg("Unable to create post: %v", err)
+		return err
+	}
+	if opts.Audience != "" {
+		as.cwHelpMsg("Created post %s restricted to audience %q",
+			summ.ID, strescape.Content(opts.Audience))
+	} else if !opts.Expiration.IsZero() {
+		as.cwHelpMsg("Created post %s expiring at %s", summ.ID,
+			opts.Expiration.Format(ISO8601DateTime))
 	} else {
 		as.cwHelpMsg("Created post %s", summ.ID)
-		as.postsMtx.Lock()
-		as.posts = append(as.posts, summ)
-		as.sortPosts()
-		as.postsMtx.Unlock()
-		as.sendMsg(summ)
+	}
+	as.postsMtx.Lock()
+	as.posts = append(as.posts, summ)
+	as.sortPosts()
+	as.postsMtx.Unlock()
+	as.sendMsg(summ)
+	return nil
+}
+
+// createPaidPost creates a new paid post by the local client. The preview is
+// shared with subscribers and the content is only sent after payment.
+func (as *appState) createPaidPost(preview, content string, root string, priceMAtoms uint64) {
+	// Process local data.
+	preview = resources.RemoveEndOfPostMarker(preview)
+	content = resources.RemoveEndOfPostMarker(content)
+	if root == "" {
+		root, _ = os.Getwd()
+	}
+	preview = resources.ProcessEmbeds(preview, root, as.log)
+	content = resources.ProcessEmbeds(content, root, as.log)
+
+	if strings.TrimSpace(preview) == "" || strings.TrimSpace(content) == "" {
+		as.cwHelpMsg("Preview and content of paid post cannot be empty")
+		return
+	}
+
+	summ, err := as.c.CreatePaidPost(preview, content, "", priceMAtoms)
+	if err != nil {
+		as.cwHelpMsg("Unable to create paid post: %v", err)
+		return
+	}
+
+	as.cwHelpMsg("Created paid post %s (price %.8f DCR)", summ.ID,
+		float64(priceMAtoms)/1e11)
+	as.postsMtx.Lock()
+	as.posts = append(as.posts, summ)
+	as.sortPosts()
+	as.postsMtx.Unlock()
+	as.sendMsg(summ)
+}
+
+// buyPost requests an invoice to unlock the full content of a paid post.
+func (as *appState) buyPost(author clientintf.UserID, pid clientintf.PostID) {
+	if err := as.c.BuyPost(author, pid); err != nil {
+		as.cwHelpMsg("Unable to buy post %s: %v", pid, err)
+		return
+	}
+	as.cwHelpMsg("Requested invoice to unlock post %s", pid)
+}
+
+// editPost creates a new revision of a post by the local client.
+func (as *appState) editPost(pid clientintf.PostID, post string, root string) {
+	// Process local data.
+	post = resources.RemoveEndOfPostMarker(post)
+	if root == "" {
+		root, _ = os.Getwd()
+	}
+	post = resources.ProcessEmbeds(post, root, as.log)
+
+	if strings.TrimSpace(post) == "" {
+		return
+	}
+
+	if _, err := as.c.EditPost(pid, post, ""); err != nil {
+		as.cwHelpMsg("Unable to edit post: %v", err)
+	} else {
+		as.cwHelpMsg("Edited post %s", pid)
 	}
 }
 
+// latestPostContent returns the contents of the latest revision of the given
+// post.
+func (as *appState) latestPostContent(from clientintf.UserID, pid clientintf.PostID) (string, error) {
+	post, err := as.c.ReadPost(from, pid)
+	if err != nil {
+		return "", err
+	}
+	revs, err := as.c.ListPostRevisions(from, pid)
+	if err != nil {
+		return "", err
+	}
+	if len(revs) > 0 {
+		post = clientdb.ApplyPostRevision(post, &revs[len(revs)-1])
+	}
+	return post.Attributes[rpc.RMPMain], nil
+}
+
 func (as *appState) loadPosts() {
 	posts, err := as.c.ListPosts()
 	if err != nil {
@@ -2166,6 +2448,14 @@ func (as *appState) activePost() (rpc.PostMetadata, clientdb.PostSummary,
 	return res, summ, status, myComments
 }
 
+// activePostRevisions returns the revisions of the active post.
+func (as *appState) activePostRevisions() []rpc.PostMetadataStatus {
+	as.postsMtx.Lock()
+	res := as.postRevs
+	as.postsMtx.Unlock()
+	return res
+}
+
 func (as *appState) activatePost(summ *clientdb.PostSummary) {
 	post, err := as.c.ReadPost(summ.From, summ.ID)
 	if err != nil {
@@ -2177,10 +2467,16 @@ func (as *appState) activatePost(summ *clientdb.PostSummary) {
 		as.diagMsg("Cannot load post status: %v", err)
 		return
 	}
+	postRevs, err := as.c.ListPostRevisions(summ.From, summ.ID)
+	if err != nil {
+		as.diagMsg("Cannot load post revisions: %v", err)
+		return
+	}
 	as.postsMtx.Lock()
 	as.post = &post
 	as.postSumm = *summ
 	as.postStatus = postStatus
+	as.postRevs = postRevs
 	delete(as.unreadPosts, summ.ID)
 	as.postsMtx.Unlock()
 }
@@ -2209,6 +2505,35 @@ func (as *appState) commentPost(from clientintf.UserID, pid clientintf.PostID,
 	}
 }
 
+// removePostComment removes a comment from a post by the local client.
+func (as *appState) removePostComment(pid clientintf.PostID, commentID clientintf.ID) {
+	if _, err := as.c.RemovePostComment(pid, commentID); err != nil {
+		as.diagMsg("Unable to remove comment: %v", err)
+	}
+}
+
+// lockPostComments locks or unlocks the comments of a post by the local
+// client.
+func (as *appState) lockPostComments(pid clientintf.PostID, lock bool) {
+	if _, err := as.c.LockPostComments(pid, lock); err != nil {
+		as.diagMsg("Unable to change comments lock: %v", err)
+	}
+}
+
+// moderateHeldComment approves or rejects a comment held for approval.
+func (as *appState) moderateHeldComment(pid clientintf.PostID, id clientintf.ID, approve bool) {
+	var err error
+	if approve {
+		err = as.c.ApproveHeldPostComment(pid, id)
+	} else {
+		err = as.c.RejectHeldPostComment(pid, id)
+	}
+	if err != nil {
+		as.diagMsg("Unable to moderate held comment: %v", err)
+	}
+	as.sendMsg(heldCommentsChanged{pid: pid})
+}
+
 // sortPosts sorts the posts in as.posts. MUST be called with the postsMtx
 // locked.
 func (as *appState) sortPosts() {
@@ -3121,7 +3446,22 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 	var lnPC *client.DcrlnPaymentClient
 	var lnWallet walletrpc.WalletKitClient
 	var lnRouter routerrpc.RouterClient
-	if args.WalletType != "disabled" {
+	if args.WalletType == "remote" {
+		if args.RemotePayURL == "" {
+			return nil, fmt.Errorf("payment.remoteurl must be set for " +
+				"the remote wallet type")
+		}
+		pcCfg := client.RemotePaymentClientCfg{
+			URL:         args.RemotePayURL,
+			TLSCertPath: args.RemotePayCertPath,
+			AuthToken:   args.RemotePayToken,
+			Log:         logBknd.logger("RMPY"),
+		}
+		pc, err = client.NewRemotePaymentClient(ctx, pcCfg)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize remote pay client: %v", err)
+		}
+	} else if args.WalletType != "disabled" {
 		pcCfg := client.DcrlnPaymentClientCfg{
 			TLSCertPath:  args.LNTLSCertPath,
 			MacaroonPath: args.LNMacaroonPath,
@@ -3265,6 +3605,7 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		}
 
 		// Mark post updated.
+		isRevision := rpc.IsPostRevision(status.Attributes)
 		for i := range as.posts {
 			post := &as.posts[i]
 			if postFrom != post.From || pid != post.ID {
@@ -3273,12 +3614,26 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 
 			// Status is for this post.
 			post.LastStatusTS = time.Now()
+			if isRevision {
+				post.LastRevision = clientdb.PostRevisionNumber(&status)
+				post.Title = clientintf.PostTitle(&rpc.PostMetadata{
+					Attributes: status.Attributes,
+				})
+			}
 		}
 
 		if postFrom == as.postSumm.From && pid == as.postSumm.ID {
 			// It's the active post, so store the new
 			// status update.
-			as.postStatus = append(as.postStatus, status)
+			switch {
+			case isRevision:
+				as.postRevs = append(as.postRevs, status)
+			case rpc.IsPostModeration(status.Attributes):
+				as.postStatus = clientdb.RemoveModeratedComments(
+					append(as.postStatus, status))
+			default:
+				as.postStatus = append(as.postStatus, status)
+			}
 		}
 
 		as.unreadPosts[pid] = struct{}{}
@@ -3349,6 +3704,27 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 			"Resetting all KXs", oldConnDate.Format(ISO8601DateTime))
 	}))
 
+	ntfns.Register(client.OnKXPaymentRejected(func(_ *clientintf.RawRVID, public zkidentity.PublicIdentity, err error) {
+		as.diagMsg("Rejected KX with %q ID %s: %v", public.Nick,
+			public.Identity, err)
+	}))
+
+	ntfns.Register(client.OnLNLiquidityActionNtfn(func(a clientdb.LNLiquidityAction) {
+		switch a.Status {
+		case clientdb.LNLiquidityActionProposed:
+			as.diagMsg("LN liquidity action %d proposed: %s of %s (%s). "+
+				"Type /ln liquidity approve %d to perform it",
+				a.ID, a.Type, a.Amount, a.Reason, a.ID)
+		case clientdb.LNLiquidityActionCompleted:
+			as.diagMsg("LN liquidity action %d completed: %s of %s "+
+				"(channel %s, cost %s)", a.ID, a.Type, a.Amount,
+				a.ChannelPoint, a.Cost)
+		case clientdb.LNLiquidityActionFailed:
+			as.diagMsg("LN liquidity action %d failed: %s", a.ID,
+				a.Error)
+		}
+	}))
+
 	ntfns.Register(client.OnKXCompleted(func(_ *clientintf.RawRVID, user *client.RemoteUser, isNew bool) {
 		as.manyDiagMsgsCb(func(pf printf) {
 			if isNew {
@@ -3525,6 +3901,60 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		}
 	}))
 
+	ntfns.Register(client.OnGCTipProgressNtfn(func(gt clientdb.GCTip) {
+		completed, failed, pending, paid := gt.Progress()
+		if gt.Completed == nil && completed+failed == 0 {
+			// Just started. The command that started it already
+			// notified the user.
+			return
+		}
+		cw := as.findOrNewGCWindow(gt.GCID)
+		if gt.Completed != nil {
+			cw.newHelpMsg("Tip of %.8f DCR completed: paid %.8f DCR "+
+				"to %d members, %d failed", float64(gt.MilliAtoms)/1e11,
+				float64(paid)/1e11, completed, failed)
+			as.recheckLNBalance()
+		} else {
+			cw.newHelpMsg("Tip of %.8f DCR in progress: %d paid, "+
+				"%d failed, %d pending", float64(gt.MilliAtoms)/1e11,
+				completed, failed, pending)
+		}
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnOnchainTipNtfn(func(ru *client.RemoteUser, ot clientdb.OnchainTip) {
+		amtStr := fmt.Sprintf("%.8f DCR", float64(ot.MilliAtoms)/1e11)
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		switch {
+		case !ot.Sent && ot.Status == clientdb.OnchainTipUnverified:
+			cw.newHelpMsg("Received receipt of on-chain tip of %s "+
+				"(tx %s). Verifying tx", amtStr, ot.TxID)
+		case !ot.Sent && ot.Status == clientdb.OnchainTipConfirmed:
+			cw.newHelpMsg("Received on-chain tip of %s verified "+
+				"(tx %s)", amtStr, ot.TxID)
+		case !ot.Sent && ot.Status == clientdb.OnchainTipFailed:
+			cw.newHelpMsg("Receipt of on-chain tip of %s is invalid: "+
+				"%s", amtStr, ot.Error)
+		case !ot.Sent:
+			return
+		case ot.Status == clientdb.OnchainTipAddrRequested:
+			cw.newHelpMsg("Unable to pay tip of %s through LN (%s). "+
+				"Requesting on-chain address", amtStr, ot.LNError)
+		case ot.Status == clientdb.OnchainTipSent && ot.TxID != "":
+			cw.newHelpMsg("Sent tip of %s on-chain (tx %s)", amtStr,
+				ot.TxID)
+		case ot.Status == clientdb.OnchainTipConfirmed:
+			cw.newHelpMsg("On-chain tip of %s confirmed (tx %s)",
+				amtStr, ot.TxID)
+		case ot.Status == clientdb.OnchainTipFailed:
+			cw.newHelpMsg("On-chain tip of %s failed: %s", amtStr,
+				ot.Error)
+		default:
+			return
+		}
+		as.repaintIfActive(cw)
+	}))
+
 	ntfns.Register(client.OnBlockNtfn(func(ru *client.RemoteUser) {
 		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
 		cw.newInternalMsg("User requested us to block them from further messages")
@@ -3699,6 +4129,155 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		as.recheckLNBalance()
 	}))
 
+	ntfns.Register(client.OnPaymentRequestReceivedNtfn(func(ru *client.RemoteUser, pr clientdb.PaymentRequest) {
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		memo := ""
+		if pr.Memo != "" {
+			memo = fmt.Sprintf(" for %q", strescape.Content(pr.Memo))
+		}
+		cw.newInternalMsg("Received payment request %s of %.8f DCR%s "+
+			"(expires %s). Use /payreq accept or /payreq decline to reply.",
+			pr.ID.ShortLogID(), float64(pr.MilliAtoms)/1e11, memo,
+			pr.Expires.Format(ISO8601DateTime))
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnPaymentRequestUpdatedNtfn(func(ru *client.RemoteUser, pr clientdb.PaymentRequest) {
+		var msg string
+		switch {
+		case pr.Status == clientdb.PaymentRequestPaid && pr.Sent:
+			msg = "Payment request %s of %.8f DCR was paid"
+		case pr.Status == clientdb.PaymentRequestPaid:
+			msg = "Paid payment request %s of %.8f DCR"
+		case pr.Status == clientdb.PaymentRequestDeclined && pr.Sent:
+			msg = "Payment request %s of %.8f DCR was declined"
+		case pr.Status == clientdb.PaymentRequestExpired:
+			msg = "Payment request %s of %.8f DCR expired"
+		default:
+			return
+		}
+		msg = fmt.Sprintf(msg, pr.ID.ShortLogID(), float64(pr.MilliAtoms)/1e11)
+		if pr.Status == clientdb.PaymentRequestDeclined && pr.Error != "" {
+			msg += ": " + strescape.Content(pr.Error)
+		}
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		cw.newInternalMsg("%s", msg)
+		as.repaintIfActive(cw)
+		if pr.Status == clientdb.PaymentRequestPaid {
+			as.recheckLNBalance()
+		}
+	}))
+
+	ntfns.Register(client.OnRecurringPaymentReceivedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		cw.newInternalMsg("Started recurring payment to you: %s",
+			recurringPaymentStr(&rp, ru.Nick()))
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnRecurringPaymentUpdatedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		cw.newInternalMsg("Recurring payment updated: %s",
+			recurringPaymentStr(&rp, ru.Nick()))
+		as.repaintIfActive(cw)
+		if rp.Paying {
+			as.recheckLNBalance()
+		}
+	}))
+
+	ntfns.Register(client.OnRecurringPaymentLapsedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		cw := as.findOrNewChatWindow(ru.ID(), strescape.Nick(ru.Nick()))
+		cw.newInternalMsg("Recurring payment lapsed: %s",
+			recurringPaymentStr(&rp, ru.Nick()))
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnExchangeRateStaleNtfn(func(lastUpdated time.Time) {
+		msg := "Unable to update the exchange rate"
+		if !lastUpdated.IsZero() {
+			msg += fmt.Sprintf(" since %s", lastUpdated.Format(ISO8601DateTime))
+		}
+		as.diagMsg(as.styles.Load().err.Render(msg))
+	}))
+
+	ntfns.Register(client.OnPaymentBlockedNtfn(func(bp client.BlockedPayment) {
+		as.cwHelpMsg("Payment %d of %.8f DCR (%s) blocked for exceeding "+
+			"budget of %s. Use /budget approve %[1]d or /budget reject %[1]d",
+			bp.ID, float64(bp.MilliAtoms)/1e11, bp.Category, bp.Budget)
+	}))
+
+	ntfns.Register(client.OnPaymentBudgetThresholdNtfn(func(st client.PaymentBudgetStatus) {
+		as.cwHelpMsg("Payments reached %.8f DCR of budget of %s",
+			float64(st.SpentMAtoms)/1e11, st.PaymentBudget)
+	}))
+
+	ntfns.Register(client.OnPostCommentHeldNtfn(func(user *client.RemoteUser, pid clientintf.PostID, status rpc.PostMetadataStatus) {
+		nick := status.Attributes[rpc.RMPFromNick]
+		if user != nil {
+			nick = user.Nick()
+		}
+		as.cwHelpMsg("Comment from %s on post %s held for approval",
+			strescape.Nick(nick), pid)
+		as.sendMsg(heldCommentsChanged{pid: pid})
+	}))
+
+	ntfns.Register(client.OnFeedItemImportedNtfn(func(source string, item clientdb.ImportedFeedItem, summ clientdb.PostSummary) {
+		as.cwHelpMsg("Republished feed item %q as post %s",
+			strescape.Content(summ.Title), summ.ID)
+		as.postsMtx.Lock()
+		as.posts = append(as.posts, summ)
+		as.sortPosts()
+		as.postsMtx.Unlock()
+		as.sendMsg(summ)
+	}))
+
+	ntfns.Register(client.OnScheduledPostPublishedNtfn(func(draft clientdb.PostDraft, summ clientdb.PostSummary, err error) {
+		if err != nil {
+			as.diagMsg("Unable to publish scheduled post draft %s: %v",
+				draft.ID.ShortLogID(), err)
+			return
+		}
+		as.cwHelpMsg("Published scheduled post draft %s as post %s",
+			draft.ID.ShortLogID(), summ.ID)
+		as.postsMtx.Lock()
+		as.posts = append(as.posts, summ)
+		as.sortPosts()
+		as.postsMtx.Unlock()
+		as.sendMsg(summ)
+	}))
+
+	ntfns.Register(client.OnPostExpiredNtfn(func(summ clientdb.PostSummary) {
+		as.postsMtx.Lock()
+		for i := range as.posts {
+			if as.posts[i].ID == summ.ID && as.posts[i].From == summ.From {
+				as.posts = append(as.posts[:i], as.posts[i+1:]...)
+				break
+			}
+		}
+		as.postsMtx.Unlock()
+		as.log.Infof("Removed expired post %s by %s", summ.ID,
+			strescape.Nick(summ.AuthorNick))
+		as.sendMsg(summ)
+	}))
+
+	ntfns.Register(client.OnPostSoldNtfn(func(user *client.RemoteUser, pid clientintf.PostID, amountMAtoms int64) {
+		as.cwHelpMsg("%s paid %.8f DCR to unlock post %s",
+			strescape.Nick(user.Nick()), float64(amountMAtoms)/1e11, pid)
+		as.recheckLNBalance()
+	}))
+
+	ntfns.Register(client.OnPaidPostUnlockedNtfn(func(user *client.RemoteUser, pid clientintf.PostID) {
+		as.cwHelpMsg("Unlocked paid post %s by %s", pid,
+			strescape.Nick(user.Nick()))
+		as.recheckLNBalance()
+		as.sendMsg(paidPostUnlocked{pid: pid})
+	}))
+
+	ntfns.Register(client.OnPaidPostPurchaseFailedNtfn(func(user *client.RemoteUser, pid clientintf.PostID, err error) {
+		as.cwHelpMsg("Unable to pay %s for post %s: %v",
+			strescape.Nick(user.Nick()), pid, err)
+	}))
+
 	ntfns.Register(client.OnPostSubscriberUpdated(func(user *client.RemoteUser, subscribed bool) {
 		cw := as.findChatWindow(user.ID())
 		msg := fmt.Sprintf("%s subscribed to my posts", strescape.Nick(user.Nick()))
@@ -3728,36 +4307,68 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		as.repaintIfActive(cw)
 	}))
 
-	ntfns.Register(client.OnContentListReceived(func(user *client.RemoteUser, files []clientdb.RemoteFile, listErr error) {
-		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+	ntfns.Register(client.OnGCContentListReceived(func(user *client.RemoteUser, gcid client.GCID, files []clientdb.RemoteFile, listErr error) {
+		cw := as.findOrNewGCWindow(gcid)
+		nick := strescape.Nick(user.Nick())
 		if listErr != nil {
-			cw.newInternalMsg("Unable to list user contents: %v", listErr)
+			cw.newInternalMsg("Unable to list files shared by %s: %v",
+				nick, listErr)
 			as.repaintIfActive(cw)
 			return
 		}
+		if len(files) == 0 {
+			return
+		}
 
 		// Store the list of files so we know what to fetch.
-		if len(files) > 0 {
-			as.contentMtx.Lock()
-			userFiles, ok := as.remoteFiles[cw.uid]
-			if !ok {
-				userFiles = make(map[clientdb.FileID]clientdb.RemoteFile, len(files))
-				as.remoteFiles[cw.uid] = userFiles
-			}
+		as.storeRemoteFiles(user.ID(), files)
 
-			for _, rf := range files {
-				userFiles[rf.FID] = rf
+		cw.manyHelpMsgs(func(pf printf) {
+			pf("")
+			pf("Files shared by %s", nick)
+			for _, f := range files {
+				meta := f.Metadata
+				pf("%s - %s (size:%v cost:%0.8f)", f.FID,
+					strescape.Content(meta.Filename), meta.Size,
+					float64(meta.Cost)/1e8)
 			}
+			pf("Use /ft get %s <filename> to fetch", nick)
+		})
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnGCFileSharedNtfn(func(ru *client.RemoteUser, gcid client.GCID, file clientdb.RemoteFile, ts time.Time) {
+		as.storeRemoteFiles(ru.ID(), []clientdb.RemoteFile{file})
 
-			as.contentMtx.Unlock()
+		cw := as.findOrNewGCWindow(gcid)
+		meta := file.Metadata
+		cw.newInternalMsg("%s shared file %q (size:%v cost:%0.8f). "+
+			"Use /ft get %s %s to fetch", strescape.Nick(ru.Nick()),
+			strescape.Content(meta.Filename), meta.Size,
+			float64(meta.Cost)/1e8, strescape.Nick(ru.Nick()), file.FID)
+		as.repaintIfActive(cw)
+	}))
+
+	ntfns.Register(client.OnContentListReceived(func(user *client.RemoteUser, files []clientdb.RemoteFile, listErr error) {
+		cw := as.findOrNewChatWindow(user.ID(), strescape.Nick(user.Nick()))
+		if listErr != nil {
+			cw.newInternalMsg("Unable to list user contents: %v", listErr)
+			as.repaintIfActive(cw)
+			return
 		}
 
+		// Store the list of files so we know what to fetch.
+		as.storeRemoteFiles(cw.uid, files)
+
 		cw.manyHelpMsgs(func(pf printf) {
 			pf("")
 			pf("Received file list")
-			dcrPrice, _ := as.c.Rates().Get()
-			for _, f := range files {
-				meta := f.Metadata
+		})
+		dcrPrice, _ := as.c.Rates().Get()
+		for _, f := range files {
+			meta := f.Metadata
+			info := mediainfo.FromAttributes(meta.Attributes)
+			cw.manyHelpMsgs(func(pf printf) {
 				dcrCost := float64(meta.Cost) / 1e8
 				usdCost := dcrPrice * dcrCost
 
@@ -3772,9 +4383,27 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 				}
 				pf("Hash       : %q", meta.Hash)
 				pf("Signature  : %q", meta.Signature)
-				pf("")
+				if info.MimeType != "" {
+					pf("Type       : %s", strescape.Content(info.MimeType))
+				}
+				if info.Width > 0 && info.Height > 0 {
+					pf("Dimensions : %dx%d", info.Width, info.Height)
+				}
+				if info.Duration > 0 {
+					pf("Duration   : %s", info.Duration.Truncate(time.Second))
+				}
+			})
+			if as.inlineImages && len(info.Thumbnail) > 0 {
+				img, err := mediainfo.DecodeThumbnail(info.Thumbnail)
+				if err != nil {
+					as.diagMsg("Unable to decode thumbnail of %q: %v",
+						strescape.Content(meta.Filename), err)
+				} else {
+					cw.newHelpThumbnail(img)
+				}
 			}
-		})
+			cw.newHelpMsg("")
+		}
 		as.repaintIfActive(cw)
 	}))
 
@@ -4147,6 +4776,11 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		TipUserReRequestInvoiceDelay: args.TipUserReRequestInvoiceDelay,
 		TipUserMaxLifetime:           args.TipUserMaxLifetime,
 		TipUserPayRetryDelayFactor:   args.TipUserPayRetryDelayFactor,
+		TipOnchainFallback:           args.TipUserOnchainFallback,
+		OnchainTipsAccount:           args.TipUserOnchainAccount,
+		KXPaymentMAtoms:              int64(args.KXPaymentPrice) * 1000,
+		KXPaymentRefund:              args.KXPaymentRefund,
+		LNLiquidity:                  args.LNLiquidity,
 
 		SendReceiveReceipts: args.SendRecvReceipts,
 
@@ -4155,6 +4789,23 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		AutoRemoveIdleUsersIgnoreList: args.AutoRemoveIdleUsersIgnore,
 		AutoSubscribeToPosts:          args.AutoSubPosts,
 
+		FeedBridgeSources:  args.FeedBridgeSources,
+		FeedBridgeInterval: args.FeedBridgeInterval,
+
+		PaymentBudgets:             args.PaymentBudgets,
+		PaymentBudgetWarnThreshold: args.PaymentBudgetWarnThreshold,
+
+		RatesProviders:   args.RatesProviders,
+		RatesHistoryFile: args.RatesHistoryFile,
+		RatesStaleAfter:  args.RatesStaleAfter,
+
+		EmbedImage: client.EmbedImageConfig{
+			MaxWidth:     args.EmbedImageMaxDim,
+			MaxHeight:    args.EmbedImageMaxDim,
+			JPEGQuality:  args.EmbedImageQuality,
+			KeepMetadata: !args.EmbedImageStripMetadata,
+		},
+
 		CertConfirmer: func(ctx context.Context, cs *tls.ConnectionState,
 			svrID *zkidentity.PublicIdentity) error {
 			msg := msgConfirmServerCert{
@@ -4445,6 +5096,25 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 				}
 				return nil
 			},
+			OnAcceptPaymentRequest: func(uid clientintf.UserID, amtMAtoms int64) error {
+				if !args.RPCAllowRemoteSendTip {
+					return fmt.Errorf("remote payments not allowed")
+				}
+				dcrAmount := float64(amtMAtoms) / 1e11
+				if args.RPCMaxRemoteSendTipAmt > 0 && dcrAmount > args.RPCMaxRemoteSendTipAmt {
+					return fmt.Errorf("payment exceeds max limit: %v", args.RPCMaxRemoteSendTipAmt)
+				}
+				return nil
+			},
+			OnCreateRecurringPayment: func(uid clientintf.UserID, dcrAmount float64) error {
+				if !args.RPCAllowRemoteSendTip {
+					return fmt.Errorf("remote payments not allowed")
+				}
+				if args.RPCMaxRemoteSendTipAmt > 0 && dcrAmount > args.RPCMaxRemoteSendTipAmt {
+					return fmt.Errorf("payment exceeds max limit: %v", args.RPCMaxRemoteSendTipAmt)
+				}
+				return nil
+			},
 		}
 		err = rpcServer.InitPaymentsService(payRPCServerCfg)
 		if err != nil {
@@ -4484,6 +5154,20 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		}
 	}
 
+	// Bind the posts feed before the upstream provider, which handles every
+	// path.
+	var postsFeed *postsfeed.Provider
+	if args.PostsFeedEnable {
+		postsFeed = postsfeed.New(postsfeed.Config{
+			Client:  c,
+			Log:     logBknd.logger("FEED"),
+			Title:   args.PostsFeedTitle,
+			BaseURL: args.PostsFeedBaseURL,
+			Authors: args.PostsFeedAuthors,
+		})
+		resRouter.BindPrefixPath(postsFeed.Prefix(), postsFeed)
+	}
+
 	// Bind the selected upstream resource provider.
 	switch {
 	case strings.HasPrefix(args.ResourcesUpstream, "http://"),
@@ -4544,6 +5228,7 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		log:         logBknd.logger("ZTUI"),
 		lndLogLines: lndLogLines,
 		serverAddr:  args.ServerAddr,
+		pc:          pc,
 		lnPC:        lnPC,
 		lnRPC:       lnRPC,
 		lnWallet:    lnWallet,
@@ -4585,6 +5270,7 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		inviteFundsAccount: args.InviteFundsAccount,
 
 		releaseTermOnViewEmbed: args.ReleaseTermOnViewEmbed,
+		inlineImages:           args.InlineImages && termSupportsInlineImages(),
 
 		collator: cfg.Collator,
 
@@ -4598,6 +5284,8 @@ func newAppState(sendMsg func(tea.Msg), lndLogLines *sloglinesbuffer.Buffer,
 		payReqStatuses: xsync.NewMapOf[chainhash.Hash, lnrpc.Payment_PaymentStatus](),
 
 		sstore:       sstore,
+		postsFeed:    postsFeed,
+		feedHTTPAddr: args.PostsFeedHTTPAddr,
 		ssPayType:    args.SimpleStorePayType,
 		ssAcct:       args.SimpleStoreAccount,
 		ssShipCharge: args.SimpleStoreShipCharge,
@@ -55,6 +55,19 @@ root = {{ .Root }}
 # embedded resources (images, etc).
 # releasetermonviewembed = false
 
+# Whether to render thumbnails of images (for example, in file listings).
+# Thumbnails are only rendered when the terminal supports at least 256 colors
+# (ANSI256 or TrueColor color profiles).
+# inlineimages = true
+
+# Images embedded in messages and posts are resized to fit the max dimensions
+# (in pixels) and JPEG images are re-encoded with the given quality (1-100).
+# Set embedimagemaxdim to -1 to disable resizing. Set embedimagestripmetadata
+# to false to keep metadata (EXIF, location, etc) in embedded images.
+# embedimagemaxdim = 1920
+# embedimagequality = 85
+# embedimagestripmetadata = true
+
 # Set whether to read chat logs to build chat history
 # noloadchathistory = false
 
@@ -134,8 +147,9 @@ blinkcursor = true
 [payment]
 
 # Type of ln wallet to use. Either "internal" (for an embedded wallet),
-# "external" (to connect to an already running LN wallet) or "disabled" to
-# disable payments (server must support sending msgs for free).
+# "external" (to connect to an already running LN wallet), "remote" (to send
+# payments through a remote payment server) or "disabled" to disable payments
+# (server must support sending msgs for free).
 wallettype = {{ .WalletType }}
 
 # The next parameters are set when using an internal (embedded) LN wallet,
@@ -173,6 +187,14 @@ lnmacaroonpath = {{ .LNMacaroonPath }}
 # lnmacaroonpath = ~/.dcrlnd/data/chain/decred/mainnet/admin.macaroon
 {{ end }}
 
+# The next parameters are used when the wallet type is "remote". Payments are
+# then delegated to the remote payment server at the given URL, which is
+# authenticated with the given TLS cert (if not using a certificate signed by
+# a system CA). The token is sent in every request.
+# remoteurl = https://127.0.0.1:9130
+# remotecert = ~/.brclient/remotepay.cert
+# remotetoken =
+
 # Log Level of the internal dcrlnd
 # lndebuglevel = info
 
@@ -240,10 +262,113 @@ minimumsendbalance = 0.01
 # cover shipping and handling.
 # shipcharge = 0.0
 
+[postsfeed]
+# Offer the posts of the local client as Atom and RSS feeds and as markdown and
+# HTML pages under the /feed path of the pages subsystem.
+# enable = false
+
+# Title of the feed. Defaults to "<nick>'s posts".
+# title =
+
+# Comma separated list of nicks or ids of users whose posts (received through
+# subscriptions) are also included in the feed.
+# authors =
+
+# Address of an HTTP server that serves the feed outside of Bison Relay. The
+# feed is only served over HTTP if this is set.
+# httpaddr = 127.0.0.1:8080
+
+# External URL under which the HTTP server is reachable. Used to generate
+# absolute links in the feeds.
+# baseurl = https://example.com/feed
+
+[feedbridge]
+# Comma separated list of external RSS or Atom feeds (HTTP(S) URLs or local
+# file paths) whose new items are republished as posts of the local client.
+# sources = https://example.com/feed.xml,/path/to/feed.xml
+
+# How often to poll the feeds.
+# interval = 30m
+
+[rates]
+
+# Comma separated list of exchange rate providers. The exchange rate is the
+# median of the rates returned by the providers. Providers are one of dcrapi
+# (api.decred.org), dcrdata, static:<dcr usd>[:<btc usd>] (a fixed rate) or
+# file:<path> (a JSON file with dcr_usd, btc_usd and time fields, useful for
+# air-gapped nodes). Defaults to dcrapi,dcrdata.
+# providers = dcrapi,dcrdata
+
+# Max age of the rates read from file providers.
+# filemaxage = 24h
+
+# Duration after which a warning is shown if the exchange rates could not be
+# updated.
+# staleafter = 1h
+
+[budget]
+
+# Limits on the amount spent by automatic payments, as a comma separated list
+# of <category>:<window>:<max dcr> entries. The category is one of serverfees,
+# downloads, tips, rtdt, other or all (to limit all payments). Payments that
+# would exceed a limit are blocked until approved with /budget approve.
+# limits = serverfees:24h:0.01,tips:168h:0.5
+
+# Fraction of a limit that must be spent before a warning is shown.
+# warnthreshold = 0.8
+
+[kxpayment]
+# Amount (in DCR) that remote users must pay to complete a KX using invites
+# created by this client. Remote users that do not pay are automatically
+# rejected. Invites for KXs mediated by existing contacts do not require a
+# payment. Set to zero to disable.
+# price = 0
+
+# Whether to refund (as a tip) the KX payment after the KX completes.
+# refund = false
+
+[lnliquidity]
+# Whether to automatically manage the liquidity of the LN wallet. When enabled,
+# the channel balances are checked periodically and, when below the minimums,
+# actions are proposed to request inbound channels from the liquidity provider
+# or to open outbound channels to the hub. Proposed actions are listed with
+# /ln liquidity and must be approved with /ln liquidity approve, unless
+# autoperform is set.
+# enable = false
+
+# Minimum inbound (receive) capacity (in DCR) and size of the inbound channels
+# requested when the capacity is lower than the minimum.
+# mininbound = 0
+# inboundchansize = 0
+
+# Minimum outbound (send) capacity (in DCR) and size of the outbound channels
+# opened when the capacity is lower than the minimum.
+# minoutbound = 0
+# outboundchansize = 0
+
+# Max amount (in DCR) spent on liquidity (fees to the liquidity provider and
+# funds of opened channels) within the budget window.
+# budget = 0
+# budgetwindow = 720h
+
+# Whether to perform actions without requiring approval.
+# autoperform = false
+
+# How often to check the channel balances.
+# checkinterval = 10m
+
 [tipuser]
 # restartdelay = 1m
 # rerequestinvoicedelay=24h
 # maxlifetime=72h
 # payretrydelayfactor=12s
+
+# When enabled, tips that could not be paid through LN are sent on-chain to an
+# address requested from the payee.
+# onchainfallback = false
+
+# Account used to generate the addresses given to users that send tips
+# on-chain. If empty, the default account is used.
+# onchainaccount =
 `
 )
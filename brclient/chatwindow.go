@@ -3,6 +3,8 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"image"
+	"image/color"
 	"regexp"
 	"strconv"
 	"strings"
@@ -18,6 +20,7 @@ import (
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/internal/mediainfo"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -34,6 +37,9 @@ var (
 	simnetParams  = chaincfg.SimNetParams()
 )
 
+// thumbnailMaxCols is the max number of columns used to render thumbnails.
+const thumbnailMaxCols = 32
+
 type formField struct {
 	typ       string
 	name      string
@@ -239,6 +245,7 @@ type chatMsgEl struct {
 	link      *string
 	form      *formEl
 	formField *formField
+	thumbnail image.Image
 }
 
 type chatMsgElLine struct {
@@ -573,6 +580,21 @@ func (cw *chatWindow) manyHelpMsgs(f func(printf)) {
 	cw.Unlock()
 }
 
+// newHelpThumbnail adds a help message that renders the given image as a
+// thumbnail.
+func (cw *chatWindow) newHelpThumbnail(img image.Image) {
+	line := &chatMsgElLine{}
+	line.PushBack(chatMsgEl{thumbnail: img})
+	m := &chatMsg{
+		help:     true,
+		elements: []*chatMsgElLine{line},
+		ts:       time.Now(),
+	}
+	cw.Lock()
+	cw.msgs = append(cw.msgs, m)
+	cw.Unlock()
+}
+
 func (cw *chatWindow) newHelpMsg(f string, args ...interface{}) {
 	cw.manyHelpMsgs(func(pf printf) {
 		pf(f, args...)
@@ -802,6 +824,40 @@ func writeWrappedWithStyle(b *strings.Builder, offset, winW int, style lipgloss.
 	return offset
 }
 
+// writeThumbnail writes the image as a block of colored half-block characters,
+// where each character cell renders two vertically stacked pixels. Lines after
+// the first one are indented to the passed offset.
+func writeThumbnail(b *strings.Builder, offset, winW int, img image.Image) int {
+	maxW := thumbnailMaxCols
+	if winW-offset < maxW {
+		maxW = winW - offset
+	}
+	if maxW <= 0 {
+		return offset
+	}
+	img = mediainfo.Resize(img, maxW, thumbnailMaxCols)
+	bounds := img.Bounds()
+	indent := strings.Repeat(" ", offset)
+	toColor := func(c color.Color) lipgloss.Color {
+		nc := color.NRGBAModel.Convert(c).(color.NRGBA)
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", nc.R, nc.G, nc.B))
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		if y > bounds.Min.Y {
+			b.WriteRune('\n')
+			b.WriteString(indent)
+		}
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			style := lipgloss.NewStyle().Foreground(toColor(img.At(x, y)))
+			if y+1 < bounds.Max.Y {
+				style = style.Background(toColor(img.At(x, y+1)))
+			}
+			b.WriteString(style.Render("\u2580"))
+		}
+	}
+	return offset + bounds.Dx()
+}
+
 func writeWrappedURL(b *strings.Builder, offset, winW int, url string) int {
 	if offset+len(url) > winW {
 		b.WriteRune('\n')
@@ -908,6 +964,8 @@ func (cw *chatWindow) renderMsgElements(winW int, as *appState, elements []*chat
 				offset = writePayReq(b, offset, winW, el.payReq, style, as)
 			} else if el.url != nil {
 				offset = writeWrappedURL(b, offset, winW, *el.url)
+			} else if el.thumbnail != nil {
+				offset = writeThumbnail(b, offset, winW, el.thumbnail)
 			} else if el.formField != nil && el.formField.label != "" {
 				style := styles.embed
 				if cw.maxSelectable == cw.selElIndex {
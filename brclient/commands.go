@@ -32,6 +32,7 @@ import (
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/mitchellh/go-homedir"
 	"github.com/skip2/go-qrcode"
+	strduration "github.com/xhit/go-str2duration/v2"
 	"golang.org/x/exp/slices"
 )
 
@@ -177,6 +178,19 @@ func gcCompleter(arg string, as *appState) []string {
 
 // rtdtSessionCompleter returns completions for RTDT sessions that have a given
 // prefix.
+// gcTipSplitModeCompleter completes the modes of splitting GC tips.
+func gcTipSplitModeCompleter(arg string) []string {
+	modes := []clientdb.GCTipSplitMode{clientdb.GCTipSplitEqual,
+		clientdb.GCTipSplitShares, clientdb.GCTipSplitAdmins}
+	var res []string
+	for _, mode := range modes {
+		if strings.HasPrefix(string(mode), arg) {
+			res = append(res, string(mode))
+		}
+	}
+	return res
+}
+
 func rtdtSessionCompleter(arg string, as *appState) []string {
 	ids := as.c.ListRTDTSessions()
 	res := make([]string, 0, len(ids))
@@ -189,6 +203,197 @@ func rtdtSessionCompleter(arg string, as *appState) []string {
 	return res
 }
 
+// postAudienceCompleter returns completions for post audiences that have a
+// given prefix.
+func postAudienceCompleter(arg string, as *appState) []string {
+	audiences, _ := as.c.ListPostAudiences()
+	res := make([]string, 0, len(audiences))
+	for i := range audiences {
+		if strings.HasPrefix(audiences[i].Name, arg) {
+			res = append(res, audiences[i].Name)
+		}
+	}
+	as.collator.SortStrings(res)
+	return res
+}
+
+func postTagCompleter(arg string, as *appState) []string {
+	tags, _ := as.c.ListPostTags()
+	res := make([]string, 0, len(tags))
+	for tag := range tags {
+		if strings.HasPrefix(tag, arg) {
+			res = append(res, tag)
+		}
+	}
+	as.collator.SortStrings(res)
+	return res
+}
+
+func postDraftCompleter(arg string, as *appState) []string {
+	drafts, _ := as.c.ListPostDrafts()
+	res := make([]string, 0, len(drafts))
+	for _, draft := range drafts {
+		id := draft.ID.ShortLogID()
+		if strings.HasPrefix(id, arg) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// postDraftByPrefix returns the post draft with the given ID prefix.
+func postDraftByPrefix(prefix string, as *appState) (clientdb.PostDraft, error) {
+	drafts, err := as.c.ListPostDrafts()
+	if err != nil {
+		return clientdb.PostDraft{}, err
+	}
+	var res []clientdb.PostDraft
+	for _, draft := range drafts {
+		if strings.HasPrefix(draft.ID.String(), prefix) {
+			res = append(res, draft)
+		}
+	}
+	switch {
+	case len(res) == 0:
+		return clientdb.PostDraft{}, fmt.Errorf("no post draft with id %q", prefix)
+	case len(res) > 1:
+		return clientdb.PostDraft{}, fmt.Errorf("id %q matches %d post drafts", prefix, len(res))
+	default:
+		return res[0], nil
+	}
+}
+
+// paymentRequestCompleter completes the IDs of the pending payment requests
+// received from remote users.
+func paymentRequestCompleter(arg string, as *appState) []string {
+	prs, _ := as.c.ListPaymentRequests()
+	res := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		id := pr.ID.ShortLogID()
+		if !pr.Sent && pr.Status == clientdb.PaymentRequestPending &&
+			strings.HasPrefix(id, arg) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// paymentRequestByPrefix returns the payment request received from a remote
+// user with the given ID prefix.
+func paymentRequestByPrefix(prefix string, as *appState) (clientdb.PaymentRequest, error) {
+	prs, err := as.c.ListPaymentRequests()
+	if err != nil {
+		return clientdb.PaymentRequest{}, err
+	}
+	var res []clientdb.PaymentRequest
+	for _, pr := range prs {
+		if !pr.Sent && strings.HasPrefix(pr.ID.String(), prefix) {
+			res = append(res, pr)
+		}
+	}
+	switch {
+	case len(res) == 0:
+		return clientdb.PaymentRequest{}, fmt.Errorf("no received payment request with id %q", prefix)
+	case len(res) > 1:
+		return clientdb.PaymentRequest{}, fmt.Errorf("id %q matches %d payment requests", prefix, len(res))
+	default:
+		return res[0], nil
+	}
+}
+
+// recurringPaymentCompleter completes the IDs of the active recurring
+// payments.
+func recurringPaymentCompleter(arg string, as *appState) []string {
+	rps, _ := as.c.ListRecurringPayments()
+	res := make([]string, 0, len(rps))
+	for _, rp := range rps {
+		id := rp.ID.ShortLogID()
+		if rp.Status == clientdb.RecurringPaymentActive && strings.HasPrefix(id, arg) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// recurringPaymentByPrefix returns the recurring payment with the given ID
+// prefix.
+func recurringPaymentByPrefix(prefix string, as *appState) (clientdb.RecurringPayment, error) {
+	rps, err := as.c.ListRecurringPayments()
+	if err != nil {
+		return clientdb.RecurringPayment{}, err
+	}
+	var res []clientdb.RecurringPayment
+	for _, rp := range rps {
+		if strings.HasPrefix(rp.ID.String(), prefix) {
+			res = append(res, rp)
+		}
+	}
+	switch {
+	case len(res) == 0:
+		return clientdb.RecurringPayment{}, fmt.Errorf("no recurring payment with id %q", prefix)
+	case len(res) > 1:
+		return clientdb.RecurringPayment{}, fmt.Errorf("id %q matches %d recurring payments", prefix, len(res))
+	default:
+		return res[0], nil
+	}
+}
+
+// recurringPaymentStr returns a one line description of a recurring payment.
+func recurringPaymentStr(rp *clientdb.RecurringPayment, nick string) string {
+	dir := "from"
+	if rp.Paying {
+		dir = "to"
+	}
+	periods := fmt.Sprintf("%d", rp.PeriodsPaid)
+	if rp.MaxPeriods > 0 {
+		periods += fmt.Sprintf("/%d", rp.MaxPeriods)
+	}
+	tier := ""
+	if rp.Tier != "" {
+		tier = fmt.Sprintf(" tier %q", strescape.Content(rp.Tier))
+	}
+	status := string(rp.Status)
+	if rp.Status == clientdb.RecurringPaymentActive {
+		status += ", next " + rp.NextPayment.Format(ISO8601DateTime)
+	}
+	if rp.Error != "" {
+		status += ": " + strescape.Content(rp.Error)
+	}
+	return fmt.Sprintf("%s %.8f DCR every %s %s %s%s, %s periods paid (%s)",
+		rp.ID.ShortLogID(), float64(rp.MilliAtoms)/1e11, rp.Period, dir,
+		strescape.Nick(nick), tier, periods, status)
+}
+
+// parsePublishTime parses the time to publish a scheduled post. It may be
+// specified as a duration from now or as a local date and time.
+func parsePublishTime(s string) (time.Time, error) {
+	if d, err := strduration.ParseDuration(s); err == nil {
+		return time.Now().Add(d), nil
+	}
+	layouts := []string{ISO8601DateTime, "2006-01-02 15:04", time.RFC3339}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// parseLedgerInterval parses the optional start and end dates of an interval
+// of the payment ledger.
+func parseLedgerInterval(args []string) (start, end time.Time, err error) {
+	if len(args) > 0 {
+		start, err = time.ParseInLocation(ISO8601Date, args[0], time.Local)
+		if err != nil {
+			return
+		}
+	}
+	if len(args) > 1 {
+		end, err = time.ParseInLocation(ISO8601Date, args[1], time.Local)
+	}
+	return
+}
+
 // subcmdNeededHandler is used on top-level commands that only work with a
 // subcommand.
 func subcmdNeededHandler(args []string, _ *appState) error {
@@ -213,10 +418,48 @@ var listCommands = []tuicmd{
 	{
 		cmd:           "exchangerate",
 		usableOffline: true,
+		usage:         "[history [<days>]]",
 		descr:         "Display the current exchange rates",
+		long: []string{
+			"The history subcommand lists the exchange rates fetched in the last days (by default, 1 day).",
+		},
 		handler: func(args []string, as *appState) error {
-			dcrPrice, btcPrice := as.c.Rates().Get()
+			r := as.c.Rates()
+			if len(args) > 0 && args[0] == "history" {
+				days := 1
+				if len(args) > 1 {
+					var err error
+					days, err = strconv.Atoi(args[1])
+					if err != nil {
+						return err
+					}
+				}
+				start := time.Now().AddDate(0, 0, -days)
+				hist, err := r.History(start, time.Time{})
+				if err != nil {
+					return err
+				}
+				as.cwHelpMsgs(func(pf printf) {
+					pf("Exchange rates (USD/coin)")
+					for _, rate := range hist {
+						pf("%s  DCR: %.2f\tBTC: %.2f",
+							rate.Time.Format(ISO8601DateTime),
+							rate.DCRPrice, rate.BTCPrice)
+					}
+				})
+				return nil
+			}
+
+			dcrPrice, btcPrice := r.Get()
 			as.cwHelpMsg(fmt.Sprintf("DCR: %.2f\tBTC: %.2f\t (USD/coin)", dcrPrice, btcPrice))
+			if lastUpdated := r.LastUpdated(); !lastUpdated.IsZero() {
+				stale := ""
+				if r.IsStale() {
+					stale = " (stale)"
+				}
+				as.cwHelpMsg("Last updated: %s%s",
+					lastUpdated.Format(ISO8601DateTime), stale)
+			}
 			return nil
 		},
 	}, {
@@ -382,13 +625,17 @@ var listCommands = []tuicmd{
 						nonGlobal = " [privately shared]"
 					}
 					pf("%s - %s%s (size:%v cost:%0.8f)", f.SF.FID, f.SF.Filename, nonGlobal, f.Size, float64(f.Cost)/1e8)
-					if len(f.Shares) > 0 {
+					if len(f.Shares) > 0 || len(f.GCShares) > 0 {
 						pf("Shared with")
 					}
 					for _, id := range f.Shares {
 						nick, _ := as.c.UserNick(id)
 						pf("  %s - %q", id, nick)
 					}
+					for _, id := range f.GCShares {
+						gcName, _ := as.c.GetGCAlias(id)
+						pf("  %s - GC %q", id, gcName)
+					}
 				}
 			})
 
@@ -432,9 +679,82 @@ var listCommands = []tuicmd{
 	}, {
 		cmd:           "paystats",
 		usableOffline: true,
-		usage:         "[<nick or user id>]",
+		usage:         "[<nick or user id> | summary [day|week|month] [<start date>] [<end date>] | export <csv|json> <filename> [<start date>] [<end date>]]",
 		descr:         "List payment stats globally or for a specific user",
+		long: []string{
+			"The summary and export subcommands use the itemized payment ledger. Summary lists the totals of the payments made and received per period (by default, per month). Export writes the ledger entries to a CSV or JSON file.",
+			"Dates are specified as YYYY-MM-DD and the end date is exclusive.",
+		},
 		handler: func(args []string, as *appState) error {
+			if len(args) > 0 && args[0] == "summary" {
+				period := client.LedgerPeriodMonth
+				if len(args) > 1 {
+					period = client.LedgerPeriod(args[1])
+				}
+				start, end, err := parseLedgerInterval(args[min(len(args), 2):])
+				if err != nil {
+					return err
+				}
+				summaries, err := as.c.SummarizeLedger(start, end, period)
+				if err != nil {
+					return err
+				}
+				as.cwHelpMsgs(func(pf printf) {
+					pf("")
+					pf("Payment Ledger Summary (per %s)", period)
+					if len(summaries) == 0 {
+						pf("No ledger entries")
+						return
+					}
+					pf("Start              Recv          Sent          Fees (DCR)   Recv      Sent (USD)")
+					for _, s := range summaries {
+						pf("%s  %12.8f  %12.8f  %12.8f  %8.2f  %8.2f",
+							s.Start.Format(ISO8601Date),
+							float64(s.Received)/1e11,
+							float64(s.Sent)/1e11,
+							float64(s.Fees)/1e11,
+							s.ReceivedUSD, s.SentUSD)
+						for _, cat := range clientintf.PaymentCategories {
+							if total, ok := s.Categories[cat]; ok {
+								pf("  %+12.8f %s", float64(total)/1e11, cat)
+							}
+						}
+					}
+				})
+				return nil
+			}
+
+			if len(args) > 0 && args[0] == "export" {
+				if len(args) < 2 {
+					return usageError{msg: "format cannot be empty"}
+				}
+				if len(args) < 3 {
+					return usageError{msg: "filename cannot be empty"}
+				}
+				format := client.LedgerExportFormat(args[1])
+				start, end, err := parseLedgerInterval(args[3:])
+				if err != nil {
+					return err
+				}
+				filename, err := homedir.Expand(args[2])
+				if err != nil {
+					return err
+				}
+				f, err := os.Create(filename)
+				if err != nil {
+					return err
+				}
+				err = as.c.ExportLedger(f, format, start, end)
+				if closeErr := f.Close(); err == nil {
+					err = closeErr
+				}
+				if err != nil {
+					return err
+				}
+				as.cwHelpMsg("Exported payment ledger to %s", filename)
+				return nil
+			}
+
 			if len(args) == 0 {
 				stats, err := as.c.ListPaymentStats()
 				if err != nil {
@@ -602,7 +922,7 @@ var listCommands = []tuicmd{
 var inviteCommands = []tuicmd{
 	{
 		cmd:   "accept",
-		usage: "<filename> [ignoreFunds]",
+		usage: "<filename> [ignoreFunds] [payKX]",
 		descr: "Accept the invite in the given file",
 		completer: func(args []string, arg string, as *appState) []string {
 			return fileCompleter(arg)
@@ -611,9 +931,14 @@ var inviteCommands = []tuicmd{
 			if len(args) < 1 {
 				return usageError{msg: "filename must be specified"}
 			}
-			var ignoreFunds bool
-			if len(args) > 1 && args[1] == "ignorefunds" {
-				ignoreFunds = true
+			var ignoreFunds, payKX bool
+			for _, arg := range args[1:] {
+				switch strings.ToLower(arg) {
+				case "ignorefunds":
+					ignoreFunds = true
+				case "paykx":
+					payKX = true
+				}
 			}
 
 			filename, err := homedir.Expand(args[0])
@@ -644,6 +969,17 @@ var inviteCommands = []tuicmd{
 				})
 				return nil
 			}
+			if pii.KXPaymentMAtoms > 0 && !payKX {
+				as.cwHelpMsgs(func(pf printf) {
+					pf("")
+					pf("Invitation from peer requires a payment to complete the KX")
+					pf("Nick: %q", pii.Public.Nick)
+					pf("Amount: %.8f DCR", float64(pii.KXPaymentMAtoms)/1e11)
+					pf("Type '/invite accept %s paykx' to accept the invite "+
+						"and pay for the KX", strings.Join(args, " "))
+				})
+				return nil
+			}
 			as.cwHelpMsgs(func(pf printf) {
 				pf("")
 				pf("Adding invitation to peer")
@@ -652,7 +988,11 @@ var inviteCommands = []tuicmd{
 				pf("ID: %s", pii.Public.Identity)
 			})
 			go func() {
-				err := as.c.AcceptInvite(pii)
+				var maxKXPayment int64
+				if payKX {
+					maxKXPayment = pii.KXPaymentMAtoms
+				}
+				err := as.c.AcceptInvite(pii, maxKXPayment)
 				if err != nil {
 					as.cwHelpMsg("Unable to accept invite: %v", err)
 				}
@@ -1325,6 +1665,158 @@ var gcCommands = []tuicmd{
 			}
 			return nil
 		},
+	}, {
+		cmd:           "share",
+		usableOffline: true,
+		usage:         "<gc> <filename> <cost>",
+		descr:         "Share the given file with the members of the GC",
+		long: []string{
+			"Imports the passed file into the local FTP repository and shares it with every current and future member of the GC. The cost is specified in DCR.",
+			"Members that are kicked or that part from the GC lose access to the file.",
+			"By default, the passed cost is *added* to the estimated upload cost. Use \"=<amount>\" to directly specify the full cost",
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "gc name cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+			if len(args) < 3 || len(args[2]) < 1 {
+				return usageError{msg: "cost cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			filename, err := homedir.Expand(args[1])
+			if err != nil {
+				return err
+			}
+			dcrCost, dcrUploadCost, err := fileShareCost(as, filename, args[2])
+			if err != nil {
+				return err
+			}
+
+			gcWin := as.findOrNewGCWindow(gcID)
+			atomCost := uint64(dcrCost * 1e8)
+			go func() {
+				sf, _, err := as.c.ShareFileWithGC(filename, gcID, atomCost, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to share file with GC %q: %v",
+						gcWin.alias, err)
+					return
+				}
+				gcWin.newInternalMsg("Shared file %q for %.8f DCR "+
+					"(est. cost %.8f DCR). FID: %s", sf.Filename,
+					dcrCost, dcrUploadCost, sf.FID)
+				as.repaintIfActive(gcWin)
+			}()
+			return nil
+		},
+	}, {
+		cmd:           "unshare",
+		usableOffline: true,
+		usage:         "<gc> <file>",
+		descr:         "Stop sharing a file with the members of the GC",
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nil
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "gc name cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "file cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+
+			var fid zkidentity.ShortID
+			if err := fid.FromString(args[1]); err != nil {
+				// Try to find the named file.
+				files, err := as.c.ListGCSharedFiles(gcID)
+				if err != nil {
+					return err
+				}
+				for _, f := range files {
+					if f.Filename == args[1] {
+						fid = f.MetadataHash()
+						break
+					}
+				}
+				if fid.IsEmpty() {
+					return fmt.Errorf("could not find file %q "+
+						"shared with GC", args[1])
+				}
+			}
+
+			if err := as.c.UnshareFileWithGC(fid, gcID); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Unshared file %s from GC %q", fid, args[0])
+			return nil
+		},
+	}, {
+		cmd:     "files",
+		usage:   "<gc> [<filename_regex>]",
+		aliases: []string{"ls"},
+		descr:   "List files shared with the GC by its members",
+		long: []string{
+			"Files shared by the local client are listed immediately, while the list of files shared by remote members is received asynchronously.",
+			"Files may be fetched from their owner with the /ft get command.",
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nil
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "gc name cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			var filter string
+			if len(args) > 1 {
+				filter = args[1]
+			}
+
+			files, err := as.c.ListGCSharedFiles(gcID)
+			if err != nil {
+				return err
+			}
+			gcWin := as.findOrNewGCWindow(gcID)
+			gcWin.manyHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Files shared by local client")
+				for _, f := range files {
+					pf("%x - %s (size:%v cost:%0.8f)", f.MetadataHash(),
+						strescape.Content(f.Filename), f.Size,
+						float64(f.Cost)/1e8)
+				}
+			})
+			as.repaintIfActive(gcWin)
+			go as.listGCContent(gcWin, filter)
+			return nil
+		},
 	}, {
 		cmd:           "ignore",
 		usableOffline: true,
@@ -1644,7 +2136,125 @@ var gcCommands = []tuicmd{
 			return nil
 		},
 	}, {
-		cmd:           "listinvites",
+		cmd:   "tip",
+		usage: "<gc> <dcr amount> [equal|shares|admins]",
+		descr: "Tip the members of a GC, splitting the amount among them",
+		long: []string{
+			"The amount is split equally among all members (equal, the default), weighted by the tip shares defined by the GC admins (shares) or equally among the GC admins (admins).",
+			"One tip attempt is started for each member. Members that are not known users are not tipped.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "GC cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "amount cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			dcrAmount, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return usageError{msg: fmt.Sprintf("invalid amount: %v", err)}
+			}
+			mode := clientdb.GCTipSplitEqual
+			if len(args) > 2 {
+				mode = clientdb.GCTipSplitMode(args[2])
+			}
+
+			const maxAttempts = 3
+			gt, err := as.c.TipGroupChat(gcID, dcrAmount, mode, maxAttempts)
+			if err != nil {
+				return err
+			}
+			cw := as.findOrNewGCWindow(gcID)
+			cw.newHelpMsg("Started tip of %.8f DCR to %d members (split: %s)",
+				float64(gt.MilliAtoms)/1e11, len(gt.Members), gt.SplitMode)
+			as.repaintIfActive(cw)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			if len(args) == 2 {
+				return gcTipSplitModeCompleter(arg)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "tipshares",
+		usage: "<gc> [<nick>:<share>...]",
+		descr: "Show or set the shares used to split tips to a GC",
+		long: []string{
+			"Without shares, show the current tip shares of the GC. Otherwise, replace the tip shares of the GC with the specified ones. Only GC admins may set the tip shares.",
+			"Tips split by shares send to each member an amount proportional to their share. Members without a share are not tipped.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "GC cannot be empty"}
+			}
+			gcID, err := as.c.GCIDByName(args[0])
+			if err != nil {
+				return err
+			}
+			cw := as.findOrNewGCWindow(gcID)
+
+			if len(args) == 1 {
+				gc, err := as.c.GetGC(gcID)
+				if err != nil {
+					return err
+				}
+				if len(gc.TipShares) == 0 {
+					cw.newHelpMsg("GC has no tip shares")
+				}
+				for _, share := range gc.TipShares {
+					nick, _ := as.c.UserNick(share.UID)
+					if share.UID == as.c.PublicID() {
+						nick = as.c.LocalNick()
+					}
+					cw.newHelpMsg("Tip share of %s: %d",
+						strescape.Nick(nick), share.Share)
+				}
+				as.repaintIfActive(cw)
+				return nil
+			}
+
+			shares := make([]rpc.RMGroupTipShare, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				nick, shareStr, ok := strings.Cut(arg, ":")
+				if !ok {
+					return usageError{msg: fmt.Sprintf("share %q is not in the format <nick>:<share>", arg)}
+				}
+				share, err := strconv.ParseUint(shareStr, 10, 32)
+				if err != nil {
+					return usageError{msg: fmt.Sprintf("invalid share %q: %v", shareStr, err)}
+				}
+				uid := as.c.PublicID()
+				if nick != as.c.LocalNick() {
+					if uid, err = as.c.UIDByNick(nick); err != nil {
+						return err
+					}
+				}
+				shares = append(shares, rpc.RMGroupTipShare{UID: uid,
+					Share: uint32(share)})
+			}
+			if err := as.c.SetGCTipShares(gcID, shares); err != nil {
+				return err
+			}
+			cw.newHelpMsg("Updated tip shares of GC")
+			as.repaintIfActive(cw)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return gcCompleter(arg, as)
+			}
+			return nickCompleter(arg, as)
+		},
+	}, {
+		cmd:           "listinvites",
 		aliases:       []string{"lsinvites"},
 		usableOffline: true,
 		descr:         "Show outstanding invites to GCs received by the client",
@@ -1718,6 +2328,41 @@ var gcCommands = []tuicmd{
 	},
 }
 
+// fileShareCost returns the cost (in DCR) to charge for a file being shared,
+// given the cost argument passed to a share command, along with the estimated
+// cost to upload the file.
+//
+// By default, the cost is added to the estimated upload cost. A cost prefixed
+// with "=" specifies the full cost.
+func fileShareCost(as *appState, filename, cost string) (float64, float64, error) {
+	stat, err := os.Stat(filename)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Figure out upload cost.
+	policy := as.serverPolicy()
+	size := stat.Size()
+	uploadCost, err := clientintf.EstimateUploadCost(size, &policy)
+	if err != nil {
+		return 0, 0, err
+	}
+	dcrUploadCost := float64(uploadCost) / 1e11
+
+	if cost[0] == '=' {
+		// Exact cost specified.
+		dcrCost, err := strconv.ParseFloat(cost[1:], 64)
+		return dcrCost, dcrUploadCost, err
+	}
+
+	// Upload cost + overcharge
+	dcrCost, err := strconv.ParseFloat(cost, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return dcrCost + dcrUploadCost, dcrUploadCost, nil
+}
+
 var ftCommands = []tuicmd{
 	{
 		cmd:           "share",
@@ -1753,35 +2398,10 @@ var ftCommands = []tuicmd{
 			if err != nil {
 				return err
 			}
-			stat, err := os.Stat(filename)
-			if err != nil {
-				return err
-			}
-
-			var dcrCost, dcrUploadCost float64
-			// Figure out upload cost.
-			policy := as.serverPolicy()
-			size := stat.Size()
-			uploadCost, err := clientintf.EstimateUploadCost(size, &policy)
+			dcrCost, dcrUploadCost, err := fileShareCost(as, filename, args[1])
 			if err != nil {
 				return err
 			}
-			dcrUploadCost = float64(uploadCost) / 1e11
-
-			if args[1][0] == '=' {
-				// Exact cost specified.
-				dcrCost, err = strconv.ParseFloat(args[1][1:], 64)
-				if err != nil {
-					return err
-				}
-			} else {
-				// Upload cost + overcharge
-				dcrCost, err = strconv.ParseFloat(args[1], 64)
-				if err != nil {
-					return err
-				}
-				dcrCost += dcrUploadCost
-			}
 
 			var uid *clientintf.UserID
 			with := ""
@@ -1865,6 +2485,76 @@ var ftCommands = []tuicmd{
 			}
 			return nil
 		},
+	}, {
+		cmd:   "play",
+		usage: "<nick> [<filename> | <FID>]",
+		descr: "Play an audio file while it is downloaded from the remote peer",
+		long: []string{
+			"Only opus audio files (such as audio notes) are supported. The file is downloaded sequentially and playback starts as soon as the first chunks are received.",
+			"Use /ft seek to change the playback position and /ft stopplay to stop the playback.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "nick cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+
+			uid, err := as.c.UIDByNick(args[0])
+			if err != nil {
+				return err
+			}
+
+			cw := as.findOrNewChatWindow(uid, args[0])
+			go as.playUserContent(cw, args[1])
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "seek",
+		usage: "<seconds>",
+		descr: "Change the position of the playback started with /ft play",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "position cannot be empty"}
+			}
+			secs, err := strconv.ParseFloat(args[0], 64)
+			if err != nil {
+				return err
+			}
+
+			as.streamPlayMtx.Lock()
+			p := as.streamPlayback
+			as.streamPlayMtx.Unlock()
+			if p == nil {
+				return errors.New("no file is being played")
+			}
+			pos := time.Duration(secs * float64(time.Second))
+			if err := p.Seek(pos); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Seeking playback to %s", pos)
+			return nil
+		},
+	}, {
+		cmd:   "stopplay",
+		descr: "Stop the playback started with /ft play",
+		handler: func(args []string, as *appState) error {
+			as.streamPlayMtx.Lock()
+			cancel := as.streamPlayCancel
+			as.streamPlayMtx.Unlock()
+			if cancel == nil {
+				return errors.New("no file is being played")
+			}
+			cancel()
+			return nil
+		},
 	}, {
 		cmd:           "estimatecost",
 		usableOffline: true,
@@ -1903,190 +2593,905 @@ var ftCommands = []tuicmd{
 		descr:         "Unshare a file",
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "file cannot be empty"}
+				return usageError{msg: "file cannot be empty"}
+			}
+			var user *clientintf.UserID
+			if len(args) > 1 {
+				uid, err := as.c.UIDByNick(args[1])
+				if err != nil {
+					return err
+				}
+				user = &uid
+			}
+
+			files, err := as.c.ListLocalSharedFiles()
+			if err != nil {
+				return nil
+			}
+			var fid zkidentity.ShortID
+			if err := fid.FromString(args[0]); err != nil {
+				// Try to find the named file.
+				for _, f := range files {
+					if f.SF.Filename == args[0] {
+						fid = f.SF.FID
+						break
+					}
+				}
+				if fid.IsEmpty() {
+					return fmt.Errorf("could not find shared file %q",
+						args[0])
+				}
+			}
+
+			err = as.c.UnshareFile(fid, user)
+			if err != nil {
+				return err
+			}
+
+			as.cwHelpMsg("Unshared file %s", fid)
+			return nil
+		},
+	}, {
+		cmd:   "send",
+		usage: "<user> <filename>",
+		descr: "Send a file to user",
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "user cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "filename cannot be empty"}
+			}
+
+			uid, err := as.c.UIDByNick(args[0])
+			if err != nil {
+				return err
+			}
+			nick, err := as.c.UserNick(uid)
+			if err != nil {
+				nick = args[0]
+			}
+			filename := args[1]
+
+			sess := as.c.ServerSession()
+			if sess == nil {
+				return errors.New("not connected to server")
+			}
+
+			cw := as.findChatWindow(uid)
+			var cm *chatMsg
+			if cw == nil {
+				as.cwHelpMsg("Sending file %q to user %q",
+					filepath.Base(filename), strescape.Nick(nick))
+			} else {
+				cm = cw.newInternalMsg("Sending file %q to user %q",
+					filepath.Base(filename), strescape.Nick(nick))
+			}
+
+			as.sendMsg(repaintActiveChat{})
+
+			go func() {
+				err = as.c.SendFile(uid, 0, filename, nil)
+				if err != nil {
+					as.diagMsg("Unable to send %s: %v", filename, err)
+					return
+				}
+				if cm != nil {
+					cw.setMsgSent(cm)
+					as.sendMsg(repaintActiveChat{})
+				}
+			}()
+
+			return nil
+		},
+	}, {
+		cmd:           "canceldownload",
+		aliases:       []string{"canceldown"},
+		descr:         "Cancel an in-progress download",
+		usage:         "<file id prefix>",
+		usableOffline: true,
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "file id must be specified"}
+			}
+			fds, err := as.c.ListDownloads()
+			if err != nil {
+				return err
+			}
+
+			var matches []clientintf.FileID
+			for _, fd := range fds {
+				if strings.HasPrefix(fd.FID.String(), args[0]) {
+					matches = append(matches, fd.FID)
+				}
+			}
+
+			if len(matches) == 0 {
+				return fmt.Errorf("file with id %q not found", args[0])
+			}
+			if len(matches) > 1 {
+				return fmt.Errorf("more than one file with id %q exists", args[0])
+			}
+
+			err = as.c.CancelDownload(matches[0])
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Canceled download of file %s", matches[0])
+			return nil
+		},
+	},
+}
+
+var postCommands = []tuicmd{
+	{
+		cmd:   "new",
+		usage: "[<filename>]",
+		descr: "Create a new post",
+		long:  []string{"If called without arguments, opens the create post window. Otherwise, it creates the post based on the contents of the file."},
+		handler: func(args []string, as *appState) error {
+			if len(args) > 0 {
+				fname, err := homedir.Expand(args[0])
+				if err != nil {
+					return err
+				}
+
+				data, err := os.ReadFile(fname)
+				if err != nil {
+					return err
+				}
+
+				go as.createPost(string(data), filepath.Dir(fname))
+				return nil
+			}
+			as.sendMsg(showNewPostWindow{})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+	}, {
+		cmd:     "external",
+		aliases: []string{"ext", "newext"},
+		descr:   "Launch $EDITOR to edit a new post",
+		handler: func(args []string, as *appState) error {
+			go func() {
+				post, err := as.editExternalTextFile(baseExternalNewPostContent, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to open external editor: %v", err)
+					return
+				}
+
+				as.createPost(post, "")
+			}()
+			return nil
+		},
+	}, {
+		cmd:   "newfor",
+		usage: "<audience> [<filename>]",
+		descr: "Create a new post restricted to an audience",
+		long: []string{"The post is only sent to subscribers that are members of the audience. Comments are only accepted from and shared with members of the audience, and the post cannot be relayed.",
+			"If called without a filename, launches $EDITOR to edit the post."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "audience cannot be empty"}
+			}
+			audience := args[0]
+			if len(args) > 1 {
+				fname, err := homedir.Expand(args[1])
+				if err != nil {
+					return err
+				}
+
+				data, err := os.ReadFile(fname)
+				if err != nil {
+					return err
+				}
+
+				go as.createPostForAudience(string(data), filepath.Dir(fname), audience)
+				return nil
+			}
+
+			go func() {
+				post, err := as.editExternalTextFile(baseExternalNewPostContent, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to open external editor: %v", err)
+					return
+				}
+
+				as.createPostForAudience(post, "", audience)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postAudienceCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "newtagged",
+		usage: "<tag>[,<tag>...] [<filename>]",
+		descr: "Create a new post with the given comma-separated tags",
+		long: []string{"Tags allow subscribers to query and filter their feed. Tags may only contain letters, digits, '-' and '_'.",
+			"If called without a filename, launches $EDITOR to edit the post."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "tags cannot be empty"}
+			}
+			tags := strings.Split(args[0], ",")
+			if _, err := rpc.NormalizePostTags(tags); err != nil {
+				return err
+			}
+			if len(args) > 1 {
+				fname, err := homedir.Expand(args[1])
+				if err != nil {
+					return err
+				}
+
+				data, err := os.ReadFile(fname)
+				if err != nil {
+					return err
+				}
+
+				go as.createTaggedPost(string(data), filepath.Dir(fname), "", tags)
+				return nil
+			}
+
+			go func() {
+				post, err := as.editExternalTextFile(baseExternalNewPostContent, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to open external editor: %v", err)
+					return
+				}
+
+				as.createTaggedPost(post, "", "", tags)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postTagCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "newexpiring",
+		usage: "<duration> [<filename>]",
+		descr: "Create a new post that is deleted after the given duration",
+		long: []string{"After the post expires, it is deleted by the local client and by the subscribers that received it, along with its comments and embedded files. Expired posts are not relayed.",
+			"Duration is specified as a Go duration string (e.g. 12h or 2d).",
+			"If called without a filename, launches $EDITOR to edit the post."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "duration cannot be empty"}
+			}
+			duration, err := strduration.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+			if duration <= 0 {
+				return usageError{msg: "duration must be positive"}
+			}
+			if len(args) > 1 {
+				fname, err := homedir.Expand(args[1])
+				if err != nil {
+					return err
+				}
+
+				data, err := os.ReadFile(fname)
+				if err != nil {
+					return err
+				}
+
+				opts := client.CreatePostOpts{Expiration: time.Now().Add(duration)}
+				go as.createPostWithOpts(string(data), filepath.Dir(fname), opts)
+				return nil
+			}
+
+			go func() {
+				post, err := as.editExternalTextFile(baseExternalNewPostContent, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to open external editor: %v", err)
+					return
+				}
+
+				// The expiration is counted from when the post is
+				// created, not from when editing started.
+				opts := client.CreatePostOpts{Expiration: time.Now().Add(duration)}
+				as.createPostWithOpts(post, "", opts)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 1 {
+				return fileCompleter(arg)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "drafts",
+		usableOffline: true,
+		descr:         "List the post drafts",
+		handler: func(args []string, as *appState) error {
+			drafts, err := as.c.ListPostDrafts()
+			if err != nil {
+				return err
+			}
+			if len(drafts) == 0 {
+				as.cwHelpMsg("No post drafts")
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Post drafts")
+				for _, draft := range drafts {
+					title := clientintf.PostTitle(&rpc.PostMetadata{
+						Attributes: map[string]string{rpc.RMPMain: draft.Content},
+					})
+					if title == "" {
+						title = "[Untitled Post]"
+					}
+					status := "updated " + draft.Updated.Format(ISO8601DateTime)
+					switch {
+					case !draft.PublishAt.IsZero():
+						status = "scheduled for " + draft.PublishAt.Format(ISO8601DateTime)
+					case draft.PublishError != "":
+						status = "failed to publish: " + draft.PublishError
+					}
+					pf("%s %s (%s)", draft.ID.ShortLogID(),
+						strescape.Content(limitStr(title, 60)), status)
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:   "editdraft",
+		usage: "<draft id>",
+		descr: "Resume editing a post draft",
+		long:  []string{"Posts being edited in the create post window are autosaved as drafts. Use /post drafts to list them."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "draft id cannot be empty"}
+			}
+			draft, err := postDraftByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			as.sendMsg(showNewPostWindow{draft: &draft})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postDraftCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "rmdraft",
+		usableOffline: true,
+		usage:         "<draft id>",
+		descr:         "Remove a post draft",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "draft id cannot be empty"}
+			}
+			draft, err := postDraftByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			if err := as.c.RemovePostDraft(draft.ID); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Removed post draft %s", draft.ID.ShortLogID())
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postDraftCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "publishdraft",
+		usage: "<draft id>",
+		descr: "Publish a post draft now",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "draft id cannot be empty"}
+			}
+			draft, err := postDraftByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			go func() {
+				summ, err := as.c.PublishPostDraft(draft.ID)
+				if err != nil {
+					as.cwHelpMsg("Unable to publish post draft: %v", err)
+					return
+				}
+				as.cwHelpMsg("Published post draft %s as post %s",
+					draft.ID.ShortLogID(), summ.ID)
+				as.postsMtx.Lock()
+				as.posts = append(as.posts, summ)
+				as.sortPosts()
+				as.postsMtx.Unlock()
+				as.sendMsg(summ)
+			}()
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postDraftCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "schedule",
+		usableOffline: true,
+		usage:         "<draft id> <duration | yyyy-mm-dd hh:mm>",
+		descr:         "Schedule a post draft to be published at a later time",
+		long: []string{"The time may be specified either as a duration from now (e.g. 2h or 1d) or as a local date and time.",
+			"The draft is published by the client once the time is reached. Use /post unschedule to cancel publishing it."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 2 {
+				return usageError{msg: "draft id and time must be specified"}
+			}
+			draft, err := postDraftByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			publishAt, err := parsePublishTime(strings.Join(args[1:], " "))
+			if err != nil {
+				return err
+			}
+			if !publishAt.After(time.Now()) {
+				return fmt.Errorf("publish time must be in the future")
+			}
+			if _, err := as.c.SchedulePostDraft(draft.ID, publishAt); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Post draft %s scheduled to be published at %s",
+				draft.ID.ShortLogID(), publishAt.Format(ISO8601DateTime))
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postDraftCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "unschedule",
+		usableOffline: true,
+		usage:         "<draft id>",
+		descr:         "Cancel publishing a scheduled post draft",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "draft id cannot be empty"}
+			}
+			draft, err := postDraftByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			if _, err := as.c.SchedulePostDraft(draft.ID, time.Time{}); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Post draft %s is no longer scheduled", draft.ID.ShortLogID())
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postDraftCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "tags",
+		usableOffline: true,
+		descr:         "List the tags of received posts",
+		handler: func(args []string, as *appState) error {
+			tags, err := as.c.ListPostTags()
+			if err != nil {
+				return err
+			}
+			if len(tags) == 0 {
+				as.cwHelpMsg("No tagged posts")
+				return nil
+			}
+			names := make([]string, 0, len(tags))
+			for tag := range tags {
+				names = append(names, tag)
+			}
+			as.collator.SortStrings(names)
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Post tags")
+				for _, tag := range names {
+					pf("#%s - %d posts", tag, tags[tag])
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "feed",
+		usableOffline: true,
+		usage:         "[tag=<tag>] [user=<nick>] [since=<yyyy-mm-dd>] [until=<yyyy-mm-dd>]",
+		descr:         "List received posts that match the given criteria",
+		handler: func(args []string, as *appState) error {
+			var q clientdb.PostsQuery
+			parseDate := func(s string) (time.Time, error) {
+				return time.ParseInLocation("2006-01-02", s, time.Local)
+			}
+			for _, arg := range args {
+				var err error
+				switch {
+				case strings.HasPrefix(arg, "tag="):
+					q.Tag = arg[4:]
+				case strings.HasPrefix(arg, "user="):
+					var uid clientintf.UserID
+					uid, err = as.c.UIDByNick(arg[5:])
+					q.Author = &uid
+				case strings.HasPrefix(arg, "since="):
+					q.Since, err = parseDate(arg[6:])
+				case strings.HasPrefix(arg, "until="):
+					q.Until, err = parseDate(arg[6:])
+					q.Until = q.Until.AddDate(0, 0, 1)
+				default:
+					err = usageError{msg: fmt.Sprintf("unknown criteria %q", arg)}
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			posts, err := as.c.QueryPosts(q)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Matching posts (%d total)", len(posts))
+				for _, summ := range posts {
+					var tags string
+					if len(summ.Tags) > 0 {
+						tags = " #" + strings.Join(summ.Tags, " #")
+					}
+					pf("%s %s %s - %s%s", summ.Date.Format("2006-01-02 15:04"),
+						summ.ID.ShortLogID(),
+						strescape.Nick(summ.AuthorNick),
+						strescape.Content(summ.Title), tags)
+				}
+			})
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			switch {
+			case strings.HasPrefix(arg, "tag="):
+				res := postTagCompleter(arg[4:], as)
+				for i := range res {
+					res[i] = "tag=" + res[i]
+				}
+				return res
+			case strings.HasPrefix(arg, "user="):
+				res := nickCompleter(arg[5:], as)
+				for i := range res {
+					res[i] = "user=" + res[i]
+				}
+				return res
+			}
+			return nil
+		},
+	}, {
+		cmd:           "audiences",
+		usableOffline: true,
+		descr:         "List the post audiences",
+		handler: func(args []string, as *appState) error {
+			audiences, err := as.c.ListPostAudiences()
+			if err != nil {
+				return err
+			}
+			if len(audiences) == 0 {
+				as.cwHelpMsg("No post audiences")
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("Post audiences")
+				for _, a := range audiences {
+					nicks := make([]string, len(a.Members))
+					for i, uid := range a.Members {
+						nick, err := as.c.UserNick(uid)
+						if err != nil {
+							nick = uid.ShortLogID()
+						}
+						nicks[i] = strescape.Nick(nick)
+					}
+					pf("%s: %s", strescape.Content(a.Name),
+						strings.Join(nicks, ", "))
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "setaudience",
+		usableOffline: true,
+		usage:         "<audience> <nick>...",
+		descr:         "Create or replace a post audience",
+		long:          []string{"The members of the audience should be subscribers of the local client's posts."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "audience cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "audience members cannot be empty"}
+			}
+			members := make([]clientintf.UserID, 0, len(args)-1)
+			for _, nick := range args[1:] {
+				uid, err := as.c.UIDByNick(nick)
+				if err != nil {
+					return err
+				}
+				members = append(members, uid)
+			}
+			if err := as.c.SavePostAudience(args[0], members); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Saved post audience %q with %d members",
+				strescape.Content(args[0]), len(members))
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postAudienceCompleter(arg, as)
+			}
+			return nickCompleter(arg, as)
+		},
+	}, {
+		cmd:           "rmaudience",
+		usableOffline: true,
+		usage:         "<audience>",
+		descr:         "Remove a post audience",
+		long:          []string{"Posts restricted to the removed audience are no longer shared with anyone."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "audience cannot be empty"}
+			}
+			if err := as.c.RemovePostAudience(args[0]); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Removed post audience %q", strescape.Content(args[0]))
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return postAudienceCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "edit",
+		usage: "<post id> [<filename>]",
+		descr: "Edit a post made by the local client",
+		long: []string{"If called with a filename, the contents of the post are replaced by the contents of the file. Otherwise, $EDITOR is launched to edit the current contents of the post.",
+			"Subscribers receive the edit as a signed revision of the post and may still view its prior versions."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "post id cannot be empty"}
 			}
-			var user *clientintf.UserID
+			var pid clientintf.PostID
+			if err := pid.FromString(args[0]); err != nil {
+				return err
+			}
+
 			if len(args) > 1 {
-				uid, err := as.c.UIDByNick(args[1])
+				fname, err := homedir.Expand(args[1])
 				if err != nil {
 					return err
 				}
-				user = &uid
-			}
 
-			files, err := as.c.ListLocalSharedFiles()
-			if err != nil {
-				return nil
-			}
-			var fid zkidentity.ShortID
-			if err := fid.FromString(args[0]); err != nil {
-				// Try to find the named file.
-				for _, f := range files {
-					if f.SF.Filename == args[0] {
-						fid = f.SF.FID
-						break
-					}
-				}
-				if fid.IsEmpty() {
-					return fmt.Errorf("could not find shared file %q",
-						args[0])
+				data, err := os.ReadFile(fname)
+				if err != nil {
+					return err
 				}
+
+				go as.editPost(pid, string(data), filepath.Dir(fname))
+				return nil
 			}
 
-			err = as.c.UnshareFile(fid, user)
+			content, err := as.latestPostContent(as.c.PublicID(), pid)
 			if err != nil {
 				return err
 			}
+			go func() {
+				post, err := as.editExternalTextFile(content+baseExternalNewPostContent, "")
+				if err != nil {
+					as.cwHelpMsg("Unable to open external editor: %v", err)
+					return
+				}
 
-			as.cwHelpMsg("Unshared file %s", fid)
+				as.editPost(pid, post, "")
+			}()
 			return nil
 		},
-	}, {
-		cmd:   "send",
-		usage: "<user> <filename>",
-		descr: "Send a file to user",
 		completer: func(args []string, arg string, as *appState) []string {
-			if len(args) == 0 {
-				return nickCompleter(arg, as)
-			}
 			if len(args) == 1 {
 				return fileCompleter(arg)
 			}
 			return nil
 		},
+	}, {
+		cmd:   "newpaid",
+		usage: "<price> <preview filename> <content filename>",
+		descr: "Create a new paid post",
+		long: []string{"The preview is shared with subscribers, along with the price (in DCR) to unlock the full post. Subscribers that pay the price receive the full content of the post.",
+			"Use '/post sales <post id>' to view the sales of the post."},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "user cannot be empty"}
+				return usageError{msg: "price cannot be empty"}
 			}
-			if len(args) < 2 {
-				return usageError{msg: "filename cannot be empty"}
+			if len(args) < 3 {
+				return usageError{msg: "preview and content filenames must be specified"}
 			}
-
-			uid, err := as.c.UIDByNick(args[0])
+			dcrPrice, err := strconv.ParseFloat(args[0], 64)
 			if err != nil {
-				return err
+				return usageError{msg: fmt.Sprintf("price not a valid DCR amount: %v", err)}
 			}
-			nick, err := as.c.UserNick(uid)
+			price, err := dcrutil.NewAmount(dcrPrice)
 			if err != nil {
-				nick = args[0]
-			}
-			filename := args[1]
-
-			sess := as.c.ServerSession()
-			if sess == nil {
-				return errors.New("not connected to server")
+				return err
 			}
-
-			cw := as.findChatWindow(uid)
-			var cm *chatMsg
-			if cw == nil {
-				as.cwHelpMsg("Sending file %q to user %q",
-					filepath.Base(filename), strescape.Nick(nick))
-			} else {
-				cm = cw.newInternalMsg("Sending file %q to user %q",
-					filepath.Base(filename), strescape.Nick(nick))
+			if price <= 0 {
+				return usageError{msg: "price must be positive"}
 			}
 
-			as.sendMsg(repaintActiveChat{})
-
-			go func() {
-				err = as.c.SendFile(uid, 0, filename, nil)
+			var data [2]string
+			var root string
+			for i := range data {
+				fname, err := homedir.Expand(args[i+1])
 				if err != nil {
-					as.diagMsg("Unable to send %s: %v", filename, err)
-					return
+					return err
 				}
-				if cm != nil {
-					cw.setMsgSent(cm)
-					as.sendMsg(repaintActiveChat{})
+				b, err := os.ReadFile(fname)
+				if err != nil {
+					return err
 				}
-			}()
+				data[i] = string(b)
+				root = filepath.Dir(fname)
+			}
 
+			go as.createPaidPost(data[0], data[1], root, uint64(price)*1000)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 1 || len(args) == 2 {
+				return fileCompleter(arg)
+			}
 			return nil
 		},
 	}, {
-		cmd:           "canceldownload",
-		aliases:       []string{"canceldown"},
-		descr:         "Cancel an in-progress download",
-		usage:         "<file id prefix>",
-		usableOffline: true,
+		cmd:   "buy",
+		usage: "<nick> <post id>",
+		descr: "Pay to unlock the full content of a paid post",
+		long:  []string{"Requests an invoice from the author of the post and pays it. The full content of the post is sent by the author after the payment completes."},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "file id must be specified"}
+				return usageError{msg: "nick cannot be empty"}
 			}
-			fds, err := as.c.ListDownloads()
+			if len(args) < 2 {
+				return usageError{msg: "post id cannot be empty"}
+			}
+			uid, err := as.c.UIDByNick(args[0])
 			if err != nil {
 				return err
 			}
-
-			var matches []clientintf.FileID
-			for _, fd := range fds {
-				if strings.HasPrefix(fd.FID.String(), args[0]) {
-					matches = append(matches, fd.FID)
-				}
+			var pid clientintf.PostID
+			if err := pid.FromString(args[1]); err != nil {
+				return err
 			}
-
-			if len(matches) == 0 {
-				return fmt.Errorf("file with id %q not found", args[0])
+			go as.buyPost(uid, pid)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
 			}
-			if len(matches) > 1 {
-				return fmt.Errorf("more than one file with id %q exists", args[0])
+			return nil
+		},
+	}, {
+		cmd:           "sales",
+		usableOffline: true,
+		usage:         "<post id>",
+		descr:         "Show the sales of a paid post",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "post id cannot be empty"}
 			}
-
-			err = as.c.CancelDownload(matches[0])
+			var pid clientintf.PostID
+			if err := pid.FromString(args[0]); err != nil {
+				return err
+			}
+			stats, err := as.c.PostSalesStats(pid)
 			if err != nil {
 				return err
 			}
-			as.cwHelpMsg("Canceled download of file %s", matches[0])
+			sales, err := as.c.ListPostSales(pid)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("Sales of post %s", pid)
+				pf("Sold %d times for a total of %.8f DCR (%d pending)",
+					stats.Sales, float64(stats.TotalMAtoms)/1e11,
+					stats.Pending)
+				for _, sale := range sales {
+					if sale.Settled == nil {
+						continue
+					}
+					nick, err := as.c.UserNick(sale.UID)
+					if err != nil {
+						nick = sale.UID.ShortLogID()
+					}
+					pf("%s %s %.8f DCR",
+						sale.Settled.Format("2006-01-02 15:04"),
+						strescape.Nick(nick),
+						float64(sale.MilliAtoms)/1e11)
+				}
+			})
 			return nil
 		},
-	},
-}
-
-var postCommands = []tuicmd{
-	{
-		cmd:   "new",
-		usage: "[<filename>]",
-		descr: "Create a new post",
-		long:  []string{"If called without arguments, opens the create post window. Otherwise, it creates the post based on the contents of the file."},
+	}, {
+		cmd:           "holdcomments",
+		usableOffline: true,
+		usage:         "[none|all|unknown]",
+		descr:         "Show or set which comments on local posts are held for approval",
+		long: []string{"Comments held for approval are listed in the moderation queue (F5) of the post window.",
+			"none - no comments are held (default)",
+			"all - all comments are held",
+			"unknown - comments from users that never had a comment approved are held"},
 		handler: func(args []string, as *appState) error {
 			if len(args) > 0 {
-				fname, err := homedir.Expand(args[0])
-				if err != nil {
-					return err
-				}
-
-				data, err := os.ReadFile(fname)
-				if err != nil {
+				mode := clientdb.CommentHoldMode(args[0])
+				if err := as.c.SetCommentHoldMode(mode); err != nil {
 					return err
 				}
-
-				go as.createPost(string(data), filepath.Dir(fname))
-				return nil
 			}
-			as.sendMsg(showNewPostWindow{})
+			cfg, err := as.c.PostModerationConfig()
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Comment hold mode: %s (%d known commenters)",
+				cfg.HoldMode, len(cfg.KnownCommenters))
 			return nil
 		},
 		completer: func(args []string, arg string, as *appState) []string {
-			if len(args) == 0 {
-				return fileCompleter(arg)
+			if len(args) > 0 {
+				return nil
 			}
-			return nil
-		},
-	}, {
-		cmd:     "external",
-		aliases: []string{"ext", "newext"},
-		descr:   "Launch $EDITOR to edit a new post",
-		handler: func(args []string, as *appState) error {
-			go func() {
-				post, err := as.editExternalTextFile(baseExternalNewPostContent, "")
-				if err != nil {
-					as.cwHelpMsg("Unable to open external editor: %v", err)
-					return
+			var res []string
+			for _, m := range []string{"none", "all", "unknown"} {
+				if strings.HasPrefix(m, arg) {
+					res = append(res, m)
 				}
-
-				as.createPost(post, "")
-			}()
-			return nil
+			}
+			return res
 		},
 	}, {
 		cmd:     "subscribe",
@@ -2244,6 +3649,96 @@ var postCommands = []tuicmd{
 	},
 }
 
+var lnLiquidityCommands = []tuicmd{
+	{
+		cmd:           "list",
+		usableOffline: true,
+		descr:         "List the actions proposed or performed to manage LN liquidity",
+		handler: func(args []string, as *appState) error {
+			actions, err := as.c.ListLNLiquidityActions()
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				if len(actions) == 0 {
+					pf("No LN liquidity actions")
+					return
+				}
+				pf("LN liquidity actions")
+				for _, a := range actions {
+					pf("%d - %s - %s of %s - %s (%s)", a.ID,
+						a.Created.Format(ISO8601DateTime),
+						a.Type, a.Amount, a.Status, a.Reason)
+					if a.ChannelPoint != "" {
+						pf("  channel %s cost %s", a.ChannelPoint, a.Cost)
+					}
+					if a.Error != "" {
+						pf("  error: %s", a.Error)
+					}
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "check",
+		usableOffline: true,
+		descr:         "Check the channel balances against the configured targets",
+		handler: func(args []string, as *appState) error {
+			go func() {
+				err := as.c.CheckLNLiquidity(as.ctx)
+				if err != nil {
+					as.cwHelpMsg("Unable to check LN liquidity: %v", err)
+				} else {
+					as.cwHelpMsg("Checked LN liquidity")
+				}
+			}()
+			return nil
+		},
+	}, {
+		cmd:           "approve",
+		usableOffline: true,
+		usage:         "<id>",
+		descr:         "Perform a proposed LN liquidity action",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "id cannot be empty"}
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Performing LN liquidity action %d", id)
+			go func() {
+				err := as.c.ApproveLNLiquidityAction(as.ctx, id)
+				if err != nil {
+					as.cwHelpMsg("Unable to perform LN liquidity "+
+						"action %d: %v", id, err)
+				}
+			}()
+			return nil
+		},
+	}, {
+		cmd:           "reject",
+		usableOffline: true,
+		usage:         "<id>",
+		descr:         "Reject a proposed LN liquidity action",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "id cannot be empty"}
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := as.c.RejectLNLiquidityAction(id); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Rejected LN liquidity action %d", id)
+			return nil
+		},
+	},
+}
+
 var lnCommands = []tuicmd{
 	{
 		cmd:           "info",
@@ -2486,9 +3981,26 @@ var lnCommands = []tuicmd{
 			if as.lnRPC == nil {
 				return fmt.Errorf("LN client not configured")
 			}
-			as.sendMsg(msgLNRequestRecv{})
+			as.sendMsg(msgLNRequestRecv{})
+			return nil
+		},
+	},
+	{
+		cmd:           "liquidity",
+		usableOffline: true,
+		usage:         "[sub]",
+		descr:         "Automatic LN liquidity management commands",
+		long: []string{
+			"Liquidity management is configured in the [lnliquidity] section of the config file. Actions to request inbound channels or to open outbound channels are proposed when the channel balances are below the configured targets.",
+		},
+		sub: lnLiquidityCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(lnLiquidityCommands, arg, false)
+			}
 			return nil
 		},
+		handler: handleWithSubcmd(lnLiquidityCommands, "list"),
 	},
 	{
 		cmd:           "pendingchannels",
@@ -3158,6 +4670,92 @@ var lnCommands = []tuicmd{
 	},
 }
 
+var budgetCommands = []tuicmd{
+	{
+		cmd:           "status",
+		usableOffline: true,
+		descr:         "Show the amount spent within each payment budget",
+		handler: func(args []string, as *appState) error {
+			status := as.c.PaymentBudgetsStatus()
+			as.cwHelpMsgs(func(pf printf) {
+				if len(status) == 0 {
+					pf("No payment budgets configured")
+					return
+				}
+				pf("Payment budgets")
+				for _, st := range status {
+					pf("%.8f DCR spent of %s",
+						float64(st.SpentMAtoms)/1e11,
+						st.PaymentBudget)
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "blocked",
+		usableOffline: true,
+		descr:         "List the payments blocked for exceeding a budget",
+		handler: func(args []string, as *appState) error {
+			blocked := as.c.ListBlockedPayments()
+			sort.Slice(blocked, func(i, j int) bool {
+				return blocked[i].ID < blocked[j].ID
+			})
+			as.cwHelpMsgs(func(pf printf) {
+				if len(blocked) == 0 {
+					pf("No blocked payments")
+					return
+				}
+				pf("Blocked payments")
+				for _, bp := range blocked {
+					pf("%d - %s - %.8f DCR (%s) exceeds %s", bp.ID,
+						bp.Created.Format(ISO8601DateTime),
+						float64(bp.MilliAtoms)/1e11, bp.Category,
+						bp.Budget)
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "approve",
+		usableOffline: true,
+		usage:         "<id>",
+		descr:         "Allow a blocked payment to proceed",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "id cannot be empty"}
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := as.c.ApproveBlockedPayment(id); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Approved blocked payment %d", id)
+			return nil
+		},
+	}, {
+		cmd:           "reject",
+		usableOffline: true,
+		usage:         "<id>",
+		descr:         "Reject a blocked payment",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "id cannot be empty"}
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := as.c.RejectBlockedPayment(id); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Rejected blocked payment %d", id)
+			return nil
+		},
+	},
+}
+
 var pagesCommands = []tuicmd{
 	{
 		cmd:   "view",
@@ -3244,6 +4842,14 @@ var filterCommands = []tuicmd{
 					if !cf.SkipPostComments {
 						s += "post comments, "
 					}
+					if cf.PostTag != "" {
+						s = "posts, "
+						if cf.SubscribeTag {
+							s += fmt.Sprintf("subscribed tag=%s, ", cf.PostTag)
+						} else {
+							s += fmt.Sprintf("muted tag=%s, ", cf.PostTag)
+						}
+					}
 					if cf.UID != nil {
 						nick, _ := as.c.UserNick(*cf.UID)
 						s += fmt.Sprintf("user=%s, ", strescape.Nick(nick))
@@ -3397,6 +5003,69 @@ var filterCommands = []tuicmd{
 			as.cwHelpMsg("Added content filter rule %d", cf.ID)
 			return nil
 		},
+	}, {
+		cmd:           "addtag",
+		usableOffline: true,
+		descr:         "Add a rule that mutes or subscribes to a post tag",
+		usage:         "[user=<user>] <mute|sub> <tag> [regexp]",
+		long: []string{
+			"Post tag rules only apply to received posts.",
+			"",
+			"  - mute: filter posts with the tag. If a regexp is specified, ",
+			"    only posts with the tag that match the regexp are filtered.",
+			"  - sub: subscribe to the tag. While there are subscription rules ",
+			"    that apply to a user, posts from that user are filtered unless ",
+			"    they have one of the subscribed tags.",
+			"",
+			"  - user=<user>: only apply the rule to posts from a specific user",
+			"",
+			"Examples:",
+			"",
+			"- Filter all posts tagged #politics:",
+			"",
+			"    /filter addtag mute politics",
+			"",
+			"- Only receive posts tagged #golang from user foo:",
+			"",
+			"    /filter addtag user=foo sub golang",
+		},
+		rawHandler: func(rawCmd string, args []string, as *appState) error {
+			var cf clientdb.ContentFilter
+			nargs := 2 // cmd+subcmd
+			if len(args) > 0 && strings.HasPrefix(args[0], "user=") {
+				uid, err := as.c.UIDByNick(args[0][5:])
+				if err != nil {
+					return err
+				}
+				cf.UID = &uid
+				args = args[1:]
+				nargs += 1
+			}
+			if len(args) < 2 {
+				return usageError{msg: "action and tag cannot be empty"}
+			}
+			switch strings.ToLower(args[0]) {
+			case "mute":
+			case "sub", "subscribe":
+				cf.SubscribeTag = true
+			default:
+				return usageError{msg: fmt.Sprintf("unknown tag action %q", args[0])}
+			}
+			cf.PostTag = args[1]
+			nargs += 2
+			_, cf.Regexp = popNArgs(rawCmd, nargs)
+			if cf.SubscribeTag && cf.Regexp != "" {
+				return usageError{msg: "subscription rules do not accept a regexp"}
+			}
+
+			err := as.c.StoreContentFilter(&cf)
+			if err != nil {
+				return err
+			}
+
+			as.cwHelpMsg("Added post tag rule %d", cf.ID)
+			return nil
+		},
 	}, {
 		cmd:     "del",
 		aliases: []string{"delete", "remove", "rem"},
@@ -4259,157 +5928,521 @@ var rtchatCommands = []tuicmd{
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
-				return rtdtSessionCompleter(arg, as)
+				return rtdtSessionCompleter(arg, as)
+			}
+
+			return nil
+		},
+	}, {
+		cmd:     "remmember",
+		aliases: []string{"removemember", "rmmember", "rmm"},
+		descr:   "Permanently remove a member from the realtime chat session",
+		long: []string{"If 'skiprotate' is specified, the appointment cookies are not rotated and must be manually rotated later.",
+			"This is useful when removing multiple members from the chat."},
+		usage: "<session> <member> [<skiprotate>]",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "session RV cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "target member cannot be empty"}
+			}
+			rotateCookies := true
+			if len(args) > 2 {
+				if args[2] == "skiprotate" {
+					rotateCookies = false
+				} else {
+					return usageError{msg: "third argument must be 'skiprotate'"}
+				}
+			}
+
+			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			if err != nil {
+				return err
+			}
+			sessRV := sess.Metadata.RV
+
+			if !sess.LocalIsAdmin() {
+				return errors.New("only session admins can remove members")
+			}
+
+			ru, err := as.c.UserByNick(args[1])
+			if err != nil {
+				return err
+			}
+
+			// When rotating cookies, ensure the local client is inv
+			// the live session first.
+			if rotateCookies {
+				isLive, _ := as.c.IsLiveAndHotRTSession(&sessRV)
+				if !isLive {
+					return fmt.Errorf("cannot remove member and rotate cookies when not in live session")
+				}
+			}
+
+			go as.rtRemoveMember(sessRV, ru, rotateCookies)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return rtdtSessionCompleter(arg, as)
+			}
+			if len(args) == 1 {
+				return nickCompleter(arg, as)
+			}
+			if len(args) == 2 && (arg == "" || strings.HasPrefix("skiprotate", arg)) {
+				return []string{"skiprotate"}
+			}
+			return nil
+		},
+	}, {
+		cmd:   "exit",
+		descr: "Permanently exit the live chat session",
+		usage: "<session>",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "session RV cannot be empty"}
+			}
+			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			if err != nil {
+				return err
+			}
+			if sess.LocalIsAdmin() {
+				return errors.New("cannot exit session when local client is the admin")
+			}
+
+			sessRV := sess.Metadata.RV
+			go as.rtExitSession(sessRV)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return rtdtSessionCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "dissolve",
+		descr: "Permanently dissolve the live chat session for all members",
+		usage: "<session>",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "session RV cannot be empty"}
+			}
+			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			if err != nil {
+				return err
+			}
+			sessRV := sess.Metadata.RV
+			if !sess.LocalIsAdmin() {
+				return errors.New("only session admins can remove members")
+			}
+
+			go as.rtDissolveSession(sessRV)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return rtdtSessionCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:     "rotatecookies",
+		aliases: []string{"rotcookies", "rotcookie"},
+		descr:   "Rotate session cookies to prevent kicked members from returning",
+		usage:   "<session>",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "session RV cannot be empty"}
+			}
+			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			if err != nil {
+				return err
+			}
+			sessRV := sess.Metadata.RV
+			if !sess.LocalIsAdmin() {
+				return errors.New("only session admins can remove members")
+			}
+
+			go as.rtRotateCookies(sessRV)
+			return nil
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return rtdtSessionCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:   "win",
+		descr: "Open the main real time chat window to manage live sessions",
+		handler: func(args []string, as *appState) error {
+			as.sendMsg(msgOpenRTChatWin{})
+			return nil
+		},
+	},
+}
+
+var payReqCommands = []tuicmd{
+	{
+		cmd:   "request",
+		usage: "<nick or id> <dcr amount> [memo]",
+		descr: "Request a payment from the user",
+		long: []string{
+			"An invoice for the amount is generated and sent to the user, who may then accept the request to pay it. The request expires after 24 hours or when the invoice expires, whichever happens first.",
+			"Use /payreq requestexp to specify a different expiration.",
+		},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "destination nick cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "amount cannot be empty"}
+			}
+			return as.requestPayment(args[0], args[1], 0, strings.Join(args[2:], " "))
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
 			}
-
 			return nil
 		},
 	}, {
-		cmd:     "remmember",
-		aliases: []string{"removemember", "rmmember", "rmm"},
-		descr:   "Permanently remove a member from the realtime chat session",
-		long: []string{"If 'skiprotate' is specified, the appointment cookies are not rotated and must be manually rotated later.",
-			"This is useful when removing multiple members from the chat."},
-		usage: "<session> <member> [<skiprotate>]",
+		cmd:   "requestexp",
+		usage: "<nick or id> <dcr amount> <expiration> [memo]",
+		descr: "Request a payment from the user that expires after the given duration",
+		long:  []string{"The expiration is specified as a duration (e.g. 30m, 2h or 3d)."},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "session RV cannot be empty"}
+				return usageError{msg: "destination nick cannot be empty"}
 			}
 			if len(args) < 2 {
-				return usageError{msg: "target member cannot be empty"}
+				return usageError{msg: "amount cannot be empty"}
 			}
-			rotateCookies := true
-			if len(args) > 2 {
-				if args[2] == "skiprotate" {
-					rotateCookies = false
-				} else {
-					return usageError{msg: "third argument must be 'skiprotate'"}
-				}
+			if len(args) < 3 {
+				return usageError{msg: "expiration cannot be empty"}
 			}
-
-			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			expiry, err := strduration.ParseDuration(args[2])
 			if err != nil {
 				return err
 			}
-			sessRV := sess.Metadata.RV
-
-			if !sess.LocalIsAdmin() {
-				return errors.New("only session admins can remove members")
+			if expiry <= 0 {
+				return usageError{msg: "expiration must be positive"}
 			}
-
-			ru, err := as.c.UserByNick(args[1])
+			return as.requestPayment(args[0], args[1], expiry, strings.Join(args[3:], " "))
+		},
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return nickCompleter(arg, as)
+			}
+			return nil
+		},
+	}, {
+		cmd:           "list",
+		usableOffline: true,
+		descr:         "List the payment requests sent and received",
+		handler: func(args []string, as *appState) error {
+			prs, err := as.c.ListPaymentRequests()
 			if err != nil {
 				return err
 			}
-
-			// When rotating cookies, ensure the local client is inv
-			// the live session first.
-			if rotateCookies {
-				isLive, _ := as.c.IsLiveAndHotRTSession(&sessRV)
-				if !isLive {
-					return fmt.Errorf("cannot remove member and rotate cookies when not in live session")
+			if len(prs) == 0 {
+				as.cwHelpMsg("No payment requests")
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Payment requests")
+				for _, pr := range prs {
+					nick, _ := as.c.UserNick(pr.UID)
+					if nick == "" {
+						nick = pr.UID.ShortLogID()
+					}
+					dir := "from"
+					if pr.Sent {
+						dir = "to"
+					}
+					status := string(pr.Status)
+					switch {
+					case pr.IsExpired(time.Now()):
+						status = string(clientdb.PaymentRequestExpired)
+					case pr.Status == clientdb.PaymentRequestPending:
+						status += ", expires " + pr.Expires.Format(ISO8601DateTime)
+					}
+					if pr.Error != "" {
+						status += ": " + strescape.Content(pr.Error)
+					}
+					pf("%s %.8f DCR %s %s %q (%s)", pr.ID.ShortLogID(),
+						float64(pr.MilliAtoms)/1e11, dir,
+						strescape.Nick(nick),
+						strescape.Content(limitStr(pr.Memo, 60)), status)
 				}
+			})
+			return nil
+		},
+	}, {
+		cmd:   "accept",
+		usage: "<request id>",
+		descr: "Pay a payment request received from a user",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "request id cannot be empty"}
 			}
-
-			go as.rtRemoveMember(sessRV, ru, rotateCookies)
+			pr, err := paymentRequestByPrefix(args[0], as)
+			if err != nil {
+				return err
+			}
+			go as.acceptPaymentRequest(pr)
 			return nil
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
-				return rtdtSessionCompleter(arg, as)
-			}
-			if len(args) == 1 {
-				return nickCompleter(arg, as)
-			}
-			if len(args) == 2 && (arg == "" || strings.HasPrefix("skiprotate", arg)) {
-				return []string{"skiprotate"}
+				return paymentRequestCompleter(arg, as)
 			}
 			return nil
 		},
 	}, {
-		cmd:   "exit",
-		descr: "Permanently exit the live chat session",
-		usage: "<session>",
+		cmd:   "decline",
+		usage: "<request id> [reason]",
+		descr: "Decline a payment request received from a user",
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "session RV cannot be empty"}
+				return usageError{msg: "request id cannot be empty"}
 			}
-			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			pr, err := paymentRequestByPrefix(args[0], as)
 			if err != nil {
 				return err
 			}
-			if sess.LocalIsAdmin() {
-				return errors.New("cannot exit session when local client is the admin")
+			reason := strings.Join(args[1:], " ")
+			if err := as.c.DeclinePaymentRequest(pr.UID, pr.ID, reason); err != nil {
+				return err
 			}
-
-			sessRV := sess.Metadata.RV
-			go as.rtExitSession(sessRV)
+			as.cwHelpMsg("Declined payment request %s", pr.ID.ShortLogID())
 			return nil
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
-				return rtdtSessionCompleter(arg, as)
+				return paymentRequestCompleter(arg, as)
 			}
 			return nil
 		},
-	}, {
-		cmd:   "dissolve",
-		descr: "Permanently dissolve the live chat session for all members",
-		usage: "<session>",
+	},
+}
+
+var recurringCommands = []tuicmd{
+	{
+		cmd:   "new",
+		usage: "<nick or id> <dcr amount> <period> [max periods] [tier]",
+		descr: "Start paying the user a fixed amount every period",
+		long: []string{
+			"The period is specified as a duration (e.g. 24h or 30d). The payment of the first period is made immediately. If max periods is zero or not specified, payments are made until the recurring payment is canceled.",
+			"Each payment is made as a tip, so the payment of a period lapses if the user cannot be paid.",
+		},
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "session RV cannot be empty"}
+				return usageError{msg: "destination nick cannot be empty"}
 			}
-			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			if len(args) < 2 {
+				return usageError{msg: "amount cannot be empty"}
+			}
+			if len(args) < 3 {
+				return usageError{msg: "period cannot be empty"}
+			}
+			uid, err := as.c.UIDByNick(args[0])
 			if err != nil {
 				return err
 			}
-			sessRV := sess.Metadata.RV
-			if !sess.LocalIsAdmin() {
-				return errors.New("only session admins can remove members")
+			dcrAmount, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return err
 			}
-
-			go as.rtDissolveSession(sessRV)
+			period, err := strduration.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+			var maxPeriods uint64
+			if len(args) > 3 {
+				maxPeriods, err = strconv.ParseUint(args[3], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+			tier := strings.Join(args[min(len(args), 4):], " ")
+			rp, err := as.c.CreateRecurringPayment(uid, dcrAmount, period,
+				uint32(maxPeriods), tier)
+			if err != nil {
+				return err
+			}
+			cw := as.findOrNewChatWindow(uid, args[0])
+			cw.newInternalMsg("Started recurring payment %s of %.8f DCR every %s",
+				rp.ID.ShortLogID(), dcrAmount, rp.Period)
+			as.repaintIfActive(cw)
 			return nil
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
-				return rtdtSessionCompleter(arg, as)
+				return nickCompleter(arg, as)
 			}
 			return nil
 		},
 	}, {
-		cmd:     "rotatecookies",
-		aliases: []string{"rotcookies", "rotcookie"},
-		descr:   "Rotate session cookies to prevent kicked members from returning",
-		usage:   "<session>",
+		cmd:           "list",
+		usableOffline: true,
+		descr:         "List the recurring payments made to and received from users",
+		handler: func(args []string, as *appState) error {
+			rps, err := as.c.ListRecurringPayments()
+			if err != nil {
+				return err
+			}
+			if len(rps) == 0 {
+				as.cwHelpMsg("No recurring payments")
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Recurring payments")
+				for i := range rps {
+					nick, _ := as.c.UserNick(rps[i].UID)
+					if nick == "" {
+						nick = rps[i].UID.ShortLogID()
+					}
+					pf("%s", recurringPaymentStr(&rps[i], nick))
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:   "cancel",
+		usage: "<id> [reason]",
+		descr: "Cancel a recurring payment",
 		handler: func(args []string, as *appState) error {
 			if len(args) < 1 {
-				return usageError{msg: "session RV cannot be empty"}
+				return usageError{msg: "recurring payment id cannot be empty"}
 			}
-			sess, err := as.c.GetRTDTSessionByPrefix(args[0])
+			rp, err := recurringPaymentByPrefix(args[0], as)
 			if err != nil {
 				return err
 			}
-			sessRV := sess.Metadata.RV
-			if !sess.LocalIsAdmin() {
-				return errors.New("only session admins can remove members")
+			reason := strings.Join(args[1:], " ")
+			if err := as.c.CancelRecurringPayment(rp.UID, rp.ID, reason); err != nil {
+				return err
 			}
-
-			go as.rtRotateCookies(sessRV)
+			as.cwHelpMsg("Canceled recurring payment %s", rp.ID.ShortLogID())
 			return nil
 		},
 		completer: func(args []string, arg string, as *appState) []string {
 			if len(args) == 0 {
-				return rtdtSessionCompleter(arg, as)
+				return recurringPaymentCompleter(arg, as)
 			}
 			return nil
 		},
 	}, {
-		cmd:   "win",
-		descr: "Open the main real time chat window to manage live sessions",
+		cmd:           "tiers",
+		usableOffline: true,
+		descr:         "List the tiers offered to supporters",
 		handler: func(args []string, as *appState) error {
-			as.sendMsg(msgOpenRTChatWin{})
+			tiers, err := as.c.ListRecurringPaymentTiers()
+			if err != nil {
+				return err
+			}
+			if len(tiers) == 0 {
+				as.cwHelpMsg("No tiers offered")
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Tiers")
+				for _, tier := range tiers {
+					pf("%q: at least %.8f DCR every %s",
+						strescape.Content(tier.Name),
+						float64(tier.MinMilliAtoms)/1e11,
+						tier.MaxPeriod)
+				}
+			})
+			return nil
+		},
+	}, {
+		cmd:           "settier",
+		usableOffline: true,
+		usage:         "<min dcr amount> <max period> <tier>",
+		descr:         "Create or modify a tier offered to supporters",
+		long:          []string{"Recurring payments for the tier must pay at least the min amount at most every max period. Recurring payments for tiers that are not offered or that do not meet the requirements of the tier are rejected."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "min amount cannot be empty"}
+			}
+			if len(args) < 2 {
+				return usageError{msg: "max period cannot be empty"}
+			}
+			if len(args) < 3 {
+				return usageError{msg: "tier cannot be empty"}
+			}
+			dcrAmount, err := strconv.ParseFloat(args[0], 64)
+			if err != nil {
+				return err
+			}
+			maxPeriod, err := strduration.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			tier, err := as.c.SetRecurringPaymentTier(strings.Join(args[2:], " "),
+				dcrAmount, maxPeriod)
+			if err != nil {
+				return err
+			}
+			as.cwHelpMsg("Tier %q requires at least %.8f DCR every %s",
+				strescape.Content(tier.Name), dcrAmount, tier.MaxPeriod)
+			return nil
+		},
+	}, {
+		cmd:           "rmtier",
+		usableOffline: true,
+		usage:         "<tier>",
+		descr:         "Stop offering a tier to supporters",
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "tier cannot be empty"}
+			}
+			tier := strings.Join(args, " ")
+			if err := as.c.RemoveRecurringPaymentTier(tier); err != nil {
+				return err
+			}
+			as.cwHelpMsg("Removed tier %q", strescape.Content(tier))
+			return nil
+		},
+	}, {
+		cmd:           "members",
+		usableOffline: true,
+		usage:         "<tier>",
+		descr:         "List the users that are members of a tier",
+		long:          []string{"Users become members of a tier while they make recurring payments for it that meet the requirements of the tier."},
+		handler: func(args []string, as *appState) error {
+			if len(args) < 1 {
+				return usageError{msg: "tier cannot be empty"}
+			}
+			tier := strings.Join(args, " ")
+			members, err := as.c.ListTierMembers(tier)
+			if err != nil {
+				return err
+			}
+			if len(members) == 0 {
+				as.cwHelpMsg("No members of tier %q", tier)
+				return nil
+			}
+			as.cwHelpMsgs(func(pf printf) {
+				pf("")
+				pf("Members of tier %q", tier)
+				for _, rp := range members {
+					nick, _ := as.c.UserNick(rp.UID)
+					if nick == "" {
+						nick = rp.UID.ShortLogID()
+					}
+					pf("%s (%d periods paid, paid until %s)",
+						strescape.Nick(nick), rp.PeriodsPaid,
+						rp.NextPayment.Format(ISO8601DateTime))
+				}
+			})
 			return nil
 		},
 	},
@@ -4866,6 +6899,46 @@ var commands = []tuicmd{
 			}
 			return nil
 		},
+	}, {
+		cmd:   "payreq",
+		usage: "[sub]",
+		descr: "Payment request commands",
+		sub:   payReqCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(payReqCommands, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:   "recurring",
+		usage: "[sub]",
+		descr: "Recurring payment commands",
+		sub:   recurringCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(recurringCommands, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
+	}, {
+		cmd:           "budget",
+		usableOffline: true,
+		usage:         "[sub]",
+		descr:         "Payment budget commands",
+		long: []string{
+			"Budgets limit the amount spent by automatic payments and are configured in the [budget] section of the config file. Payments that would exceed a budget are blocked until approved or rejected.",
+		},
+		sub: budgetCommands,
+		completer: func(args []string, arg string, as *appState) []string {
+			if len(args) == 0 {
+				return cmdCompleter(budgetCommands, arg, false)
+			}
+			return nil
+		},
+		handler: subcmdNeededHandler,
 	}, {
 		cmd:   "ft",
 		usage: "[sub]",
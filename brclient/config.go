@@ -18,8 +18,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/version"
+	"github.com/companyzero/bisonrelay/rates"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/go-socks/socks"
 	"github.com/jrick/flagfile"
@@ -89,6 +91,9 @@ type config struct {
 	LNRPCHost         string
 	LNTLSCertPath     string
 	LNMacaroonPath    string
+	RemotePayURL      string
+	RemotePayCertPath string
+	RemotePayToken    string
 	LNDebugLevel      string
 	LNMaxLogFiles     int
 	LNRPCListen       []string
@@ -113,6 +118,11 @@ type config struct {
 	AutoSubPosts      bool
 
 	ReleaseTermOnViewEmbed bool
+	InlineImages           bool
+
+	EmbedImageMaxDim        int
+	EmbedImageQuality       int
+	EmbedImageStripMetadata bool
 
 	AutoHandshakeInterval       time.Duration
 	AutoRemoveIdleUsersInterval time.Duration
@@ -150,6 +160,14 @@ type config struct {
 	TipUserReRequestInvoiceDelay time.Duration
 	TipUserMaxLifetime           time.Duration
 	TipUserPayRetryDelayFactor   time.Duration
+	TipUserOnchainFallback       bool
+	TipUserOnchainAccount        string
+
+	KXPaymentPrice  dcrutil.Amount
+	KXPaymentRefund bool
+
+	LNLiquidity *client.LNLiquidityConfig
+
 	// rpc configurable params
 	RPCAllowRemoteSendTip  bool
 	RPCMaxRemoteSendTipAmt float64
@@ -161,6 +179,22 @@ type config struct {
 	SimpleStoreAccount    string
 	SimpleStoreShipCharge float64
 
+	PostsFeedEnable   bool
+	PostsFeedTitle    string
+	PostsFeedBaseURL  string
+	PostsFeedAuthors  []string
+	PostsFeedHTTPAddr string
+
+	FeedBridgeSources  []string
+	FeedBridgeInterval time.Duration
+
+	PaymentBudgets             []client.PaymentBudget
+	PaymentBudgetWarnThreshold float64
+
+	RatesProviders   []rates.Provider
+	RatesHistoryFile string
+	RatesStaleAfter  time.Duration
+
 	RTAutoHotAudio bool
 
 	dialFunc func(context.Context, string, string) (net.Conn, error)
@@ -293,6 +327,10 @@ func loadConfig() (*config, error) {
 	var mimetypes cfgStringArray
 	fs.Var(&mimetypes, "mimetype", "List of mimetypes with viewer")
 	flagReleaseTermOnViewEmbed := fs.Bool("releasetermonviewembed", false, "Release terminal")
+	flagInlineImages := fs.Bool("inlineimages", true, "Render image thumbnails (requires ANSI256 or TrueColor terminal support)")
+	flagEmbedImageMaxDim := fs.Int("embedimagemaxdim", 1920, "Max width and height of embedded images")
+	flagEmbedImageQuality := fs.Int("embedimagequality", 85, "JPEG quality of embedded images")
+	flagEmbedImageStripMetadata := fs.Bool("embedimagestripmetadata", true, "Strip metadata from embedded images")
 
 	flagBellCmd := fs.String("bellcmd", "", "Bell command on new msgs")
 	flagSyncFreeList := fs.Bool("syncfreelist", true, "")
@@ -326,6 +364,9 @@ func loadConfig() (*config, error) {
 	flagLNHost := fs.String("payment.lnrpchost", "127.0.0.1:10009", "dcrlnd network address")
 	flagLNTLSCert := fs.String("payment.lntlscert", "~/.dcrlnd/tls.cert", "path to dcrlnd tls.cert")
 	flagLNMacaroonPath := fs.String("payment.lnmacaroonpath", "", "path do dcrlnd admin.macaroon")
+	flagRemotePayURL := fs.String("payment.remoteurl", "", "URL of the remote payment server")
+	flagRemotePayCert := fs.String("payment.remotecert", "", "path to the TLS cert of the remote payment server")
+	flagRemotePayToken := fs.String("payment.remotetoken", "", "auth token for the remote payment server")
 	flagLNDebugLevel := fs.String("payment.lndebuglevel", "info", "LN log level")
 	flagLNMaxLogFiles := fs.Int("payment.lnmaxlogfiles", 3, "LN Max Log Files")
 	flagMinWalletBal := fs.Float64("payment.minimumwalletbalance", 1.0, "Minimum wallet balance before warn")
@@ -351,6 +392,23 @@ func loadConfig() (*config, error) {
 	flagTipUserReRequestInvoiceDelay := fs.String("tipuser.rerequestinvoicedelay", "24h", "Re-request invoice delay for tip user attempts")
 	flagTipUserMaxLifetime := fs.String("tipuser.maxlifetime", "72h", "Maximum lifetime for tip user")
 	flagTipUserPayRetryDelayFactor := fs.String("tipuser.payretrydelayfactor", "12s", "Retry delay factor for tip user payment")
+	flagTipUserOnchainFallback := fs.Bool("tipuser.onchainfallback", false, "Send tips on-chain when LN payments fail")
+	flagTipUserOnchainAccount := fs.String("tipuser.onchainaccount", "", "Account to use for on-chain tip addresses")
+
+	// kxpayment
+	flagKXPaymentPrice := fs.Float64("kxpayment.price", 0, "Amount required to complete KXs with invites")
+	flagKXPaymentRefund := fs.Bool("kxpayment.refund", false, "Refund KX payments after the KX completes")
+
+	// lnliquidity
+	flagLNLiqEnable := fs.Bool("lnliquidity.enable", false, "Enable automatic management of LN liquidity")
+	flagLNLiqMinInbound := fs.Float64("lnliquidity.mininbound", 0, "Minimum inbound capacity")
+	flagLNLiqInboundChanSize := fs.Float64("lnliquidity.inboundchansize", 0, "Size of requested inbound channels")
+	flagLNLiqMinOutbound := fs.Float64("lnliquidity.minoutbound", 0, "Minimum outbound capacity")
+	flagLNLiqOutboundChanSize := fs.Float64("lnliquidity.outboundchansize", 0, "Size of opened outbound channels")
+	flagLNLiqBudget := fs.Float64("lnliquidity.budget", 0, "Max amount spent on liquidity within the budget window")
+	flagLNLiqBudgetWindow := fs.String("lnliquidity.budgetwindow", "720h", "Window of the liquidity budget")
+	flagLNLiqAutoPerform := fs.Bool("lnliquidity.autoperform", false, "Automatically perform liquidity actions")
+	flagLNLiqCheckInterval := fs.String("lnliquidity.checkinterval", "10m", "Interval between liquidity checks")
 
 	// resources
 	flagResourcesUpstream := fs.String("resources.upstream", "", "Upstream processor of resource requests")
@@ -360,6 +418,26 @@ func loadConfig() (*config, error) {
 	flagSimpleStoreAccount := fs.String("simplestore.account", "", "Account to use for on-chain adresses")
 	flagSimpleStoreShipCharge := fs.Float64("simplestore.shipcharge", 0, "How much to charge for s&h")
 
+	// postsfeed
+	flagPostsFeedEnable := fs.Bool("postsfeed.enable", false, "Offer the local posts as Atom/RSS feeds under /feed")
+	flagPostsFeedTitle := fs.String("postsfeed.title", "", "Title of the posts feed")
+	flagPostsFeedBaseURL := fs.String("postsfeed.baseurl", "", "External URL of the posts feed HTTP server")
+	flagPostsFeedAuthors := fs.String("postsfeed.authors", "", "Comma separated list of users whose posts are also included in the feed")
+	flagPostsFeedHTTPAddr := fs.String("postsfeed.httpaddr", "", "Address of the HTTP server of the posts feed")
+
+	// feedbridge
+	flagFeedBridgeSources := fs.String("feedbridge.sources", "", "Comma separated list of RSS/Atom feeds to republish as posts")
+	flagFeedBridgeInterval := fs.String("feedbridge.interval", "30m", "How often to poll the feeds republished as posts")
+
+	// budget
+	flagBudgetLimits := fs.String("budget.limits", "", "Comma separated list of <category>:<window>:<max dcr> limits for automatic payments")
+	flagBudgetWarnThreshold := fs.Float64("budget.warnthreshold", 0.8, "Fraction of a budget spent before warning")
+
+	// rates
+	flagRatesProviders := fs.String("rates.providers", "", "Comma separated list of exchange rate providers")
+	flagRatesFileMaxAge := fs.String("rates.filemaxage", "24h", "Max age of the rates read from file providers")
+	flagRatesStaleAfter := fs.String("rates.staleafter", "1h", "Duration after which exchange rates that could not be updated are stale")
+
 	// Open config file.
 	f, err := os.Open(cfgFile)
 	if os.IsNotExist(err) {
@@ -467,6 +545,7 @@ func loadConfig() (*config, error) {
 	*flagLogFile = expandPath(homeDir, *flagLogFile)
 	*flagLNTLSCert = expandPath(homeDir, *flagLNTLSCert)
 	*flagLNMacaroonPath = expandPath(homeDir, *flagLNMacaroonPath)
+	*flagRemotePayCert = expandPath(homeDir, *flagRemotePayCert)
 	*flagMsgRoot = expandPath(homeDir, *flagMsgRoot)
 	*flagRPCKeyPath = expandPath(homeDir, *flagRPCKeyPath)
 	*flagRPCCertPath = expandPath(homeDir, *flagRPCCertPath)
@@ -489,6 +568,21 @@ func loadConfig() (*config, error) {
 	if err != nil || minSendBal < 0 {
 		return nil, fmt.Errorf("invalid minimum send balance")
 	}
+	kxPaymentPrice, err := dcrutil.NewAmount(*flagKXPaymentPrice)
+	if err != nil || kxPaymentPrice < 0 {
+		return nil, fmt.Errorf("invalid kx payment price")
+	}
+	var lnLiquidity *client.LNLiquidityConfig
+	if *flagLNLiqEnable {
+		lnLiquidity, err = parseLNLiquidityConfig(*flagLNLiqMinInbound,
+			*flagLNLiqInboundChanSize, *flagLNLiqMinOutbound,
+			*flagLNLiqOutboundChanSize, *flagLNLiqBudget,
+			*flagLNLiqBudgetWindow, *flagLNLiqCheckInterval)
+		if err != nil {
+			return nil, err
+		}
+		lnLiquidity.AutoPerform = *flagLNLiqAutoPerform
+	}
 	var winpin []string
 	if *flagWinPin != "" {
 		winpin = strings.Split(*flagWinPin, ",")
@@ -503,6 +597,43 @@ func loadConfig() (*config, error) {
 	}
 
 	autoRemoveIgnoreList := strings.Split(*flagAutoRemoveIgnoreList, ",")
+
+	var feedBridgeSources []string
+	for _, source := range strings.Split(*flagFeedBridgeSources, ",") {
+		if source = strings.TrimSpace(source); source != "" {
+			feedBridgeSources = append(feedBridgeSources, source)
+		}
+	}
+	feedBridgeInterval, err := strduration.ParseDuration(*flagFeedBridgeInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'feedbridge.interval': %v", err)
+	}
+
+	paymentBudgets, err := parsePaymentBudgets(*flagBudgetLimits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'budget.limits': %v", err)
+	}
+
+	ratesFileMaxAge, err := strduration.ParseDuration(*flagRatesFileMaxAge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'rates.filemaxage': %v", err)
+	}
+	ratesProviders, err := parseRatesProviders(*flagRatesProviders,
+		*flagProxyAddr != "", ratesFileMaxAge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'rates.providers': %v", err)
+	}
+	ratesStaleAfter, err := strduration.ParseDuration(*flagRatesStaleAfter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'rates.staleafter': %v", err)
+	}
+
+	var postsFeedAuthors []string
+	for _, author := range strings.Split(*flagPostsFeedAuthors, ",") {
+		if author = strings.TrimSpace(author); author != "" {
+			postsFeedAuthors = append(postsFeedAuthors, author)
+		}
+	}
 	for i := range autoRemoveIgnoreList {
 		autoRemoveIgnoreList[i] = strings.TrimSpace(autoRemoveIgnoreList[i])
 	}
@@ -582,6 +713,9 @@ func loadConfig() (*config, error) {
 		LNRPCHost:              *flagLNHost,
 		LNTLSCertPath:          *flagLNTLSCert,
 		LNMacaroonPath:         *flagLNMacaroonPath,
+		RemotePayURL:           *flagRemotePayURL,
+		RemotePayCertPath:      *flagRemotePayCert,
+		RemotePayToken:         *flagRemotePayToken,
 		LNDebugLevel:           *flagLNDebugLevel,
 		LNMaxLogFiles:          *flagLNMaxLogFiles,
 		LNRPCListen:            lnRPCListen,
@@ -624,11 +758,23 @@ func loadConfig() (*config, error) {
 		RPCAllowRemoteSendTip:  *flagRPCAllowRemoteSendTip,
 		RPCMaxRemoteSendTipAmt: *flagRPCMaxRemoteSendTipAmt,
 		ReleaseTermOnViewEmbed: *flagReleaseTermOnViewEmbed,
+		InlineImages:           *flagInlineImages,
+
+		EmbedImageMaxDim:        *flagEmbedImageMaxDim,
+		EmbedImageQuality:       *flagEmbedImageQuality,
+		EmbedImageStripMetadata: *flagEmbedImageStripMetadata,
 
 		TipUserRestartDelay:          tipUserRestartDelay,
 		TipUserReRequestInvoiceDelay: tipUserReRequestInvoiceDelay,
 		TipUserMaxLifetime:           tipUserMaxLifetime,
 		TipUserPayRetryDelayFactor:   tipUserPayRetryDelayFactor,
+		TipUserOnchainFallback:       *flagTipUserOnchainFallback,
+		TipUserOnchainAccount:        *flagTipUserOnchainAccount,
+
+		KXPaymentPrice:  kxPaymentPrice,
+		KXPaymentRefund: *flagKXPaymentRefund,
+
+		LNLiquidity: lnLiquidity,
 
 		AutoHandshakeInterval:       autoHandshakeInterval,
 		AutoRemoveIdleUsersInterval: autoRemoveInterval,
@@ -644,12 +790,158 @@ func loadConfig() (*config, error) {
 		SimpleStoreAccount:    *flagSimpleStoreAccount,
 		SimpleStoreShipCharge: *flagSimpleStoreShipCharge,
 
+		PostsFeedEnable:   *flagPostsFeedEnable,
+		PostsFeedTitle:    *flagPostsFeedTitle,
+		PostsFeedBaseURL:  *flagPostsFeedBaseURL,
+		PostsFeedAuthors:  postsFeedAuthors,
+		PostsFeedHTTPAddr: *flagPostsFeedHTTPAddr,
+
+		FeedBridgeSources:  feedBridgeSources,
+		FeedBridgeInterval: feedBridgeInterval,
+
+		PaymentBudgets:             paymentBudgets,
+		PaymentBudgetWarnThreshold: *flagBudgetWarnThreshold,
+
+		RatesProviders:   ratesProviders,
+		RatesHistoryFile: filepath.Join(*flagRootDir, "rateshistory.json"),
+		RatesStaleAfter:  ratesStaleAfter,
+
 		RTAutoHotAudio: *flagRTAudioHotAudio,
 
 		dialFunc: dialFunc,
 	}, nil
 }
 
+// parseLNLiquidityConfig parses the config for the LN liquidity manager.
+func parseLNLiquidityConfig(minInbound, inboundChanSize, minOutbound,
+	outboundChanSize, budget float64, budgetWindow,
+	checkInterval string) (*client.LNLiquidityConfig, error) {
+
+	var cfg client.LNLiquidityConfig
+	amounts := []struct {
+		name string
+		dcr  float64
+		dst  *dcrutil.Amount
+	}{
+		{"mininbound", minInbound, &cfg.MinInbound},
+		{"inboundchansize", inboundChanSize, &cfg.InboundChanSize},
+		{"minoutbound", minOutbound, &cfg.MinOutbound},
+		{"outboundchansize", outboundChanSize, &cfg.OutboundChanSize},
+		{"budget", budget, &cfg.Budget},
+	}
+	for _, a := range amounts {
+		amt, err := dcrutil.NewAmount(a.dcr)
+		if err != nil || amt < 0 {
+			return nil, fmt.Errorf("invalid value for 'lnliquidity.%s'", a.name)
+		}
+		*a.dst = amt
+	}
+	if cfg.MinInbound > 0 && cfg.InboundChanSize <= 0 {
+		return nil, fmt.Errorf("'lnliquidity.inboundchansize' must be set " +
+			"when 'lnliquidity.mininbound' is set")
+	}
+	if cfg.MinOutbound > 0 && cfg.OutboundChanSize <= 0 {
+		return nil, fmt.Errorf("'lnliquidity.outboundchansize' must be set " +
+			"when 'lnliquidity.minoutbound' is set")
+	}
+
+	var err error
+	cfg.BudgetWindow, err = strduration.ParseDuration(budgetWindow)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'lnliquidity.budgetwindow': %v", err)
+	}
+	cfg.CheckInterval, err = strduration.ParseDuration(checkInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for 'lnliquidity.checkinterval': %v", err)
+	}
+	return &cfg, nil
+}
+
+// parsePaymentBudgets parses a comma separated list of budgets in the format
+// <category>:<window>:<max dcr>. The category "all" limits all payments.
+func parsePaymentBudgets(s string) ([]client.PaymentBudget, error) {
+	var res []client.PaymentBudget
+	for _, limit := range strings.Split(s, ",") {
+		if limit = strings.TrimSpace(limit); limit == "" {
+			continue
+		}
+		fields := strings.Split(limit, ":")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("limit %q is not in the format "+
+				"<category>:<window>:<max dcr>", limit)
+		}
+
+		var b client.PaymentBudget
+		if fields[0] != "all" {
+			b.Category = clientintf.PaymentCategory(fields[0])
+			if !slices.Contains(clientintf.PaymentCategories, b.Category) {
+				return nil, fmt.Errorf("unknown payment category %q",
+					fields[0])
+			}
+		}
+		window, err := strduration.ParseDuration(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid window %q: %v", fields[1], err)
+		}
+		if window <= 0 {
+			return nil, fmt.Errorf("window %q is not positive", fields[1])
+		}
+		b.Window = window
+
+		var dcr float64
+		if _, err := fmt.Sscanf(fields[2], "%g", &dcr); err != nil {
+			return nil, fmt.Errorf("invalid amount %q: %v", fields[2], err)
+		}
+		amt, err := dcrutil.NewAmount(dcr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount %q: %v", fields[2], err)
+		}
+		b.MaxMAtoms = int64(amt) * 1000
+		res = append(res, b)
+	}
+	return res, nil
+}
+
+// parseRatesProviders parses a comma separated list of exchange rate providers.
+// Each provider is one of dcrapi, dcrdata, static:<dcr usd>[:<btc usd>] or
+// file:<path>.
+func parseRatesProviders(s string, onion bool, fileMaxAge time.Duration) ([]rates.Provider, error) {
+	var res []rates.Provider
+	for _, spec := range strings.Split(s, ",") {
+		if spec = strings.TrimSpace(spec); spec == "" {
+			continue
+		}
+		kind, arg, _ := strings.Cut(spec, ":")
+		switch kind {
+		case "dcrapi":
+			res = append(res, rates.NewDcrAPIProvider(nil, onion))
+		case "dcrdata":
+			res = append(res, rates.NewDcrDataProvider(nil, onion))
+		case "static":
+			var p rates.StaticProvider
+			dcrPrice, btcPrice, _ := strings.Cut(arg, ":")
+			if _, err := fmt.Sscanf(dcrPrice, "%g", &p.DCRPrice); err != nil {
+				return nil, fmt.Errorf("invalid DCR price in %q: %v", spec, err)
+			}
+			if btcPrice != "" {
+				if _, err := fmt.Sscanf(btcPrice, "%g", &p.BTCPrice); err != nil {
+					return nil, fmt.Errorf("invalid BTC price in %q: %v", spec, err)
+				}
+			}
+			res = append(res, &p)
+		case "file":
+			if arg == "" {
+				return nil, fmt.Errorf("empty path in %q", spec)
+			}
+			path := cleanAndExpandPath(arg)
+			res = append(res, &rates.FileProvider{Path: path, MaxAge: fileMaxAge})
+		default:
+			return nil, fmt.Errorf("unknown provider %q", spec)
+		}
+	}
+	return res, nil
+}
+
 func saveNewConfig(cfgFile string, cfg *config) error {
 	// Figure out the config file name (which also establishes the data
 	// root).
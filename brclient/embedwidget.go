@@ -99,11 +99,26 @@ func (ew *embedWidget) tryEmbed() error {
 			return err
 		}
 
-		if uint64(len(data)) > uint64(ew.as.c.MaxMsgPayloadSize()) {
+		args.Typ = mime.TypeByExtension(filepath.Ext(filename))
+		prepared, err := ew.as.c.PrepareEmbed(data, args.Typ)
+		if err != nil {
+			return err
+		}
+		data, args.Typ = prepared.Data, prepared.MimeType
+
+		if uint64(len(data)) > uint64(ew.as.c.MaxFragmentedMsgPayloadSize()) {
 			return fmt.Errorf("file too big to embed")
 		}
 
-		args.Typ = mime.TypeByExtension(filepath.Ext(filename))
+		if prepared.Transcoded {
+			ew.as.cwHelpMsg("Embedded image transcoded from %s to %s",
+				hbytes(int64(prepared.OrigSize)), hbytes(int64(len(data))))
+		}
+		if prepared.EstCostMAtoms > 0 {
+			ew.as.cwHelpMsg("Estimated cost to send embed: %s",
+				dcrutil.Amount(prepared.EstCostMAtoms/1000))
+		}
+
 		id = chainhash.HashH(data).String()[:8]
 		pseudoData := fmt.Sprintf("[content %s]", id)
 		args.Data = []byte(pseudoData)
@@ -52,9 +52,15 @@ func (fw *feedWindow) renderPostSumm(post clientdb.PostSummary,
 
 	// Limit displayed title.
 	maxTitleLen := fw.as.winW - 22 - min(len(author), 15) // timestamp + "by <author>"
+	if post.LastRevision > 0 {
+		maxTitleLen -= len(" (edited)")
+	}
 	if maxTitleLen > 0 && len(title) > maxTitleLen {
 		title = title[:maxTitleLen]
 	}
+	if post.LastRevision > 0 {
+		title += " (edited)"
+	}
 
 	if fw.idx == i {
 		b.WriteString(st.focused.Render(pf("%s %s by %s", date, title, author)))
@@ -83,6 +89,9 @@ func (fw *feedWindow) renderPostSumm(post clientdb.PostSummary,
 
 	b.WriteString(st.help.Render("    Last Update: "))
 	b.WriteString(st.timestampHelp.Render(lastStatus))
+	if !post.Expiration.IsZero() {
+		b.WriteString(st.help.Render(" - " + postExpirationStr(post.Expiration)))
+	}
 	b.WriteString("\n\n")
 }
 
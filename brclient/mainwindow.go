@@ -655,7 +655,7 @@ func (mws mainWindowState) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case showNewPostWindow:
 		mws.as.workingCmd = ""
-		return newNewPostWindow(mws.as)
+		return newNewPostWindow(mws.as, msg.draft)
 
 	case showFeedWindow:
 		mws.as.workingCmd = ""
@@ -139,8 +139,11 @@ type requestShutdown struct{}
 // UI update.
 type currentTimeChanged struct{}
 
-// showNewPostWindow shows the create post window.
-type showNewPostWindow struct{}
+// showNewPostWindow shows the create post window. If draft is not nil, the
+// window is used to edit the draft.
+type showNewPostWindow struct {
+	draft *clientdb.PostDraft
+}
 
 // showFeedWindow shows the feed window.
 type showFeedWindow struct {
@@ -155,6 +158,13 @@ type feedUpdated struct {
 // sentPostComment is sent when a new local comment to a post is sent.
 type sentPostComment struct{}
 
+// paidPostUnlocked is sent when the full content of a paid post is received.
+type paidPostUnlocked struct{ pid clientintf.PostID }
+
+// heldCommentsChanged is sent when the list of comments held for approval on
+// a local post changes.
+type heldCommentsChanged struct{ pid clientintf.PostID }
+
 // kxCompleted is sent when a KX process has completed with a remote peer.
 type kxCompleted struct{ uid clientintf.UserID }
 
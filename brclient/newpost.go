@@ -3,11 +3,15 @@ package main
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/chaincfg/chainhash"
 )
 
 type newPostWindow struct {
@@ -24,6 +28,11 @@ type newPostWindow struct {
 	ew           *embedWidget
 
 	estSize uint64
+
+	// draft is the post draft where the contents are autosaved.
+	draft           clientdb.PostDraft
+	savedContent    string
+	autosavePending bool
 }
 
 func (pw *newPostWindow) updateTextAreaSize() {
@@ -34,7 +43,7 @@ func (pw *newPostWindow) updateTextAreaSize() {
 }
 
 func (pw *newPostWindow) addEmbedCB(id string, data []byte, embedStr string) error {
-	maxChunkSize := pw.as.c.MaxMsgPayloadSize()
+	maxChunkSize := pw.as.c.MaxFragmentedMsgPayloadSize()
 	if pw.estSize+uint64(len(data)) >= uint64(maxChunkSize) {
 		return fmt.Errorf("file too big to embed")
 	}
@@ -47,9 +56,9 @@ func (pw *newPostWindow) addEmbedCB(id string, data []byte, embedStr string) err
 	return nil
 }
 
-func (pw *newPostWindow) createPost(post string) {
-	// Replace pseudo-data with data.
-	fullPost := mdembeds.ReplaceEmbeds(post, func(args mdembeds.EmbeddedArgs) string {
+// fullPost replaces the pseudo-data of embeds with the embedded data.
+func (pw *newPostWindow) fullPost(post string) string {
+	return mdembeds.ReplaceEmbeds(post, func(args mdembeds.EmbeddedArgs) string {
 		data := string(args.Data)
 		if strings.HasPrefix(data, "[content ") {
 			id := data[9 : len(args.Data)-1]
@@ -59,7 +68,50 @@ func (pw *newPostWindow) createPost(post string) {
 		return args.String()
 
 	})
-	go pw.as.createPost(fullPost, "")
+}
+
+func (pw *newPostWindow) createPost(post string, draftID zkidentity.ShortID) {
+	fullPost := pw.fullPost(post)
+	if err := pw.as.createPost(fullPost, ""); err != nil {
+		// Keep the draft, so that the post can be published later.
+		return
+	}
+	if !draftID.IsEmpty() {
+		if err := pw.as.c.RemovePostDraft(draftID); err != nil {
+			pw.as.diagMsg("Unable to remove post draft: %v", err)
+		}
+	}
+}
+
+// saveDraft saves the current contents of the post as a draft, if they
+// changed since the last save.
+func (pw *newPostWindow) saveDraft() {
+	content := pw.fullPost(pw.textArea.Value())
+	if content == pw.savedContent {
+		return
+	}
+	if strings.TrimSpace(content) == "" {
+		// Do not keep empty drafts.
+		if !pw.draft.ID.IsEmpty() {
+			if err := pw.as.c.RemovePostDraft(pw.draft.ID); err != nil {
+				pw.errMsg = fmt.Sprintf("Unable to remove draft: %v", err)
+				return
+			}
+			pw.draft = clientdb.PostDraft{}
+		}
+		pw.savedContent = content
+		return
+	}
+
+	draft := pw.draft
+	draft.Content = content
+	draft, err := pw.as.c.SavePostDraft(draft)
+	if err != nil {
+		pw.errMsg = fmt.Sprintf("Unable to save draft: %v", err)
+		return
+	}
+	pw.draft = draft
+	pw.savedContent = content
 }
 
 func (pw newPostWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -102,13 +154,19 @@ func (pw newPostWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = pw.ew.activate()
 
 		case msg.Type == tea.KeyEsc:
-			// Cancel post.
+			// Cancel post, keeping the contents as a draft.
+			pw.saveDraft()
+			if !pw.draft.ID.IsEmpty() {
+				pw.as.cwHelpMsg("Post saved as draft %s. Use "+
+					"/post editdraft to resume editing it.",
+					pw.draft.ID.ShortLogID())
+			}
 			return newMainWindowState(pw.as)
 
 		case pw.focusIdx == 1 && msg.Type == tea.KeyEnter:
 			post := pw.textArea.Value()
 			if post != "" {
-				go pw.createPost(post)
+				go pw.createPost(post, pw.draft.ID)
 			}
 
 			return newMainWindowState(pw.as)
@@ -131,8 +189,18 @@ func (pw newPostWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if err != nil {
 				pw.errMsg = err.Error()
 			}
+
+			if !pw.autosavePending {
+				pw.autosavePending = true
+				cmds = appendCmd(cmds, emitAfter(msgAutosavePostDraft{},
+					autosavePostDraftDelay))
+			}
 		}
 
+	case msgAutosavePostDraft:
+		pw.autosavePending = false
+		pw.saveDraft()
+
 	default:
 		// Handle other messages.
 		pw.textArea, cmd = pw.textArea.Update(msg)
@@ -174,7 +242,7 @@ func (pw newPostWindow) View() string {
 		b.WriteString(styles.err.Render(pw.errMsg))
 	} else {
 		estSizeMsg := fmt.Sprintf(" Estimated post size: %s.", hbytes(int64(pw.estSize)))
-		if pw.estSize > uint64(pw.as.c.MaxMsgPayloadSize()) {
+		if pw.estSize > uint64(pw.as.c.MaxFragmentedMsgPayloadSize()) {
 			estSizeMsg = styles.err.Render(estSizeMsg)
 		}
 		b.WriteString(estSizeMsg)
@@ -191,7 +259,14 @@ func (pw newPostWindow) View() string {
 	return b.String()
 }
 
-func newNewPostWindow(as *appState) (newPostWindow, tea.Cmd) {
+// autosavePostDraftDelay is the delay after an edit to autosave the post
+// being edited as a draft.
+const autosavePostDraftDelay = 3 * time.Second
+
+// msgAutosavePostDraft is sent to autosave the post being edited.
+type msgAutosavePostDraft struct{}
+
+func newNewPostWindow(as *appState, draft *clientdb.PostDraft) (newPostWindow, tea.Cmd) {
 	styles := as.styles.Load()
 	t := newTextAreaModel(styles)
 	t.Placeholder = "Post"
@@ -208,6 +283,23 @@ func newNewPostWindow(as *appState) (newPostWindow, tea.Cmd) {
 		embedContent: make(map[string][]byte),
 	}
 
+	if draft != nil {
+		// Replace embedded data with pseudo-data, as is done when
+		// embedding new files.
+		content := mdembeds.ReplaceEmbeds(draft.Content, func(args mdembeds.EmbeddedArgs) string {
+			if len(args.Data) > 0 {
+				id := chainhash.HashH(args.Data).String()[:8]
+				nw.embedContent[id] = args.Data
+				args.Data = []byte(fmt.Sprintf("[content %s]", id))
+			}
+			return args.String()
+		})
+		t.SetValue(content)
+		nw.draft = *draft
+		nw.savedContent = draft.Content
+		nw.estSize, _ = clientintf.EstimatePostSize(content, "")
+	}
+
 	nw.ew = newEmbedWidget(as, nw.addEmbedCB)
 	nw.updateTextAreaSize()
 	return nw, batchCmds(nil)
@@ -30,11 +30,14 @@ type comment struct {
 	comment   string
 	fromUID   *client.UserID
 	id        clientintf.ID
-	parent    *clientintf.ID
-	children  []*comment
 	depth     int
 	idx       int
 	timestamp int64
+
+	// replies is the total number of replies in the thread started by
+	// this comment.
+	replies   int
+	collapsed bool
 }
 
 // postWindow tracks what needs to be initialized before the app can
@@ -51,6 +54,27 @@ type postWindow struct {
 	relayedBy   string
 	knowsAuthor bool
 
+	// revisions are the revisions of the post. viewRev is the index of
+	// the version being viewed: 0 is the original version and -1 is the
+	// latest one.
+	revisions []rpc.PostMetadataStatus
+	viewRev   int
+
+	// audience is the audience of restricted posts by the local client.
+	audience string
+
+	// price is the price (in milliatoms) to unlock paid posts and
+	// paidContent is their full content, after being unlocked.
+	price       uint64
+	paidContent string
+
+	// commentsLocked is true when the author locked the comments of the
+	// post. held are the comments held for approval on local posts.
+	commentsLocked bool
+	held           []clientdb.HeldPostComment
+	selHeld        int
+	moderating     bool
+
 	feedActiveIdx   int
 	feedYOffsetHint int
 
@@ -59,6 +83,9 @@ type postWindow struct {
 	kxSearchCompleted bool
 	requestedInvites  map[clientintf.UserID]struct{}
 
+	// collapsed are the comments whose thread of replies is collapsed.
+	collapsed map[clientintf.ID]struct{}
+
 	debug string
 
 	startCommentsLine int
@@ -110,14 +137,6 @@ func (pw *postWindow) processStatus(status rpc.PostMetadataStatus) *comment {
 				from, err)
 		}
 
-		var parent *clientintf.ID
-		if s, ok := status.Attributes[rpc.RMPParent]; ok {
-			var id clientintf.ID
-			if err := id.FromString(s); err == nil {
-				parent = &id
-			}
-		}
-
 		var timeStamp int64
 		if time, ok := status.Attributes[rpc.RMPTimestamp]; ok {
 			date, err := strconv.ParseInt(time, 16, 64)
@@ -131,7 +150,6 @@ func (pw *postWindow) processStatus(status rpc.PostMetadataStatus) *comment {
 			fromUID:   fromUID,
 			comment:   txt,
 			id:        status.Hash(),
-			parent:    parent,
 			timestamp: timeStamp,
 		}
 	}
@@ -141,6 +159,20 @@ func (pw *postWindow) processStatus(status rpc.PostMetadataStatus) *comment {
 func (pw *postWindow) updatePost() {
 	var status []rpc.PostMetadataStatus
 	pw.post, pw.summ, status, pw.myComments = pw.as.activePost()
+	pw.revisions = pw.as.activePostRevisions()
+	pw.audience = ""
+	pw.commentsLocked = clientdb.PostCommentsLocked(status)
+	pw.loadHeldComments()
+	pw.price, _ = clientdb.PaidPostPrice(&pw.post)
+	pw.paidContent = ""
+	if pw.price > 0 {
+		pw.paidContent, _ = pw.as.c.PaidPostContent(pw.summ.AuthorID, pw.summ.ID)
+	}
+	if pw.summ.From == pw.as.c.PublicID() {
+		if audience, _ := pw.as.c.PostAudience(pw.summ.ID); audience != nil {
+			pw.audience = audience.Name
+		}
+	}
 
 	// Process status updates into hearts and comments.
 	if pw.comments != nil {
@@ -158,7 +190,6 @@ func (pw *postWindow) updatePost() {
 	_, err = pw.as.c.GetKXSearch(pw.summ.AuthorID)
 	pw.kxSearchingAuthor = err == nil
 
-	var roots []*comment
 	cmap := make(map[clientintf.ID]*comment)
 	for i, status := range status {
 		cmt := pw.processStatus(status)
@@ -166,39 +197,107 @@ func (pw *postWindow) updatePost() {
 			continue
 		}
 		cmt.idx = i
-
-		// Create tree of comments.
 		cmap[cmt.id] = cmt
-		if cmt.parent == nil {
-			roots = append(roots, cmt)
-			continue
-		}
+	}
 
-		pc := cmap[*cmt.parent]
-		if pc == nil {
-			// Comment without knowing parent
-			roots = append(roots, cmt)
-			continue
+	// Flatten the tree of comments into an ordered list, skipping the
+	// replies of collapsed threads.
+	var flatten func(nodes []*clientdb.PostCommentNode)
+	flatten = func(nodes []*clientdb.PostCommentNode) {
+		for _, node := range nodes {
+			cmt := cmap[node.ID]
+			if cmt == nil {
+				continue
+			}
+			cmt.depth = node.Depth
+			cmt.replies = node.NumReplies()
+			_, cmt.collapsed = pw.collapsed[cmt.id]
+			pw.comments = append(pw.comments, cmt)
+			if !cmt.collapsed {
+				flatten(node.Children)
+			}
 		}
+	}
+	flatten(clientdb.PostCommentTree(status))
+}
 
-		pc.children = append(pc.children, cmt)
-		cmt.depth = pc.depth + 1
+// toggleSelectedThread collapses or expands the thread of replies of the
+// selected comment.
+func (pw *postWindow) toggleSelectedThread() {
+	if pw.selComment >= len(pw.comments) {
+		return
 	}
+	cmt := pw.comments[pw.selComment]
+	if cmt.replies == 0 {
+		return
+	}
+	if pw.collapsed == nil {
+		pw.collapsed = make(map[clientintf.ID]struct{})
+	}
+	if cmt.collapsed {
+		delete(pw.collapsed, cmt.id)
+	} else {
+		pw.collapsed[cmt.id] = struct{}{}
+	}
+	pw.updatePost()
+	pw.renderPost()
+}
 
-	// Flatten tree into ordered list of comments.
-	stack := make([]*comment, 0, len(roots))
-	for i := len(roots) - 1; i >= 0; i-- {
-		stack = append(stack, roots[i])
+// loadHeldComments loads the comments of the post held for approval.
+func (pw *postWindow) loadHeldComments() {
+	pw.held = pw.held[:0]
+	if pw.summ.From != pw.as.c.PublicID() {
+		return
 	}
-	for len(stack) > 0 {
-		l := len(stack)
-		el := stack[l-1]
-		stack = stack[:l-1]
-		pw.comments = append(pw.comments, el)
-		for i := len(el.children) - 1; i >= 0; i-- {
-			stack = append(stack, el.children[i])
+	held, err := pw.as.c.ListHeldPostComments()
+	if err != nil {
+		pw.as.diagMsg("Unable to load held comments: %v", err)
+		return
+	}
+	for _, hc := range held {
+		if hc.PostID == pw.summ.ID {
+			pw.held = append(pw.held, hc)
 		}
 	}
+	pw.selHeld = clamp(pw.selHeld, 0, max(len(pw.held)-1, 0))
+}
+
+// renderModerationQueue renders the comments held for approval.
+func (pw *postWindow) renderModerationQueue() {
+	styles := pw.as.styles.Load()
+	var b strings.Builder
+	b.WriteString(styles.help.Render("═════ Moderation Queue ══════════ (A)pprove, (X) Reject, F5 Back "))
+	b.WriteString("\n\n")
+	if len(pw.held) == 0 {
+		b.WriteString("(no comments held for approval)\n")
+	}
+	for i, hc := range pw.held {
+		style := styles.noStyle
+		nickStyle := styles.nick
+		if i == pw.selHeld {
+			style = styles.focused
+			nickStyle = styles.focused
+		}
+		nick, _ := pw.as.c.UserNick(hc.From)
+		if nick == "" {
+			nick = hc.Status.Attributes[rpc.RMPFromNick]
+		}
+		b.WriteString(nickStyle.Render(strescape.Nick(nick)))
+		b.WriteString(styles.timestampHelp.Render(" Received " +
+			hc.Received.Format("2006-01-02 15:04")))
+		b.WriteString("\n")
+		comment := strescape.CannonicalizeNL(strescape.Content(
+			hc.Status.Attributes[rpc.RMPSComment]))
+		comment = ansi.Wordwrap(comment, pw.as.winW-2, wordBreakpoints)
+		for _, l := range strings.Split(comment, "\n") {
+			b.WriteString(style.Render(l))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	pw.recalcViewportSize()
+	pw.viewport.SetContent(b.String())
 }
 
 func (pw *postWindow) renderComment(cmt *comment, write func(s string), idx int) {
@@ -292,6 +391,12 @@ func (pw *postWindow) renderComment(cmt *comment, write func(s string), idx int)
 		}
 	}
 
+	if cmt.collapsed {
+		write(indent)
+		write(styles.help.Render(fmt.Sprintf("[+%d replies collapsed]", cmt.replies)))
+		write("\n")
+	}
+
 	write("\n")
 }
 
@@ -309,6 +414,16 @@ func (pw *postWindow) renderPost() {
 	styles := pw.as.styles.Load()
 	date := pw.summ.Date.Format("2006-01-02 15:04")
 
+	// Show the contents of the selected version of the post.
+	nbRevs := len(pw.revisions)
+	viewRev := pw.viewRev
+	if viewRev < 0 || viewRev > nbRevs {
+		viewRev = nbRevs
+	}
+	if viewRev > 0 {
+		attr = clientdb.ApplyPostRevision(pw.post, &pw.revisions[viewRev-1]).Attributes
+	}
+
 	write(styles.help.Render(pf("Post %s by ", id)))
 	write(styles.nick.Render(pf("%s", pw.author)))
 	write("\n")
@@ -326,15 +441,76 @@ func (pw *postWindow) renderPost() {
 		write("\n")
 	}
 
+	if pw.audience != "" {
+		write(styles.help.Render(pf("Restricted to audience %q (cannot be relayed)",
+			strescape.Content(pw.audience))))
+		write("\n")
+	} else if rpc.IsPostRestricted(&pw.post) {
+		write(styles.help.Render("Restricted post (cannot be relayed)"))
+		write("\n")
+	}
+
+	if !pw.summ.Expiration.IsZero() {
+		write(styles.help.Render(pf("Ephemeral post (%s at %s)",
+			postExpirationStr(pw.summ.Expiration),
+			pw.summ.Expiration.Format(ISO8601DateTime))))
+		write("\n")
+	}
+
+	if len(pw.summ.Tags) > 0 {
+		write(styles.help.Render("Tags: #" + strings.Join(pw.summ.Tags, " #")))
+		write("\n")
+	}
+
+	if pw.price > 0 {
+		dcrPrice := float64(pw.price) / 1e11
+		switch {
+		case pw.summ.AuthorID == pw.as.c.PublicID():
+			write(styles.help.Render(pf("Paid post (%.8f DCR)", dcrPrice)))
+		case pw.paidContent != "":
+			write(styles.help.Render(pf("Paid post (%.8f DCR) - unlocked", dcrPrice)))
+		default:
+			write(styles.help.Render(pf("Paid post preview - (B) unlock full post for %.8f DCR", dcrPrice)))
+		}
+		write("\n")
+	}
+
 	write(styles.help.Render("Received "))
 	write(styles.timestampHelp.Render(date))
 	//write(styles.help.Render(pf(" - %d ♥", pw.hearts)))
-	write("\n\n")
+	write("\n")
+
+	if nbRevs > 0 {
+		switch viewRev {
+		case 0:
+			write(styles.help.Render("Viewing original version"))
+		case nbRevs:
+			write(styles.help.Render("Edited"))
+		default:
+			rev := clientdb.PostRevisionNumber(&pw.revisions[viewRev-1])
+			write(styles.help.Render(pf("Viewing revision %d", rev)))
+		}
+		if viewRev > 0 {
+			revAttr := pw.revisions[viewRev-1].Attributes
+			ts, err := strconv.ParseInt(revAttr[rpc.RMPTimestamp], 16, 64)
+			if err == nil {
+				write(styles.help.Render(" on "))
+				write(styles.timestampHelp.Render(time.Unix(ts, 0).Format("2006-01-02 15:04")))
+			}
+		}
+		write(styles.help.Render(pf(" - (V) view prior versions (%d of %d)",
+			viewRev+1, nbRevs+1)))
+		write("\n")
+	}
+	write("\n")
 
 	content := strings.TrimSpace(attr[rpc.RMPMain])
 	if content == "" {
 		content = " (empty content) "
 	}
+	if pw.paidContent != "" {
+		content += "\n\n" + strings.TrimSpace(pw.paidContent)
+	}
 	content = strescape.Content(content)
 
 	// Replace embedded data tags.
@@ -422,9 +598,23 @@ func (pw *postWindow) renderPost() {
 		write(fmt.Sprintf("  /post get %s %s\n", nick, pw.summ.ID))
 
 	} else {
-		write(styles.help.Render("═════ Comments ══════════ (R)eply, (C)omment, (S+I) Req. Invite, F4 Recv Receipts "))
+		write(styles.help.Render("═════ Comments ══════════ (R)eply, (C)omment, (T)oggle thread, (S+I) Req. Invite, F4 Recv Receipts "))
 		write(styles.help.Render(strings.Repeat("═", pw.as.winW-15)))
-		write("\n\n")
+		write("\n")
+		if pw.summ.From == pw.as.c.PublicID() {
+			lockAction := "(L)ock comments"
+			if pw.commentsLocked {
+				lockAction = "(L) Unlock comments"
+			}
+			write(styles.help.Render(pf("(D)elete comment, %s, F5 Moderation queue (%d held)",
+				lockAction, len(pw.held))))
+			write("\n")
+		}
+		if pw.commentsLocked {
+			write(styles.help.Render("Comments locked by the author"))
+			write("\n")
+		}
+		write("\n")
 		pw.startCommentsLine = lineCount
 
 		// Render comments.
@@ -734,6 +924,38 @@ func (pw postWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case pw.showingRR:
 			// Ignore all other msgs when showing receive receipts.
 
+		case pw.moderating && (msg.Type == tea.KeyF5 || msg.Type == tea.KeyEsc):
+			pw.moderating = false
+			pw.recalcViewportSize()
+			pw.renderPost()
+			return pw, cmd
+
+		case pw.moderating && (msg.Type == tea.KeyUp || msg.Type == tea.KeyDown):
+			if msg.Type == tea.KeyDown {
+				pw.selHeld += 1
+			} else {
+				pw.selHeld -= 1
+			}
+			pw.selHeld = clamp(pw.selHeld, 0, max(len(pw.held)-1, 0))
+			pw.renderModerationQueue()
+
+		case pw.moderating && (msg.String() == "A" || msg.String() == "X"):
+			if pw.selHeld < len(pw.held) {
+				hc := pw.held[pw.selHeld]
+				go pw.as.moderateHeldComment(hc.PostID,
+					hc.Status.Hash(), msg.String() == "A")
+			}
+
+		case pw.moderating:
+			// Ignore all other msgs when moderating.
+
+		case msg.Type == tea.KeyF5 && pw.summ.From == pw.as.c.PublicID() &&
+			!pw.commenting && !pw.relaying:
+			pw.moderating = true
+			pw.loadHeldComments()
+			pw.renderModerationQueue()
+			return pw, cmd
+
 		case msg.Type == tea.KeyEsc:
 			pw.cmdErr = ""
 			if pw.commenting {
@@ -944,6 +1166,48 @@ func (pw postWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmd = pw.textArea.Focus()
 			return pw, cmd
 
+		case msg.String() == "V":
+			// Cycle from the latest version towards the original
+			// one.
+			if len(pw.revisions) > 0 {
+				if pw.viewRev < 0 || pw.viewRev > len(pw.revisions) {
+					pw.viewRev = len(pw.revisions)
+				}
+				pw.viewRev -= 1
+				if pw.viewRev < 0 {
+					pw.viewRev = len(pw.revisions)
+				}
+				pw.renderPost()
+			}
+			return pw, cmd
+
+		case msg.String() == "T":
+			pw.toggleSelectedThread()
+			return pw, cmd
+
+		case msg.String() == "D":
+			pw.debug = ""
+			if pw.summ.From == pw.as.c.PublicID() && pw.selComment < len(pw.comments) {
+				go pw.as.removePostComment(pw.summ.ID, pw.comments[pw.selComment].id)
+			}
+			return pw, cmd
+
+		case msg.String() == "L":
+			pw.debug = ""
+			if pw.summ.From == pw.as.c.PublicID() {
+				go pw.as.lockPostComments(pw.summ.ID, !pw.commentsLocked)
+			}
+			return pw, cmd
+
+		case msg.String() == "B":
+			pw.debug = ""
+			if pw.price > 0 && pw.paidContent == "" &&
+				pw.summ.AuthorID != pw.as.c.PublicID() {
+				go pw.as.buyPost(pw.summ.AuthorID, pw.summ.ID)
+				pw.debug = "Requesting invoice to unlock post"
+			}
+			return pw, cmd
+
 		case msg.Type == tea.KeyF4:
 			pw.showingRR = true
 			pw.renderReceiveReceipts()
@@ -966,6 +1230,22 @@ func (pw postWindow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			pw.viewport.GotoBottom()
 		}
 
+	case heldCommentsChanged:
+		if msg.pid == pw.summ.ID {
+			pw.loadHeldComments()
+			if pw.moderating {
+				pw.renderModerationQueue()
+			} else {
+				pw.renderPost()
+			}
+		}
+
+	case paidPostUnlocked:
+		if msg.pid == pw.summ.ID {
+			pw.updatePost()
+			pw.renderPost()
+		}
+
 	case sentPostComment:
 		pw.as.postsMtx.Lock()
 		pw.myComments = pw.as.myComments[pw.summ.ID]
@@ -1090,6 +1370,7 @@ func newPostWin(as *appState, feedActiveIdx, feedYOffsetHint int) (postWindow, t
 		as:              as,
 		feedActiveIdx:   feedActiveIdx,
 		feedYOffsetHint: feedYOffsetHint,
+		viewRev:         -1,
 	}
 	pw.textArea = newTextAreaModel(as.styles.Load())
 	pw.textArea.Placeholder = "Type comment"
@@ -14,11 +14,13 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/companyzero/bisonrelay/internal/version"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/zpay32"
+	"github.com/muesli/termenv"
 	"golang.org/x/text/collate"
 	"golang.org/x/text/language"
 )
@@ -520,3 +522,30 @@ func fileSize(filepath string) (int64, error) {
 	}
 	return stat.Size(), nil
 }
+
+// termSupportsInlineImages returns true if the terminal supports rendering
+// image thumbnails. Thumbnails are rendered with colored half-block
+// characters, so this requires a terminal with at least 256 colors.
+func termSupportsInlineImages() bool {
+	profile := lipgloss.ColorProfile()
+	return profile == termenv.TrueColor || profile == termenv.ANSI256
+}
+
+// postExpirationStr returns a description of the time remaining until the
+// given post expiration.
+func postExpirationStr(expiration time.Time) string {
+	left := time.Until(expiration)
+	switch {
+	case left <= 0:
+		return "expired"
+	case left < time.Minute:
+		return "expires in less than a minute"
+	case left < time.Hour:
+		return fmt.Sprintf("expires in %dm", int(left/time.Minute))
+	case left < 48*time.Hour:
+		return fmt.Sprintf("expires in %dh%02dm", int(left/time.Hour),
+			int(left%time.Hour/time.Minute))
+	default:
+		return fmt.Sprintf("expires in %d days", int(left/(24*time.Hour)))
+	}
+}
@@ -942,6 +942,25 @@ func handleInitClient(handle uint32, args initClient) error {
 				}
 				return nil
 			},
+			OnAcceptPaymentRequest: func(uid clientintf.UserID, amtMAtoms int64) error {
+				if !args.RPCAllowRemoteSendTip {
+					return fmt.Errorf("remote payments not allowed")
+				}
+				dcrAmount := float64(amtMAtoms) / 1e11
+				if dcrAmount > args.RPCMaxRemoteSendTipAmt {
+					return fmt.Errorf("payment exceeds max limit: %v", args.RPCMaxRemoteSendTipAmt)
+				}
+				return nil
+			},
+			OnCreateRecurringPayment: func(uid clientintf.UserID, dcrAmount float64) error {
+				if !args.RPCAllowRemoteSendTip {
+					return fmt.Errorf("remote payments not allowed")
+				}
+				if dcrAmount > args.RPCMaxRemoteSendTipAmt {
+					return fmt.Errorf("payment exceeds max limit: %v", args.RPCMaxRemoteSendTipAmt)
+				}
+				return nil
+			},
 		}
 		err = rpcServer.InitPaymentsService(payRPCServerCfg)
 		if err != nil {
@@ -1135,7 +1154,9 @@ func handleClientCmd(cc *clientCtx, cmd *cmd) (interface{}, error) {
 			return nil, err
 		}
 
-		err = c.AcceptInvite(invite)
+		// Invites that require payment to complete the KX are
+		// not accepted through this command.
+		err = c.AcceptInvite(invite, 0)
 		if err == nil {
 			return remoteUserFromPII(&invite.Public), nil
 		} else {
@@ -21,6 +21,7 @@ import (
 	"github.com/companyzero/bisonrelay/client/resources"
 	"github.com/companyzero/bisonrelay/client/timestats"
 	"github.com/companyzero/bisonrelay/internal/audio"
+	"github.com/companyzero/bisonrelay/internal/mediainfo"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/rates"
 	"github.com/companyzero/bisonrelay/rpc"
@@ -133,6 +134,28 @@ type Config struct {
 	// If unspecified, a default value of 12 seconds (1/5 minute) is used.
 	TipUserPayRetryDelayFactor time.Duration
 
+	// TipOnchainFallback indicates whether tips to users that could not be
+	// paid through LN should fall back to being paid through an on-chain
+	// transaction to an address provided by the payee. This requires the
+	// PayClient to be able to send on-chain payments.
+	TipOnchainFallback bool
+
+	// OnchainTipsAccount is the wallet account used to generate addresses
+	// provided to remote users that need to send tips on-chain. If empty,
+	// the default account is used.
+	OnchainTipsAccount string
+
+	// KXPaymentMAtoms is the amount that remote users must pay to
+	// complete a KX using invites created by the local client. Remote
+	// users that do not pay for the KX are automatically rejected. Invites
+	// created for resets and for KXs mediated by existing contacts do not
+	// require a payment. If zero, no payment is required.
+	KXPaymentMAtoms int64
+
+	// KXPaymentRefund indicates whether the payment made by a remote user
+	// to complete a KX is refunded (as a tip) after the KX completes.
+	KXPaymentRefund bool
+
 	// GCMQMaxLifetime is how long to wait for a message from an user,
 	// after which the GCMQ considers no other messages from this user
 	// will be received.
@@ -218,6 +241,19 @@ type Config struct {
 	// UseOnion specifies if the rate collection uses Tor hidden services.
 	UseOnion bool
 
+	// RatesProviders are the sources of exchange rates. If empty, the rates
+	// are fetched from the default online sources.
+	RatesProviders []rates.Provider
+
+	// RatesHistoryFile is the file where fetched exchange rates are
+	// persisted. If empty, the history of rates is not persisted.
+	RatesHistoryFile string
+
+	// RatesStaleAfter is the duration after which the exchange rates are
+	// considered stale if they could not be updated. Zero disables the
+	// staleness notifications.
+	RatesStaleAfter time.Duration
+
 	// RTDTRandomStreamHandler is the handler for random data received from
 	// RTDT sessions.
 	RTDTRandomStreamHandler rtdtclient.StreamHandler
@@ -226,6 +262,70 @@ type Config struct {
 	// live session structure, while an RTDT session is live. This may
 	// be set to true when user code does not track chat messages itself.
 	TrackRTDTChatMessages bool
+
+	// FileThumbnailMaxDim is the max width and height of thumbnails
+	// generated for shared image files. If negative, thumbnails are not
+	// generated. Defaults to 128 and is capped at
+	// mediainfo.MaxThumbnailDim.
+	FileThumbnailMaxDim int
+
+	// EmbedImage is the configuration for transcoding images before they
+	// are embedded in messages and posts.
+	EmbedImage EmbedImageConfig
+
+	// RMFragmentsMaxLifetime is how long to keep the fragments of an
+	// incomplete fragmented message, after its last received fragment.
+	// Defaults to 72 hours.
+	RMFragmentsMaxLifetime time.Duration
+
+	// RMFragmentsMaxPending is the max number of incomplete fragmented
+	// messages kept per remote user. Defaults to 8.
+	RMFragmentsMaxPending int
+
+	// RMFragmentsMaxPendingSize is the max total size (in bytes) of the
+	// incomplete fragmented messages kept per remote user. Defaults to
+	// twice the max size of a fragmented message.
+	RMFragmentsMaxPendingSize uint64
+
+	// PostsJanitorInterval is how often to check for and delete expired
+	// posts. Defaults to 1 minute.
+	PostsJanitorInterval time.Duration
+
+	// ScheduledPostsInterval is how often to check for post drafts that
+	// are scheduled to be published. Defaults to 1 minute.
+	ScheduledPostsInterval time.Duration
+
+	// RecurringPaymentsInterval is how often to check for recurring
+	// payments that are due or that lapsed. Defaults to 1 minute.
+	RecurringPaymentsInterval time.Duration
+
+	// PaymentBudgets limits the amounts spent on payments. Payments that
+	// would exceed a budget are blocked until approved by the user.
+	PaymentBudgets []PaymentBudget
+
+	// PaymentBudgetWarnThreshold is the fraction of a budget that, once
+	// spent, triggers a warning notification. Defaults to 0.8.
+	PaymentBudgetWarnThreshold float64
+
+	// FeedBridgeSources is a list of external RSS or Atom feeds (HTTP(S)
+	// URLs or local file paths) whose new items are republished as posts
+	// of the local client.
+	FeedBridgeSources []string
+
+	// FeedBridgeInterval is how often the feed bridge sources are polled.
+	// Defaults to 30 minutes.
+	FeedBridgeInterval time.Duration
+
+	// FeedBridgeMaxPostsPerPoll is the max number of posts created for
+	// each feed on every poll. When more new items are found (for example,
+	// on the first poll of a feed), only the most recent ones are
+	// published. Defaults to 5.
+	FeedBridgeMaxPostsPerPoll int
+
+	// LNLiquidity configures the automatic management of the liquidity of
+	// the LN wallet. If nil, liquidity is not managed. Requires PayClient
+	// to be a DcrlnPaymentClient.
+	LNLiquidity *LNLiquidityConfig
 }
 
 // logger creates a logger for the given subsystem in the configured backend.
@@ -238,7 +338,7 @@ func (cfg *Config) logger(subsys string) slog.Logger {
 }
 
 // setDefaults sets default options for unset/empty config fields.
-func (cfg *Config) setDefaults() {
+func (cfg *Config) setDefaults() error {
 	if cfg.TipUserRestartDelay == 0 {
 		cfg.TipUserRestartDelay = time.Minute
 	}
@@ -277,6 +377,61 @@ func (cfg *Config) setDefaults() {
 		cfg.GCInviteExpiration = time.Hour * 24 * 7
 	}
 
+	if cfg.RMFragmentsMaxLifetime == 0 {
+		cfg.RMFragmentsMaxLifetime = time.Hour * 72
+	}
+
+	if cfg.RMFragmentsMaxPending == 0 {
+		cfg.RMFragmentsMaxPending = 8
+	}
+
+	if cfg.RMFragmentsMaxPendingSize == 0 {
+		cfg.RMFragmentsMaxPendingSize = 2 * rpc.MaxFragmentedRMSize
+	}
+
+	if cfg.PostsJanitorInterval == 0 {
+		cfg.PostsJanitorInterval = time.Minute
+	}
+
+	if cfg.ScheduledPostsInterval == 0 {
+		cfg.ScheduledPostsInterval = time.Minute
+	}
+
+	if cfg.RecurringPaymentsInterval == 0 {
+		cfg.RecurringPaymentsInterval = time.Minute
+	}
+
+	if cfg.PaymentBudgetWarnThreshold == 0 {
+		cfg.PaymentBudgetWarnThreshold = 0.8
+	}
+
+	if cfg.FeedBridgeInterval == 0 {
+		cfg.FeedBridgeInterval = 30 * time.Minute
+	}
+	if cfg.FeedBridgeMaxPostsPerPoll < 0 {
+		return fmt.Errorf("invalid max number of feed bridge posts per "+
+			"poll %d", cfg.FeedBridgeMaxPostsPerPoll)
+	}
+	if cfg.FeedBridgeMaxPostsPerPoll == 0 {
+		cfg.FeedBridgeMaxPostsPerPoll = 5
+	}
+
+	if cfg.FileThumbnailMaxDim == 0 {
+		cfg.FileThumbnailMaxDim = mediainfo.DefaultThumbnailMaxDim
+	} else if cfg.FileThumbnailMaxDim > mediainfo.MaxThumbnailDim {
+		cfg.FileThumbnailMaxDim = mediainfo.MaxThumbnailDim
+	}
+
+	if cfg.EmbedImage.MaxWidth == 0 {
+		cfg.EmbedImage.MaxWidth = defaultEmbedImageMaxDim
+	}
+	if cfg.EmbedImage.MaxHeight == 0 {
+		cfg.EmbedImage.MaxHeight = defaultEmbedImageMaxDim
+	}
+	if cfg.EmbedImage.JPEGQuality == 0 {
+		cfg.EmbedImage.JPEGQuality = mediainfo.DefaultJPEGQuality
+	}
+
 	// These following GCMQ times were obtained by profiling a client
 	// connected over tor to the server and may need tweaking from time to
 	// time.
@@ -289,6 +444,7 @@ func (cfg *Config) setDefaults() {
 	if cfg.GCMQInitialDelay == 0 {
 		cfg.GCMQInitialDelay = time.Second * 10
 	}
+	return nil
 }
 
 // localIdentity stores identity related data that is not modified throughout
@@ -380,6 +536,13 @@ type Client struct {
 	gcmq  *gcmcacher.Cacher
 	ntfns *NotificationManager
 
+	// budgetPC enforces the payment budgets. It is also set as pc.
+	budgetPC *budgetPayClient
+
+	// lnlm manages the liquidity of the LN wallet. It is nil when
+	// liquidity is not managed.
+	lnlm *lnLiquidityManager
+
 	// abLoaded is closed when the address book has finished loading.
 	abLoaded chan struct{}
 
@@ -424,6 +587,10 @@ type Client struct {
 	filters        []clientdb.ContentFilter
 	filtersRegexps map[uint64]*regexp.Regexp
 
+	// fileStreams tracks the downloads that are being streamed.
+	fileStreamsMtx sync.Mutex
+	fileStreams    map[clientdb.FileID]*FileStream
+
 	// RTDT related fields.
 	noterec        *audio.NoteRecorder
 	rtmgr          *lowlevel.RTDTSessionManager
@@ -440,7 +607,9 @@ type Client struct {
 func New(cfg Config) (*Client, error) {
 	var c *Client
 
-	cfg.setDefaults()
+	if err := cfg.setDefaults(); err != nil {
+		return nil, err
+	}
 
 	ntfns := cfg.Notifications
 	if ntfns == nil {
@@ -477,8 +646,12 @@ func New(cfg Config) (*Client, error) {
 		})
 	}
 
+	// Enforce the payment budgets on all payments.
+	budgetPC := newBudgetPayClient(cfg.PayClient, cfg.PaymentBudgets,
+		cfg.PaymentBudgetWarnThreshold, cfg.DB, cfg.logger("PBGT"), ntfns)
+
 	ckCfg := lowlevel.ConnKeeperCfg{
-		PC:                      cfg.PayClient,
+		PC:                      budgetPC,
 		Dialer:                  cfg.Dialer,
 		CertConf:                certConfirmer,
 		ReconnectDelay:          cfg.ReconnectDelay,
@@ -515,6 +688,10 @@ func New(cfg Config) (*Client, error) {
 		HTTPClient:  &httpClient,
 		Log:         cfg.logger("RATE"),
 		OnionEnable: cfg.UseOnion,
+		Providers:   cfg.RatesProviders,
+		HistoryFile: cfg.RatesHistoryFile,
+		StaleAfter:  cfg.RatesStaleAfter,
+		OnStale:     ntfns.notifyExchangeRateStale,
 	})
 	go r.Run(ctx)
 
@@ -526,7 +703,7 @@ func New(cfg Config) (*Client, error) {
 		dbCtxCancel: dbCtxCancel,
 
 		db:    cfg.DB,
-		pc:    cfg.PayClient,
+		pc:    budgetPC,
 		ck:    ck,
 		q:     q,
 		rmgr:  rmgr,
@@ -534,11 +711,14 @@ func New(cfg Config) (*Client, error) {
 		rul:   newRemoteUserList(cfg.Collator),
 		ntfns: ntfns,
 
+		budgetPC: budgetPC,
+
 		abLoaded:         make(chan struct{}),
 		firstSubDone:     make(chan struct{}),
 		newUsersChan:     make(chan *RemoteUser),
 		gcWarnedVersions: &singlesetmap.Map[zkidentity.ShortID]{},
 		unkxdWarnings:    make(map[clientintf.UserID]time.Time),
+		fileStreams:      make(map[clientdb.FileID]*FileStream),
 
 		onboardCancelChan: make(chan struct{}, 1),
 
@@ -590,6 +770,7 @@ func New(cfg Config) (*Client, error) {
 	kxl := newKXList(q, rmgr, &c.localID, c.Public, cfg.DB, ctx)
 	kxl.compressLevel = cfg.CompressLevel
 	kxl.dbCtx = dbCtx
+	budgetPC.dbCtx = dbCtx
 	kxl.log = cfg.logger("KXLS")
 	c.kxl = kxl
 
@@ -603,6 +784,17 @@ func New(cfg Config) (*Client, error) {
 	rmgrdb.c = c
 	rmqdb.c = c
 	kxl.kxCompleted = c.kxCompleted
+	kxl.recordPayEvent = c.recordPayEvent
+	kxl.paymentMAtoms = cfg.KXPaymentMAtoms
+	kxl.pc = c.pc
+	kxl.kxPaymentReceived = c.kxPaymentReceived
+	kxl.kxPaymentRejected = ntfns.notifyKXPaymentRejected
+
+	if pc, ok := cfg.PayClient.(*DcrlnPaymentClient); ok && cfg.LNLiquidity != nil {
+		c.lnlm = newLNLiquidityManager(*cfg.LNLiquidity, pc.LNRPC(),
+			cfg.DB, dbCtx, cfg.logger("LNLQ"))
+		c.lnlm.actionUpdated = ntfns.notifyLNLiquidityAction
+	}
 
 	return c, nil
 }
@@ -689,8 +881,10 @@ func (c *Client) queueUnackedUserRM(ctx context.Context, unacked clientdb.Unacke
 			amount = -amount
 			fees = -fees
 			err := c.db.Update(c.dbCtx, func(tx clientdb.ReadWriteTx) error {
-				return c.db.RecordUserPayEvent(tx,
-					unacked.UID, unacked.PayEvent, amount, fees)
+				return c.recordPayEvent(tx, unacked.UID,
+					unacked.PayEvent,
+					clientintf.PaymentCategoryServerFees, "",
+					amount, fees)
 			})
 			if err != nil {
 				c.log.Warnf("Unable to store payment %d (fees %d) "+
@@ -971,6 +1165,16 @@ func (c *Client) MaxMsgPayloadSize() int {
 	return res
 }
 
+// MaxFragmentedMsgPayloadSize returns the max payload size of PMs, GC messages
+// and posts. These are automatically split into fragments when they are larger
+// than MaxMsgPayloadSize(), so the max size is limited by the max number of
+// fragments that fit the max message size of the server.
+func (c *Client) MaxFragmentedMsgPayloadSize() int {
+	maxMsgSize := int(c.q.MaxMsgSize())
+	return min(rpc.MaxFragmentedRMSize,
+		rpc.MaxRMFragments*rpc.MaxRMFragmentDataSize(maxMsgSize))
+}
+
 // RemainOffline requests the client to remain offline.
 func (c *Client) RemainOffline() {
 	c.ck.RemainOffline()
@@ -1138,6 +1342,10 @@ func (c *Client) PM(uid UserID, msg string) error {
 	if err != nil {
 		return err
 	}
+	msg, err = c.transcodeEmbeds(msg)
+	if err != nil {
+		return err
+	}
 
 	myNick := c.LocalNick()
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
@@ -1481,6 +1689,7 @@ func (c *Client) Run(ctx context.Context) error {
 				}
 
 				c.cleanupPushPaymentAttempts(nextSess.Policy().PushPaymentLifetime)
+				c.cleanupStaleRMFragments()
 			} else {
 				// c.gcmq.SessionChanged(true) is called after
 				// the initial batch of subscriptions is done
@@ -1603,8 +1812,32 @@ func (c *Client) Run(ctx context.Context) error {
 	// Restart tracking tip receiving.
 	g.Go(func() error { return c.restartTrackGeneratedTipInvoices(gctx) })
 
+	// Restart tracking paid post sales.
+	g.Go(func() error { return c.restartTrackPostSales(gctx) })
+
+	// Restart tracking payment requests.
+	g.Go(func() error { return c.restartPaymentRequests(gctx) })
+	g.Go(func() error { return c.restartOnchainTips(gctx) })
+
+	// Make and check recurring payments.
+	g.Go(func() error { return c.runRecurringPayments(gctx) })
+
 	// Track RTDT peers that have stalled.
 	g.Go(func() error { return c.detectStalledRTDTPeers(gctx) })
 
+	// Delete expired posts.
+	g.Go(func() error { return c.runPostsJanitor(gctx) })
+
+	// Publish scheduled post drafts.
+	g.Go(func() error { return c.runScheduledPostDrafts(gctx) })
+
+	// Republish items of external feeds.
+	g.Go(func() error { return c.runFeedBridge(gctx) })
+
+	// Manage the liquidity of the LN wallet.
+	if c.lnlm != nil {
+		g.Go(func() error { return c.lnlm.run(gctx) })
+	}
+
 	return g.Wait()
 }
@@ -221,7 +221,7 @@ func (c *Client) handleTransitiveIDInvite(ru *RemoteUser, pii rpc.OOBPublicIdent
 		return fmt.Errorf("received pii with key different then expected by identity")
 	}
 
-	err = c.kxl.acceptInvite(pii, false, !wasManualMI)
+	err = c.kxl.acceptInvite(pii, false, !wasManualMI, 0)
 	if errors.Is(err, errUserBlocked) {
 		ru.log.Infof("Canceled invite from blocked identity %s (%q)", pii.Public.Identity,
 			pii.Public.Nick)
@@ -2,18 +2,21 @@ package client
 
 import (
 	"context"
-	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/internal/mediainfo"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/slog"
@@ -64,6 +67,35 @@ import (
 //   handleFTGetChunkReply()
 //
 
+// maxFTListThumbnailsSize is the max total size of thumbnails to include in a
+// single RMFTListReply.
+const maxFTListThumbnailsSize = 256 * 1024
+
+// fileMediaAttributes returns the media attributes (mime type, dimensions,
+// duration and thumbnail) of the given file. Errors are logged and result in
+// only partial (or no) attributes being returned.
+func (c *Client) fileMediaAttributes(fname string) map[string]string {
+	cfg := mediainfo.DefaultConfig()
+	if c.cfg.FileThumbnailMaxDim < 0 {
+		cfg.ThumbnailMaxDim = 0
+	} else {
+		cfg.ThumbnailMaxDim = c.cfg.FileThumbnailMaxDim
+	}
+	info, err := mediainfo.FromFile(fname, cfg)
+	if err != nil {
+		c.log.Warnf("Unable to extract media info from %q: %v",
+			filepath.Base(fname), err)
+	}
+	if info == nil {
+		return nil
+	}
+	attrs := info.Attributes()
+	if len(attrs) == 0 {
+		return nil
+	}
+	return attrs
+}
+
 // ShareFile shares the given filename with the given user (or to all users if
 // none is specified).
 //
@@ -79,9 +111,10 @@ func (c *Client) ShareFile(fname string, uid *UserID,
 		return sig[:], nil
 	}
 
+	attrs := c.fileMediaAttributes(fname)
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
-		f, md, err = c.db.ShareFile(tx, fname, uid, cost, descr, sign)
+		f, md, err = c.db.ShareFile(tx, fname, uid, cost, descr, attrs, sign)
 		return err
 	})
 
@@ -113,6 +146,186 @@ func (c *Client) UnshareFile(fid clientdb.FileID, uid *UserID) error {
 	})
 }
 
+// ShareFileWithGC shares the given filename with the members of the given GC.
+// Members of the GC are notified about the new file.
+//
+// Access to the file is determined by the GC membership at the time the file
+// is requested, so members that join the GC later are also able to fetch it,
+// while members that are kicked or part from it are not.
+//
+// Cost is in atoms.
+func (c *Client) ShareFileWithGC(fname string, gcID zkidentity.ShortID,
+	cost uint64, descr string) (clientdb.SharedFile, rpc.FileMetadata, error) {
+
+	var f clientdb.SharedFile
+	var md rpc.FileMetadata
+	sign := func(hash []byte) ([]byte, error) {
+		sig := c.localID.signMessage(hash)
+		return sig[:], nil
+	}
+
+	var gc clientdb.GroupChat
+	var gcBlockList clientdb.GCBlockList
+	attrs := c.fileMediaAttributes(fname)
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		if gc, err = c.db.GetGC(tx, gcID); err != nil {
+			return err
+		}
+		if gcBlockList, err = c.db.GetGCBlockList(tx, gcID); err != nil {
+			return err
+		}
+		f, md, err = c.db.ShareFileWithGC(tx, fname, gcID, cost, descr,
+			attrs, sign)
+		if err != nil {
+			return err
+		}
+		_, err = c.db.LogGCMsg(tx, gc.Name(), gcID, true, "",
+			fmt.Sprintf("Shared file %q with the GC", f.Filename),
+			time.Now())
+		return err
+	})
+	if err != nil {
+		return f, md, err
+	}
+
+	c.log.Infof("Shared file %q with GC %q", f.Filename, gc.Name())
+
+	// Thumbnails are not sent in the notification to keep the msg small.
+	// Members may fetch them by listing the GC files.
+	ntfnMD := md
+	ntfnMD.Attributes = nil
+	rm := rpc.RMGroupFileShared{
+		ID:   gcID,
+		File: ntfnMD,
+	}
+	members := gcBlockList.FilterMembers(gc.Metadata.Members)
+	if len(members) == 0 {
+		return f, md, nil
+	}
+	err = c.sendToGCMembers(gcID, members, "fileshared", rm, nil)
+	return f, md, err
+}
+
+// UnshareFileWithGC stops sharing the given file with the members of the given
+// GC.
+func (c *Client) UnshareFileWithGC(fid clientdb.FileID, gcID zkidentity.ShortID) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.UnshareFileWithGC(tx, fid, gcID)
+	})
+}
+
+// ListGCSharedFiles lists the files the local client shared with the given GC.
+func (c *Client) ListGCSharedFiles(gcID zkidentity.ShortID) ([]rpc.FileMetadata, error) {
+	var files []rpc.FileMetadata
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		files, err = c.db.ListGCSharedFiles(tx, gcID)
+		return err
+	})
+	return files, err
+}
+
+// ListGCContent requests the list of files shared with the given GC from
+// every GC member. Replies are sent through the OnGCContentListReceived
+// notification.
+func (c *Client) ListGCContent(gcID zkidentity.ShortID, filter string) error {
+	gc, err := c.getGC(gcID)
+	if err != nil {
+		return err
+	}
+	gcBlockList, err := c.GetGCBlockList(gcID)
+	if err != nil {
+		return err
+	}
+
+	// Handle regex
+	if filter != "" {
+		_, err := regexp.Compile(filter)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %v", err)
+		}
+	}
+
+	rm := rpc.RMFTList{
+		Directories: []string{rpc.RMFTDGroupChat},
+		Filter:      filter,
+		GC:          &gcID,
+	}
+	localID := c.PublicID()
+	for _, uid := range gcBlockList.FilterMembers(gc.Metadata.Members) {
+		if uid == localID {
+			continue
+		}
+		ru, err := c.rul.byID(uid)
+		if err != nil {
+			c.log.Debugf("Skipping listing GC %q content of unkxd "+
+				"member %s", gc.Name(), uid)
+			continue
+		}
+		if err := ru.sendRM(rm, "ftlist"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleGCFileShared handles a notification that a GC member shared a file
+// with the GC.
+func (c *Client) handleGCFileShared(ru *RemoteUser, gfs rpc.RMGroupFileShared, ts time.Time) error {
+	if ru.IsIgnored() {
+		ru.log.Tracef("Ignoring GC file shared msg")
+		return nil
+	}
+
+	var gc clientdb.GroupChat
+	var isMember, isBlocked bool
+	var res []clientdb.RemoteFile
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		if gc, err = c.db.GetGC(tx, gfs.ID); err != nil {
+			return err
+		}
+		if isMember = slices.Contains(gc.Metadata.Members, ru.ID()); !isMember {
+			return nil
+		}
+		gcBlockList, err := c.db.GetGCBlockList(tx, gfs.ID)
+		if err != nil {
+			return err
+		}
+		if isBlocked = gcBlockList.IsBlocked(ru.ID()); isBlocked {
+			return nil
+		}
+
+		files := []rpc.FileMetadata{gfs.File}
+		res, err = c.db.HasDownloadedFiles(tx, ru.Nick(), ru.ID(), files)
+		if err != nil {
+			return err
+		}
+		_, err = c.db.LogGCMsg(tx, gc.Name(), gfs.ID, true, "",
+			fmt.Sprintf("%s shared file %q with the GC", ru.Nick(),
+				gfs.File.Filename), ts)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		ru.log.Warnf("Received GC file shared msg for GC %q from non-member",
+			gc.Name())
+		return nil
+	}
+	if isBlocked {
+		ru.log.Debugf("Ignoring GC file shared msg for GC %q from blocked "+
+			"member", gc.Name())
+		return nil
+	}
+
+	ru.log.Infof("Shared file %q with GC %q", gfs.File.Filename, gc.Name())
+	c.ntfns.notifyGCFileShared(ru, gfs.ID, res[0], ts)
+	return nil
+}
+
 // ListLocalSharedFiles lists all locally shared files.
 func (c *Client) ListLocalSharedFiles() ([]clientdb.SharedFileAndShares, error) {
 	var files []clientdb.SharedFileAndShares
@@ -125,7 +338,8 @@ func (c *Client) ListLocalSharedFiles() ([]clientdb.SharedFileAndShares, error)
 }
 
 // ListUserContent lists the content shared by the given remote user. Dirs must
-// be one of the supported dirs (rpc.RMFTDGlobal or rpc.RMFTDShared).
+// be one of the supported dirs (rpc.RMFTDGlobal or rpc.RMFTDShared). Use
+// ListGCContent to list files shared with a GC.
 func (c *Client) ListUserContent(uid UserID, dirs []string, filter string) error {
 	ru, err := c.rul.byID(uid)
 	if err != nil {
@@ -157,13 +371,13 @@ func (c *Client) ListUserContent(uid UserID, dirs []string, filter string) error
 
 // handleFTList handles listing of local user files requested by a remote user.
 func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
-	var global, shared []rpc.FileMetadata
+	var global, shared, gcShared []rpc.FileMetadata
 	err := c.dbView(func(tx clientdb.ReadTx) error {
 		// Ensure unique list of dirs.
-		dirs := make(map[string]struct{}, 2)
+		dirs := make(map[string]struct{}, 3)
 		for _, v := range ftls.Directories {
 			switch v {
-			case rpc.RMFTDGlobal, rpc.RMFTDShared:
+			case rpc.RMFTDGlobal, rpc.RMFTDShared, rpc.RMFTDGroupChat:
 				dirs[v] = struct{}{}
 			default:
 				return fmt.Errorf("unknown ftls dir %q", v)
@@ -184,6 +398,34 @@ func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
 				return err
 			}
 		}
+		if _, ok := dirs[rpc.RMFTDGroupChat]; ok {
+			if ftls.GC == nil {
+				return fmt.Errorf("GC not specified when listing "+
+					"%q dir", rpc.RMFTDGroupChat)
+			}
+
+			// Only members of the GC that are not blocked may list
+			// its files.
+			gc, err := c.db.GetGC(tx, *ftls.GC)
+			if errors.Is(err, clientdb.ErrNotFound) {
+				return fmt.Errorf("GC %s not found", ftls.GC)
+			} else if err != nil {
+				return err
+			}
+			gcBlockList, err := c.db.GetGCBlockList(tx, *ftls.GC)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(gc.Metadata.Members, ru.ID()) ||
+				gcBlockList.IsBlocked(ru.ID()) {
+				return fmt.Errorf("not a member of GC %s", ftls.GC)
+			}
+
+			gcShared, err = c.db.ListGCSharedFiles(tx, *ftls.GC)
+			if err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -192,6 +434,7 @@ func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
 			errStr := err.Error()
 			err := ru.sendRM(rpc.RMFTListReply{
 				Tag:   ftls.Tag,
+				GC:    ftls.GC,
 				Error: &errStr,
 			}, "ftlistreply")
 			if err != nil {
@@ -202,10 +445,22 @@ func (c *Client) handleFTList(ru *RemoteUser, ftls rpc.RMFTList) error {
 		return err
 	}
 
+	// Limit the total size of the inline thumbnails, so that the reply
+	// does not exceed the max msg size.
+	budget := c.MaxMsgPayloadSize() / 4
+	if budget > maxFTListThumbnailsSize {
+		budget = maxFTListThumbnailsSize
+	}
+	budget = limitFileThumbnails(global, budget)
+	budget = limitFileThumbnails(shared, budget)
+	limitFileThumbnails(gcShared, budget)
+
 	return ru.sendRM(rpc.RMFTListReply{
-		Tag:    ftls.Tag,
-		Global: global,
-		Shared: shared,
+		Tag:       ftls.Tag,
+		Global:    global,
+		Shared:    shared,
+		GroupChat: gcShared,
+		GC:        ftls.GC,
 	}, "ftlistreply")
 }
 
@@ -224,10 +479,31 @@ func (c *Client) HasDownloadedFile(fid zkidentity.ShortID) (string, error) {
 func (c *Client) handleFTListReply(ru *RemoteUser, ftrp rpc.RMFTListReply) error {
 	if ftrp.Error != nil {
 		err := errors.New(*ftrp.Error)
-		c.ntfns.notifyContentListReceived(ru, nil, err)
+		if ftrp.GC != nil {
+			c.ntfns.notifyGCContentListReceived(ru, *ftrp.GC, nil, err)
+		} else {
+			c.ntfns.notifyContentListReceived(ru, nil, err)
+		}
 		return err
 	}
 
+	if ftrp.GC != nil {
+		var res []clientdb.RemoteFile
+		err := c.dbView(func(tx clientdb.ReadTx) error {
+			var err error
+			res, err = c.db.HasDownloadedFiles(tx, ru.Nick(), ru.ID(),
+				ftrp.GroupChat)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		c.log.Infof("User listed %d files shared with GC %s", len(res),
+			ftrp.GC)
+		c.ntfns.notifyGCContentListReceived(ru, *ftrp.GC, res, nil)
+		return nil
+	}
+
 	files := append(ftrp.Global, ftrp.Shared...)
 	var res []clientdb.RemoteFile
 	err := c.dbView(func(tx clientdb.ReadTx) error {
@@ -393,9 +669,12 @@ func (c *Client) payFileChunkInvoice(ru *RemoteUser, fid clientdb.FileID,
 	}
 
 	// Attempt to pay invoice.
-	fees, invErr := c.pc.PayInvoice(c.ctx, invoice)
+	ctx := clientintf.WithPaymentCategory(c.ctx, clientintf.PaymentCategoryDownloads)
+	fees, invErr := c.pc.PayInvoice(ctx, invoice)
+	var invHash string
 	if invErr == nil {
 		ru.log.Debugf("Paid for chunk %d of file download %s", chunkIdx, fid)
+		invHash = c.invoiceHash(invoice)
 	}
 
 	// Record result of attempting the payment.
@@ -416,7 +695,9 @@ func (c *Client) payFileChunkInvoice(ru *RemoteUser, fid clientdb.FileID,
 		payEvent := fmt.Sprintf("ftpaychunk.%s.%d", fid.ShortLogID(), chunkIdx)
 		amount := -matoms
 		fees := -fees
-		if err := c.db.RecordUserPayEvent(tx, ru.ID(), payEvent, amount, fees); err != nil {
+		err := c.recordPayEvent(tx, ru.ID(), payEvent,
+			clientintf.PaymentCategoryDownloads, invHash, amount, fees)
+		if err != nil {
 			return err
 		}
 
@@ -461,6 +742,9 @@ func (c *Client) downloadChunks(ru *RemoteUser, fd clientdb.FileDownload) error
 	c.log.Infof("Starting to downloading %d missing chunks of file %q (%s)",
 		len(missing), fd.Metadata.Filename, fd.FID)
 
+	// When streaming, only request the chunks close to the read position.
+	missing = c.streamDownloadWindow(fd.FID, missing)
+
 	for _, chunkIdx := range missing {
 		chunkIdx := chunkIdx
 
@@ -607,6 +891,7 @@ func (c *Client) handleFTGetReply(ru *RemoteUser, gr rpc.RMFTGetReply) error {
 	if err != nil {
 		return err
 	}
+	c.fileStreamUpdated(fid)
 
 	// Ignore this request when download is supposed to be entirely sent
 	// by the uploader.
@@ -680,13 +965,15 @@ func (c *Client) ftPaymentForChunkCompleted(ru *RemoteUser, sf clientdb.SharedFi
 
 	// Mark payment as completed on the DB.
 	uid := ru.ID()
+	invHash := c.invoiceHash(invoice)
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		err := c.db.MarkChunkUploadPaid(tx, uid, sf.FID, cid, chunkIdx, invoice)
 		if err != nil {
 			return err
 		}
 		payEvent := fmt.Sprintf("ftrecvforchunk.%s.%d", sf.FID.ShortLogID(), chunkIdx)
-		return c.db.RecordUserPayEvent(tx, ru.ID(), payEvent, receivedMAtoms, 0)
+		return c.recordPayEvent(tx, ru.ID(), payEvent,
+			clientintf.PaymentCategoryDownloads, invHash, receivedMAtoms, 0)
 	})
 	if err != nil {
 		return err
@@ -938,6 +1225,11 @@ func (c *Client) handleFTGetChunkReply(ru *RemoteUser, gcr rpc.RMFTGetChunkReply
 	}
 
 	ru.log.Debugf("Downloaded chunk %d of file %s", gcr.Index, fd.FID)
+	c.fileStreamUpdated(fd.FID)
+	if completedFname == "" && c.isFileStreamed(fd.FID) {
+		// Request the next chunks of the streamed file.
+		c.resumeStreamedDownload(fd)
+	}
 
 	if completedFname != "" {
 		baseName := filepath.Base(completedFname)
@@ -1127,17 +1419,166 @@ func (c *Client) handleFTSendFile(ru *RemoteUser, sf rpc.RMFTSendFile) error {
 	return nil
 }
 
+// defaultEmbedImageMaxDim is the default max width and height of images
+// embedded in messages.
+const defaultEmbedImageMaxDim = 1920
+
+// EmbedImageConfig is the configuration for transcoding images before they
+// are embedded in messages and posts.
+type EmbedImageConfig struct {
+	// Disable disables transcoding of embedded images.
+	Disable bool
+
+	// MaxWidth and MaxHeight are the max dimensions of embedded images.
+	// Larger images are resized. If zero, defaults to 1920. Negative
+	// values mean there is no limit.
+	MaxWidth  int
+	MaxHeight int
+
+	// JPEGQuality is the quality (1-100) used when re-encoding JPEG
+	// images. Defaults to 85.
+	JPEGQuality int
+
+	// KeepMetadata indicates whether to keep metadata (EXIF, location,
+	// etc) in embedded images. By default, metadata is stripped.
+	KeepMetadata bool
+}
+
+// PreparedEmbed is data that has been prepared to be embedded in a message.
+type PreparedEmbed struct {
+	// Data is the final data to embed.
+	Data []byte
+
+	// MimeType is the final mime type of the data.
+	MimeType string
+
+	// OrigSize is the size of the data before being prepared.
+	OrigSize int
+
+	// Transcoded is true if the data was transcoded (resized, re-encoded
+	// or stripped of metadata).
+	Transcoded bool
+
+	// EstCostMAtoms is the estimated cost (in milliatoms) to send a PM
+	// with only the embed to a single user, given the policy of the
+	// currently connected server. This is zero if the client is offline.
+	EstCostMAtoms uint64
+}
+
+// transcodeEmbedImage transcodes embedded image data according to the
+// EmbedImage config of the client.
+func (c *Client) transcodeEmbedImage(data []byte) (mediainfo.TranscodeResult, error) {
+	cfg := c.cfg.EmbedImage
+	if cfg.Disable {
+		return mediainfo.TranscodeResult{Data: data}, nil
+	}
+	tres, err := mediainfo.TranscodeImage(data, mediainfo.TranscodeConfig{
+		MaxWidth:      cfg.MaxWidth,
+		MaxHeight:     cfg.MaxHeight,
+		JPEGQuality:   cfg.JPEGQuality,
+		StripMetadata: !cfg.KeepMetadata,
+	})
+	if err != nil {
+		return tres, fmt.Errorf("unable to transcode image: %w", err)
+	}
+	if tres.Transcoded {
+		c.log.Debugf("Transcoded embedded image from %d to %d bytes "+
+			"(%dx%d)", len(data), len(tres.Data), tres.Width,
+			tres.Height)
+	}
+	return tres, nil
+}
+
+// transcodeEmbeds transcodes the images embedded in the given message, post
+// or comment according to the EmbedImage config of the client. Embeds that
+// do not need to be transcoded are kept unmodified.
+func (c *Client) transcodeEmbeds(s string) (string, error) {
+	if c.cfg.EmbedImage.Disable {
+		return s, nil
+	}
+	idxs := mdembeds.FindAllStringIndex(s)
+	if len(idxs) == 0 {
+		return s, nil
+	}
+
+	var b strings.Builder
+	var last int
+	for _, idx := range idxs {
+		b.WriteString(s[last:idx[0]])
+		last = idx[1]
+		raw := s[idx[0]:idx[1]]
+		args := mdembeds.ParseEmbedArgs(raw)
+		if len(args.Data) == 0 {
+			b.WriteString(raw)
+			continue
+		}
+		tres, err := c.transcodeEmbedImage(args.Data)
+		if err != nil {
+			return s, err
+		}
+		if !tres.Transcoded {
+			b.WriteString(raw)
+			continue
+		}
+		args.Data = tres.Data
+		args.Typ = tres.MimeType
+		args.Alt = url.PathEscape(args.Alt)
+		b.WriteString(args.String())
+	}
+	b.WriteString(s[last:])
+	return b.String(), nil
+}
+
+// PrepareEmbed prepares data to be embedded in a message or post. Images are
+// transcoded according to the EmbedImage config of the client. Messages,
+// posts and comments sent by the client are also transcoded when sent, but
+// calling this before the data is included in the message allows displaying
+// the final size and cost of the embed.
+func (c *Client) PrepareEmbed(data []byte, typ string) (PreparedEmbed, error) {
+	res := PreparedEmbed{
+		Data:     data,
+		MimeType: typ,
+		OrigSize: len(data),
+	}
+
+	tres, err := c.transcodeEmbedImage(data)
+	if err != nil {
+		return res, err
+	}
+	if tres.Transcoded {
+		res.Data = tres.Data
+		res.MimeType = tres.MimeType
+		res.Transcoded = true
+	}
+
+	if sess := c.ServerSession(); sess != nil {
+		policy := sess.Policy()
+		args := mdembeds.EmbeddedArgs{Typ: res.MimeType, Data: res.Data}
+		cost, err := clientintf.EstimatePMCost(args.String(), &policy)
+		if err != nil {
+			return res, err
+		}
+		res.EstCostMAtoms = cost
+	}
+
+	return res, nil
+}
+
+// SaveEmbed saves the given embedded data to a file in the embeds dir, so that
+// it can be opened by an external viewer. It returns the path to the file.
+//
+// This is used to view embeds of received (or already sent) messages, so the
+// data is not transcoded: the file must have the same contents (and thus the
+// same name) as the embed in the message. Embeds are transcoded when the
+// messages, posts and comments that include them are sent.
 func (c *Client) SaveEmbed(data []byte, typ string) (string, error) {
 	var filePath string
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-		sp := strings.Split(typ, "/")
-		if len(sp) != 2 {
-			return fmt.Errorf("invalid mimetype")
+		fileName, err := clientdb.EmbedFilename(data, typ)
+		if err != nil {
+			return err
 		}
 
-		var err error
-		fileName := fmt.Sprintf("%x.%s", sha256.Sum256(data), sp[1])
-
 		filePath, err = c.db.SaveEmbed(fileName, data)
 		return err
 	})
@@ -1236,8 +1677,9 @@ func (c *Client) restartUploads(ctx context.Context) error {
 
 					payEvent := fmt.Sprintf("ftrecvforchunk.%s.%d",
 						cup.FID.ShortLogID(), chunkIdx)
-					err = c.db.RecordUserPayEvent(tx, cup.UID,
-						payEvent, wantMAtoms, 0)
+					err = c.recordPayEvent(tx, cup.UID, payEvent,
+						clientintf.PaymentCategoryDownloads,
+						c.invoiceHash(inv), wantMAtoms, 0)
 					if err != nil {
 						return err
 					}
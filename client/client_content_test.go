@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/decred/slog"
+)
+
+// TestTranscodeEmbeds tests that images embedded in messages are transcoded
+// according to the client config, while other embeds are kept unmodified.
+func TestTranscodeEmbeds(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	c, err := New(Config{
+		DB:            testDB(t, id, nil),
+		LocalIDIniter: fixedIDIniter(id),
+		Logger:        func(string) slog.Logger { return slog.Disabled },
+		EmbedImage:    EmbedImageConfig{MaxWidth: 100, MaxHeight: 100},
+	})
+	assert.NilErr(t, err)
+
+	var b bytes.Buffer
+	assert.NilErr(t, png.Encode(&b, image.NewGray(image.Rect(0, 0, 400, 200))))
+	imgEmbed := mdembeds.EmbeddedArgs{
+		Typ:  "image/png",
+		Alt:  url.PathEscape("an image, resized"),
+		Data: b.Bytes(),
+	}.String()
+	txtEmbed := mdembeds.EmbeddedArgs{
+		Typ:  "text/plain",
+		Alt:  url.PathEscape("some text"),
+		Data: []byte("not an image"),
+	}.String()
+	msg := "first " + imgEmbed + " second " + txtEmbed + " end"
+
+	got, err := c.transcodeEmbeds(msg)
+	assert.NilErr(t, err)
+	if !strings.HasPrefix(got, "first ") || !strings.HasSuffix(got, " second "+txtEmbed+" end") {
+		t.Fatalf("unexpected transcoded message %q", got)
+	}
+	idxs := mdembeds.FindAllStringIndex(got)
+	assert.DeepEqual(t, len(idxs), 2)
+	args := mdembeds.ParseEmbedArgs(got[idxs[0][0]:idxs[0][1]])
+	assert.DeepEqual(t, args.Alt, "an image, resized")
+	imgCfg, _, err := image.DecodeConfig(bytes.NewReader(args.Data))
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, imgCfg.Width, 100)
+	assert.DeepEqual(t, imgCfg.Height, 50)
+
+	// Transcoding an already transcoded message does not change it.
+	again, err := c.transcodeEmbeds(got)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, again, got)
+
+	// Disabling transcoding keeps the message unmodified.
+	c.cfg.EmbedImage.Disable = true
+	got, err = c.transcodeEmbeds(msg)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, got, msg)
+}
+
+// TestTranscodeEmbedMetadata tests that metadata is stripped from embedded
+// images unless configured otherwise.
+func TestTranscodeEmbedMetadata(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	c, err := New(Config{
+		DB:            testDB(t, id, nil),
+		LocalIDIniter: fixedIDIniter(id),
+		Logger:        func(string) slog.Logger { return slog.Disabled },
+	})
+	assert.NilErr(t, err)
+
+	// Add a comment segment right after the SOI marker of a JPEG.
+	var b bytes.Buffer
+	assert.NilErr(t, jpeg.Encode(&b, image.NewGray(image.Rect(0, 0, 10, 10)), nil))
+	comment := "location"
+	data := append([]byte{}, b.Bytes()[:2]...)
+	data = append(data, 0xff, 0xfe, 0, byte(len(comment)+2))
+	data = append(data, comment...)
+	data = append(data, b.Bytes()[2:]...)
+
+	tres, err := c.transcodeEmbedImage(data)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tres.Transcoded, true)
+	if bytes.Contains(tres.Data, []byte(comment)) {
+		t.Fatalf("metadata was not stripped")
+	}
+
+	c.cfg.EmbedImage.KeepMetadata = true
+	tres, err = c.transcodeEmbedImage(data)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tres.Transcoded, false)
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/feeds"
+)
+
+const (
+	// feedBridgeMaxFeedSize is the max size of a feed fetched by the feed
+	// bridge.
+	feedBridgeMaxFeedSize = 4 * 1024 * 1024
+
+	// feedBridgeFetchTimeout is the timeout for fetching a feed.
+	feedBridgeFetchTimeout = time.Minute
+
+	// feedBridgeMaxDescrLen is the max length of the description of posts
+	// created from feed items.
+	feedBridgeMaxDescrLen = 256
+)
+
+// fetchFeedSource fetches the contents of the given feed source. Sources may
+// be HTTP(S) URLs or local files.
+func (c *Client) fetchFeedSource(ctx context.Context, source string) ([]byte, error) {
+	u, err := url.Parse(source)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		path := source
+		if err == nil && u.Scheme == "file" {
+			path = u.Path
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return io.ReadAll(io.LimitReader(f, feedBridgeMaxFeedSize))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, feedBridgeFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, "+
+		"application/xml;q=0.9, text/xml;q=0.8, */*;q=0.5")
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.cfg.DialFunc != nil {
+		dial := c.cfg.DialFunc
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return dial(ctx, network, addr)
+		}
+	}
+	httpClient := &http.Client{Transport: transport}
+	defer httpClient.CloseIdleConnections()
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", res.Status)
+	}
+	return io.ReadAll(io.LimitReader(res.Body, feedBridgeMaxFeedSize))
+}
+
+// feedItemPost returns the content and description of the post created to
+// republish a feed item. The post includes the attribution to the original
+// feed and author.
+func feedItemPost(feed *feeds.Feed, item *feeds.Item) (string, string) {
+	var b strings.Builder
+	title := item.Title
+	if title == "" {
+		title = feed.Title
+	}
+	if title != "" {
+		fmt.Fprintf(&b, "# %s\n\n", title)
+	}
+	if item.Summary != "" {
+		b.WriteString(item.Summary)
+		b.WriteString("\n\n")
+	}
+	if item.Link != "" {
+		fmt.Fprintf(&b, "Read more: %s\n\n", item.Link)
+	}
+
+	source := feed.Title
+	if source == "" {
+		source = feed.Link
+	}
+	switch {
+	case source != "" && item.Author != "":
+		fmt.Fprintf(&b, "*Originally published by %s on %s*", item.Author, source)
+	case source != "":
+		fmt.Fprintf(&b, "*Originally published on %s*", source)
+	case item.Author != "":
+		fmt.Fprintf(&b, "*Originally published by %s*", item.Author)
+	}
+	if !item.Published.IsZero() {
+		fmt.Fprintf(&b, " *(%s)*", item.Published.UTC().Format("2006-01-02 15:04 MST"))
+	}
+
+	descr := strings.Join(strings.Fields(item.Summary), " ")
+	if len(descr) > feedBridgeMaxDescrLen {
+		descr = descr[:feedBridgeMaxDescrLen]
+		for !utf8.ValidString(descr) {
+			descr = descr[:len(descr)-1]
+		}
+		descr += "…"
+	}
+
+	return strings.TrimSpace(b.String()), descr
+}
+
+// pollFeedSource fetches the given feed source and republishes its new items
+// as local posts.
+func (c *Client) pollFeedSource(ctx context.Context, source string) error {
+	data, err := c.fetchFeedSource(ctx, source)
+	if err != nil {
+		return fmt.Errorf("unable to fetch feed: %w", err)
+	}
+	feed, err := feeds.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	var imported clientdb.ImportedFeed
+	err = c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		imported, err = c.db.ReadImportedFeed(tx, source)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Feeds are usually listed newest first. Items are published oldest
+	// first, falling back to the reverse feed order for undated items.
+	var newItems []*feeds.Item
+	seen := make(map[string]struct{}, len(feed.Items))
+	for i := len(feed.Items) - 1; i >= 0; i-- {
+		item := &feed.Items[i]
+		if _, ok := seen[item.ID]; ok {
+			continue
+		}
+		seen[item.ID] = struct{}{}
+		if _, ok := imported.Items[item.ID]; !ok {
+			newItems = append(newItems, item)
+		}
+	}
+	allDated := true
+	for _, item := range newItems {
+		allDated = allDated && !item.Published.IsZero()
+	}
+	if allDated {
+		sort.SliceStable(newItems, func(i, j int) bool {
+			return newItems[i].Published.Before(newItems[j].Published)
+		})
+	}
+
+	// Items beyond the max number of posts per poll are marked as imported
+	// without being published, to avoid flooding subscribers.
+	var skipped []*feeds.Item
+	if maxPosts := c.cfg.FeedBridgeMaxPostsPerPoll; len(newItems) > maxPosts {
+		skipped = newItems[:len(newItems)-maxPosts]
+		newItems = newItems[len(newItems)-maxPosts:]
+	}
+
+	imported.Title = feed.Title
+	imported.LastPolled = time.Now()
+	for _, item := range skipped {
+		imported.Items[item.ID] = clientdb.ImportedFeedItem{
+			ItemID:   item.ID,
+			Imported: imported.LastPolled,
+		}
+	}
+	if len(skipped) > 0 {
+		c.log.Infof("Skipping %d older items of feed %s", len(skipped),
+			source)
+	}
+	saveImported := func() error {
+		return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			return c.db.SaveImportedFeed(tx, imported)
+		})
+	}
+	if err := saveImported(); err != nil {
+		return err
+	}
+
+	for _, item := range newItems {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		post, descr := feedItemPost(feed, item)
+		summ, err := c.CreatePost(post, descr)
+		if err != nil {
+			return fmt.Errorf("unable to create post for item %q: %w",
+				item.ID, err)
+		}
+
+		ifi := clientdb.ImportedFeedItem{
+			ItemID:   item.ID,
+			PostID:   summ.ID,
+			Imported: time.Now(),
+		}
+		imported.Items[item.ID] = ifi
+		if err := saveImported(); err != nil {
+			return err
+		}
+
+		c.log.Infof("Republished item %q of feed %s as post %s",
+			item.Title, source, summ.ID)
+		c.ntfns.notifyFeedItemImported(source, ifi, summ)
+	}
+	return nil
+}
+
+// runFeedBridge periodically polls the configured feed sources and
+// republishes their new items as local posts.
+func (c *Client) runFeedBridge(ctx context.Context) error {
+	if len(c.cfg.FeedBridgeSources) == 0 {
+		return nil
+	}
+
+	// Wait until the address book is loaded, so that posts are shared with
+	// all subscribers.
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	c.log.Infof("Starting feed bridge for %d feeds",
+		len(c.cfg.FeedBridgeSources))
+	for {
+		for _, source := range c.cfg.FeedBridgeSources {
+			err := c.pollFeedSource(ctx, source)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				c.log.Warnf("Unable to poll feed %s: %v", source, err)
+			}
+		}
+
+		select {
+		case <-time.After(c.cfg.FeedBridgeInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
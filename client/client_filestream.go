@@ -0,0 +1,447 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// streamChunkWindow is the max number of missing chunks of a streamed download
+// that are requested at a time.
+const streamChunkWindow = 4
+
+// errFileStreamClosed is returned when attempting to use a closed FileStream.
+var errFileStreamClosed = errors.New("file stream closed")
+
+// FileStream is a reader over a (possibly partially) downloaded file. Reads
+// block until the data at the current read position has been downloaded.
+//
+// While the stream is open, chunks of the download are requested sequentially,
+// starting at the chunk at the read position, such that data can be consumed
+// (for example, by an audio player) before the download completes. Seeking
+// reprioritizes the chunks requested from the remote user.
+type FileStream struct {
+	c       *Client
+	uid     UserID
+	fid     clientdb.FileID
+	updated chan struct{}
+	kick    chan struct{}
+	closed  chan struct{}
+
+	// ctx is canceled when the stream is closed, unblocking any pending
+	// reads.
+	ctx    context.Context
+	cancel func()
+
+	mtx       sync.Mutex
+	pos       int64
+	readChunk int
+	lastIdx   int
+	lastData  []byte
+}
+
+// signal signals that the underlying download was updated.
+func (fs *FileStream) signal() {
+	select {
+	case fs.updated <- struct{}{}:
+	default:
+	}
+}
+
+// requestChunks signals the stream to request the next chunks of the download.
+func (fs *FileStream) requestChunks() {
+	select {
+	case fs.kick <- struct{}{}:
+	default:
+	}
+}
+
+// run requests the chunks of the download whenever the stream is kicked. This
+// ensures the requests are serialized, which avoids requesting the same chunk
+// multiple times.
+func (fs *FileStream) run(ru *RemoteUser) {
+	for {
+		select {
+		case <-fs.kick:
+		case <-fs.ctx.Done():
+			return
+		}
+
+		fd, err := fs.readDownload()
+		if err != nil {
+			ru.log.Warnf("Unable to read streamed download %s: %v",
+				fs.fid, err)
+			continue
+		}
+		if fd.Metadata == nil || fd.DiskPath != "" || fd.IsSentFile {
+			continue
+		}
+		err = fs.c.downloadChunks(ru, fd)
+		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+			ru.log.Errorf("Error downloading chunks of file %s: %v",
+				fs.fid, err)
+		}
+	}
+}
+
+// wait waits until the underlying download is updated.
+func (fs *FileStream) wait(ctx context.Context) error {
+	select {
+	case <-fs.updated:
+		return nil
+	case <-fs.closed:
+		return errFileStreamClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-fs.ctx.Done():
+		if fs.c.ctx.Err() != nil {
+			return clientintf.ErrSubsysExiting
+		}
+		return errFileStreamClosed
+	}
+}
+
+// readDownload reads the current state of the underlying download.
+func (fs *FileStream) readDownload() (clientdb.FileDownload, error) {
+	var fd clientdb.FileDownload
+	err := fs.c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		fd, err = fs.c.db.ReadFileDownload(tx, fs.uid, fs.fid)
+		return err
+	})
+	return fd, err
+}
+
+// Metadata returns the metadata of the file being streamed. This blocks until
+// the metadata is received from the remote user.
+func (fs *FileStream) Metadata(ctx context.Context) (rpc.FileMetadata, error) {
+	for {
+		fd, err := fs.readDownload()
+		if err != nil {
+			return rpc.FileMetadata{}, err
+		}
+		if fd.Metadata != nil {
+			return *fd.Metadata, nil
+		}
+		if err := fs.wait(ctx); err != nil {
+			return rpc.FileMetadata{}, err
+		}
+	}
+}
+
+// chunkAt returns the index of the chunk that contains the given file offset
+// and the offset within that chunk.
+func chunkAt(md *rpc.FileMetadata, pos int64) (int, int64) {
+	for i, ch := range md.Manifest {
+		if pos < int64(ch.Size) {
+			return i, pos
+		}
+		pos -= int64(ch.Size)
+	}
+	return len(md.Manifest), 0
+}
+
+// Read reads data from the file, blocking until the data at the current
+// position is downloaded. It returns io.EOF at the end of the file.
+func (fs *FileStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for {
+		select {
+		case <-fs.closed:
+			return 0, errFileStreamClosed
+		default:
+		}
+
+		md, err := fs.Metadata(fs.ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		fs.mtx.Lock()
+		pos := fs.pos
+		idx, off := chunkAt(&md, pos)
+		fs.readChunk = idx
+		var data []byte
+		if fs.lastData != nil && fs.lastIdx == idx {
+			data = fs.lastData
+		}
+		fs.mtx.Unlock()
+
+		if pos >= int64(md.Size) {
+			return 0, io.EOF
+		}
+
+		if data == nil {
+			err := fs.c.dbView(func(tx clientdb.ReadTx) error {
+				fd, err := fs.c.db.ReadFileDownload(tx, fs.uid, fs.fid)
+				if err != nil {
+					return err
+				}
+				data, err = fs.c.db.ReadFileDownloadChunk(tx, &fd, idx)
+				return err
+			})
+			if errors.Is(err, clientdb.ErrNotFound) {
+				// Chunk not downloaded yet. Wait until the
+				// download is updated.
+				if err := fs.wait(fs.ctx); err != nil {
+					return 0, err
+				}
+				continue
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		fs.mtx.Lock()
+		if fs.pos != pos {
+			// Concurrent seek. Read again.
+			fs.mtx.Unlock()
+			continue
+		}
+		n := copy(p, data[off:])
+		fs.pos += int64(n)
+		fs.lastIdx, fs.lastData = idx, data
+		fs.mtx.Unlock()
+		return n, nil
+	}
+}
+
+// Seek sets the offset for the next Read. Seeking relative to the end of the
+// file blocks until the file metadata is received.
+//
+// Seeking reprioritizes the requests for the chunks of the file, so that the
+// data at the new position is fetched first.
+func (fs *FileStream) Seek(offset int64, whence int) (int64, error) {
+	select {
+	case <-fs.closed:
+		return 0, errFileStreamClosed
+	default:
+	}
+
+	var size int64
+	if whence == io.SeekEnd {
+		md, err := fs.Metadata(fs.ctx)
+		if err != nil {
+			return 0, err
+		}
+		size = int64(md.Size)
+	}
+
+	fs.mtx.Lock()
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = fs.pos + offset
+	case io.SeekEnd:
+		pos = size + offset
+	default:
+		fs.mtx.Unlock()
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if pos < 0 {
+		fs.mtx.Unlock()
+		return 0, fmt.Errorf("negative position %d", pos)
+	}
+	oldChunk := fs.readChunk
+	fs.pos = pos
+	fs.mtx.Unlock()
+
+	// Reprioritize the chunk requests when the new position is in a
+	// different chunk.
+	fd, err := fs.readDownload()
+	if err != nil {
+		return pos, err
+	}
+	if fd.Metadata == nil || fd.DiskPath != "" {
+		return pos, nil
+	}
+	newChunk, _ := chunkAt(fd.Metadata, pos)
+	fs.mtx.Lock()
+	fs.readChunk = newChunk
+	fs.mtx.Unlock()
+	if newChunk != oldChunk {
+		fs.c.log.Debugf("Seeking stream of file %s to chunk %d", fs.fid,
+			newChunk)
+		fs.c.resumeStreamedDownload(fd)
+	}
+	return pos, nil
+}
+
+// Close closes the stream, unblocking any pending Read. The download of the
+// file proceeds (in non-sequential order) until completed.
+func (fs *FileStream) Close() error {
+	fs.c.fileStreamsMtx.Lock()
+	select {
+	case <-fs.closed:
+		fs.c.fileStreamsMtx.Unlock()
+		return errFileStreamClosed
+	default:
+	}
+	close(fs.closed)
+	fs.cancel()
+	if fs.c.fileStreams[fs.fid] == fs {
+		delete(fs.c.fileStreams, fs.fid)
+	}
+	fs.c.fileStreamsMtx.Unlock()
+
+	// Request the remaining chunks.
+	fd, err := fs.readDownload()
+	if err == nil && fd.Metadata != nil && fd.DiskPath == "" && !fd.IsSentFile {
+		fs.c.resumeStreamedDownload(fd)
+	}
+	return nil
+}
+
+// StreamUserContent opens a stream to read the given file from the remote
+// user, while it is downloaded. If the download of the file has not been
+// started yet, it is started.
+//
+// Only one stream may be open for a given file at a time. The stream must be
+// closed once it is no longer needed.
+func (c *Client) StreamUserContent(uid UserID, fid clientdb.FileID) (*FileStream, error) {
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	fs := &FileStream{
+		c:       c,
+		uid:     uid,
+		fid:     fid,
+		updated: make(chan struct{}, 1),
+		kick:    make(chan struct{}, 1),
+		closed:  make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	c.fileStreamsMtx.Lock()
+	if _, ok := c.fileStreams[fid]; ok {
+		c.fileStreamsMtx.Unlock()
+		cancel()
+		return nil, fmt.Errorf("file %s is already being streamed", fid)
+	}
+	c.fileStreams[fid] = fs
+	c.fileStreamsMtx.Unlock()
+
+	var fd clientdb.FileDownload
+	var started bool
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		fd, err = c.db.ReadFileDownload(tx, uid, fid)
+		if errors.Is(err, clientdb.ErrNotFound) {
+			started = true
+			fd, err = c.db.StartFileDownload(tx, uid, fid, false)
+		}
+		return err
+	})
+	if err != nil {
+		c.fileStreamsMtx.Lock()
+		delete(c.fileStreams, fid)
+		c.fileStreamsMtx.Unlock()
+		cancel()
+		return nil, err
+	}
+
+	go fs.run(ru)
+
+	switch {
+	case started:
+		ru.log.Infof("Starting streamed download of file %s", fid)
+		rmftg := rpc.RMFTGet{
+			FileID: fid.String(),
+		}
+		payEvent := fmt.Sprintf("ftget.%s", fid.ShortLogID())
+		if err := c.sendWithSendQ(payEvent, rmftg, uid); err != nil {
+			fs.Close()
+			return nil, err
+		}
+
+	case fd.Metadata != nil && fd.DiskPath == "" && !fd.IsSentFile:
+		ru.log.Infof("Streaming in-progress download of file %s", fid)
+		c.resumeStreamedDownload(fd)
+	}
+
+	return fs, nil
+}
+
+// fileStreamUpdated signals the stream of the given file (if there is one)
+// that its download was updated.
+func (c *Client) fileStreamUpdated(fid clientdb.FileID) {
+	c.fileStreamsMtx.Lock()
+	if fs := c.fileStreams[fid]; fs != nil {
+		fs.signal()
+	}
+	c.fileStreamsMtx.Unlock()
+}
+
+// isFileStreamed returns true if the given file is being streamed.
+func (c *Client) isFileStreamed(fid clientdb.FileID) bool {
+	c.fileStreamsMtx.Lock()
+	_, ok := c.fileStreams[fid]
+	c.fileStreamsMtx.Unlock()
+	return ok
+}
+
+// streamDownloadWindow returns the subset of missing chunks of a download
+// that should be requested next. When the file is being streamed, this is the
+// first streamChunkWindow missing chunks at or after the chunk being read,
+// wrapping around to earlier chunks (skipped due to seeks). Otherwise, all
+// missing chunks are returned.
+func (c *Client) streamDownloadWindow(fid clientdb.FileID, missing []int) []int {
+	c.fileStreamsMtx.Lock()
+	fs := c.fileStreams[fid]
+	c.fileStreamsMtx.Unlock()
+	if fs == nil {
+		return missing
+	}
+
+	fs.mtx.Lock()
+	start := fs.readChunk
+	fs.mtx.Unlock()
+
+	i := sort.SearchInts(missing, start)
+	window := make([]int, 0, len(missing))
+	window = append(window, missing[i:]...)
+	window = append(window, missing[:i]...)
+	if len(window) > streamChunkWindow {
+		window = window[:streamChunkWindow]
+	}
+	return window
+}
+
+// resumeStreamedDownload requests the next chunks of the given download.
+func (c *Client) resumeStreamedDownload(fd clientdb.FileDownload) {
+	c.fileStreamsMtx.Lock()
+	fs := c.fileStreams[fd.FID]
+	c.fileStreamsMtx.Unlock()
+	if fs != nil {
+		fs.requestChunks()
+		return
+	}
+
+	ru, err := c.rul.byID(fd.UID)
+	if err != nil {
+		c.log.Warnf("Unable to resume download %s: %v", fd.FID, err)
+		return
+	}
+	go func() {
+		err := c.downloadChunks(ru, fd)
+		if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+			ru.log.Errorf("Error downloading chunks of file %s: %v",
+				fd.FID, err)
+		}
+	}()
+}
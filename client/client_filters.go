@@ -7,6 +7,7 @@ import (
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
 	"golang.org/x/exp/slices"
 )
@@ -44,6 +45,17 @@ func (c *Client) StoreContentFilter(cf *clientdb.ContentFilter) error {
 	if _, err := regexp.Compile(cf.Regexp); err != nil {
 		return fmt.Errorf("invalid content filter regexp: %v", err)
 	}
+	if cf.PostTag != "" {
+		tag, err := rpc.NormalizePostTag(cf.PostTag)
+		if err != nil {
+			return err
+		}
+		cf.PostTag = tag
+	} else if cf.SubscribeTag {
+		return fmt.Errorf("tag subscription rule without a tag")
+	} else if cf.Regexp == "" {
+		return fmt.Errorf("content filter regexp cannot be empty")
+	}
 
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		return c.db.StoreContentFilter(tx, cf)
@@ -123,6 +135,26 @@ func (c *Client) ListContentFilters() []clientdb.ContentFilter {
 	return res
 }
 
+// filterRegexp returns the compiled regexp of the filter or nil if the regexp
+// is invalid. It must be called with filtersMtx held.
+func (c *Client) filterRegexp(cf *clientdb.ContentFilter) *regexp.Regexp {
+	re, ok := c.filtersRegexps[cf.ID]
+	if !ok {
+		// First time this regexp is being used, initialize it.
+		var err error
+		re, err = regexp.Compile(cf.Regexp)
+		if err != nil {
+			c.log.Warnf("Invalid content filter regexp (filter %d): %v",
+				cf.ID, err)
+		}
+
+		// Store nil in case of errors, so that we don't attempt
+		// to compile again.
+		c.filtersRegexps[cf.ID] = re
+	}
+	return re
+}
+
 // shouldFilter determines if any of the content filtering rules applies to
 // the data. It returns the id of the rule that filters the data.
 func (c *Client) shouldFilter(uid clientintf.UserID, gcid *zkidentity.ShortID,
@@ -138,6 +170,11 @@ func (c *Client) shouldFilter(uid clientintf.UserID, gcid *zkidentity.ShortID,
 
 	c.filtersMtx.Lock()
 	for _, cf := range c.filters {
+		// Post tag rules are checked in shouldFilterPostTags.
+		if cf.PostTag != "" {
+			continue
+		}
+
 		// Determine if this cf applies to this message.
 		if isPM && cf.SkipPMs {
 			continue
@@ -159,20 +196,7 @@ func (c *Client) shouldFilter(uid clientintf.UserID, gcid *zkidentity.ShortID,
 		}
 
 		// This cf does in fact apply to this message. Check the regexp.
-		re, ok := c.filtersRegexps[cf.ID]
-		if !ok {
-			// First time this regexp is being used, initialize it.
-			var err error
-			re, err = regexp.Compile(cf.Regexp)
-			if err != nil {
-				c.log.Warnf("Invalid content filter regexp (filter %d): %v",
-					cf.ID, err)
-			}
-
-			// Store nil in case of errors, so that we don't attempt
-			// to compile again.
-			c.filtersRegexps[cf.ID] = re
-		}
+		re := c.filterRegexp(&cf)
 		if re == nil {
 			// Invalid filter, skip it.
 			continue
@@ -208,6 +232,64 @@ func (c *Client) shouldFilter(uid clientintf.UserID, gcid *zkidentity.ShortID,
 	return filter, id
 }
 
+// shouldFilterPostTags determines if any of the post tag rules applies to the
+// post with the given tags. Muted tags take precedence over subscribed tags.
+// It returns the id of the rule that filters the post.
+func (c *Client) shouldFilterPostTags(uid clientintf.UserID, pid clientintf.PostID,
+	tags []string, post string) (bool, uint64) {
+
+	var muteRule, subRule *clientdb.ContentFilter
+	var subscribed bool
+
+	c.filtersMtx.Lock()
+	for i := range c.filters {
+		cf := &c.filters[i]
+		if cf.PostTag == "" {
+			continue
+		}
+		if cf.UID != nil && !cf.UID.ConstantTimeEq(&uid) {
+			continue
+		}
+
+		hasTag := slices.Contains(tags, cf.PostTag)
+		switch {
+		case cf.SubscribeTag && hasTag:
+			subscribed = true
+		case cf.SubscribeTag:
+			if subRule == nil {
+				subRule = cf
+			}
+		case hasTag && muteRule == nil:
+			if re := c.filterRegexp(cf); re != nil && re.MatchString(post) {
+				muteRule = cf
+			}
+		}
+	}
+	rule := muteRule
+	if rule == nil && !subscribed {
+		rule = subRule
+	}
+	var cf clientdb.ContentFilter
+	if rule != nil {
+		cf = *rule
+	}
+	c.filtersMtx.Unlock()
+
+	if rule == nil {
+		return false, 0
+	}
+
+	c.log.Tracef("Filtering post %s from %s due to tag rule %d", pid, uid, cf.ID)
+	event := MsgContentFilteredEvent{
+		UID:  uid,
+		PID:  &pid,
+		Msg:  post,
+		Rule: cf,
+	}
+	c.ntfns.notifyMsgContentFiltered(event)
+	return true, cf.ID
+}
+
 // FilterPM returns true if the pm sent by the specified user should be filtered.
 func (c *Client) FilterPM(uid UserID, msg string) (bool, uint64) {
 	return c.shouldFilter(uid, nil, nil, nil, msg)
@@ -225,6 +307,12 @@ func (c *Client) FilterPost(uid UserID, pid clientintf.PostID, post string) (boo
 	return c.shouldFilter(uid, nil, &pid, nil, post)
 }
 
+// FilterPostTags returns true if the post sent by the specified user should be
+// filtered due to its tags.
+func (c *Client) FilterPostTags(uid UserID, pid clientintf.PostID, tags []string, post string) (bool, uint64) {
+	return c.shouldFilterPostTags(uid, pid, tags, post)
+}
+
 // FilterPostComment returns true if the post comment sent by the specified
 // user should be filtered.
 func (c *Client) FilterPostComment(uid, postFrom UserID, pid clientintf.PostID, comment string) (bool, uint64) {
@@ -0,0 +1,318 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/strescape"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// gcTipRecipient is a member of a GC that receives part of a GC tip, along
+// with its weight in the split.
+type gcTipRecipient struct {
+	uid    UserID
+	weight uint64
+}
+
+// gcTipRecipients returns the members of the GC that receive part of a tip
+// split with the given mode. The local client and members that are not known
+// users are never tipped.
+func (c *Client) gcTipRecipients(gc rpc.RMGroupList, mode clientdb.GCTipSplitMode) ([]gcTipRecipient, error) {
+	localID := c.PublicID()
+	isTippable := func(uid UserID) bool {
+		if uid == localID {
+			return false
+		}
+		_, err := c.rul.byID(uid)
+		return err == nil
+	}
+
+	var res []gcTipRecipient
+	switch mode {
+	case clientdb.GCTipSplitEqual:
+		for _, uid := range gc.Members {
+			if isTippable(uid) {
+				res = append(res, gcTipRecipient{uid: uid, weight: 1})
+			}
+		}
+
+	case clientdb.GCTipSplitAdmins:
+		for i, uid := range gc.Members {
+			isAdmin := i == 0 || slices.Contains(gc.ExtraAdmins, uid)
+			if isAdmin && isTippable(uid) {
+				res = append(res, gcTipRecipient{uid: uid, weight: 1})
+			}
+		}
+
+	case clientdb.GCTipSplitShares:
+		for _, share := range gc.TipShares {
+			if share.Share == 0 || !slices.Contains(gc.Members, share.UID) {
+				continue
+			}
+			if isTippable(share.UID) {
+				res = append(res, gcTipRecipient{uid: share.UID,
+					weight: uint64(share.Share)})
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown gc tip split mode %q", mode)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no members of GC %q to tip with split "+
+			"mode %q", gc.Name, mode)
+	}
+	return res, nil
+}
+
+// splitGCTip splits the total amount (in atoms) among the recipients,
+// proportionally to their weights. The atoms left over from rounding are
+// added, one each, to the first recipients. The returned amounts are in
+// milliatoms.
+func splitGCTip(total uint64, recipients []gcTipRecipient) ([]clientdb.GCTipMember, error) {
+	if total > math.MaxUint64/1000 {
+		return nil, fmt.Errorf("total amount %d is too large", total)
+	}
+	var totalWeight, carry uint64
+	for _, r := range recipients {
+		totalWeight, carry = bits.Add64(totalWeight, r.weight, 0)
+		if carry != 0 {
+			return nil, fmt.Errorf("total weight of recipients overflows")
+		}
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("total weight of recipients is zero")
+	}
+
+	atoms := make([]uint64, len(recipients))
+	var assigned uint64
+	for i, r := range recipients {
+		// The full 128 bit product is used to avoid overflowing. As
+		// weight <= totalWeight, the quotient always fits in 64 bits.
+		hi, lo := bits.Mul64(total, r.weight)
+		atoms[i], _ = bits.Div64(hi, lo, totalWeight)
+		assigned += atoms[i]
+	}
+	for i := 0; assigned < total; i = (i + 1) % len(atoms) {
+		atoms[i] += 1
+		assigned += 1
+	}
+
+	res := make([]clientdb.GCTipMember, len(recipients))
+	for i, r := range recipients {
+		if atoms[i] == 0 {
+			return nil, fmt.Errorf("total amount is too small to " +
+				"tip all members")
+		}
+		res[i] = clientdb.GCTipMember{
+			UID:        r.uid,
+			MilliAtoms: atoms[i] * 1e3,
+			Status:     clientdb.GCTipMemberPending,
+		}
+	}
+	return res, nil
+}
+
+// TipGroupChat tips the members of a GC. The total amount is split among the
+// members according to the split mode and one tip attempt (with up to
+// maxAttempts to fetch and pay an invoice) is started for each member.
+//
+// The progress of the tip is reported through [OnGCTipProgressNtfn].
+func (c *Client) TipGroupChat(gcid zkidentity.ShortID, dcrAmount float64,
+	mode clientdb.GCTipSplitMode, maxAttempts int32) (clientdb.GCTip, error) {
+
+	if dcrAmount <= 0 {
+		return clientdb.GCTip{}, fmt.Errorf("cannot tip GC %f <= 0", dcrAmount)
+	}
+	if maxAttempts <= 0 {
+		return clientdb.GCTip{}, fmt.Errorf("maxAttempts %d <= 0", maxAttempts)
+	}
+	amt, err := dcrutil.NewAmount(dcrAmount)
+	if err != nil {
+		return clientdb.GCTip{}, err
+	}
+
+	gc, err := c.GetGC(gcid)
+	if err != nil {
+		return clientdb.GCTip{}, err
+	}
+	recipients, err := c.gcTipRecipients(gc, mode)
+	if err != nil {
+		return clientdb.GCTip{}, err
+	}
+	members, err := splitGCTip(uint64(amt), recipients)
+	if err != nil {
+		return clientdb.GCTip{}, err
+	}
+
+	gt := clientdb.GCTip{
+		ID:         zkidentity.RandomShortID(),
+		GCID:       gcid,
+		SplitMode:  mode,
+		MilliAtoms: uint64(amt) * 1e3,
+		Created:    time.Now(),
+		Members:    members,
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreGCTip(tx, gt)
+	})
+	if err != nil {
+		return clientdb.GCTip{}, err
+	}
+
+	c.log.Infof("Starting tip of %.8f DCR to %d members of GC %q (%s) "+
+		"split by %s", float64(gt.MilliAtoms)/1e11, len(members), gc.Name,
+		gcid, mode)
+	c.logGCEvent(gcid, time.Now(), "Local client started tip of %.8f DCR "+
+		"to %d members (split: %s)", float64(gt.MilliAtoms)/1e11,
+		len(members), mode)
+	c.ntfns.notifyGCTipProgress(gt)
+
+	// Start the attempts for each member. These proceed in parallel.
+	id := gt.ID
+	for _, m := range members {
+		err := c.startTipAttempt(m.UID, m.MilliAtoms, maxAttempts, nil, &id)
+		if err != nil {
+			c.log.Warnf("Unable to start GC tip %s attempt to %s: %v",
+				id, m.UID, err)
+			ta := clientdb.TipUserAttempt{UID: m.UID, GCTipID: &id}
+			c.gcTipAttemptDone(&ta, err)
+		}
+	}
+
+	return gt, nil
+}
+
+// gcTipAttemptDone is called when a tip attempt finishes. If the attempt was
+// the payout to one member of a GC tip, the GC tip is updated accordingly.
+func (c *Client) gcTipAttemptDone(ta *clientdb.TipUserAttempt, payErr error) {
+	if ta.GCTipID == nil {
+		return
+	}
+
+	var gt clientdb.GCTip
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		gt, err = c.db.ReadGCTip(tx, *ta.GCTipID)
+		if err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(gt.Members, func(m clientdb.GCTipMember) bool {
+			return m.UID == ta.UID && m.Status == clientdb.GCTipMemberPending
+		})
+		if i < 0 {
+			return fmt.Errorf("user %s is not a pending member of "+
+				"gc tip", ta.UID)
+		}
+		if payErr != nil {
+			gt.Members[i].Status = clientdb.GCTipMemberFailed
+			gt.Members[i].Error = payErr.Error()
+		} else {
+			gt.Members[i].Status = clientdb.GCTipMemberCompleted
+		}
+
+		if _, _, pending, _ := gt.Progress(); pending == 0 {
+			now := time.Now()
+			gt.Completed = &now
+		}
+		return c.db.StoreGCTip(tx, gt)
+	})
+	if err != nil {
+		c.log.Errorf("Unable to update GC tip %s: %v", *ta.GCTipID, err)
+		return
+	}
+
+	if gt.Completed != nil {
+		completed, failed, _, paid := gt.Progress()
+		c.log.Infof("Completed GC tip %s: %d members paid %.8f DCR, "+
+			"%d failed", gt.ID, completed, float64(paid)/1e11, failed)
+		c.logGCEvent(gt.GCID, time.Now(), "Tip of %.8f DCR completed: "+
+			"%d members paid %.8f DCR, %d failed",
+			float64(gt.MilliAtoms)/1e11, completed, float64(paid)/1e11,
+			failed)
+	}
+	c.ntfns.notifyGCTipProgress(gt)
+}
+
+// ListGCTips lists the tips made to GCs, oldest first.
+func (c *Client) ListGCTips() ([]clientdb.GCTip, error) {
+	var res []clientdb.GCTip
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListGCTips(tx)
+		return err
+	})
+	return res, err
+}
+
+// SetGCTipShares sets the shares of the members of the GC used to split tips
+// made with the [clientdb.GCTipSplitShares] mode. Only admins of the GC may
+// set the shares. Members with a zero share are removed from the list.
+func (c *Client) SetGCTipShares(gcid zkidentity.ShortID, shares []rpc.RMGroupTipShare) error {
+	var newShares []rpc.RMGroupTipShare
+	for _, share := range shares {
+		if share.Share == 0 {
+			continue
+		}
+		if slices.ContainsFunc(newShares, func(s rpc.RMGroupTipShare) bool {
+			return s.UID == share.UID
+		}) {
+			return fmt.Errorf("duplicate share for user %s", share.UID)
+		}
+		newShares = append(newShares, share)
+	}
+
+	cb := func(gc *clientdb.GroupChat) error {
+		if gc.Metadata.Version < 1 {
+			return fmt.Errorf("cannot set tip shares for GC with version < 1")
+		}
+		for _, share := range newShares {
+			if !slices.Contains(gc.Metadata.Members, share.UID) {
+				return fmt.Errorf("cannot set tip share of non-member")
+			}
+		}
+
+		gc.Metadata.Timestamp = time.Now().Unix()
+		gc.Metadata.Generation += 1
+		gc.Metadata.TipShares = newShares
+		return nil
+	}
+
+	_, newGC, err := c.maybeUpdateGCFunc(nil, gcid, cb)
+	if err != nil {
+		return err
+	}
+
+	c.log.Infof("Changed tip shares of GC %s to %v", gcid, newShares)
+	c.logGCEvent(gcid, time.Now(), "Local client modified GC tip shares:\n%s",
+		c.gcTipSharesTxt(newShares))
+
+	rm := rpc.RMGroupUpdateAdmins{
+		Reason:       "updated tip shares",
+		NewGroupList: newGC.Metadata,
+	}
+	return c.sendToGCMembers(gcid, newGC.Metadata.Members, "modifyTipShares", rm, nil)
+}
+
+// gcTipSharesTxt returns a textual description of the tip shares of a GC.
+func (c *Client) gcTipSharesTxt(shares []rpc.RMGroupTipShare) string {
+	if len(shares) == 0 {
+		return "  - No tip shares"
+	}
+	lines := make([]string, len(shares))
+	for i, share := range shares {
+		nick := c.UserLogNick(share.UID)
+		lines[i] = fmt.Sprintf("  - %s: %d", strescape.Nick(nick), share.Share)
+	}
+	return strings.Join(lines, "\n")
+}
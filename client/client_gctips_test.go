@@ -0,0 +1,90 @@
+package client
+
+import (
+	"math"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestSplitGCTip tests splitting the total amount of a GC tip among the
+// recipients.
+func TestSplitGCTip(t *testing.T) {
+	t.Parallel()
+
+	var uids [3]UserID
+	for i := range uids {
+		uids[i][0] = byte(i)
+	}
+
+	tests := []struct {
+		name    string
+		total   uint64
+		weights []uint64
+		want    []uint64
+		wantErr bool
+	}{{
+		name:    "equal",
+		total:   300,
+		weights: []uint64{1, 1, 1},
+		want:    []uint64{100, 100, 100},
+	}, {
+		name:    "equal with remainder",
+		total:   302,
+		weights: []uint64{1, 1, 1},
+		want:    []uint64{101, 101, 100},
+	}, {
+		name:    "weighted",
+		total:   1000,
+		weights: []uint64{5, 3, 2},
+		want:    []uint64{500, 300, 200},
+	}, {
+		name:    "weighted with remainder",
+		total:   10,
+		weights: []uint64{1, 1, 2},
+		want:    []uint64{3, 2, 5},
+	}, {
+		name:    "too small",
+		total:   2,
+		weights: []uint64{1, 1, 1},
+		wantErr: true,
+	}, {
+		name:    "large total and weights",
+		total:   21e14,
+		weights: []uint64{math.MaxUint32, math.MaxUint32},
+		want:    []uint64{105e13, 105e13},
+	}, {
+		name:    "overflowing weights",
+		total:   1000,
+		weights: []uint64{math.MaxUint64, 1},
+		wantErr: true,
+	}, {
+		name:    "overflowing total",
+		total:   math.MaxUint64 / 100,
+		weights: []uint64{1, 1},
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			recipients := make([]gcTipRecipient, len(tc.weights))
+			for i, w := range tc.weights {
+				recipients[i] = gcTipRecipient{uid: uids[i], weight: w}
+			}
+			members, err := splitGCTip(tc.total, recipients)
+			if tc.wantErr {
+				assert.NonNilErr(t, err)
+				return
+			}
+			assert.NilErr(t, err)
+			var sum uint64
+			for i, m := range members {
+				assert.DeepEqual(t, m.UID, uids[i])
+				assert.DeepEqual(t, m.MilliAtoms, tc.want[i]*1e3)
+				sum += m.MilliAtoms
+			}
+			assert.DeepEqual(t, sum, tc.total*1e3)
+		})
+	}
+}
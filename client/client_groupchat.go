@@ -1131,10 +1131,14 @@ func (c *Client) GCMessage(gcID zkidentity.ShortID, msg string, mode rpc.Message
 	progressChan chan SendProgress) error {
 
 	<-c.abLoaded
+	msg, err := c.transcodeEmbeds(msg)
+	if err != nil {
+		return err
+	}
 	var gc clientdb.GroupChat
 	var gcBlockList clientdb.GCBlockList
 	myNick := c.LocalNick()
-	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
 		if gc, err = c.db.GetGC(tx, gcID); err != nil {
 			return err
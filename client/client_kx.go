@@ -135,6 +135,7 @@ func (c *Client) initRemoteUser(id *zkidentity.PublicIdentity, r *ratchet.Ratche
 	ru.myResetRV = myResetRV
 	ru.theirResetRV = theirResetRV
 	ru.ntfns = c.ntfns
+	ru.recordPayEvent = c.recordPayEvent
 	if nickAlias != "" {
 		ru.setNick(nickAlias)
 	}
@@ -321,6 +322,23 @@ func (c *Client) kxCompleted(public *zkidentity.PublicIdentity, r *ratchet.Ratch
 	}
 }
 
+// kxPaymentReceived is called after a KX that required a payment from the
+// remote user completes.
+func (c *Client) kxPaymentReceived(uid UserID, amount int64) {
+	if !c.cfg.KXPaymentRefund {
+		return
+	}
+
+	c.log.Infof("Refunding KX payment of %.8f DCR to %s", float64(amount)/1e11,
+		c.UserLogNick(uid))
+	const maxAttempts = 3
+	err := c.startTipAttempt(uid, uint64(amount), maxAttempts, nil, nil)
+	if err != nil {
+		c.log.Errorf("Unable to refund KX payment to %s: %v",
+			c.UserLogNick(uid), err)
+	}
+}
+
 // AddInviteOnKX adds a post kx action, based on the initial rv,
 // that invites the user to the given groupchat.
 func (c *Client) AddInviteOnKX(initialRV, gcID zkidentity.ShortID) error {
@@ -356,8 +374,13 @@ func (c *Client) ReadInvite(r io.Reader) (rpc.OOBPublicIdentityInvite, error) {
 
 // AcceptInvite blocks until the remote party reponds with us accepting the
 // remote party's invitation. The invite should've been created by ReadInvite.
-func (c *Client) AcceptInvite(invite rpc.OOBPublicIdentityInvite) error {
-	return c.kxl.acceptInvite(invite, false, false)
+//
+// If the invite requires a payment to complete the KX, that payment is only
+// made if it is not larger than maxKXPaymentMAtoms. Otherwise, an
+// ErrKXPaymentNotAllowed error is returned. Callers should only specify a
+// non-zero max payment after the local user agreed to pay for the KX.
+func (c *Client) AcceptInvite(invite rpc.OOBPublicIdentityInvite, maxKXPaymentMAtoms int64) error {
+	return c.kxl.acceptInvite(invite, false, false, maxKXPaymentMAtoms)
 }
 
 // FetchPrepaidInvite fetches a pre-paid invite from the server, using the
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+)
+
+// errLNLiquidityDisabled is returned when liquidity management is not enabled.
+var errLNLiquidityDisabled = errors.New("LN liquidity management is not enabled")
+
+// ListLNLiquidityActions returns the actions proposed or performed to manage
+// the liquidity of the LN wallet.
+func (c *Client) ListLNLiquidityActions() ([]clientdb.LNLiquidityAction, error) {
+	if c.lnlm == nil {
+		return nil, errLNLiquidityDisabled
+	}
+	return c.lnlm.listActions()
+}
+
+// CheckLNLiquidity checks the channel balances of the LN wallet against the
+// configured targets, proposing new actions when needed.
+func (c *Client) CheckLNLiquidity(ctx context.Context) error {
+	if c.lnlm == nil {
+		return errLNLiquidityDisabled
+	}
+	return c.lnlm.check(ctx)
+}
+
+// ApproveLNLiquidityAction performs a proposed liquidity action. This blocks
+// until the action completes.
+func (c *Client) ApproveLNLiquidityAction(ctx context.Context, id uint64) error {
+	if c.lnlm == nil {
+		return errLNLiquidityDisabled
+	}
+	return c.lnlm.perform(ctx, id)
+}
+
+// RejectLNLiquidityAction rejects a proposed liquidity action. A new action
+// may be proposed on the next check if the balances are still below the
+// configured targets.
+func (c *Client) RejectLNLiquidityAction(id uint64) error {
+	if c.lnlm == nil {
+		return errLNLiquidityDisabled
+	}
+	return c.lnlm.reject(id)
+}
@@ -159,7 +159,7 @@ func (c *Client) CancelOnboarding() error {
 func (c *Client) onboardRedeemOnchainFunds(ctx context.Context, funds *rpc.InviteFunds) (dcrutil.Amount, chainhash.Hash, error) {
 	var amount dcrutil.Amount
 	var tx chainhash.Hash
-	pc, ok := c.pc.(*DcrlnPaymentClient)
+	pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 	if !ok {
 		return amount, tx, fmt.Errorf("payment client is not a dcrlnd payment client")
 	}
@@ -187,9 +187,62 @@ func (c *Client) onboardRedeemOnchainFunds(ctx context.Context, funds *rpc.Invit
 	}
 }
 
+// defaultLNHub returns the pubkey and address of the default LN hub node of
+// the given network, with which outbound channels are opened.
+func defaultLNHub(network string) (string, string, error) {
+	switch network {
+	case "mainnet":
+		return "03bd03386d7b2efe80ae46d6c8cfcfdfcf9c9297a465ac0d48c110d11ae58ed509",
+			"hub0.bisonrelay.org:9735", nil
+	case "simnet":
+		server := "127.0.0.1:20202"
+		if runtime.GOOS == "android" {
+			server = "10.0.2.2:20202" // Proxy from emulator to local machine.
+		}
+		return "029398ddb14e4b3cb92fc64d61fcaaa2f3b590951b0b05ba1ecc04a7504d333213",
+			server, nil
+	default:
+		return "", "", fmt.Errorf("network %q does not have default hub", network)
+	}
+}
+
+// defaultLPD returns the address and TLS certificate of the default liquidity
+// provider of the given network, from which inbound channels are requested.
+func defaultLPD(network string) (string, []byte, error) {
+	switch network {
+	case "mainnet":
+		return "https://hub0.bisonrelay.org:9130", []byte(`-----BEGIN CERTIFICATE-----
+MIIBwzCCAWigAwIBAgIQJNKWfgRSQnnMdBwKsVshhTAKBggqhkjOPQQDAjAxMREw
+DwYDVQQKEwhkY3JsbmxwZDEcMBoGA1UEAxMTaHViMC5iaXNvbnJlbGF5Lm9yZzAe
+Fw0yNDA5MTIxNTMyNTVaFw0zNDA5MTExNTMyNTVaMDExETAPBgNVBAoTCGRjcmxu
+bHBkMRwwGgYDVQQDExNodWIwLmJpc29ucmVsYXkub3JnMFkwEwYHKoZIzj0CAQYI
+KoZIzj0DAQcDQgAE8BvBcDlzJs+DLRHa08bLVx1ya9S+PX+b7obfhq45VdkenSNt
+xk9OJZUGnpTkDbt1CBLjQg6RRqYkADYviCuDfaNiMGAwDgYDVR0PAQH/BAQDAgKE
+MA8GA1UdEwEB/wQFMAMBAf8wHQYDVR0OBBYEFBkc97rEXLNm3S/166Q7OqOoBuwd
+MB4GA1UdEQQXMBWCE2h1YjAuYmlzb25yZWxheS5vcmcwCgYIKoZIzj0EAwIDSQAw
+RgIhAKW0WpOpb0HyXofI1ML0Yu29NqU+WNwyOVzD9IlOluerAiEA84ltFlil8D1i
+L6izsBzTqk6GKYSfl095BKOGyIrT+1c=
+-----END CERTIFICATE-----`), nil
+
+	case "simnet":
+		// On simnet, load the cert from the default
+		// ~/.dcrlnlpd/tls.cert location.
+		dir := dcrutil.AppDataDir("dcrlnlpd", false)
+		tlsCertFile := filepath.Join(dir, "tls.cert")
+		cert, err := os.ReadFile(tlsCertFile)
+		if err != nil {
+			return "", nil, err
+		}
+		return "https://127.0.0.1:29130", cert, nil
+
+	default:
+		return "", nil, fmt.Errorf("network %q does not have default LPD", network)
+	}
+}
+
 // onboardOpenOutboundChan opens the outbound LN channel.
 func (c *Client) onboardOpenOutboundChan(ctx context.Context, onchainAmount dcrutil.Amount) (string, bool, uint32, error) {
-	pc, ok := c.pc.(*DcrlnPaymentClient)
+	pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 	if !ok {
 		return "", false, 0, fmt.Errorf("payment client is not a dcrlnd payment client")
 	}
@@ -200,22 +253,12 @@ func (c *Client) onboardOpenOutboundChan(ctx context.Context, onchainAmount dcru
 		return "", false, 0, err
 	}
 
-	var key, server string
 	if len(info.Chains) == 0 || info.Chains[0].Chain != "decred" {
 		return "", false, 0, fmt.Errorf("not connected to decred LN")
 	}
-	switch info.Chains[0].Network {
-	case "mainnet":
-		key = "03bd03386d7b2efe80ae46d6c8cfcfdfcf9c9297a465ac0d48c110d11ae58ed509"
-		server = "hub0.bisonrelay.org:9735"
-	case "simnet":
-		key = "029398ddb14e4b3cb92fc64d61fcaaa2f3b590951b0b05ba1ecc04a7504d333213"
-		server = "127.0.0.1:20202"
-		if runtime.GOOS == "android" {
-			server = "10.0.2.2:20202" // Proxy from emulator to local machine.
-		}
-	default:
-		return "", false, 0, fmt.Errorf("network %q does not have default hub", info.Chains[0].Network)
+	key, server, err := defaultLNHub(info.Chains[0].Network)
+	if err != nil {
+		return "", false, 0, err
 	}
 
 	// Check if connecting to the node was successful.
@@ -292,7 +335,7 @@ func (c *Client) onboardWaitOutChannelMined(ctx context.Context, cp string, heig
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	pc, ok := c.pc.(*DcrlnPaymentClient)
+	pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 	if !ok {
 		return 0, 0, fmt.Errorf("payment client is not a dcrlnd payment client")
 	}
@@ -354,7 +397,7 @@ func (c *Client) onboardWaitChannelOpened(ctx context.Context, cp string, minedH
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	pc, ok := c.pc.(*DcrlnPaymentClient)
+	pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 	if !ok {
 		return false, 0, fmt.Errorf("payment client is not a dcrlnd payment client")
 	}
@@ -472,7 +515,7 @@ func (c *Client) onboardWaitChannelOpened(ctx context.Context, cp string, minedH
 // onboardOpenInboundChan requests to the LPD that the inbound channel be
 // opened.
 func (c *Client) onboardOpenInboundChan(ctx context.Context) (string, error) {
-	pc, ok := c.pc.(*DcrlnPaymentClient)
+	pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 	if !ok {
 		return "", fmt.Errorf("payment client is not a dcrlnd payment client")
 	}
@@ -488,41 +531,12 @@ func (c *Client) onboardOpenInboundChan(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	var server, cert string
 	if len(info.Chains) == 0 || info.Chains[0].Chain != "decred" {
 		return "", fmt.Errorf("not connected to decred LN")
 	}
-	switch info.Chains[0].Network {
-	case "mainnet":
-		server = "https://hub0.bisonrelay.org:9130"
-		cert = `-----BEGIN CERTIFICATE-----
-MIIBwzCCAWigAwIBAgIQJNKWfgRSQnnMdBwKsVshhTAKBggqhkjOPQQDAjAxMREw
-DwYDVQQKEwhkY3JsbmxwZDEcMBoGA1UEAxMTaHViMC5iaXNvbnJlbGF5Lm9yZzAe
-Fw0yNDA5MTIxNTMyNTVaFw0zNDA5MTExNTMyNTVaMDExETAPBgNVBAoTCGRjcmxu
-bHBkMRwwGgYDVQQDExNodWIwLmJpc29ucmVsYXkub3JnMFkwEwYHKoZIzj0CAQYI
-KoZIzj0DAQcDQgAE8BvBcDlzJs+DLRHa08bLVx1ya9S+PX+b7obfhq45VdkenSNt
-xk9OJZUGnpTkDbt1CBLjQg6RRqYkADYviCuDfaNiMGAwDgYDVR0PAQH/BAQDAgKE
-MA8GA1UdEwEB/wQFMAMBAf8wHQYDVR0OBBYEFBkc97rEXLNm3S/166Q7OqOoBuwd
-MB4GA1UdEQQXMBWCE2h1YjAuYmlzb25yZWxheS5vcmcwCgYIKoZIzj0EAwIDSQAw
-RgIhAKW0WpOpb0HyXofI1ML0Yu29NqU+WNwyOVzD9IlOluerAiEA84ltFlil8D1i
-L6izsBzTqk6GKYSfl095BKOGyIrT+1c=
------END CERTIFICATE-----`
-
-	case "simnet":
-		server = "https://127.0.0.1:29130"
-
-		// On simnet, load the cert from the default
-		// ~/.dcrlnlpd/tls.cert location.
-		dir := dcrutil.AppDataDir("dcrlnlpd", false)
-		tlsCertFile := filepath.Join(dir, "tls.cert")
-		certBytes, err := os.ReadFile(tlsCertFile)
-		if err != nil {
-			return "", err
-		}
-		cert = string(certBytes)
-
-	default:
-		return "", fmt.Errorf("network %q does not have default LPD", info.Chains[0].Network)
+	server, cert, err := defaultLPD(info.Chains[0].Network)
+	if err != nil {
+		return "", err
 	}
 
 	// Size the requested channel so that 66% of the outgoing channel
@@ -539,7 +553,7 @@ L6izsBzTqk6GKYSfl095BKOGyIrT+1c=
 	lpcfg := lpclient.Config{
 		LC:           lnRPC,
 		Address:      server,
-		Certificates: []byte(cert),
+		Certificates: cert,
 
 		PolicyFetched: func(policy lpclient.ServerPolicy) error {
 			estInvoice := lpclient.EstimatedInvoiceAmount(chanSize,
@@ -612,7 +626,7 @@ func (c *Client) onboardInitialKX(ctx context.Context, ostate clientintf.Onboard
 
 	if !kxing {
 		// Not yet. Accept.
-		err := c.AcceptInvite(*ostate.Invite)
+		err := c.AcceptInvite(*ostate.Invite, 0)
 		if err != nil {
 			return err
 		}
@@ -713,7 +727,7 @@ func (c *Client) runOnboarding(ctx context.Context, ostate clientintf.OnboardSta
 			// Verify that the invite funds were confirmed onchain.
 			c.log.Infof("Waiting for tx %s to confirm to proceed with onboarding",
 				ostate.RedeemTx)
-			pc, ok := c.pc.(*DcrlnPaymentClient)
+			pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient)
 			if !ok {
 				runErr = fmt.Errorf("payment client is not a dcrlnd payment client")
 			} else {
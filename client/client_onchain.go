@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
 )
 
 // OnchainRecvAddrForUser returns the on-chain receive address of the local
@@ -23,7 +24,7 @@ func (c *Client) OnchainRecvAddrForUser(uid UserID, acct string) (string, error)
 		return addr, nil
 	}
 
-	if pc, ok := c.cfg.PayClient.(*DcrlnPaymentClient); ok {
+	if pc, ok := c.cfg.PayClient.(clientintf.OnchainPaymentClient); ok {
 		newAddr, err := pc.NewReceiveAddress(c.ctx, acct)
 		if err != nil {
 			return "", fmt.Errorf("unable to generate new on-chain address: %v", err)
@@ -0,0 +1,426 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// onchainPayClient returns the payment client if it is able to send on-chain
+// payments.
+func (c *Client) onchainPayClient() (clientintf.OnchainPaymentClient, error) {
+	pc, ok := c.cfg.PayClient.(clientintf.OnchainPaymentClient)
+	if !ok {
+		return nil, fmt.Errorf("payment client is not able to send " +
+			"on-chain payments")
+	}
+	return pc, nil
+}
+
+// startOnchainTipFallback starts sending on-chain the tip of the given attempt,
+// after attempts to pay it through LN failed with lnErr. It returns false if
+// the on-chain fallback is not enabled or not possible for the attempt.
+//
+// Only tips made directly to users (as opposed to recurring payments and GC
+// tips) fall back to on-chain payments.
+func (c *Client) startOnchainTipFallback(ru *RemoteUser, ta *clientdb.TipUserAttempt, lnErr error) bool {
+	if !c.cfg.TipOnchainFallback || ta.RecurringPaymentID != nil || ta.GCTipID != nil {
+		return false
+	}
+	if _, err := c.onchainPayClient(); err != nil {
+		ru.log.Warnf("Unable to fall back to on-chain tip: %v", err)
+		return false
+	}
+
+	now := time.Now()
+	ot := clientdb.OnchainTip{
+		ID:         zkidentity.RandomShortID(),
+		UID:        ta.UID,
+		Sent:       true,
+		MilliAtoms: ta.MilliAtoms,
+		Created:    now,
+		Updated:    now,
+		Status:     clientdb.OnchainTipAddrRequested,
+		LNError:    lnErr.Error(),
+		LNAttempts: ta.Attempts,
+	}
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store on-chain tip: %v", err)
+		return false
+	}
+
+	ru.log.Infof("Falling back to sending tip of %.8f DCR on-chain (id %s)",
+		float64(ot.MilliAtoms)/1e11, ot.ID)
+	c.ntfns.notifyOnchainTip(ru, ot)
+
+	rm := rpc.RMGetOnchainTipAddr{ID: ot.ID, MilliAtoms: ot.MilliAtoms}
+	if err := ru.sendRM(rm, "getonchaintipaddr"); err != nil {
+		c.onchainTipFailed(ru, ot, err)
+	}
+	return true
+}
+
+// onchainTipFailed marks the on-chain tip as failed and notifies the UI.
+func (c *Client) onchainTipFailed(ru *RemoteUser, ot clientdb.OnchainTip, failErr error) {
+	ot.Status = clientdb.OnchainTipFailed
+	ot.Error = failErr.Error()
+	ot.Updated = time.Now()
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store on-chain tip %s: %v", ot.ID, err)
+	}
+
+	ru.log.Warnf("On-chain tip %s of %.8f DCR failed: %v", ot.ID,
+		float64(ot.MilliAtoms)/1e11, failErr)
+	c.ntfns.notifyOnchainTip(ru, ot)
+	c.ntfns.notifyTipAttemptProgress(ru, int64(ot.MilliAtoms), false,
+		int(ot.LNAttempts), failErr, false)
+}
+
+// handleGetOnchainTipAddr handles a request for an on-chain address to send a
+// tip to, made by a remote user that was unable to pay the tip through LN.
+func (c *Client) handleGetOnchainTipAddr(ru *RemoteUser, rm rpc.RMGetOnchainTipAddr) error {
+	reply := rpc.RMOnchainTipAddr{ID: rm.ID}
+	addr, err := c.OnchainRecvAddrForUser(ru.ID(), c.cfg.OnchainTipsAccount)
+	if err != nil {
+		ru.log.Warnf("Unable to provide on-chain address for tip: %v", err)
+		errStr := "unable to generate on-chain address"
+		reply.Error = &errStr
+	} else {
+		reply.Address = addr
+		ru.log.Infof("Providing on-chain address %s for tip of %.8f DCR",
+			addr, float64(rm.MilliAtoms)/1e11)
+	}
+	return ru.sendRM(reply, "onchaintipaddr")
+}
+
+// handleOnchainTipAddr handles the on-chain address sent by the payee of an
+// on-chain tip.
+func (c *Client) handleOnchainTipAddr(ru *RemoteUser, rm rpc.RMOnchainTipAddr) error {
+	var ot clientdb.OnchainTip
+	var replyErr error
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		ot, err = c.db.ReadOnchainTip(tx, ru.ID(), rm.ID)
+		if err != nil {
+			return err
+		}
+		if !ot.Sent || ot.Status != clientdb.OnchainTipAddrRequested {
+			return fmt.Errorf("on-chain tip %s is not waiting for "+
+				"an address", rm.ID)
+		}
+
+		switch {
+		case rm.Error != nil:
+			replyErr = fmt.Errorf("remote user unable to provide "+
+				"on-chain address: %s", *rm.Error)
+			return nil
+		case rm.Address == "":
+			replyErr = errors.New("remote user sent empty on-chain address")
+			return nil
+		}
+
+		// Mark as sent before actually sending, so that duplicate
+		// replies do not cause the tip to be paid twice.
+		ot.Address = rm.Address
+		ot.Status = clientdb.OnchainTipSent
+		ot.Updated = time.Now()
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		return err
+	}
+	if replyErr != nil {
+		c.onchainTipFailed(ru, ot, replyErr)
+		return nil
+	}
+
+	go c.sendOnchainTip(c.ctx, ru, ot)
+	return nil
+}
+
+// sendOnchainTip sends the transaction that pays the on-chain tip and waits
+// for it to be confirmed. The transaction is subject to the tips budget.
+func (c *Client) sendOnchainTip(ctx context.Context, ru *RemoteUser, ot clientdb.OnchainTip) {
+	amount := dcrutil.Amount(ot.MilliAtoms / 1e3)
+	payCtx := clientintf.WithPaymentCategory(ctx, clientintf.PaymentCategoryTips)
+	txh, err := c.budgetPC.SendOnchain(payCtx, ot.Address, amount)
+	if err != nil {
+		c.onchainTipFailed(ru, ot, fmt.Errorf("unable to send "+
+			"on-chain transaction: %v", err))
+		return
+	}
+
+	ot.TxID = txh.String()
+	ot.Updated = time.Now()
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store on-chain tip %s: %v", ot.ID, err)
+	}
+	ru.log.Infof("Sent on-chain tip %s of %.8f DCR on tx %s", ot.ID,
+		float64(ot.MilliAtoms)/1e11, ot.TxID)
+	c.ntfns.notifyOnchainTip(ru, ot)
+
+	c.waitOnchainTipConfirmed(ctx, ru, ot)
+}
+
+// waitOnchainTipConfirmed waits until the transaction of the on-chain tip is
+// confirmed, then records the payment and sends the receipt to the payee.
+func (c *Client) waitOnchainTipConfirmed(ctx context.Context, ru *RemoteUser, ot clientdb.OnchainTip) {
+	pc, err := c.onchainPayClient()
+	if err != nil {
+		c.onchainTipFailed(ru, ot, err)
+		return
+	}
+	txh, err := chainhash.NewHashFromStr(ot.TxID)
+	if err != nil {
+		c.onchainTipFailed(ru, ot, err)
+		return
+	}
+
+	if err := pc.WaitTxConfirmed(ctx, *txh); err != nil {
+		if ctx.Err() == nil {
+			ru.log.Warnf("Unable to wait for confirmation of on-chain "+
+				"tip %s: %v", ot.ID, err)
+		}
+		return
+	}
+
+	ot.Status = clientdb.OnchainTipConfirmed
+	ot.Updated = time.Now()
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		err := c.recordPayEvent(tx, ot.UID, "tiponchain",
+			clientintf.PaymentCategoryTips, "", -int64(ot.MilliAtoms), 0)
+		if err != nil {
+			return err
+		}
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store on-chain tip %s: %v", ot.ID, err)
+		return
+	}
+
+	ru.log.Infof("On-chain tip %s of %.8f DCR confirmed", ot.ID,
+		float64(ot.MilliAtoms)/1e11)
+	c.ntfns.notifyOnchainTip(ru, ot)
+	c.ntfns.notifyTipAttemptProgress(ru, int64(ot.MilliAtoms), true,
+		int(ot.LNAttempts), nil, false)
+
+	receipt := rpc.RMOnchainTipReceipt{
+		ID:         ot.ID,
+		TxID:       ot.TxID,
+		MilliAtoms: ot.MilliAtoms,
+	}
+	err = ru.sendRM(receipt, "onchaintipreceipt")
+	if err != nil && !errors.Is(err, clientintf.ErrSubsysExiting) {
+		ru.log.Warnf("Unable to send receipt of on-chain tip %s: %v",
+			ot.ID, err)
+	}
+}
+
+// handleOnchainTipReceipt handles the receipt of an on-chain tip sent by the
+// payer after the transaction was confirmed. The tip is stored as unverified
+// until the local wallet confirms the transaction pays the tip.
+//
+// A transaction may only be claimed by a single tip, so that the same payment
+// is not accounted for multiple times.
+func (c *Client) handleOnchainTipReceipt(ru *RemoteUser, rm rpc.RMOnchainTipReceipt) error {
+	txh, err := chainhash.NewHashFromStr(rm.TxID)
+	if err != nil {
+		return fmt.Errorf("invalid txid in receipt of on-chain tip %s: %v",
+			rm.ID, err)
+	}
+
+	now := time.Now()
+	ot := clientdb.OnchainTip{
+		ID:         rm.ID,
+		UID:        ru.ID(),
+		MilliAtoms: rm.MilliAtoms,
+		Created:    now,
+		Updated:    now,
+		Status:     clientdb.OnchainTipUnverified,
+		TxID:       txh.String(),
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.ReadOnchainTip(tx, ot.UID, ot.ID)
+		if err == nil {
+			return fmt.Errorf("already received receipt of on-chain "+
+				"tip %s", ot.ID)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+		other, err := c.db.ReceivedOnchainTipByTxID(tx, ot.UID, ot.TxID)
+		if err == nil {
+			return fmt.Errorf("tx %s of on-chain tip %s already "+
+				"claimed by on-chain tip %s", ot.TxID, ot.ID,
+				other.ID)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+		ot.Address, err = c.db.OnchainRecvAddrForUser(tx, ot.UID)
+		if err != nil {
+			return err
+		}
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Received receipt of on-chain tip of %.8f DCR on tx %s",
+		float64(ot.MilliAtoms)/1e11, ot.TxID)
+	c.ntfns.notifyOnchainTip(ru, ot)
+	go c.verifyOnchainTipReceipt(c.ctx, ru, ot)
+	return nil
+}
+
+// verifyOnchainTipReceipt verifies with the local wallet that the transaction
+// of a received on-chain tip pays the tip amount to the address provided to
+// the payer. Verified tips are recorded as received payments.
+func (c *Client) verifyOnchainTipReceipt(ctx context.Context, ru *RemoteUser, ot clientdb.OnchainTip) {
+	pc, err := c.onchainPayClient()
+	if err != nil {
+		ru.log.Warnf("Unable to verify on-chain tip %s: %v", ot.ID, err)
+		return
+	}
+
+	verifyErr := func() error {
+		if ot.Address == "" {
+			return errors.New("no on-chain address provided to payer")
+		}
+		txh, err := chainhash.NewHashFromStr(ot.TxID)
+		if err != nil {
+			return err
+		}
+		if err := pc.WaitTxConfirmed(ctx, *txh); err != nil {
+			return err
+		}
+		amount := dcrutil.Amount(ot.MilliAtoms / 1e3)
+		return pc.VerifyTxPayment(ctx, *txh, ot.Address, amount)
+	}()
+	if ctx.Err() != nil {
+		return
+	}
+
+	if verifyErr != nil {
+		ot.Status = clientdb.OnchainTipFailed
+		ot.Error = fmt.Sprintf("unable to verify tx: %v", verifyErr)
+		ru.log.Warnf("Unable to verify receipt of on-chain tip %s: %v",
+			ot.ID, verifyErr)
+	} else {
+		ot.Status = clientdb.OnchainTipConfirmed
+		ru.log.Infof("Verified on-chain tip %s of %.8f DCR", ot.ID,
+			float64(ot.MilliAtoms)/1e11)
+	}
+	ot.Updated = time.Now()
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		if verifyErr == nil {
+			err := c.recordPayEvent(tx, ot.UID, "tiponchain",
+				clientintf.PaymentCategoryTips, "", int64(ot.MilliAtoms), 0)
+			if err != nil {
+				return err
+			}
+		}
+		return c.db.StoreOnchainTip(tx, ot)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store on-chain tip %s: %v", ot.ID, err)
+		return
+	}
+	c.ntfns.notifyOnchainTip(ru, ot)
+}
+
+// ListOnchainTips lists the on-chain tips sent to and received from remote
+// users, oldest first.
+func (c *Client) ListOnchainTips() ([]clientdb.OnchainTip, error) {
+	var res []clientdb.OnchainTip
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListOnchainTips(tx)
+		return err
+	})
+	return res, err
+}
+
+// restartOnchainTips resumes the on-chain tips that were in progress when the
+// client was last stopped.
+func (c *Client) restartOnchainTips(ctx context.Context) error {
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	ots, err := c.ListOnchainTips()
+	if err != nil {
+		return err
+	}
+
+	for _, ot := range ots {
+		if ot.Sent && ot.Status != clientdb.OnchainTipAddrRequested &&
+			ot.Status != clientdb.OnchainTipSent {
+			continue
+		}
+		if !ot.Sent && ot.Status != clientdb.OnchainTipUnverified {
+			continue
+		}
+
+		ru, err := c.rul.byID(ot.UID)
+		if err != nil {
+			c.log.Warnf("Unable to find user of on-chain tip %s: %v",
+				ot.ID, err)
+			continue
+		}
+
+		if !ot.Sent {
+			go c.verifyOnchainTipReceipt(ctx, ru, ot)
+			continue
+		}
+
+		switch {
+		case ot.Status == clientdb.OnchainTipAddrRequested &&
+			time.Since(ot.Created) > c.cfg.TipUserMaxLifetime:
+			c.onchainTipFailed(ru, ot, fmt.Errorf("remote user did "+
+				"not provide an on-chain address after %s",
+				time.Since(ot.Created).Truncate(time.Second)))
+
+		case ot.Status == clientdb.OnchainTipAddrRequested:
+			// The address is the same for every request, so it is
+			// safe to request it again.
+			rm := rpc.RMGetOnchainTipAddr{ID: ot.ID, MilliAtoms: ot.MilliAtoms}
+			if err := ru.sendRM(rm, "getonchaintipaddr"); err != nil {
+				ru.log.Warnf("Unable to request address for "+
+					"on-chain tip %s: %v", ot.ID, err)
+			}
+
+		case ot.TxID == "":
+			// It is unknown whether the transaction was sent, so
+			// do not attempt to send it again.
+			c.onchainTipFailed(ru, ot, errors.New("client stopped "+
+				"while sending the on-chain transaction (check "+
+				"the wallet)"))
+
+		default:
+			go c.waitOnchainTipConfirmed(ctx, ru, ot)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,551 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// CreatePaidPost creates a new paid post and shares its preview with all
+// current subscribers. The full content is stored locally and only sent to
+// subscribers that pay an invoice of priceMAtoms milliatoms.
+func (c *Client) CreatePaidPost(preview, content, descr string, priceMAtoms uint64) (clientdb.PostSummary, error) {
+	// Filename for embedded data is not currently used, so it's disabled at
+	// the client API level.
+	const fname = ""
+
+	var summ clientdb.PostSummary
+	if priceMAtoms == 0 {
+		return summ, errors.New("price of paid post cannot be zero")
+	}
+	if content == "" {
+		return summ, errors.New("content of paid post cannot be empty")
+	}
+	preview, err := c.transcodeEmbeds(preview)
+	if err != nil {
+		return summ, err
+	}
+	content, err = c.transcodeEmbeds(content)
+	if err != nil {
+		return summ, err
+	}
+
+	extraAttrs := map[string]string{
+		rpc.RMPPrice:       strconv.FormatUint(priceMAtoms, 10),
+		rpc.RMPContentHash: rpc.PaidPostContentHash(content),
+	}
+
+	me := c.Public()
+	var pm rpc.PostMetadata
+	var subs []clientdb.UserID
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		summ, pm, err = c.db.CreatePost(tx, preview, descr, fname, extraAttrs,
+			&me, c.localID.signMessage)
+		if err != nil {
+			return err
+		}
+
+		err = c.db.StorePaidPostContent(tx, me.Identity, summ.ID, content)
+		if err != nil {
+			return err
+		}
+
+		subs, err = c.db.ListPostRecipients(tx, summ.ID)
+		return err
+	})
+	if err != nil {
+		return summ, fmt.Errorf("unable to create local paid post: %w", err)
+	}
+
+	c.log.Infof("Created paid post %s (price %.8f DCR)", summ.ID,
+		float64(priceMAtoms)/1e11)
+	rm := rpc.RMPostShare(pm)
+	if err := c.shareWithPostSubscribers(subs, summ.ID, rm, "sharecreated"); err != nil {
+		return summ, err
+	}
+
+	return summ, nil
+}
+
+// BuyPost requests an invoice from the author of a paid post to unlock its
+// full content. The invoice is paid as soon as it is received and the full
+// content is sent by the author once the payment settles.
+func (c *Client) BuyPost(author UserID, pid clientintf.PostID) error {
+	ru, err := c.rul.byID(author)
+	if err != nil {
+		return err
+	}
+
+	var pp clientdb.PostPurchase
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		post, err := c.db.ReadPost(tx, author, pid)
+		if err != nil {
+			return err
+		}
+		price, err := clientdb.PaidPostPrice(&post)
+		if err != nil {
+			return err
+		}
+		if price == 0 {
+			return ErrNotPaidPost
+		}
+
+		_, err = c.db.ReadPaidPostContent(tx, author, pid)
+		if err == nil {
+			return ErrPaidPostUnlocked
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+
+		pp, err = c.db.ReadPostPurchase(tx, author, pid)
+		switch {
+		case errors.Is(err, clientdb.ErrNotFound):
+		case err != nil:
+			return err
+		case pp.Paid != nil:
+			return fmt.Errorf("already paid for post %s and waiting "+
+				"for its content", pid)
+		case pp.Invoice != "":
+			return fmt.Errorf("payment for post %s already in-flight", pid)
+		}
+
+		pp = clientdb.PostPurchase{
+			Author:     author,
+			PostID:     pid,
+			MilliAtoms: price,
+			Created:    time.Now(),
+		}
+		return c.db.StorePostPurchase(tx, pp)
+	})
+	if err != nil {
+		return err
+	}
+
+	getInvoice := rpc.RMGetInvoice{
+		PayScheme:  c.pc.PayScheme(),
+		MilliAtoms: pp.MilliAtoms,
+		PostID:     &pid,
+	}
+	ru.log.Infof("Requesting invoice to unlock post %s (price %.8f DCR)",
+		pid, float64(pp.MilliAtoms)/1e11)
+	payEvent := "getpostinvoice"
+	return c.sendWithSendQ(payEvent, getInvoice, ru.ID())
+}
+
+// handleGetPaidPostInvoice handles a request from a subscriber for an invoice
+// to unlock a paid post.
+func (c *Client) handleGetPaidPostInvoice(ru *RemoteUser, getInvoice rpc.RMGetInvoice) error {
+	pid := *getInvoice.PostID
+
+	// Helper to reply with an error.
+	replyWithErr := func(err error) {
+		errStr := err.Error()
+		reply := rpc.RMInvoice{
+			Tag:    getInvoice.Tag,
+			Error:  &errStr,
+			PostID: &pid,
+		}
+		errSend := c.sendWithSendQ("getpostinvoicereply", reply, ru.ID())
+		if errSend != nil && !errors.Is(errSend, clientintf.ErrSubsysExiting) {
+			ru.log.Warnf("Error sending paid post invoice reply: %v", errSend)
+		}
+	}
+
+	clientPS := c.pc.PayScheme()
+	if getInvoice.PayScheme != clientPS {
+		err := fmt.Errorf("requested pay scheme %q not same as %q",
+			getInvoice.PayScheme, clientPS)
+		replyWithErr(err)
+		return err
+	}
+
+	var price uint64
+	var pendingInv string
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		isSub, err := c.db.IsPostSubscriber(tx, ru.ID())
+		if err != nil {
+			return err
+		}
+		if !isSub {
+			return errors.New("not a subscriber")
+		}
+		if err := c.checkPostAudienceMember(tx, pid, ru.ID()); err != nil {
+			return err
+		}
+
+		post, err := c.db.ReadPost(tx, c.PublicID(), pid)
+		if err != nil {
+			return err
+		}
+		if price, err = clientdb.PaidPostPrice(&post); err != nil {
+			return err
+		}
+		if price == 0 {
+			return ErrNotPaidPost
+		}
+		_, err = c.db.ReadPaidPostContent(tx, c.PublicID(), pid)
+		if err != nil {
+			return err
+		}
+
+		// Find the last invoice generated for this subscriber that is
+		// still pending.
+		sales, err := c.db.ListPostSales(tx, pid)
+		if err != nil {
+			return err
+		}
+		for _, sale := range sales {
+			if sale.UID == ru.ID() && sale.MilliAtoms == price &&
+				sale.Settled == nil && sale.Expired == nil {
+				pendingInv = sale.Invoice
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, clientdb.ErrNotFound) {
+		err = fmt.Errorf("post %s: %w", pid, clientdb.ErrNotFound)
+	}
+	if err == nil && getInvoice.MilliAtoms != price {
+		err = fmt.Errorf("requested amount %d different than post price %d",
+			getInvoice.MilliAtoms, price)
+	}
+	if err != nil {
+		ru.log.Warnf("Unable to generate invoice for paid post %s: %v",
+			pid, err)
+		replyWithErr(err)
+		return nil
+	}
+
+	// Reuse the pending invoice (which is already being tracked) if it
+	// does not expire soon, instead of generating a new one.
+	if pendingInv != "" {
+		decoded, err := c.pc.DecodeInvoice(c.ctx, pendingInv)
+		if err != nil || decoded.IsExpired(time.Minute) {
+			pendingInv = ""
+		}
+	}
+
+	inv := pendingInv
+	if inv != "" {
+		ru.log.Debugf("Reusing pending invoice for paid post %s", pid)
+	} else {
+		amountMAtoms := int64(price)
+		dcrAmount := float64(amountMAtoms) / 1e11
+		inv, err = c.pc.GetInvoice(c.ctx, amountMAtoms, nil)
+		if err != nil {
+			c.ntfns.notifyInvoiceGenFailed(ru, dcrAmount, err)
+			replyWithErr(rpc.ErrUnableToGenerateInvoice)
+			ru.log.Warnf("Unable to generate invoice for post %s of %.8f DCR: %v",
+				pid, dcrAmount, err)
+			return nil
+		}
+
+		ru.log.Infof("Generated invoice for paid post %s of %.8f DCR", pid,
+			dcrAmount)
+
+		// Persist the generated invoice.
+		sale := clientdb.PostSale{
+			PostID:     pid,
+			UID:        ru.ID(),
+			Invoice:    inv,
+			MilliAtoms: price,
+			Created:    time.Now(),
+		}
+		err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			return c.db.StorePostSale(tx, sale)
+		})
+		if err != nil {
+			return err
+		}
+
+		go c.trackPostSaleInvoice(c.ctx, sale)
+	}
+
+	// Send reply.
+	reply := rpc.RMInvoice{
+		Invoice: inv,
+		Tag:     getInvoice.Tag,
+		PostID:  &pid,
+	}
+	return c.sendWithSendQ("getpostinvoicereply", reply, ru.ID())
+}
+
+// trackPostSaleInvoice tracks an invoice generated by the local client for a
+// subscriber to unlock a paid post. This blocks until the invoice is paid or
+// expires. After the invoice is paid, the full content of the post is sent
+// to the subscriber.
+func (c *Client) trackPostSaleInvoice(ctx context.Context, sale clientdb.PostSale) {
+	var err error
+	defer func() {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.log.Errorf("Unable to handle paid invoice for post %s: %v",
+				sale.PostID, err)
+		}
+	}()
+
+	// Wait until invoice is settled or expires.
+	receivedMAtoms, err := c.pc.TrackInvoice(ctx, sale.Invoice, int64(sale.MilliAtoms))
+	if errors.Is(err, clientintf.ErrInvoiceExpired) {
+		err = c.db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+			return c.db.MarkPostSaleExpired(tx, sale.PostID, sale.Invoice)
+		})
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	// Invoice settled. Update DB, send content and notify UI.
+	ru, err := c.rul.byID(sale.UID)
+	if err != nil {
+		return
+	}
+
+	var content string
+	invHash := c.invoiceHash(sale.Invoice)
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		content, err = c.db.ReadPaidPostContent(tx, c.PublicID(), sale.PostID)
+		if err != nil {
+			return err
+		}
+		err = c.recordPayEvent(tx, sale.UID, "postsale",
+			clientintf.PaymentCategoryOther, invHash, receivedMAtoms, 0)
+		if err != nil {
+			return err
+		}
+		_, err = c.db.MarkPostSaleSettled(tx, sale.PostID, sale.Invoice, receivedMAtoms)
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	ru.log.Infof("Received %.8f DCR for paid post %s",
+		float64(receivedMAtoms)/1e11, sale.PostID)
+	rm := rpc.RMPaidPostContent{ID: sale.PostID, Content: content}
+	if err = c.sendWithSendQ("paidpostcontent", rm, sale.UID); err != nil {
+		return
+	}
+
+	c.ntfns.notifyPostSold(ru, sale.PostID, receivedMAtoms)
+}
+
+// restartTrackPostSales restarts tracking of invoices generated for selling
+// paid posts.
+func (c *Client) restartTrackPostSales(ctx context.Context) error {
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var sales []clientdb.PostSale
+	err := c.db.View(ctx, func(tx clientdb.ReadTx) error {
+		var err error
+		sales, err = c.db.ListPendingPostSales(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(sales) > 0 {
+		c.log.Infof("Tracking %d invoices for paid posts", len(sales))
+	}
+
+	for _, sale := range sales {
+		go c.trackPostSaleInvoice(ctx, sale)
+	}
+
+	return nil
+}
+
+// handlePaidPostInvoice handles an invoice received to unlock a paid post.
+func (c *Client) handlePaidPostInvoice(ru *RemoteUser, invoice rpc.RMInvoice) error {
+	pid := *invoice.PostID
+
+	// Decode invoice to determine if it's valid.
+	var decoded clientintf.DecodedInvoice
+	var decodedErr error
+	if invoice.Error == nil {
+		decoded, decodedErr = c.pc.DecodeInvoice(c.ctx, invoice.Invoice)
+	}
+
+	var pp clientdb.PostPurchase
+	var invoiceErr error
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		pp, err = c.db.ReadPostPurchase(tx, ru.ID(), pid)
+		if err != nil {
+			return err
+		}
+		if pp.Paid != nil {
+			return errors.New("post already paid")
+		}
+		if pp.Invoice != "" {
+			return errors.New("payment attempt already in-flight")
+		}
+
+		// Determine if invoice is good to attempt payment.
+		if invoice.Error != nil {
+			invoiceErr = errors.New(*invoice.Error)
+		}
+		if invoiceErr == nil && decodedErr != nil {
+			invoiceErr = decodedErr
+		}
+		if invoiceErr == nil && decoded.MAtoms != int64(pp.MilliAtoms) {
+			invoiceErr = fmt.Errorf("milliatoms requested in invoice (%d) "+
+				"different than post price (%d)", decoded.MAtoms,
+				pp.MilliAtoms)
+		}
+		if invoiceErr == nil && decoded.IsExpired(time.Second) {
+			invoiceErr = fmt.Errorf("invoice received is already expired")
+		}
+
+		if invoiceErr == nil {
+			pp.Invoice = invoice.Invoice
+			pp.Error = nil
+		} else {
+			errMsg := invoiceErr.Error()
+			pp.Error = &errMsg
+		}
+		return c.db.StorePostPurchase(tx, pp)
+	})
+	if err != nil {
+		ru.log.Warnf("Not paying received invoice for post %s: %v", pid, err)
+		return nil
+	}
+
+	if invoiceErr != nil {
+		ru.log.Warnf("Unable to pay for post %s: %v", pid, invoiceErr)
+		c.ntfns.notifyPaidPostPurchaseFailed(ru, pid, invoiceErr)
+		return nil
+	}
+
+	go c.payPaidPostInvoice(ru, pp)
+	return nil
+}
+
+// payPaidPostInvoice pays the invoice to unlock a paid post.
+func (c *Client) payPaidPostInvoice(ru *RemoteUser, pp clientdb.PostPurchase) {
+	ru.log.Infof("Paying %.8f DCR to unlock post %s",
+		float64(pp.MilliAtoms)/1e11, pp.PostID)
+	fees, payErr := c.pc.PayInvoice(c.ctx, pp.Invoice)
+	if errors.Is(payErr, context.Canceled) {
+		return
+	}
+
+	var invHash string
+	if payErr == nil {
+		invHash = c.invoiceHash(pp.Invoice)
+	}
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		if payErr != nil {
+			// Allow the purchase to be attempted again.
+			errMsg := payErr.Error()
+			pp.Error = &errMsg
+			pp.Invoice = ""
+			return c.db.StorePostPurchase(tx, pp)
+		}
+
+		// Amount is negative because we're paying an invoice.
+		amount := -int64(pp.MilliAtoms)
+		err := c.recordPayEvent(tx, ru.ID(), "paypost",
+			clientintf.PaymentCategoryOther, invHash, amount, -fees)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		pp.Paid = &now
+		return c.db.StorePostPurchase(tx, pp)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store payment result for post %s: %v",
+			pp.PostID, err)
+	}
+
+	if payErr != nil {
+		ru.log.Warnf("Unable to pay for post %s: %v", pp.PostID, payErr)
+		c.ntfns.notifyPaidPostPurchaseFailed(ru, pp.PostID, payErr)
+	}
+}
+
+// handlePaidPostContent handles the full content of a paid post, sent by its
+// author after the payment settled.
+func (c *Client) handlePaidPostContent(ru *RemoteUser, ppc rpc.RMPaidPostContent) error {
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		pp, err := c.db.ReadPostPurchase(tx, ru.ID(), ppc.ID)
+		if err != nil {
+			return err
+		}
+		err = c.db.StorePaidPostContent(tx, ru.ID(), ppc.ID, ppc.Content)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		pp.Unlocked = &now
+		return c.db.StorePostPurchase(tx, pp)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store content of paid post %s: %w",
+			ppc.ID, err)
+	}
+
+	ru.log.Infof("Unlocked paid post %s", ppc.ID)
+	c.ntfns.notifyPaidPostUnlocked(ru, ppc.ID)
+	return nil
+}
+
+// PaidPostContent returns the full content of a paid post. It returns an
+// error wrapping clientdb.ErrNotFound if the post has not been unlocked.
+func (c *Client) PaidPostContent(author UserID, pid clientintf.PostID) (string, error) {
+	var content string
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		content, err = c.db.ReadPaidPostContent(tx, author, pid)
+		return err
+	})
+	return content, err
+}
+
+// PostPurchase returns the state of the attempt to unlock the given paid post.
+func (c *Client) PostPurchase(author UserID, pid clientintf.PostID) (clientdb.PostPurchase, error) {
+	var pp clientdb.PostPurchase
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		pp, err = c.db.ReadPostPurchase(tx, author, pid)
+		return err
+	})
+	return pp, err
+}
+
+// ListPostSales lists the sales attempts of the given local paid post.
+func (c *Client) ListPostSales(pid clientintf.PostID) ([]clientdb.PostSale, error) {
+	var res []clientdb.PostSale
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPostSales(tx, pid)
+		return err
+	})
+	return res, err
+}
+
+// PostSalesStats returns a summary of the sales of the given local paid post.
+func (c *Client) PostSalesStats(pid clientintf.PostID) (clientdb.PostSalesStats, error) {
+	var res clientdb.PostSalesStats
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.PostSalesStats(tx, pid)
+		return err
+	})
+	return res, err
+}
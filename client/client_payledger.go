@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/decred/slog"
+)
+
+// payEventRecorder records a payment event related to a user.
+type payEventRecorder func(tx clientdb.ReadWriteTx, uid UserID, event string,
+	cat clientintf.PaymentCategory, invoiceHash string, amount, fees int64) error
+
+// recordPayStatsOnly returns a payEventRecorder that only records the event in
+// the per-user payment stats. This is used until the remote user or kx list is
+// attached to a client.
+func recordPayStatsOnly(db *clientdb.DB) payEventRecorder {
+	return func(tx clientdb.ReadWriteTx, uid UserID, event string,
+		_ clientintf.PaymentCategory, _ string, amount, fees int64) error {
+		return db.RecordUserPayEvent(tx, uid, event, amount, fees)
+	}
+}
+
+// invoiceHash returns the hex-encoded payment hash of the invoice, or an empty
+// string if the invoice is empty or could not be decoded.
+//
+// Decoding may require a call to the payment client, therefore this must be
+// called before opening the db transaction where the payment is recorded.
+func invoiceHash(ctx context.Context, pc clientintf.PaymentClient,
+	log slog.Logger, invoice string) string {
+
+	if invoice == "" || pc == nil {
+		return ""
+	}
+	decoded, err := pc.DecodeInvoice(ctx, invoice)
+	if err != nil {
+		log.Warnf("Unable to decode invoice of payment event: %v", err)
+		return ""
+	}
+	return hex.EncodeToString(decoded.ID)
+}
+
+// invoiceHash returns the hex-encoded payment hash of the invoice, to be
+// recorded in the payment ledger. It must be called outside db transactions.
+func (c *Client) invoiceHash(invoice string) string {
+	return invoiceHash(c.ctx, c.cfg.PayClient, c.log, invoice)
+}
+
+// recordPayEvent records a payment event in the per-user payment stats and
+// appends it as an entry of the payment ledger.
+//
+// If amount is < 0, then a payment was made related to this user. If amount is
+// > 0, then a payment was received from this user. The invoice hash is optional
+// and must be obtained (see invoiceHash) before opening the transaction.
+func (c *Client) recordPayEvent(tx clientdb.ReadWriteTx, uid UserID, event string,
+	cat clientintf.PaymentCategory, invoiceHash string, amount, fees int64) error {
+
+	if err := c.db.RecordUserPayEvent(tx, uid, event, amount, fees); err != nil {
+		return err
+	}
+
+	entry := clientdb.LedgerEntry{
+		Timestamp:   time.Now(),
+		UID:         uid,
+		Event:       event,
+		Category:    cat,
+		Amount:      amount,
+		PayFee:      fees,
+		InvoiceHash: invoiceHash,
+	}
+	entry.DCRUSDRate, _ = c.rates.Get()
+	if err := c.db.AppendLedgerEntry(tx, entry); err != nil {
+		return err
+	}
+	c.ntfns.notifyLedgerEntry(entry)
+	return nil
+}
+
+// ListLedgerEntries lists the entries of the payment ledger recorded in the
+// interval [start, end). A zero start or end time means the interval is
+// unbounded on that side.
+func (c *Client) ListLedgerEntries(start, end time.Time) ([]clientdb.LedgerEntry, error) {
+	var res []clientdb.LedgerEntry
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListLedgerEntries(tx, start, end)
+		return err
+	})
+	return res, err
+}
+
+// LedgerExportFormat is the format in which the payment ledger is exported.
+type LedgerExportFormat string
+
+const (
+	LedgerExportCSV  LedgerExportFormat = "csv"
+	LedgerExportJSON LedgerExportFormat = "json"
+)
+
+// csvEscapeText escapes a text cell of an exported CSV file, so that it is not
+// interpreted as a formula by spreadsheet applications.
+func csvEscapeText(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + s
+	}
+	return s
+}
+
+// ExportLedger writes the entries of the payment ledger recorded in the
+// interval [start, end) to w, in the given format.
+func (c *Client) ExportLedger(w io.Writer, format LedgerExportFormat, start, end time.Time) error {
+	entries, err := c.ListLedgerEntries(start, end)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case LedgerExportJSON:
+		if entries == nil {
+			entries = []clientdb.LedgerEntry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+
+	case LedgerExportCSV:
+		cw := csv.NewWriter(w)
+		err := cw.Write([]string{"timestamp", "uid", "nick", "event",
+			"category", "amount_dcr", "fee_dcr", "invoice_hash",
+			"dcr_usd_rate", "amount_usd"})
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			nick, _ := c.UserNick(e.UID)
+			dcrAmount := float64(e.Amount) / 1e11
+			var usdAmount string
+			if e.DCRUSDRate > 0 {
+				usdAmount = strconv.FormatFloat(dcrAmount*e.DCRUSDRate, 'f', 2, 64)
+			}
+			err := cw.Write([]string{
+				e.Timestamp.UTC().Format(time.RFC3339),
+				csvEscapeText(e.UID.String()),
+				csvEscapeText(nick),
+				csvEscapeText(e.Event),
+				csvEscapeText(string(e.Category)),
+				strconv.FormatFloat(dcrAmount, 'f', 11, 64),
+				strconv.FormatFloat(float64(e.PayFee)/1e11, 'f', 11, 64),
+				csvEscapeText(e.InvoiceHash),
+				strconv.FormatFloat(e.DCRUSDRate, 'f', -1, 64),
+				usdAmount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+
+	default:
+		return fmt.Errorf("unknown ledger export format %q", format)
+	}
+}
+
+// LedgerPeriod is the length of the periods in which the payment ledger is
+// summarized.
+type LedgerPeriod string
+
+const (
+	LedgerPeriodDay   LedgerPeriod = "day"
+	LedgerPeriodWeek  LedgerPeriod = "week"
+	LedgerPeriodMonth LedgerPeriod = "month"
+)
+
+// periodStart returns the start of the period that contains t, in the time
+// zone of t. Weeks start on Mondays.
+func (p LedgerPeriod) periodStart(t time.Time) (time.Time, error) {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	switch p {
+	case LedgerPeriodDay:
+		return day, nil
+	case LedgerPeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset), nil
+	case LedgerPeriodMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location()), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown ledger period %q", p)
+	}
+}
+
+// next returns the start of the period after the one that starts at start.
+func (p LedgerPeriod) next(start time.Time) time.Time {
+	switch p {
+	case LedgerPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case LedgerPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// LedgerPeriodSummary is the summary of the payment ledger entries of a
+// period. All amounts are in milliatoms.
+type LedgerPeriodSummary struct {
+	Start   time.Time
+	End     time.Time
+	Entries int
+
+	// Received and Sent are the total amounts received and sent (both
+	// positive). Fees is the total amount paid in fees (positive).
+	Received int64
+	Sent     int64
+	Fees     int64
+
+	// ReceivedUSD and SentUSD are the received and sent amounts converted
+	// to USD at the exchange rate of each payment. Payments with an
+	// unknown exchange rate are not included.
+	ReceivedUSD float64
+	SentUSD     float64
+
+	// Categories is the net amount (received minus sent) of each payment
+	// category.
+	Categories map[clientintf.PaymentCategory]int64
+}
+
+// SummarizeLedger summarizes the entries of the payment ledger recorded in the
+// interval [start, end), grouped by period. Only periods with entries are
+// returned, oldest first.
+func (c *Client) SummarizeLedger(start, end time.Time, period LedgerPeriod) ([]LedgerPeriodSummary, error) {
+	if _, err := period.periodStart(time.Now()); err != nil {
+		return nil, err
+	}
+	entries, err := c.ListLedgerEntries(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[time.Time]*LedgerPeriodSummary)
+	for _, e := range entries {
+		ps, _ := period.periodStart(e.Timestamp.Local())
+		s, ok := summaries[ps]
+		if !ok {
+			s = &LedgerPeriodSummary{
+				Start:      ps,
+				End:        period.next(ps),
+				Categories: make(map[clientintf.PaymentCategory]int64),
+			}
+			summaries[ps] = s
+		}
+
+		s.Entries += 1
+		usd := float64(e.Amount) / 1e11 * e.DCRUSDRate
+		if e.Amount < 0 {
+			s.Sent += -e.Amount
+			s.SentUSD += -usd
+		} else {
+			s.Received += e.Amount
+			s.ReceivedUSD += usd
+		}
+		s.Fees += -e.PayFee
+		s.Categories[e.Category] += e.Amount
+	}
+
+	res := make([]LedgerPeriodSummary, 0, len(summaries))
+	for _, s := range summaries {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestCSVEscapeText tests that text cells of the exported ledger are escaped
+// so that they are not interpreted as formulas.
+func TestCSVEscapeText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"tip", "tip"},
+		{"a=b", "a=b"},
+		{"=HYPERLINK(\"x\")", "'=HYPERLINK(\"x\")"},
+		{"+1", "'+1"},
+		{"-1", "'-1"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\tx", "'\tx"},
+	}
+	for _, tc := range tests {
+		assert.DeepEqual(t, csvEscapeText(tc.in), tc.want)
+	}
+}
+
+// TestListLedgerEntriesCorrupted tests that listing a corrupted payment ledger
+// fails instead of silently returning only part of the entries.
+func TestListLedgerEntriesCorrupted(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	ctx := context.Background()
+
+	entry := clientdb.LedgerEntry{
+		Timestamp: time.Now(),
+		Event:     "tip",
+		Category:  clientintf.PaymentCategoryTips,
+		Amount:    1000,
+	}
+	err := db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+		return db.AppendLedgerEntry(tx, entry)
+	})
+	assert.NilErr(t, err)
+	listEntries := func() ([]clientdb.LedgerEntry, error) {
+		var res []clientdb.LedgerEntry
+		err := db.View(ctx, func(tx clientdb.ReadTx) error {
+			var err error
+			res, err = db.ListLedgerEntries(tx, time.Time{}, time.Time{})
+			return err
+		})
+		return res, err
+	}
+	entries, err := listEntries()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(entries), 1)
+
+	// Append a corrupted entry.
+	fname := filepath.Join(db.DBRoot(), "payledger.json")
+	f, err := os.OpenFile(fname, os.O_APPEND|os.O_WRONLY, 0o600)
+	assert.NilErr(t, err)
+	_, err = f.WriteString("{corrupted\n")
+	assert.NilErr(t, err)
+	assert.NilErr(t, f.Close())
+	_, err = listEntries()
+	assert.NonNilErr(t, err)
+}
@@ -0,0 +1,475 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// Client payment request flow is:
+//
+//          Alice                                    Bob
+//         -------                                  -----
+//
+//   RequestPayment()
+//       \-------- RMPaymentRequest -->
+//
+//                                           handlePaymentRequest()
+//                                           AcceptPaymentRequest()
+//                                             (out-of-band payment)
+//
+//   trackPaymentRequestInvoice()
+//     (invoice settled)
+//
+// If Bob declines the request, an RMPaymentRequestReply is sent back.
+
+const (
+	// maxPaymentRequestMemoLen is the max length of the memo of payment
+	// requests.
+	maxPaymentRequestMemoLen = 1024
+
+	// DefaultPaymentRequestExpiry is the expiration used for payment
+	// requests when none is specified.
+	DefaultPaymentRequestExpiry = 24 * time.Hour
+)
+
+// RequestPayment generates an invoice for the given amount and sends it to
+// the remote user as a request to be paid. The request expires after the
+// given duration or when the invoice expires, whichever happens first.
+func (c *Client) RequestPayment(uid UserID, milliAtoms uint64, memo string,
+	expiry time.Duration) (clientdb.PaymentRequest, error) {
+
+	var pr clientdb.PaymentRequest
+	if milliAtoms == 0 {
+		return pr, errors.New("amount of payment request cannot be zero")
+	}
+	if len(memo) > maxPaymentRequestMemoLen {
+		return pr, fmt.Errorf("memo length %d is greater than max %d",
+			len(memo), maxPaymentRequestMemoLen)
+	}
+	if expiry <= 0 {
+		expiry = DefaultPaymentRequestExpiry
+	}
+
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return pr, err
+	}
+
+	inv, err := c.pc.GetInvoice(c.ctx, int64(milliAtoms), nil)
+	if err != nil {
+		return pr, fmt.Errorf("unable to generate invoice: %w", err)
+	}
+	decoded, err := c.pc.DecodeInvoice(c.ctx, inv)
+	if err != nil {
+		return pr, fmt.Errorf("unable to decode generated invoice: %w", err)
+	}
+
+	now := time.Now()
+	expires := now.Add(expiry)
+	if decoded.ExpiryTime.Before(expires) {
+		expires = decoded.ExpiryTime
+	}
+	pr = clientdb.PaymentRequest{
+		ID:         zkidentity.RandomShortID(),
+		UID:        uid,
+		Sent:       true,
+		Invoice:    inv,
+		MilliAtoms: milliAtoms,
+		Memo:       memo,
+		Created:    now,
+		Expires:    expires,
+		Status:     clientdb.PaymentRequestPending,
+		Updated:    now,
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StorePaymentRequest(tx, pr)
+	})
+	if err != nil {
+		return pr, err
+	}
+
+	ru.log.Infof("Requesting payment of %.8f DCR (request %s)",
+		float64(milliAtoms)/1e11, pr.ID)
+	go c.trackPaymentRequestInvoice(c.ctx, pr)
+
+	rm := rpc.RMPaymentRequest{
+		ID:         pr.ID,
+		Invoice:    inv,
+		MilliAtoms: milliAtoms,
+		Memo:       memo,
+		Expiry:     expires.Unix(),
+	}
+	return pr, c.sendWithSendQ("paymentrequest", rm, uid)
+}
+
+// updatePaymentRequestStatus updates the status of a payment request if it is
+// still pending. It returns the updated request.
+func (c *Client) updatePaymentRequestStatus(uid UserID, id zkidentity.ShortID,
+	status clientdb.PaymentRequestStatus, errMsg string) (clientdb.PaymentRequest, error) {
+
+	var pr clientdb.PaymentRequest
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		pr, err = c.db.ReadPaymentRequest(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		if pr.Status != clientdb.PaymentRequestPending {
+			return fmt.Errorf("payment request %s is %s: %w", id,
+				pr.Status, ErrPaymentRequestNotPending)
+		}
+		pr.Status = status
+		pr.Error = errMsg
+		pr.Updated = time.Now()
+		return c.db.StorePaymentRequest(tx, pr)
+	})
+	return pr, err
+}
+
+// trackPaymentRequestInvoice tracks the invoice of a payment request sent by
+// the local client. This blocks until the invoice is paid or expires.
+func (c *Client) trackPaymentRequestInvoice(ctx context.Context, pr clientdb.PaymentRequest) {
+	var err error
+	defer func() {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.log.Errorf("Unable to track invoice of payment request %s: %v",
+				pr.ID, err)
+		}
+	}()
+
+	receivedMAtoms, err := c.pc.TrackInvoice(ctx, pr.Invoice, int64(pr.MilliAtoms))
+	if errors.Is(err, clientintf.ErrInvoiceExpired) {
+		pr, err = c.updatePaymentRequestStatus(pr.UID, pr.ID,
+			clientdb.PaymentRequestExpired, "")
+		if errors.Is(err, ErrPaymentRequestNotPending) {
+			// Request was declined.
+			err = nil
+		} else if err == nil {
+			c.notifyPaymentRequestUpdated(pr)
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	invHash := c.invoiceHash(pr.Invoice)
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		pr, err = c.db.ReadPaymentRequest(tx, pr.UID, pr.ID)
+		if err != nil {
+			return err
+		}
+
+		// The invoice may be paid even after the request was declined
+		// or deemed expired locally.
+		pr.Status = clientdb.PaymentRequestPaid
+		pr.Error = ""
+		pr.Updated = time.Now()
+		if err := c.db.StorePaymentRequest(tx, pr); err != nil {
+			return err
+		}
+		return c.recordPayEvent(tx, pr.UID, "payrequest",
+			clientintf.PaymentCategoryOther, invHash, receivedMAtoms, 0)
+	})
+	if err != nil {
+		return
+	}
+
+	c.log.Infof("Received %.8f DCR for payment request %s",
+		float64(receivedMAtoms)/1e11, pr.ID)
+	c.notifyPaymentRequestUpdated(pr)
+}
+
+// restartPaymentRequests restarts tracking of invoices of payment requests
+// sent by the local client and of in-flight payments of received requests.
+func (c *Client) restartPaymentRequests(ctx context.Context) error {
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	var prs []clientdb.PaymentRequest
+	err := c.db.View(ctx, func(tx clientdb.ReadTx) error {
+		var err error
+		prs, err = c.db.ListPaymentRequests(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pr := range prs {
+		switch {
+		case pr.Sent && pr.Status == clientdb.PaymentRequestPending:
+			go c.trackPaymentRequestInvoice(ctx, pr)
+
+		case !pr.Sent && pr.Status == clientdb.PaymentRequestPaying:
+			ru, err := c.rul.byID(pr.UID)
+			if err != nil {
+				c.log.Warnf("Unable to find user of payment request "+
+					"%s: %v", pr.ID, err)
+				continue
+			}
+			go func() {
+				fees, payErr := c.pc.IsPaymentCompleted(ctx, pr.Invoice)
+				if errors.Is(payErr, context.Canceled) {
+					return
+				}
+				c.storePaymentRequestPayResult(ru, pr, fees, payErr)
+			}()
+		}
+	}
+	return nil
+}
+
+// notifyPaymentRequestUpdated notifies the UI about a change in the status
+// of the given payment request.
+func (c *Client) notifyPaymentRequestUpdated(pr clientdb.PaymentRequest) {
+	ru, err := c.rul.byID(pr.UID)
+	if err != nil {
+		c.log.Warnf("Unable to find user of payment request %s: %v",
+			pr.ID, err)
+		return
+	}
+	c.ntfns.notifyPaymentRequestUpdated(ru, pr)
+}
+
+// declinePaymentRequest replies to the sender of a payment request that it
+// was declined.
+func (c *Client) declinePaymentRequest(uid UserID, id zkidentity.ShortID, reason string) error {
+	rm := rpc.RMPaymentRequestReply{
+		ID:       id,
+		Declined: true,
+		Reason:   reason,
+	}
+	return c.sendWithSendQ("paymentrequestreply", rm, uid)
+}
+
+// handlePaymentRequest handles a request from a remote user to pay an
+// invoice.
+func (c *Client) handlePaymentRequest(ru *RemoteUser, rm rpc.RMPaymentRequest) error {
+	decoded, err := c.pc.DecodeInvoice(c.ctx, rm.Invoice)
+	if err == nil && decoded.MAtoms != int64(rm.MilliAtoms) {
+		err = fmt.Errorf("milliatoms requested in invoice (%d) different "+
+			"than milliatoms of the payment request (%d)", decoded.MAtoms,
+			rm.MilliAtoms)
+	}
+	if err == nil && decoded.IsExpired(time.Second) {
+		err = errors.New("invoice is already expired")
+	}
+	if err == nil && rm.MilliAtoms == 0 {
+		err = errors.New("amount of payment request is zero")
+	}
+	if err == nil && len(rm.Memo) > maxPaymentRequestMemoLen {
+		err = fmt.Errorf("memo length %d is greater than max %d",
+			len(rm.Memo), maxPaymentRequestMemoLen)
+	}
+	if err != nil {
+		ru.log.Warnf("Declining invalid payment request %s: %v", rm.ID, err)
+		return c.declinePaymentRequest(ru.ID(), rm.ID, err.Error())
+	}
+
+	now := time.Now()
+	pr := clientdb.PaymentRequest{
+		ID:         rm.ID,
+		UID:        ru.ID(),
+		Invoice:    rm.Invoice,
+		MilliAtoms: rm.MilliAtoms,
+		Memo:       rm.Memo,
+		Created:    now,
+		Expires:    time.Unix(rm.Expiry, 0),
+		Status:     clientdb.PaymentRequestPending,
+		Updated:    now,
+	}
+	if decoded.ExpiryTime.Before(pr.Expires) {
+		pr.Expires = decoded.ExpiryTime
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.ReadPaymentRequest(tx, pr.UID, pr.ID)
+		if err == nil {
+			return fmt.Errorf("payment request %s already received", pr.ID)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+		return c.db.StorePaymentRequest(tx, pr)
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Received request to pay %.8f DCR (request %s)",
+		float64(pr.MilliAtoms)/1e11, pr.ID)
+	c.ntfns.notifyPaymentRequestReceived(ru, pr)
+	return nil
+}
+
+// handlePaymentRequestReply handles the reply of the target of a payment
+// request sent by the local client.
+func (c *Client) handlePaymentRequestReply(ru *RemoteUser, rm rpc.RMPaymentRequestReply) error {
+	if !rm.Declined {
+		return nil
+	}
+
+	pr, err := c.PaymentRequest(ru.ID(), rm.ID)
+	if err != nil {
+		return err
+	}
+	if !pr.Sent {
+		return fmt.Errorf("payment request %s was not sent by the local "+
+			"client", rm.ID)
+	}
+	pr, err = c.updatePaymentRequestStatus(ru.ID(), rm.ID,
+		clientdb.PaymentRequestDeclined, rm.Reason)
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Payment request %s declined", rm.ID)
+	c.ntfns.notifyPaymentRequestUpdated(ru, pr)
+	return nil
+}
+
+// AcceptPaymentRequest pays the invoice of a payment request received from
+// the given user. This blocks until the payment completes.
+func (c *Client) AcceptPaymentRequest(uid UserID, id zkidentity.ShortID) (clientdb.PaymentRequest, error) {
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return clientdb.PaymentRequest{}, err
+	}
+
+	var pr clientdb.PaymentRequest
+	var expired bool
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		pr, err = c.db.ReadPaymentRequest(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		if pr.Sent {
+			return errors.New("cannot pay payment request sent by the local client")
+		}
+		if pr.Status != clientdb.PaymentRequestPending {
+			return fmt.Errorf("payment request %s is %s: %w", id,
+				pr.Status, ErrPaymentRequestNotPending)
+		}
+		pr.Updated = time.Now()
+		if pr.IsExpired(pr.Updated) {
+			expired = true
+			pr.Status = clientdb.PaymentRequestExpired
+		} else {
+			pr.Status = clientdb.PaymentRequestPaying
+			pr.Error = ""
+		}
+		return c.db.StorePaymentRequest(tx, pr)
+	})
+	if err != nil {
+		return pr, err
+	}
+	if expired {
+		c.ntfns.notifyPaymentRequestUpdated(ru, pr)
+		return pr, ErrPaymentRequestExpired
+	}
+
+	ru.log.Infof("Paying %.8f DCR for payment request %s",
+		float64(pr.MilliAtoms)/1e11, pr.ID)
+	fees, payErr := c.pc.PayInvoice(c.ctx, pr.Invoice)
+	if errors.Is(payErr, context.Canceled) {
+		return pr, payErr
+	}
+	pr = c.storePaymentRequestPayResult(ru, pr, fees, payErr)
+	return pr, payErr
+}
+
+// storePaymentRequestPayResult stores the result of an attempt to pay the
+// invoice of a received payment request.
+func (c *Client) storePaymentRequestPayResult(ru *RemoteUser, pr clientdb.PaymentRequest,
+	fees int64, payErr error) clientdb.PaymentRequest {
+
+	var invHash string
+	if payErr == nil {
+		invHash = c.invoiceHash(pr.Invoice)
+	}
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		pr.Updated = time.Now()
+		if payErr != nil {
+			// Allow the payment to be attempted again.
+			pr.Status = clientdb.PaymentRequestPending
+			pr.Error = payErr.Error()
+			return c.db.StorePaymentRequest(tx, pr)
+		}
+
+		pr.Status = clientdb.PaymentRequestPaid
+		pr.Error = ""
+		if err := c.db.StorePaymentRequest(tx, pr); err != nil {
+			return err
+		}
+
+		// Amount is negative because we're paying an invoice.
+		amount := -int64(pr.MilliAtoms)
+		return c.recordPayEvent(tx, pr.UID, "payrequest",
+			clientintf.PaymentCategoryOther, invHash, amount, -fees)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to store payment result of payment request "+
+			"%s: %v", pr.ID, err)
+	}
+
+	if payErr != nil {
+		ru.log.Warnf("Unable to pay payment request %s: %v", pr.ID, payErr)
+	} else {
+		ru.log.Infof("Paid payment request %s", pr.ID)
+	}
+	c.ntfns.notifyPaymentRequestUpdated(ru, pr)
+	return pr
+}
+
+// DeclinePaymentRequest declines to pay a payment request received from the
+// given user. The reason is sent to the user.
+func (c *Client) DeclinePaymentRequest(uid UserID, id zkidentity.ShortID, reason string) error {
+	pr, err := c.PaymentRequest(uid, id)
+	if err != nil {
+		return err
+	}
+	if pr.Sent {
+		return errors.New("cannot decline payment request sent by the local client")
+	}
+	if _, err := c.updatePaymentRequestStatus(uid, id,
+		clientdb.PaymentRequestDeclined, reason); err != nil {
+		return err
+	}
+	return c.declinePaymentRequest(uid, id, reason)
+}
+
+// PaymentRequest returns the payment request with the given ID, sent to or
+// received from the given user.
+func (c *Client) PaymentRequest(uid UserID, id zkidentity.ShortID) (clientdb.PaymentRequest, error) {
+	var pr clientdb.PaymentRequest
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		pr, err = c.db.ReadPaymentRequest(tx, uid, id)
+		return err
+	})
+	return pr, err
+}
+
+// ListPaymentRequests lists the payment requests sent to and received from
+// remote users, newest first.
+func (c *Client) ListPaymentRequests() ([]clientdb.PaymentRequest, error) {
+	var res []clientdb.PaymentRequest
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPaymentRequests(tx)
+		return err
+	})
+	return res, err
+}
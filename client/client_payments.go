@@ -9,6 +9,7 @@ import (
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/slog"
 )
@@ -43,6 +44,22 @@ func (c *Client) TipUser(uid UserID, dcrAmount float64, maxAttempts int32) error
 		return fmt.Errorf("maxAttempts %d <= 0", maxAttempts)
 	}
 
+	amt, err := dcrutil.NewAmount(dcrAmount)
+	if err != nil {
+		return err
+	}
+	milliAmt := uint64(amt) * 1e3
+
+	return c.startTipAttempt(uid, milliAmt, maxAttempts, nil, nil)
+}
+
+// startTipAttempt stores a new TipUser attempt and sends it to the main tip
+// attempts goroutine for scheduling. recurringID is set when the tip is the
+// payment of one period of a recurring payment agreement. gcTipID is set when
+// the tip is the payout to one member of a group chat tip.
+func (c *Client) startTipAttempt(uid UserID, milliAmt uint64, maxAttempts int32,
+	recurringID, gcTipID *zkidentity.ShortID) error {
+
 	// Wait until the main tip processing goroutine has performed its
 	// startup.
 	select {
@@ -56,23 +73,19 @@ func (c *Client) TipUser(uid UserID, dcrAmount float64, maxAttempts int32) error
 		return err
 	}
 
-	amt, err := dcrutil.NewAmount(dcrAmount)
-	if err != nil {
-		return err
-	}
-	milliAmt := uint64(amt) * 1e3
-
 	var tag int32
 	var ta clientdb.TipUserAttempt
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		tag = c.db.UnusedTipUserTag(tx, uid)
 		ta = clientdb.TipUserAttempt{
-			UID:         uid,
-			Tag:         tag,
-			MilliAtoms:  milliAmt,
-			Created:     time.Now(),
-			Attempts:    0,
-			MaxAttempts: maxAttempts,
+			UID:                uid,
+			Tag:                tag,
+			MilliAtoms:         milliAmt,
+			Created:            time.Now(),
+			Attempts:           0,
+			MaxAttempts:        maxAttempts,
+			RecurringPaymentID: recurringID,
+			GCTipID:            gcTipID,
 		}
 		return c.db.StoreTipUserAttempt(tx, ta)
 	})
@@ -84,8 +97,8 @@ func (c *Client) TipUser(uid UserID, dcrAmount float64, maxAttempts int32) error
 		ru.log.Infof("Starting tip attempt of %d MAtoms (tag %d)", milliAmt,
 			tag)
 	} else {
-		ru.log.Infof("Starting tip attempt of %.8f DCR (tag %d)", dcrAmount,
-			tag)
+		ru.log.Infof("Starting tip attempt of %.8f DCR (tag %d)",
+			float64(milliAmt)/1e11, tag)
 	}
 
 	// Send for the main tip attempts run() goroutine for scheduling.
@@ -99,8 +112,11 @@ func (c *Client) TipUser(uid UserID, dcrAmount float64, maxAttempts int32) error
 
 // trackGeneratedTipInvoice tracks an invoice generated by the local client for
 // a remote tip payment. This blocks until the invoice is paid or expires.
+//
+// recurringID is set when the invoice was generated for the payment of one
+// period of a recurring payment agreement.
 func (c *Client) trackGeneratedTipInvoice(ctx context.Context, uid clientintf.UserID, invoice string,
-	wantMAtoms int64) {
+	wantMAtoms int64, recurringID *zkidentity.ShortID) {
 
 	var err error
 	defer func() {
@@ -129,8 +145,11 @@ func (c *Client) trackGeneratedTipInvoice(ctx context.Context, uid clientintf.Us
 
 	dcrAmt := float64(receivedMAtoms) / 1e11
 	ru.log.Infof("Received %f DCR as tip", dcrAmt)
+	invHash := c.invoiceHash(invoice)
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-		if err := c.db.RecordUserPayEvent(tx, uid, "tip", receivedMAtoms, 0); err != nil {
+		err := c.recordPayEvent(tx, uid, "tip",
+			clientintf.PaymentCategoryTips, invHash, receivedMAtoms, 0)
+		if err != nil {
 			return err
 		}
 		return c.db.MarkGeneratedTipInvoiceReceived(tx, uid, invoice, receivedMAtoms)
@@ -140,9 +159,16 @@ func (c *Client) trackGeneratedTipInvoice(ctx context.Context, uid clientintf.Us
 	}
 
 	c.ntfns.notifyTipReceived(ru, receivedMAtoms)
+
+	if recurringID != nil {
+		err = c.creditRecurringPayment(ru, *recurringID, receivedMAtoms)
+	}
 }
 
 func (c *Client) handleGetInvoice(ru *RemoteUser, getInvoice rpc.RMGetInvoice) error {
+	if getInvoice.PostID != nil {
+		return c.handleGetPaidPostInvoice(ru, getInvoice)
+	}
 
 	// Helper to reply with an error.
 	replyWithErr := func(err error) {
@@ -165,6 +191,14 @@ func (c *Client) handleGetInvoice(ru *RemoteUser, getInvoice rpc.RMGetInvoice) e
 		return err
 	}
 
+	if getInvoice.RecurringPaymentID != nil {
+		err := c.checkRecurringPaymentInvoiceRequest(ru, getInvoice)
+		if err != nil {
+			replyWithErr(err)
+			return err
+		}
+	}
+
 	amountMAtoms := int64(getInvoice.MilliAtoms)
 	dcrAmount := float64(amountMAtoms) / 1e11
 	inv, err := c.pc.GetInvoice(c.ctx, amountMAtoms, nil)
@@ -190,13 +224,15 @@ func (c *Client) handleGetInvoice(ru *RemoteUser, getInvoice rpc.RMGetInvoice) e
 
 	// Persist the generated invoice.
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
-		return c.db.StoreGeneratedTipInvoice(tx, ru.ID(), inv, amountMAtoms)
+		return c.db.StoreGeneratedTipInvoice(tx, ru.ID(), inv, amountMAtoms,
+			getInvoice.RecurringPaymentID)
 	})
 	if err != nil {
 		return err
 	}
 
-	go c.trackGeneratedTipInvoice(c.ctx, ru.ID(), inv, amountMAtoms)
+	go c.trackGeneratedTipInvoice(c.ctx, ru.ID(), inv, amountMAtoms,
+		getInvoice.RecurringPaymentID)
 	c.ntfns.notifyTipUserInvoiceGenerated(ru, getInvoice.Tag, inv)
 
 	// Send reply.
@@ -208,13 +244,19 @@ func (c *Client) handleGetInvoice(ru *RemoteUser, getInvoice rpc.RMGetInvoice) e
 }
 
 // handleTipUserPaymentResult takes the appropriate action after a payment
-// attempt for a TipUser request.
-func (c *Client) handleTipUserPaymentResult(ru *RemoteUser, tag int32, payErr error, fees int64) {
+// attempt of the given invoice for a TipUser request.
+func (c *Client) handleTipUserPaymentResult(ru *RemoteUser, tag int32, invoice string,
+	payErr error, fees int64) {
+
 	if errors.Is(payErr, context.Canceled) {
 		// Cancelation isn't a fatal error.
 		return
 	}
 
+	var invHash string
+	if payErr == nil {
+		invHash = c.invoiceHash(invoice)
+	}
 	var ta clientdb.TipUserAttempt
 	dbErr := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
@@ -234,7 +276,10 @@ func (c *Client) handleTipUserPaymentResult(ru *RemoteUser, tag int32, payErr er
 			payEvent := "paytip"
 			amount := -int64(ta.MilliAtoms)
 			fees := -fees
-			if err := c.db.RecordUserPayEvent(tx, ru.ID(), payEvent, amount, fees); err != nil {
+			err := c.recordPayEvent(tx, ru.ID(), payEvent,
+				clientintf.PaymentCategoryTips, invHash,
+				amount, fees)
+			if err != nil {
 				return err
 			}
 			now := time.Now()
@@ -288,12 +333,16 @@ func (c *Client) handleTipUserPaymentResult(ru *RemoteUser, tag int32, payErr er
 
 // payTipInvoice starts the payment process for a received invoice.
 func (c *Client) payTipInvoice(ru *RemoteUser, invoice string, amtMAtoms int64, tag int32) {
-	fees, payErr := c.pc.PayInvoice(c.ctx, invoice)
-	c.handleTipUserPaymentResult(ru, tag, payErr, fees)
+	ctx := clientintf.WithPaymentCategory(c.ctx, clientintf.PaymentCategoryTips)
+	fees, payErr := c.pc.PayInvoice(ctx, invoice)
+	c.handleTipUserPaymentResult(ru, tag, invoice, payErr, fees)
 }
 
 // handleInvoice handles received RMInvoice calls.
 func (c *Client) handleInvoice(ru *RemoteUser, invoice rpc.RMInvoice) error {
+	if invoice.PostID != nil {
+		return c.handlePaidPostInvoice(ru, invoice)
+	}
 
 	// Decode invoice to determine if it's valid.
 	var decoded clientintf.DecodedInvoice
@@ -452,7 +501,7 @@ func (c *Client) ListRunningTipUserAttempts() ([]RunningTipUserAttempt, error) {
 func (c *Client) restartTipUserPayment(ctx context.Context, ru *RemoteUser, ta clientdb.TipUserAttempt) {
 	// This may block for a _long_ time.
 	fees, payErr := c.pc.IsPaymentCompleted(ctx, ta.LastInvoice)
-	c.handleTipUserPaymentResult(ru, ta.Tag, payErr, fees)
+	c.handleTipUserPaymentResult(ru, ta.Tag, ta.LastInvoice, payErr, fees)
 }
 
 // takeTipAttemptAction executes the TipUser action specified in rta. This is
@@ -586,8 +635,16 @@ func (c *Client) takeTipAttemptAction(ctx context.Context, attempts *tipAttempts
 		ru.log.Infof("Tip attempt (tag %d) failed after %d attempts "+
 			"to request invoice due to %v. Giving up.",
 			ta.Tag, ta.Attempts, err)
+		if c.startOnchainTipFallback(ru, &ta, err) {
+			// The tip will be retried on-chain.
+			c.ntfns.notifyTipAttemptProgress(ru, int64(ta.MilliAtoms), false,
+				int(ta.Attempts), err, true)
+			break
+		}
 		c.ntfns.notifyTipAttemptProgress(ru, int64(ta.MilliAtoms), false,
 			int(ta.Attempts), err, false)
+		c.recurringPaymentAttemptDone(ru, &ta, err)
+		c.gcTipAttemptDone(&ta, err)
 
 	case actionExpire:
 		// Notify tip attempt expired.
@@ -598,6 +655,8 @@ func (c *Client) takeTipAttemptAction(ctx context.Context, attempts *tipAttempts
 			ta.MaxAttempts)
 		c.ntfns.notifyTipAttemptProgress(ru, int64(ta.MilliAtoms), false,
 			int(ta.Attempts), err, false)
+		c.recurringPaymentAttemptDone(ru, &ta, err)
+		c.gcTipAttemptDone(&ta, err)
 
 	case actionComplete:
 		// Notify tip completed successfully.
@@ -605,13 +664,16 @@ func (c *Client) takeTipAttemptAction(ctx context.Context, attempts *tipAttempts
 			ta.Tag, float64(ta.MilliAtoms)/1e11)
 		c.ntfns.notifyTipAttemptProgress(ru, int64(ta.MilliAtoms), true,
 			int(ta.Attempts), nil, false)
+		c.recurringPaymentAttemptDone(ru, &ta, nil)
+		c.gcTipAttemptDone(&ta, nil)
 
 	case actionRequestInvoice:
 		// Request a new invoice from remote user.
 		getInvoice := rpc.RMGetInvoice{
-			PayScheme:  c.pc.PayScheme(),
-			MilliAtoms: ta.MilliAtoms,
-			Tag:        uint32(ta.Tag),
+			PayScheme:          c.pc.PayScheme(),
+			MilliAtoms:         ta.MilliAtoms,
+			Tag:                uint32(ta.Tag),
+			RecurringPaymentID: ta.RecurringPaymentID,
 		}
 
 		ru.log.Debugf("Attempt %d/%d at requesting invoice for tip payment of "+
@@ -817,7 +879,8 @@ func (c *Client) restartTrackGeneratedTipInvoices(ctx context.Context) error {
 
 	// Check ones that are expired.
 	for _, inv := range invoices {
-		go c.trackGeneratedTipInvoice(ctx, inv.UID, inv.Invoice, int64(inv.MilliAtoms))
+		go c.trackGeneratedTipInvoice(ctx, inv.UID, inv.Invoice,
+			int64(inv.MilliAtoms), inv.RecurringPaymentID)
 	}
 
 	return nil
@@ -0,0 +1,281 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// SavePostDraft creates or updates a post draft. If the ID of the draft is
+// empty, a new draft is created. The saved draft is returned.
+//
+// Saving a draft clears the error of any previous attempt to publish it at
+// its scheduled time.
+func (c *Client) SavePostDraft(draft clientdb.PostDraft) (clientdb.PostDraft, error) {
+	if len(draft.Tags) > 0 {
+		if _, err := rpc.NormalizePostTags(draft.Tags); err != nil {
+			return draft, err
+		}
+	}
+
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		if draft.Audience != "" {
+			if _, err := c.db.GetPostAudience(tx, draft.Audience); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		if draft.ID.IsEmpty() {
+			draft.ID = zkidentity.RandomShortID()
+			draft.Created = now
+		} else {
+			old, err := c.db.ReadPostDraft(tx, draft.ID)
+			if err != nil {
+				return err
+			}
+			if old.Publishing {
+				return ErrPostDraftPublishing
+			}
+			draft.Created = old.Created
+		}
+		draft.Updated = now
+		draft.PublishError = ""
+		return c.db.SavePostDraft(tx, &draft)
+	})
+	return draft, err
+}
+
+// SchedulePostDraft schedules the given draft to be published at the given
+// time. If publishAt is zero, the draft is unscheduled.
+func (c *Client) SchedulePostDraft(id zkidentity.ShortID, publishAt time.Time) (clientdb.PostDraft, error) {
+	var draft *clientdb.PostDraft
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		draft, err = c.db.ReadPostDraft(tx, id)
+		if err != nil {
+			return err
+		}
+		if draft.Publishing {
+			return ErrPostDraftPublishing
+		}
+		draft.PublishAt = publishAt
+		draft.PublishError = ""
+		return c.db.SavePostDraft(tx, draft)
+	})
+	if err != nil {
+		return clientdb.PostDraft{}, err
+	}
+	if !publishAt.IsZero() {
+		c.log.Infof("Scheduled post draft %s to be published at %s", id,
+			publishAt.Format(time.RFC3339))
+	}
+	return *draft, nil
+}
+
+// GetPostDraft returns the post draft with the given ID.
+func (c *Client) GetPostDraft(id zkidentity.ShortID) (clientdb.PostDraft, error) {
+	var draft *clientdb.PostDraft
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		draft, err = c.db.ReadPostDraft(tx, id)
+		return err
+	})
+	if err != nil {
+		return clientdb.PostDraft{}, err
+	}
+	return *draft, nil
+}
+
+// ListPostDrafts lists the existing post drafts, most recently updated first.
+func (c *Client) ListPostDrafts() ([]clientdb.PostDraft, error) {
+	var res []clientdb.PostDraft
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPostDrafts(tx)
+		return err
+	})
+	return res, err
+}
+
+// RemovePostDraft removes the post draft with the given ID.
+func (c *Client) RemovePostDraft(id zkidentity.ShortID) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.RemovePostDraft(tx, id)
+	})
+}
+
+// PublishPostDraft creates a post from the given draft and removes the draft.
+//
+// The draft is marked as being published before the post is created, so that
+// it is not published twice by concurrent calls. The mark is cleared if
+// creating the post fails.
+func (c *Client) PublishPostDraft(id zkidentity.ShortID) (clientdb.PostSummary, error) {
+	var draft *clientdb.PostDraft
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		draft, err = c.db.ReadPostDraft(tx, id)
+		if err != nil {
+			return err
+		}
+		if draft.Publishing {
+			return ErrPostDraftPublishing
+		}
+		draft.Publishing = true
+		return c.db.SavePostDraft(tx, draft)
+	})
+	if err != nil {
+		return clientdb.PostSummary{}, err
+	}
+
+	opts := CreatePostOpts{
+		Audience: draft.Audience,
+		Tags:     draft.Tags,
+	}
+	summ, err := c.CreatePostWithOpts(draft.Content, draft.Description, opts)
+	if err != nil {
+		restoreErr := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+			current, err := c.db.ReadPostDraft(tx, id)
+			if err != nil {
+				return err
+			}
+			current.Publishing = false
+			return c.db.SavePostDraft(tx, current)
+		})
+		if restoreErr != nil && !errors.Is(restoreErr, clientdb.ErrNotFound) {
+			c.log.Errorf("Unable to restore post draft %s after "+
+				"failing to publish it: %v", id, restoreErr)
+		}
+		return summ, err
+	}
+
+	// The post was already created, so failing to remove the draft is not
+	// reported as a failure to publish it.
+	if err := c.RemovePostDraft(id); err != nil {
+		c.log.Errorf("Unable to remove published post draft %s: %v", id, err)
+	}
+	c.log.Infof("Published post draft %s as post %s", id, summ.ID)
+	return summ, nil
+}
+
+// publishDuePostDrafts publishes the post drafts whose scheduled time has
+// been reached.
+func (c *Client) publishDuePostDrafts(ctx context.Context) error {
+	var drafts []clientdb.PostDraft
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		drafts, err = c.db.ListDuePostDrafts(tx, time.Now())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, draft := range drafts {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		summ, err := c.PublishPostDraft(draft.ID)
+		if errors.Is(err, ErrPostDraftPublishing) {
+			// Draft is being published concurrently.
+			continue
+		}
+		if errors.Is(err, clientdb.ErrNotFound) {
+			_, getErr := c.GetPostDraft(draft.ID)
+			if errors.Is(getErr, clientdb.ErrNotFound) {
+				// Draft was removed concurrently.
+				continue
+			}
+		}
+		if err != nil {
+			c.log.Errorf("Unable to publish scheduled post draft %s: %v",
+				draft.ID, err)
+
+			// Unschedule the draft, so that it is not retried until
+			// the user reviews it. The draft is reloaded so that
+			// concurrent edits are not overwritten.
+			updateErr := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+				current, readErr := c.db.ReadPostDraft(tx, draft.ID)
+				if readErr != nil {
+					return readErr
+				}
+				current.PublishAt = time.Time{}
+				current.PublishError = err.Error()
+				draft = *current
+				return c.db.SavePostDraft(tx, current)
+			})
+			if errors.Is(updateErr, clientdb.ErrNotFound) {
+				// Draft was removed concurrently.
+				continue
+			}
+			if updateErr != nil {
+				return fmt.Errorf("unable to save post draft %s: %w",
+					draft.ID, updateErr)
+			}
+		}
+		c.ntfns.notifyScheduledPostPublished(draft, summ, err)
+	}
+	return nil
+}
+
+// recoverPublishingPostDrafts clears the publishing mark of the post drafts
+// that were being published when the client was last stopped. These drafts are
+// unscheduled and marked with an error, because it is unknown whether their
+// post was created.
+func (c *Client) recoverPublishingPostDrafts() error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		drafts, err := c.db.ListPostDrafts(tx)
+		if err != nil {
+			return err
+		}
+		for _, draft := range drafts {
+			if !draft.Publishing {
+				continue
+			}
+			c.log.Warnf("Post draft %s was being published when the "+
+				"client stopped", draft.ID)
+			draft.Publishing = false
+			draft.PublishAt = time.Time{}
+			draft.PublishError = "client stopped while publishing " +
+				"the draft (check whether the post was created)"
+			if err := c.db.SavePostDraft(tx, &draft); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// runScheduledPostDrafts periodically publishes the post drafts whose scheduled
+// time has been reached.
+func (c *Client) runScheduledPostDrafts(ctx context.Context) error {
+	if err := c.recoverPublishingPostDrafts(); err != nil {
+		c.log.Errorf("Unable to recover post drafts being published: %v", err)
+	}
+
+	// Wait until the address book is loaded, so that posts are shared with
+	// all subscribers.
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		if err := c.publishDuePostDrafts(ctx); err != nil && ctx.Err() == nil {
+			c.log.Errorf("Unable to publish scheduled post drafts: %v", err)
+		}
+
+		select {
+		case <-time.After(c.cfg.ScheduledPostsInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
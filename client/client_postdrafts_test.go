@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/decred/slog"
+)
+
+// TestPublishingPostDrafts tests that post drafts that are being published
+// cannot be published or changed again and that drafts left in that state by
+// a client stop are recovered.
+func TestPublishingPostDrafts(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	c, err := New(Config{
+		DB:            db,
+		LocalIDIniter: fixedIDIniter(id),
+		Logger:        func(string) slog.Logger { return slog.Disabled },
+	})
+	assert.NilErr(t, err)
+
+	draft, err := c.SavePostDraft(clientdb.PostDraft{Content: "draft"})
+	assert.NilErr(t, err)
+
+	// Simulate the client stopping while publishing the draft.
+	err = db.Update(context.Background(), func(tx clientdb.ReadWriteTx) error {
+		d, err := db.ReadPostDraft(tx, draft.ID)
+		if err != nil {
+			return err
+		}
+		d.Publishing = true
+		return db.SavePostDraft(tx, d)
+	})
+	assert.NilErr(t, err)
+
+	// The draft cannot be published or changed while being published.
+	_, err = c.PublishPostDraft(draft.ID)
+	assert.ErrorIs(t, err, ErrPostDraftPublishing)
+	_, err = c.SavePostDraft(draft)
+	assert.ErrorIs(t, err, ErrPostDraftPublishing)
+
+	// Recovering the draft clears the mark and records an error.
+	assert.NilErr(t, c.recoverPublishingPostDrafts())
+	draft, err = c.GetPostDraft(draft.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, draft.Publishing, false)
+	assert.DeepEqual(t, draft.PublishAt.IsZero(), true)
+	if draft.PublishError == "" {
+		t.Fatalf("draft does not have publish error")
+	}
+}
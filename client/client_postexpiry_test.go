@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// TestRemoveExpiredPosts tests that expired posts are listed through the index
+// of expiring posts and that removing them only removes the embeds that are
+// not referenced by other posts.
+func TestRemoveExpiredPosts(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	ctx := context.Background()
+	signer := func(msg []byte) zkidentity.FixedSizeSignature {
+		return id.SignMessage(msg)
+	}
+
+	embedData := []byte("embedded data")
+	embed := mdembeds.EmbeddedArgs{Typ: "text/plain", Data: embedData}
+	embedFname, err := clientdb.EmbedFilename(embedData, embed.Typ)
+	assert.NilErr(t, err)
+	_, err = db.SaveEmbed(embedFname, embedData)
+	assert.NilErr(t, err)
+	embedPath := filepath.Join(db.DBRoot(), "embeds", embedFname)
+	assertEmbedExists := func(want bool) {
+		t.Helper()
+		_, err := os.Stat(embedPath)
+		assert.DeepEqual(t, err == nil, want)
+	}
+	assertEmbedExists(true)
+
+	// Create an expiring and a non-expiring post that share the embed.
+	expiration := time.Now().Add(time.Hour)
+	var expiring, permanent clientdb.PostSummary
+	err = db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+		attrs := map[string]string{
+			rpc.RMPExpiration: strconv.FormatInt(expiration.Unix(), 16),
+		}
+		var err error
+		expiring, _, err = db.CreatePost(tx, "expiring "+embed.String(),
+			"", "", attrs, &id.Public, signer)
+		if err != nil {
+			return err
+		}
+		permanent, _, err = db.CreatePost(tx, "permanent "+embed.String(),
+			"", "", nil, &id.Public, signer)
+		return err
+	})
+	assert.NilErr(t, err)
+
+	listExpired := func(now time.Time) []clientdb.PostSummary {
+		t.Helper()
+		var res []clientdb.PostSummary
+		err := db.View(ctx, func(tx clientdb.ReadTx) error {
+			var err error
+			res, err = db.ListExpiredPosts(tx, now)
+			return err
+		})
+		assert.NilErr(t, err)
+		return res
+	}
+	assert.DeepEqual(t, len(listExpired(time.Now())), 0)
+	expired := listExpired(expiration)
+	assert.DeepEqual(t, len(expired), 1)
+	assert.DeepEqual(t, expired[0].ID, expiring.ID)
+
+	removePost := func(pid clientdb.PostID) {
+		t.Helper()
+		err := db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+			return db.RemovePost(tx, id.Public.Identity, pid)
+		})
+		assert.NilErr(t, err)
+	}
+
+	// The embed is kept while referenced by the other post.
+	removePost(expiring.ID)
+	assert.DeepEqual(t, len(listExpired(expiration)), 0)
+	assertEmbedExists(true)
+
+	// The embed is removed with the last post that references it.
+	removePost(permanent.ID)
+	assertEmbedExists(false)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -67,6 +68,16 @@ func (c *Client) handlePostsSubscribe(ru *RemoteUser, ps rpc.RMPostsSubscribe) e
 			return nil
 		}
 
+		err = c.checkPostAudienceMember(tx, *ps.GetPost, ru.ID())
+		if errors.Is(err, clientdb.ErrNotFound) {
+			// Do not send restricted post to non-members.
+			ru.log.Warnf("Attempted to fetch restricted post %s "+
+				"while subscribing", *ps.GetPost)
+			ps.GetPost = nil
+			return nil
+		} else if err != nil {
+			return err
+		}
 		if post, err = c.db.ReadPost(tx, c.PublicID(), *ps.GetPost); err != nil {
 			return err
 		}
@@ -267,9 +278,33 @@ func (c *Client) ListUserPosts(uid UserID) error {
 func (c *Client) handleListPosts(ru *RemoteUser, lp rpc.RMListPosts) error {
 	var posts []rpc.PostMetadata
 	err := c.dbView(func(tx clientdb.ReadTx) error {
-		var err error
-		posts, err = c.db.ListUserPosts(tx, c.PublicID())
-		return err
+		allPosts, err := c.db.ListUserPosts(tx, c.PublicID())
+		if err != nil {
+			return err
+		}
+
+		// Do not list expired posts or restricted posts to users
+		// outside their audience.
+		now := time.Now()
+		posts = make([]rpc.PostMetadata, 0, len(allPosts))
+		for _, p := range allPosts {
+			var pid clientintf.PostID
+			if err := pid.FromString(p.Attributes[rpc.RMPIdentifier]); err != nil {
+				continue
+			}
+			if rpc.IsPostExpired(&p, now) {
+				continue
+			}
+			err := c.checkPostAudienceMember(tx, pid, ru.ID())
+			if errors.Is(err, clientdb.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			posts = append(posts, p)
+		}
+		return nil
 	})
 	if err != nil {
 		return err
@@ -330,23 +365,99 @@ func (c *Client) shareWithPostSubscribers(subs []clientintf.UserID,
 
 // CreatePost creates a new post and shares it with all current subscribers.
 func (c *Client) CreatePost(post, descr string) (clientdb.PostSummary, error) {
+	return c.CreatePostForAudience(post, descr, "")
+}
+
+// CreatePostForAudience creates a new post and shares it with the current
+// subscribers that are members of the specified audience. Status updates of
+// the post are only shared with (and accepted from) members of the audience,
+// and the post cannot be relayed.
+//
+// If audience is empty, the post is shared with all current subscribers.
+func (c *Client) CreatePostForAudience(post, descr, audience string) (clientdb.PostSummary, error) {
+	return c.CreateTaggedPost(post, descr, audience, nil)
+}
+
+// CreateTaggedPost creates a new post with the given tags. Tags are signed as
+// part of the post and allow subscribers to query and filter their feed. The
+// audience follows the same rules as in CreatePostForAudience.
+func (c *Client) CreateTaggedPost(post, descr, audience string, tags []string) (clientdb.PostSummary, error) {
+	opts := CreatePostOpts{Audience: audience, Tags: tags}
+	return c.CreatePostWithOpts(post, descr, opts)
+}
+
+// CreatePostOpts are the options for creating a new post.
+type CreatePostOpts struct {
+	// Audience restricts the post to the members of the given audience.
+	// See CreatePostForAudience.
+	Audience string
+
+	// Tags are the tags of the post. See CreateTaggedPost.
+	Tags []string
+
+	// Expiration is the time after which the post expires. Expired posts
+	// are deleted by the author and its subscribers and are not relayed.
+	// If zero, the post does not expire.
+	Expiration time.Time
+}
+
+// CreatePostWithOpts creates a new post with the given options and shares it
+// with the current subscribers.
+func (c *Client) CreatePostWithOpts(post, descr string, opts CreatePostOpts) (clientdb.PostSummary, error) {
 	// Filename for embedded data is not currently used, so it's disabled at
 	// the client API level.
 	const fname = ""
 
+	post, err := c.transcodeEmbeds(post)
+	if err != nil {
+		return clientdb.PostSummary{}, err
+	}
+
+	extraAttrs := make(map[string]string)
+	if opts.Audience != "" {
+		extraAttrs[rpc.RMPRestricted] = "1"
+	}
+	if len(opts.Tags) > 0 {
+		tagsAttr, err := rpc.NormalizePostTags(opts.Tags)
+		if err != nil {
+			return clientdb.PostSummary{}, err
+		}
+		extraAttrs[rpc.RMPTags] = tagsAttr
+	}
+	if !opts.Expiration.IsZero() {
+		if !opts.Expiration.After(time.Now()) {
+			return clientdb.PostSummary{}, fmt.Errorf("post expiration " +
+				"must be in the future")
+		}
+		extraAttrs[rpc.RMPExpiration] = strconv.FormatInt(opts.Expiration.Unix(), 16)
+	}
+
 	me := c.Public()
 	var pm rpc.PostMetadata
 	var subs []clientdb.UserID
 	var summ clientdb.PostSummary
-	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		if opts.Audience != "" {
+			if _, err := c.db.GetPostAudience(tx, opts.Audience); err != nil {
+				return err
+			}
+		}
+
 		var err error
-		summ, pm, err = c.db.CreatePost(tx, post, descr, fname, nil,
+		summ, pm, err = c.db.CreatePost(tx, post, descr, fname, extraAttrs,
 			&me, c.localID.signMessage)
 		if err != nil {
 			return err
 		}
 
-		subs, err = c.db.ListPostSubscribers(tx)
+		if opts.Audience != "" {
+			err := c.db.RestrictPostToAudience(tx, summ.ID, opts.Audience)
+			if err != nil {
+				return err
+			}
+		}
+
+		subs, err = c.db.ListPostRecipients(tx, summ.ID)
 		return err
 	})
 	if err != nil {
@@ -435,6 +546,13 @@ func (c *Client) verifyPostStatusSignature(pms rpc.PostMetadataStatus) error {
 		verifyMsg = c.localID.verifyMessage
 	} else {
 		ru, err := c.rul.byID(from)
+		if err != nil && (rpc.IsPostRevision(pms.Attributes) ||
+			rpc.IsPostModeration(pms.Attributes)) {
+			// Revisions and moderation actions change the contents
+			// of the post, so only accept the ones that can be
+			// verified.
+			return failf("unknown author of revision or moderation action")
+		}
 		if err != nil {
 			c.log.Warnf("Unable to verify signature on post status %x: "+
 				"unknown author", pms.Hash())
@@ -481,6 +599,7 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 	errStatusWithoutPost := errors.New("status without post")
 	errHaveCopyFromAuthor := errors.New("have post copy from author")
 	errFilter := errors.New("filtered post/comment")
+	errExpiredPost := errors.New("expired post")
 
 	var summ clientdb.PostSummary
 	var isUpdate bool
@@ -534,6 +653,12 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 				}
 
 			}
+			if rpc.IsPostRevision(p.Attributes) && statusFrom != c.PublicID() {
+				main := p.Attributes[rpc.RMPMain]
+				if filter, _ := c.FilterPost(statusFrom, pid, main); filter {
+					return errFilter
+				}
+			}
 
 			_, update, err = c.db.AddPostStatusUpdate(tx, from, p)
 			hash := update.Hash()
@@ -554,6 +679,11 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 			return err
 		}
 
+		// Do not store posts that already expired.
+		if rpc.IsPostExpired(&p, time.Now()) {
+			return errExpiredPost
+		}
+
 		// If we received this post from its author, remove the relayed
 		// copies.
 		var postAuthor UserID
@@ -566,6 +696,12 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 				return err
 			}
 		}
+		if rpc.IsPostRestricted(&p) && from != postAuthor {
+			// Restricted posts may only be received from their
+			// author.
+			return fmt.Errorf("relayed post %s: %w", pid,
+				ErrRestrictedPost)
+		}
 		if !postAuthor.IsEmpty() && from != postAuthor {
 			if exists, _ := c.db.PostExists(tx, postAuthor, pid); exists {
 				// Ignore relayed copy of the post in favor of
@@ -578,6 +714,16 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 		if filter, _ := c.FilterPost(ru.ID(), pid, p.Attributes[rpc.RMPMain]); filter {
 			return errFilter
 		}
+		tagsFrom := postAuthor
+		if tagsFrom.IsEmpty() {
+			tagsFrom = ru.ID()
+		}
+		if tags := rpc.PostTags(&p); tagsFrom != c.PublicID() {
+			filter, _ := c.FilterPostTags(tagsFrom, pid, tags, p.Attributes[rpc.RMPMain])
+			if filter {
+				return errFilter
+			}
+		}
 
 		// Post does not exist. Save it.
 		pid, summ, err = c.db.SaveReceivedPost(tx, from, p)
@@ -621,6 +767,11 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 		if errors.Is(err, errFilter) {
 			return nil
 		}
+
+		if errors.Is(err, errExpiredPost) {
+			ru.log.Infof("Ignoring expired post %s", pid)
+			return nil
+		}
 		return err
 	}
 
@@ -666,6 +817,62 @@ func (c *Client) handlePostShare(ru *RemoteUser, ps rpc.RMPostShare) error {
 	return nil
 }
 
+// checkPostAudienceMember returns an error if the given post created by the
+// local client is restricted to an audience and uid is not a member of it. The
+// error wraps ErrNotFound, so that the existence of the post is not revealed.
+func (c *Client) checkPostAudienceMember(tx clientdb.ReadTx, pid clientintf.PostID,
+	uid UserID) error {
+
+	audience, err := c.db.PostAudienceOf(tx, pid)
+	if err != nil {
+		return err
+	}
+	if audience != nil && !audience.IsMember(uid) {
+		return fmt.Errorf("post %s: %w", pid, clientdb.ErrNotFound)
+	}
+	return nil
+}
+
+// SavePostAudience creates or replaces the post audience with the given name.
+// Members of the audience should be post subscribers of the local client, as
+// posts restricted to the audience are only sent to subscribers.
+func (c *Client) SavePostAudience(name string, members []UserID) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.SavePostAudience(tx, name, members)
+	})
+}
+
+// RemovePostAudience removes the post audience with the given name. Posts that
+// were restricted to the audience are no longer shared with anyone.
+func (c *Client) RemovePostAudience(name string) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.RemovePostAudience(tx, name)
+	})
+}
+
+// ListPostAudiences lists the existing post audiences.
+func (c *Client) ListPostAudiences() ([]clientdb.PostAudience, error) {
+	var res []clientdb.PostAudience
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPostAudiences(tx)
+		return err
+	})
+	return res, err
+}
+
+// PostAudience returns the audience the given post created by the local client
+// is restricted to. Returns nil if the post is not restricted.
+func (c *Client) PostAudience(pid clientintf.PostID) (*clientdb.PostAudience, error) {
+	var res *clientdb.PostAudience
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.PostAudienceOf(tx, pid)
+		return err
+	})
+	return res, err
+}
+
 // ListReceivedPosts lists all posts created or received by the local client.
 func (c *Client) ListPosts() ([]clientdb.PostSummary, error) {
 	var res []clientdb.PostSummary
@@ -677,6 +884,19 @@ func (c *Client) ListPosts() ([]clientdb.PostSummary, error) {
 	return res, err
 }
 
+// ListLocalUserPosts lists the posts by the given user that are stored in the
+// local client. Edited posts are returned with the contents of their latest
+// revision.
+func (c *Client) ListLocalUserPosts(uid clientintf.UserID) ([]rpc.PostMetadata, error) {
+	var res []rpc.PostMetadata
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListUserPosts(tx, uid)
+		return err
+	})
+	return res, err
+}
+
 // ReadReceivedPost returns the post data for the given user/post.
 func (c *Client) ReadPost(uid clientintf.UserID, pid clientintf.PostID) (rpc.PostMetadata, error) {
 	var res rpc.PostMetadata
@@ -692,14 +912,21 @@ func (c *Client) ReadPost(uid clientintf.UserID, pid clientintf.PostID) (rpc.Pos
 // update message with all subscribers. The statusFrom argument refers to who
 // sent the status update (which may be the local client).
 //
+// Comments from remote users are held for approval (instead of being added to
+// the post) according to the post moderation config, unless approved is true.
+//
 // This should only be called to add status to posts created by the local
 // client.
-func (c *Client) addStatusToPost(statusFrom clientintf.UserID, pms *rpc.PostMetadataStatus) error {
+func (c *Client) addStatusToPost(statusFrom clientintf.UserID, pms *rpc.PostMetadataStatus,
+	approved bool) error {
+
 	var pid clientintf.PostID
 	var err error
 	var subs []clientintf.UserID
+	var held bool
 	postFrom := c.PublicID()
 	statusFromMe := statusFrom == c.PublicID()
+	_, isComment := pms.Attributes[rpc.RMPSComment]
 
 	if err = pid.FromString(pms.Link); err != nil {
 		return fmt.Errorf("specified link is not a post ID: %v", err)
@@ -719,11 +946,45 @@ func (c *Client) addStatusToPost(statusFrom clientintf.UserID, pms *rpc.PostMeta
 	// Add status to DB (this validates the status udpate against
 	// the DB as well).
 	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		// Only members of the audience may add status to restricted
+		// posts.
+		audience, err := c.db.PostAudienceOf(tx, pid)
+		if err != nil {
+			return err
+		}
+		if audience != nil && !statusFromMe && !audience.IsMember(statusFrom) {
+			return fmt.Errorf("user is not a member of the post audience")
+		}
+
+		if isComment && !statusFromMe {
+			updates, err := c.db.ListPostStatusUpdates(tx, postFrom, pid)
+			if err != nil {
+				return err
+			}
+			if clientdb.PostCommentsLocked(updates) {
+				return ErrPostCommentsLocked
+			}
+
+			modCfg, err := c.db.ReadPostModerationConfig(tx)
+			if err != nil {
+				return err
+			}
+			if !approved && modCfg.ShouldHold(statusFrom) {
+				held = true
+				hc := clientdb.HeldPostComment{
+					PostID:   pid,
+					From:     statusFrom,
+					Status:   *pms,
+					Received: time.Now(),
+				}
+				return c.db.HoldPostComment(tx, hc)
+			}
+		}
+
 		if err := c.db.AddPostStatus(tx, postFrom, statusFrom, pid, pms); err != nil {
 			return err
 		}
-		var err error
-		subs, err = c.db.ListPostSubscribers(tx)
+		subs, err = c.db.ListPostRecipients(tx, pid)
 		return err
 	})
 	if err != nil {
@@ -731,6 +992,14 @@ func (c *Client) addStatusToPost(statusFrom clientintf.UserID, pms *rpc.PostMeta
 		return err
 	}
 
+	if held {
+		ru, _ := c.rul.byID(statusFrom)
+		c.log.Infof("Holding comment %x from %s on post %s for approval",
+			pms.Hash(), statusFrom, pid)
+		c.ntfns.notifyPostCommentHeld(ru, pid, *pms)
+		return nil
+	}
+
 	// Prepare post share msg.
 	attr := pms.Attributes
 	attr[rpc.RMPIdentifier] = pid.String()
@@ -753,6 +1022,10 @@ func (c *Client) addStatusToPost(statusFrom clientintf.UserID, pms *rpc.PostMeta
 		statusType = "comment"
 	} else if _, ok := attr[rpc.RMPSHeart]; ok {
 		statusType = "heart"
+	} else if _, ok := attr[rpc.RMPSRevision]; ok {
+		statusType = "revision"
+	} else if _, ok := attr[rpc.RMPSModeration]; ok {
+		statusType = "moderation"
 	}
 	c.log.Infof("New %s %x from %s on post %s", statusType, pms.Hash(), fromStr, pid)
 
@@ -838,7 +1111,7 @@ func (c *Client) sendPostStatus(postFrom clientintf.UserID,
 	// we'll send the status update to the post author.
 	if postFrom == statusFrom {
 		pms.Attributes[rpc.RMPFromNick] = c.LocalNick()
-		return statusID, c.addStatusToPost(statusFrom, &pms)
+		return statusID, c.addStatusToPost(statusFrom, &pms, false)
 	}
 
 	// Ensure we know author.
@@ -883,10 +1156,24 @@ func (c *Client) HeartPost(from clientintf.UserID, pid clientintf.PostID, heart
 	return err
 }
 
-// CommentPost sends a comment status update on the received post.
+// CommentPost sends a comment status update on the received post. If parent
+// is specified, the comment is a reply to the comment with that ID. Replies to
+// comments unknown to a client are displayed as top-level comments.
 func (c *Client) CommentPost(postFrom clientintf.UserID, pid clientintf.PostID,
 	comment string, parent *clientintf.ID) (clientintf.ID, error) {
 
+	locked, err := c.PostCommentsLocked(postFrom, pid)
+	if err != nil {
+		return clientintf.ID{}, err
+	}
+	if locked {
+		return clientintf.ID{}, ErrPostCommentsLocked
+	}
+	comment, err = c.transcodeEmbeds(comment)
+	if err != nil {
+		return clientintf.ID{}, err
+	}
+
 	attr := map[string]string{
 		rpc.RMPSComment: comment,
 	}
@@ -896,6 +1183,185 @@ func (c *Client) CommentPost(postFrom clientintf.UserID, pid clientintf.PostID,
 	return c.sendPostStatus(postFrom, pid, attr)
 }
 
+// PostCommentsLocked returns true if the author of the given post locked its
+// comments.
+func (c *Client) PostCommentsLocked(postFrom clientintf.UserID, pid clientintf.PostID) (bool, error) {
+	var locked bool
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		updates, err := c.db.ListPostStatusUpdates(tx, postFrom, pid)
+		locked = clientdb.PostCommentsLocked(updates)
+		return err
+	})
+	return locked, err
+}
+
+// RemovePostComment removes a comment from a post created by the local client.
+// The removal is shared with subscribers as a signed status update, after
+// which they no longer show the comment.
+func (c *Client) RemovePostComment(pid clientintf.PostID, commentID clientintf.ID) (clientintf.ID, error) {
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		updates, err := c.db.ListPostStatusUpdates(tx, c.PublicID(), pid)
+		if err != nil {
+			return err
+		}
+		for i := range updates {
+			if _, ok := updates[i].Attributes[rpc.RMPSComment]; !ok {
+				continue
+			}
+			if updates[i].Hash() == commentID {
+				return nil
+			}
+		}
+		return fmt.Errorf("comment %s: %w", commentID, clientdb.ErrNotFound)
+	})
+	if err != nil {
+		return clientintf.ID{}, err
+	}
+
+	attr := map[string]string{
+		rpc.RMPSModeration: rpc.RMPSModRemove,
+		rpc.RMPParent:      commentID.String(),
+	}
+	return c.sendPostStatus(c.PublicID(), pid, attr)
+}
+
+// LockPostComments locks (or unlocks) the comments of a post created by the
+// local client. Comments on locked posts are rejected.
+func (c *Client) LockPostComments(pid clientintf.PostID, lock bool) (clientintf.ID, error) {
+	locked, err := c.PostCommentsLocked(c.PublicID(), pid)
+	if err != nil {
+		return clientintf.ID{}, err
+	}
+	if locked == lock {
+		return clientintf.ID{}, fmt.Errorf("post comments already have "+
+			"locked status %v", lock)
+	}
+
+	action := rpc.RMPSModLockComms
+	if !lock {
+		action = rpc.RMPSModUnlockComms
+	}
+	attr := map[string]string{
+		rpc.RMPSModeration: action,
+	}
+	return c.sendPostStatus(c.PublicID(), pid, attr)
+}
+
+// SetCommentHoldMode sets the mode of holding comments received on local posts
+// for approval.
+func (c *Client) SetCommentHoldMode(mode clientdb.CommentHoldMode) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		cfg, err := c.db.ReadPostModerationConfig(tx)
+		if err != nil {
+			return err
+		}
+		cfg.HoldMode = mode
+		return c.db.SavePostModerationConfig(tx, cfg)
+	})
+}
+
+// PostModerationConfig returns the config for moderating comments on local
+// posts.
+func (c *Client) PostModerationConfig() (clientdb.PostModerationConfig, error) {
+	var res clientdb.PostModerationConfig
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ReadPostModerationConfig(tx)
+		return err
+	})
+	return res, err
+}
+
+// ListHeldPostComments lists the comments on local posts that are held for
+// approval.
+func (c *Client) ListHeldPostComments() ([]clientdb.HeldPostComment, error) {
+	var res []clientdb.HeldPostComment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListHeldPostComments(tx)
+		return err
+	})
+	return res, err
+}
+
+// ApproveHeldPostComment approves a comment held for approval, sharing it with
+// the post subscribers. The comment author becomes a known commenter, whose
+// comments are not held in the CommentHoldUnknown mode.
+func (c *Client) ApproveHeldPostComment(pid clientintf.PostID, id clientintf.ID) error {
+	var hc clientdb.HeldPostComment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		hc, err = c.db.ReadHeldPostComment(tx, pid, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.addStatusToPost(hc.From, &hc.Status, true)
+	if err != nil && !errors.Is(err, clientdb.ErrDuplicatePostStatus) {
+		return err
+	}
+
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		if _, err := c.db.RemoveHeldPostComment(tx, pid, id); err != nil {
+			return err
+		}
+		return c.db.AddKnownCommenter(tx, hc.From)
+	})
+}
+
+// RejectHeldPostComment discards a comment held for approval.
+func (c *Client) RejectHeldPostComment(pid clientintf.PostID, id clientintf.ID) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.RemoveHeldPostComment(tx, pid, id)
+		return err
+	})
+}
+
+// EditPost creates a new revision of a post created by the local client,
+// replacing its contents. The revision is signed by the local client and shared
+// with the post subscribers, which display it in place of the original post
+// contents.
+func (c *Client) EditPost(pid clientintf.PostID, post, descr string) (clientintf.ID, error) {
+	if strings.TrimSpace(post) == "" {
+		return clientintf.ID{}, errors.New("post cannot be empty")
+	}
+
+	var lastRev uint32
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		revs, err := c.db.ListPostRevisions(tx, c.PublicID(), pid)
+		if len(revs) > 0 {
+			lastRev = clientdb.PostRevisionNumber(&revs[len(revs)-1])
+		}
+		return err
+	})
+	if err != nil {
+		return clientintf.ID{}, err
+	}
+
+	attr := map[string]string{
+		rpc.RMPSRevision: strconv.FormatUint(uint64(lastRev+1), 10),
+		rpc.RMPMain:      post,
+	}
+	if descr != "" {
+		attr[rpc.RMPDescription] = descr
+	}
+	return c.sendPostStatus(c.PublicID(), pid, attr)
+}
+
+// ListPostRevisions lists the revisions of the specified post, from oldest to
+// newest. The original version of the post is returned by ReadPost.
+func (c *Client) ListPostRevisions(from UserID, pid clientintf.PostID) ([]rpc.PostMetadataStatus, error) {
+	var res []rpc.PostMetadataStatus
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPostRevisions(tx, from, pid)
+		return err
+	})
+	return res, err
+}
+
 func (c *Client) handlePostStatus(ru *RemoteUser, rmps rpc.RMPostStatus) error {
 	ru.log.Infof("Received status update on post %q", rmps.Link)
 
@@ -919,7 +1385,7 @@ func (c *Client) handlePostStatus(ru *RemoteUser, rmps rpc.RMPostStatus) error {
 		err = c.verifyPostStatusSignature(pms)
 	}
 	if err == nil {
-		err = c.addStatusToPost(ru.ID(), &pms)
+		err = c.addStatusToPost(ru.ID(), &pms, false)
 	}
 	if errors.Is(err, clientintf.ErrSubsysExiting) {
 		// Special case for early return.
@@ -955,6 +1421,40 @@ func (c *Client) ListPostStatusUpdates(from UserID, pid clientintf.PostID) ([]rp
 	return res, err
 }
 
+// ListPostComments returns the comments of the specified post, assembled into
+// threads of replies.
+func (c *Client) ListPostComments(from UserID, pid clientintf.PostID) ([]*clientdb.PostCommentNode, error) {
+	updates, err := c.ListPostStatusUpdates(from, pid)
+	if err != nil {
+		return nil, err
+	}
+	return clientdb.PostCommentTree(updates), nil
+}
+
+// QueryPosts returns the summary of the posts received by the local client that
+// are selected by the query, ordered by date.
+func (c *Client) QueryPosts(q clientdb.PostsQuery) ([]clientdb.PostSummary, error) {
+	var res []clientdb.PostSummary
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.QueryPosts(tx, q)
+		return err
+	})
+	return res, err
+}
+
+// ListPostTags returns the tags of the posts received by the local client and
+// the number of posts with each tag.
+func (c *Client) ListPostTags() (map[string]int, error) {
+	var res map[string]int
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListPostTags(tx)
+		return err
+	})
+	return res, err
+}
+
 // GetUserPost attempts to fetch the given post from the specified user. The
 // post will be supplied in the PostReceived event of the client.
 //
@@ -1006,16 +1506,40 @@ func (c *Client) GetUserPost(from UserID, pid clientintf.PostID, includeStatus b
 	return ru.sendRM(rm, payEvent)
 }
 
-// sendPostToUser sends the given post to the user.
+// sendPostToUser sends the given post to the user. The revisions of the post
+// are always sent after it.
 func (c *Client) sendPostToUser(ru *RemoteUser, pid clientintf.PostID, post rpc.PostMetadata, updates []rpc.PostMetadataStatus) error {
 
-	ru.log.Infof("Sending requested post %s (IncludeStatus=%v)", pid,
-		updates != nil)
+	var revisions []rpc.PostMetadataStatus
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		revisions, err = c.db.ListPostRevisions(tx, c.PublicID(), pid)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Sending requested post %s (IncludeStatus=%v, Revisions=%d)",
+		pid, updates != nil, len(revisions))
 	payEvent := fmt.Sprintf("posts.%s.getreply", pid.ShortLogID())
 	rm := rpc.RMPostShare(post)
 	if err := c.sendWithSendQ(payEvent, rm, ru.ID()); err != nil {
 		return err
 	}
+	if len(revisions) > 0 {
+		payEvent := fmt.Sprintf("posts.%s.getreplyrevision",
+			pid.ShortLogID())
+		for _, rev := range revisions {
+			rm := rpc.RMPostShare{
+				Version:    rev.Version,
+				Attributes: rev.Attributes,
+			}
+			if err := c.sendWithSendQ(payEvent, rm, ru.ID()); err != nil {
+				return err
+			}
+		}
+	}
 	if len(updates) > 0 {
 		payEvent := fmt.Sprintf("posts.%s.getreplystatusupdate",
 			pid.ShortLogID())
@@ -1046,9 +1570,15 @@ func (c *Client) handleGetPost(ru *RemoteUser, gp rpc.RMGetPost) error {
 			return errNotSubscriber
 		}
 
+		if err := c.checkPostAudienceMember(tx, gp.ID, ru.ID()); err != nil {
+			return err
+		}
 		if post, err = c.db.ReadPost(tx, c.PublicID(), gp.ID); err != nil {
 			return err
 		}
+		if rpc.IsPostExpired(&post, time.Now()) {
+			return clientdb.ErrNotFound
+		}
 		if gp.IncludeStatus {
 			if updates, err = c.db.ListPostStatusUpdates(tx, c.PublicID(), gp.ID); err != nil {
 				return err
@@ -1079,9 +1609,20 @@ func (c *Client) relayPost(postFrom clientintf.UserID, pid clientintf.PostID,
 	users ...clientintf.UserID) error {
 
 	var post rpc.PostMetadata
+	var revisions []rpc.PostMetadataStatus
 	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
 		var err error
 		post, err = c.db.ReadPost(tx, postFrom, pid)
+		if err != nil {
+			return err
+		}
+		if rpc.IsPostRestricted(&post) {
+			return ErrRestrictedPost
+		}
+		if rpc.IsPostExpired(&post, time.Now()) {
+			return ErrExpiredPost
+		}
+		revisions, err = c.db.ListPostRevisions(tx, postFrom, pid)
 		return err
 	})
 	if err != nil {
@@ -1102,6 +1643,18 @@ func (c *Client) relayPost(postFrom clientintf.UserID, pid clientintf.PostID,
 		return err
 	}
 
+	// Relay the revisions signed by the author, so that the edited version
+	// of the post is shown.
+	for _, rev := range revisions {
+		rm := rpc.RMPostShare{
+			Version:    rev.Version,
+			Attributes: rev.Attributes,
+		}
+		if err := c.shareWithPostSubscribers(users, pid, rm, "relayrevision"); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1180,3 +1733,49 @@ func (c *Client) ListPostCommentReceiveReceipts(pid clientintf.PostID,
 	})
 	return res, err
 }
+
+// removeExpiredPosts deletes the posts (both local and received) that have
+// expired.
+func (c *Client) removeExpiredPosts() error {
+	var removed []clientdb.PostSummary
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		expired, err := c.db.ListExpiredPosts(tx, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, summ := range expired {
+			err := c.db.RemovePost(tx, summ.From, summ.ID)
+			if errors.Is(err, clientdb.ErrNotFound) {
+				// Post was already removed.
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("unable to remove expired post %s: %w",
+					summ.ID, err)
+			}
+			removed = append(removed, summ)
+		}
+		return nil
+	})
+
+	for _, summ := range removed {
+		c.log.Infof("Removed expired post %s from %s", summ.ID, summ.From)
+		c.ntfns.notifyPostExpired(summ)
+	}
+	return err
+}
+
+// runPostsJanitor periodically deletes expired posts.
+func (c *Client) runPostsJanitor(ctx context.Context) error {
+	for {
+		if err := c.removeExpiredPosts(); err != nil {
+			c.log.Errorf("Unable to remove expired posts: %v", err)
+		}
+
+		select {
+		case <-time.After(c.cfg.PostsJanitorInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,604 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// Recurring payment flow is:
+//
+//          Alice (supporter)                        Bob (creator)
+//         -------                                  -----
+//
+//   CreateRecurringPayment()
+//       \-------- RMRecurringPayment -->
+//
+//                                            handleRecurringPayment()
+//
+//   (every period, a TipUser attempt)
+//       \-------- RMGetInvoice -->
+//
+//                                            handleGetInvoice()
+//                               <-- RMInvoice --------/
+//
+//   handleInvoice()
+//     (out-of-band payment)
+//                                            creditRecurringPayment()
+//
+// Either side may cancel the agreement by sending an RMRecurringPaymentCancel.
+
+const (
+	// recurringPaymentMaxAttempts is the max number of attempts made at
+	// fetching and paying an invoice for each period of a recurring
+	// payment.
+	recurringPaymentMaxAttempts = 3
+
+	// maxRecurringPaymentTierLen is the max length of the name of the tier
+	// of a recurring payment.
+	maxRecurringPaymentTierLen = 64
+)
+
+// CreateRecurringPayment starts an agreement to pay dcrAmount to the given
+// user every period, for at most maxPeriods periods (or until canceled, if
+// maxPeriods is zero). The remote user is informed of the agreement and the
+// payment of the first period is started immediately.
+func (c *Client) CreateRecurringPayment(uid UserID, dcrAmount float64,
+	period time.Duration, maxPeriods uint32, tier string) (clientdb.RecurringPayment, error) {
+
+	var rp clientdb.RecurringPayment
+	if dcrAmount <= 0 {
+		return rp, fmt.Errorf("cannot pay user %f <= 0", dcrAmount)
+	}
+	period = period.Truncate(time.Second)
+	if period <= 0 {
+		return rp, errors.New("period must be at least one second")
+	}
+	if len(tier) > maxRecurringPaymentTierLen {
+		return rp, fmt.Errorf("tier length %d is greater than max %d",
+			len(tier), maxRecurringPaymentTierLen)
+	}
+
+	ru, err := c.rul.byID(uid)
+	if err != nil {
+		return rp, err
+	}
+
+	amt, err := dcrutil.NewAmount(dcrAmount)
+	if err != nil {
+		return rp, err
+	}
+
+	now := time.Now()
+	rp = clientdb.RecurringPayment{
+		ID:          zkidentity.RandomShortID(),
+		UID:         uid,
+		Paying:      true,
+		Tier:        tier,
+		MilliAtoms:  uint64(amt) * 1e3,
+		Period:      period,
+		MaxPeriods:  maxPeriods,
+		Created:     now,
+		Status:      clientdb.RecurringPaymentActive,
+		Updated:     now,
+		NextPayment: now,
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		return rp, err
+	}
+
+	rm := rpc.RMRecurringPayment{
+		ID:         rp.ID,
+		Tier:       tier,
+		MilliAtoms: rp.MilliAtoms,
+		Period:     int64(period / time.Second),
+		MaxPeriods: maxPeriods,
+	}
+	if err := c.sendWithSendQ("recurringpayment", rm, uid); err != nil {
+		return rp, err
+	}
+
+	ru.log.Infof("Started recurring payment %s of %s every %s", rp.ID,
+		amt, period)
+	return rp, nil
+}
+
+// SetRecurringPaymentTier creates or replaces a tier offered by the local
+// client. Recurring payments for the tier must pay at least minDCRAmount at
+// most every maxPeriod.
+func (c *Client) SetRecurringPaymentTier(name string, minDCRAmount float64,
+	maxPeriod time.Duration) (clientdb.RecurringPaymentTier, error) {
+
+	var tier clientdb.RecurringPaymentTier
+	switch {
+	case name == "":
+		return tier, errors.New("tier name cannot be empty")
+	case len(name) > maxRecurringPaymentTierLen:
+		return tier, fmt.Errorf("tier length %d is greater than max %d",
+			len(name), maxRecurringPaymentTierLen)
+	case minDCRAmount <= 0:
+		return tier, fmt.Errorf("min amount %f <= 0", minDCRAmount)
+	case maxPeriod < time.Second:
+		return tier, errors.New("max period must be at least one second")
+	}
+	amt, err := dcrutil.NewAmount(minDCRAmount)
+	if err != nil {
+		return tier, err
+	}
+
+	tier = clientdb.RecurringPaymentTier{
+		Name:          name,
+		MinMilliAtoms: uint64(amt) * 1e3,
+		MaxPeriod:     maxPeriod.Truncate(time.Second),
+	}
+	err = c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.StoreRecurringPaymentTier(tx, tier)
+	})
+	return tier, err
+}
+
+// RemoveRecurringPaymentTier removes a tier offered by the local client. New
+// recurring payments for the tier are rejected and existing ones no longer
+// count towards membership of the tier.
+func (c *Client) RemoveRecurringPaymentTier(name string) error {
+	return c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		return c.db.RemoveRecurringPaymentTier(tx, name)
+	})
+}
+
+// ListRecurringPaymentTiers lists the tiers offered by the local client.
+func (c *Client) ListRecurringPaymentTiers() ([]clientdb.RecurringPaymentTier, error) {
+	var res []clientdb.RecurringPaymentTier
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListRecurringPaymentTiers(tx)
+		return err
+	})
+	return res, err
+}
+
+// handleRecurringPayment handles a remote user informing the local client
+// that it will make recurring payments to it.
+//
+// Recurring payments for a tier must meet the requirements of a tier offered
+// by the local client. Otherwise, the agreement is rejected and the remote
+// user is informed it was canceled.
+func (c *Client) handleRecurringPayment(ru *RemoteUser, rm rpc.RMRecurringPayment) error {
+	switch {
+	case rm.MilliAtoms == 0:
+		return errors.New("recurring payment amount cannot be zero")
+	case rm.Period <= 0:
+		return fmt.Errorf("invalid recurring payment period %d", rm.Period)
+	case len(rm.Tier) > maxRecurringPaymentTierLen:
+		return fmt.Errorf("tier length %d is greater than max %d",
+			len(rm.Tier), maxRecurringPaymentTierLen)
+	}
+
+	now := time.Now()
+	rp := clientdb.RecurringPayment{
+		ID:          rm.ID,
+		UID:         ru.ID(),
+		Tier:        rm.Tier,
+		MilliAtoms:  rm.MilliAtoms,
+		Period:      time.Duration(rm.Period) * time.Second,
+		MaxPeriods:  rm.MaxPeriods,
+		Created:     now,
+		Status:      clientdb.RecurringPaymentActive,
+		Updated:     now,
+		NextPayment: now,
+	}
+	var rejectReason string
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		_, err := c.db.ReadRecurringPayment(tx, ru.ID(), rm.ID)
+		if err == nil {
+			return fmt.Errorf("recurring payment %s already exists", rm.ID)
+		}
+		if !errors.Is(err, clientdb.ErrNotFound) {
+			return err
+		}
+
+		if rp.Tier != "" {
+			tier, err := c.db.ReadRecurringPaymentTier(tx, rp.Tier)
+			switch {
+			case errors.Is(err, clientdb.ErrNotFound):
+				rejectReason = fmt.Sprintf("tier %q is not offered",
+					rp.Tier)
+				return nil
+			case err != nil:
+				return err
+			case !tier.Accepts(&rp):
+				rejectReason = fmt.Sprintf("tier %q requires "+
+					"at least %.8f DCR every %s", tier.Name,
+					float64(tier.MinMilliAtoms)/1e11,
+					tier.MaxPeriod)
+				return nil
+			}
+		}
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		return err
+	}
+
+	if rejectReason != "" {
+		ru.log.Infof("Rejecting recurring payment %s: %s", rp.ID,
+			rejectReason)
+		cancel := rpc.RMRecurringPaymentCancel{ID: rp.ID, Reason: rejectReason}
+		return c.sendWithSendQ("recurringpaymentcancel", cancel, ru.ID())
+	}
+
+	ru.log.Infof("Received recurring payment %s of %.8f DCR every %s "+
+		"(tier %q)", rp.ID, float64(rp.MilliAtoms)/1e11, rp.Period,
+		rp.Tier)
+	c.ntfns.notifyRecurringPaymentReceived(ru, rp)
+	return nil
+}
+
+// checkRecurringPaymentInvoiceRequest checks whether the remote user may
+// request an invoice to pay for a period of a recurring payment.
+func (c *Client) checkRecurringPaymentInvoiceRequest(ru *RemoteUser, getInvoice rpc.RMGetInvoice) error {
+	var rp clientdb.RecurringPayment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, ru.ID(), *getInvoice.RecurringPaymentID)
+		return err
+	})
+	switch {
+	case err != nil:
+		return err
+	case rp.Paying:
+		return fmt.Errorf("recurring payment %s is paid by the local client", rp.ID)
+	case rp.Status != clientdb.RecurringPaymentActive:
+		return fmt.Errorf("recurring payment %s: %w", rp.ID, ErrRecurringPaymentNotActive)
+	case getInvoice.MilliAtoms != rp.MilliAtoms:
+		return fmt.Errorf("requested invoice amount %d different than "+
+			"recurring payment amount %d", getInvoice.MilliAtoms,
+			rp.MilliAtoms)
+	}
+	return nil
+}
+
+// creditRecurringPayment records that a period of a recurring payment made
+// by the remote user was paid.
+func (c *Client) creditRecurringPayment(ru *RemoteUser, id zkidentity.ShortID, receivedMAtoms int64) error {
+	var rp clientdb.RecurringPayment
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, ru.ID(), id)
+		if err != nil {
+			return err
+		}
+		if rp.Status != clientdb.RecurringPaymentActive {
+			return fmt.Errorf("recurring payment %s: %w", id,
+				ErrRecurringPaymentNotActive)
+		}
+		if receivedMAtoms < int64(rp.MilliAtoms) {
+			return fmt.Errorf("received %d milliatoms for recurring "+
+				"payment %s of %d milliatoms", receivedMAtoms, id,
+				rp.MilliAtoms)
+		}
+
+		rp.PeriodsPaid += 1
+		rp.NextPayment = rp.NextPayment.Add(rp.Period)
+		rp.Updated = time.Now()
+		if rp.AllPeriodsPaid() {
+			rp.Status = clientdb.RecurringPaymentCompleted
+		}
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Received payment %d of recurring payment %s", rp.PeriodsPaid,
+		rp.ID)
+	c.ntfns.notifyRecurringPaymentUpdated(ru, rp)
+	return nil
+}
+
+// recurringPaymentAttemptDone is called when a tip attempt finishes. If the
+// tip was the payment of a period of a recurring payment, the agreement is
+// updated.
+func (c *Client) recurringPaymentAttemptDone(ru *RemoteUser, ta *clientdb.TipUserAttempt, payErr error) {
+	if ta.RecurringPaymentID == nil {
+		return
+	}
+
+	var rp clientdb.RecurringPayment
+	var wasActive bool
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, ta.UID, *ta.RecurringPaymentID)
+		if err != nil {
+			return err
+		}
+		rp.PaymentInFlight = false
+		rp.Updated = time.Now()
+		wasActive = rp.Status == clientdb.RecurringPaymentActive
+		switch {
+		case !wasActive:
+			// Canceled or lapsed while the payment was in flight.
+		case payErr != nil:
+			rp.Status = clientdb.RecurringPaymentLapsed
+			rp.Error = payErr.Error()
+		default:
+			rp.PeriodsPaid += 1
+			rp.Error = ""
+			if rp.AllPeriodsPaid() {
+				rp.Status = clientdb.RecurringPaymentCompleted
+			}
+		}
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		ru.log.Errorf("Unable to update recurring payment %s: %v",
+			*ta.RecurringPaymentID, err)
+		return
+	}
+
+	if !wasActive {
+		return
+	}
+	if rp.Status == clientdb.RecurringPaymentLapsed {
+		ru.log.Warnf("Recurring payment %s lapsed: %v", rp.ID, payErr)
+		c.ntfns.notifyRecurringPaymentLapsed(ru, rp)
+		return
+	}
+	ru.log.Infof("Made payment %d of recurring payment %s", rp.PeriodsPaid,
+		rp.ID)
+	c.ntfns.notifyRecurringPaymentUpdated(ru, rp)
+}
+
+// CancelRecurringPayment cancels a recurring payment agreement made with the
+// given user and informs them about it.
+func (c *Client) CancelRecurringPayment(uid UserID, id zkidentity.ShortID, reason string) error {
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		rp, err := c.db.ReadRecurringPayment(tx, uid, id)
+		if err != nil {
+			return err
+		}
+		if rp.Status != clientdb.RecurringPaymentActive {
+			return ErrRecurringPaymentNotActive
+		}
+		rp.Status = clientdb.RecurringPaymentCanceled
+		rp.Error = reason
+		rp.Updated = time.Now()
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		return err
+	}
+
+	rm := rpc.RMRecurringPaymentCancel{ID: id, Reason: reason}
+	return c.sendWithSendQ("recurringpaymentcancel", rm, uid)
+}
+
+// handleRecurringPaymentCancel handles a remote user canceling a recurring
+// payment agreement.
+func (c *Client) handleRecurringPaymentCancel(ru *RemoteUser, rm rpc.RMRecurringPaymentCancel) error {
+	var rp clientdb.RecurringPayment
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, ru.ID(), rm.ID)
+		if err != nil {
+			return err
+		}
+		if rp.Status != clientdb.RecurringPaymentActive {
+			return fmt.Errorf("recurring payment %s: %w", rm.ID,
+				ErrRecurringPaymentNotActive)
+		}
+		rp.Status = clientdb.RecurringPaymentCanceled
+		rp.Error = rm.Reason
+		rp.Updated = time.Now()
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if err != nil {
+		return err
+	}
+
+	ru.log.Infof("Recurring payment %s canceled by remote user", rm.ID)
+	c.ntfns.notifyRecurringPaymentUpdated(ru, rp)
+	return nil
+}
+
+// RecurringPayment returns the recurring payment agreement with the given ID,
+// made with the given user.
+func (c *Client) RecurringPayment(uid UserID, id zkidentity.ShortID) (clientdb.RecurringPayment, error) {
+	var rp clientdb.RecurringPayment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, uid, id)
+		return err
+	})
+	return rp, err
+}
+
+// ListRecurringPayments lists the recurring payment agreements made by the
+// local client and by remote users, oldest first.
+func (c *Client) ListRecurringPayments() ([]clientdb.RecurringPayment, error) {
+	var res []clientdb.RecurringPayment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		res, err = c.db.ListRecurringPayments(tx)
+		return err
+	})
+	return res, err
+}
+
+// ListTierMembers lists the recurring payments of the remote users that are
+// currently members of the given tier. Only agreements that meet the current
+// requirements of the tier are considered.
+func (c *Client) ListTierMembers(name string) ([]clientdb.RecurringPayment, error) {
+	var tier clientdb.RecurringPaymentTier
+	var rps []clientdb.RecurringPayment
+	err := c.dbView(func(tx clientdb.ReadTx) error {
+		var err error
+		tier, err = c.db.ReadRecurringPaymentTier(tx, name)
+		if err != nil {
+			return err
+		}
+		rps, err = c.db.ListRecurringPayments(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var res []clientdb.RecurringPayment
+	for _, rp := range rps {
+		if !rp.Paying && tier.Accepts(&rp) && rp.IsMember(now) {
+			res = append(res, rp)
+		}
+	}
+	return res, nil
+}
+
+// startRecurringPaymentPeriod starts the payment of the next period of a
+// recurring payment made by the local client.
+func (c *Client) startRecurringPaymentPeriod(rp clientdb.RecurringPayment) error {
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		// Reload to check it was not canceled concurrently.
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, rp.UID, rp.ID)
+		if err != nil {
+			return err
+		}
+		if rp.Status != clientdb.RecurringPaymentActive || rp.PaymentInFlight {
+			return ErrRecurringPaymentNotActive
+		}
+		rp.PaymentInFlight = true
+		rp.NextPayment = rp.NextPayment.Add(rp.Period)
+		rp.Updated = time.Now()
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if errors.Is(err, ErrRecurringPaymentNotActive) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	id := rp.ID
+	err = c.startTipAttempt(rp.UID, rp.MilliAtoms, recurringPaymentMaxAttempts, &id, nil)
+	if err != nil {
+		ta := clientdb.TipUserAttempt{UID: rp.UID, RecurringPaymentID: &id}
+		ru, ruErr := c.rul.byID(rp.UID)
+		if ruErr != nil {
+			return err
+		}
+		c.recurringPaymentAttemptDone(ru, &ta, err)
+	}
+	return nil
+}
+
+// lapseRecurringPayment marks a recurring payment made by a remote user as
+// lapsed.
+func (c *Client) lapseRecurringPayment(rp clientdb.RecurringPayment) error {
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		// Reload to check it was not paid or canceled concurrently.
+		var err error
+		rp, err = c.db.ReadRecurringPayment(tx, rp.UID, rp.ID)
+		if err != nil {
+			return err
+		}
+		if rp.Status != clientdb.RecurringPaymentActive {
+			return ErrRecurringPaymentNotActive
+		}
+		rp.Status = clientdb.RecurringPaymentLapsed
+		rp.Error = fmt.Sprintf("payment due at %s not received",
+			rp.NextPayment.Format(time.RFC3339))
+		rp.Updated = time.Now()
+		return c.db.StoreRecurringPayment(tx, rp)
+	})
+	if errors.Is(err, ErrRecurringPaymentNotActive) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ru, err := c.rul.byID(rp.UID)
+	if err != nil {
+		return err
+	}
+	ru.log.Infof("Recurring payment %s lapsed", rp.ID)
+	c.ntfns.notifyRecurringPaymentLapsed(ru, rp)
+	return nil
+}
+
+// processRecurringPayments starts the payments of recurring payments made by
+// the local client that are due and lapses the ones made by remote users that
+// were not paid in time.
+func (c *Client) processRecurringPayments(ctx context.Context) error {
+	rps, err := c.ListRecurringPayments()
+	if err != nil {
+		return err
+	}
+
+	// Payments of remote users are only considered lapsed after they had
+	// the max lifetime of a tip attempt to complete.
+	lapseGrace := c.cfg.TipUserMaxLifetime
+
+	now := time.Now()
+	for _, rp := range rps {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if rp.Status != clientdb.RecurringPaymentActive {
+			continue
+		}
+
+		switch {
+		case rp.Paying && !rp.PaymentInFlight && !now.Before(rp.NextPayment):
+			err = c.startRecurringPaymentPeriod(rp)
+		case rp.Paying && rp.PaymentInFlight && now.After(rp.Updated.Add(2*lapseGrace)):
+			// The tip attempt should have expired by now, so it
+			// was lost (for example, if it was removed).
+			ta := clientdb.TipUserAttempt{UID: rp.UID, RecurringPaymentID: &rp.ID}
+			var ru *RemoteUser
+			if ru, err = c.rul.byID(rp.UID); err == nil {
+				c.recurringPaymentAttemptDone(ru, &ta,
+					errors.New("payment did not complete"))
+			}
+		case !rp.Paying && now.After(rp.NextPayment.Add(lapseGrace)):
+			err = c.lapseRecurringPayment(rp)
+		default:
+			continue
+		}
+		if err != nil {
+			c.log.Errorf("Unable to process recurring payment %s: %v",
+				rp.ID, err)
+		}
+	}
+	return nil
+}
+
+// runRecurringPayments periodically processes the recurring payments.
+func (c *Client) runRecurringPayments(ctx context.Context) error {
+	select {
+	case <-c.abLoaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		if err := c.processRecurringPayments(ctx); err != nil && ctx.Err() == nil {
+			c.log.Errorf("Unable to process recurring payments: %v", err)
+		}
+
+		select {
+		case <-time.After(c.cfg.RecurringPaymentsInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
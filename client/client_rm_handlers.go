@@ -204,6 +204,9 @@ func (c *Client) innerHandleUserRM(ru *RemoteUser, h *rpc.RMHeader,
 	case rpc.RMGroupUpdateAdmins:
 		return c.handleGCUpdateAdmins(ru, p, ts)
 
+	case rpc.RMGroupFileShared:
+		return c.handleGCFileShared(ru, p, ts)
+
 	case rpc.RMMediateIdentity:
 		return c.handleMediateID(ru, p)
 
@@ -243,6 +246,30 @@ func (c *Client) innerHandleUserRM(ru *RemoteUser, h *rpc.RMHeader,
 	case rpc.RMInvoice:
 		return c.handleInvoice(ru, p)
 
+	case rpc.RMPaidPostContent:
+		return c.handlePaidPostContent(ru, p)
+
+	case rpc.RMPaymentRequest:
+		return c.handlePaymentRequest(ru, p)
+
+	case rpc.RMPaymentRequestReply:
+		return c.handlePaymentRequestReply(ru, p)
+
+	case rpc.RMRecurringPayment:
+		return c.handleRecurringPayment(ru, p)
+
+	case rpc.RMRecurringPaymentCancel:
+		return c.handleRecurringPaymentCancel(ru, p)
+
+	case rpc.RMGetOnchainTipAddr:
+		return c.handleGetOnchainTipAddr(ru, p)
+
+	case rpc.RMOnchainTipAddr:
+		return c.handleOnchainTipAddr(ru, p)
+
+	case rpc.RMOnchainTipReceipt:
+		return c.handleOnchainTipReceipt(ru, p)
+
 	case rpc.RMListPosts:
 		return c.handleListPosts(ru, p)
 
@@ -276,6 +303,9 @@ func (c *Client) innerHandleUserRM(ru *RemoteUser, h *rpc.RMHeader,
 	case rpc.RMReceiveReceipt:
 		return c.handleReceiveReceipt(ru, p, ts)
 
+	case rpc.RMFragment:
+		return c.handleRMFragment(ru, p, ts)
+
 	case rpc.RMGroupKick:
 		return c.handleGCKick(ru, p, ts)
 
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// isFragmentableRM returns true if the RM may be split into fragments when it
+// is larger than the max message size.
+func isFragmentableRM(rm interface{}) bool {
+	switch rm.(type) {
+	case rpc.RMPrivateMessage, rpc.RMGroupMessage, rpc.RMPostShare,
+		rpc.RMPostStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// queueFragmentedRM splits the composed RM me into fragments and queues each
+// fragment in the underlying RMQ.
+//
+// replyChan is written to once the server acks all fragments.
+func (ru *RemoteUser) queueFragmentedRM(payload interface{}, me []byte,
+	priority uint, replyChan chan error, payEvent string,
+	sendqID *clientdb.SendQID) error {
+
+	maxMsgSize := int(ru.q.MaxMsgSize())
+	frags, err := rpc.SplitRM(me, rpc.MaxRMFragmentDataSize(maxMsgSize))
+	if err != nil {
+		return fmt.Errorf("unable to split %T into fragments: %w",
+			payload, err)
+	}
+
+	orms := make([]*remoteUserRM, len(frags))
+	for i := range frags {
+		// Fragments contain already compressed data, so they are not
+		// compressed again.
+		fme, err := rpc.ComposeCompressedRM(ru.localIDSigner, frags[i],
+			rpc.RMDefaultCompressionLevel)
+		if err != nil {
+			return err
+		}
+		if estSize := rpc.EstimateRoutedRMWireSize(len(fme)); estSize > maxMsgSize {
+			return fmt.Errorf("fragment of %T estimated as larger "+
+				"than max message size %d > %d: %w", payload,
+				estSize, maxMsgSize, errRMTooLarge)
+		}
+		orms[i] = &remoteUserRM{
+			pri:      priority,
+			msg:      fme,
+			ru:       ru,
+			payloadT: fmt.Sprintf("%T (fragment %d/%d)", payload, i+1, len(frags)),
+			payEvent: payEvent,
+		}
+	}
+
+	// Only the last fragment removes the msg from the sendq, so that the
+	// full msg is sent again if the client restarts before all fragments
+	// are sent.
+	orms[len(orms)-1].sendqID = sendqID
+
+	ru.log.Debugf("Queueing %T of size %d as %d fragments", payload,
+		len(me), len(frags))
+
+	// The inner reply channels are buffered so that the RMQ is not
+	// blocked while waiting for the replies of the prior fragments.
+	innerReplyChans := make([]chan error, len(orms))
+	for i := range orms {
+		innerReplyChans[i] = make(chan error, 1)
+		if err := ru.q.QueueRM(orms[i], innerReplyChans[i]); err != nil {
+			return err
+		}
+	}
+
+	if ru.ntfns != nil {
+		ru.ntfns.notifyRMQueued(ru, payload)
+	}
+
+	// Handle sending reply.
+	go func() {
+		var err error
+		for i, orm := range orms {
+			var fragErr error
+			select {
+			case fragErr = <-innerReplyChans[i]:
+			case <-ru.stopped:
+				err = errRemoteUserExiting
+			}
+			if err != nil {
+				break
+			}
+
+			if removeUnackedRMDueToErr(fragErr) {
+				ru.removeUnacked(orm.sendRV)
+			}
+			if fragErr != nil {
+				err = fragErr
+			}
+		}
+
+		last := orms[len(orms)-1]
+		ru.log.Debugf("Sent RM %T as %d fragments (last via RV %s, err: %v)",
+			payload, len(orms), last.sendRV, err)
+		if err == nil && ru.ntfns != nil {
+			ru.ntfns.notifyRMSent(ru, last.sendRV, payload)
+		}
+
+		if replyChan != nil {
+			replyChan <- err
+		}
+	}()
+
+	return nil
+}
+
+// handleRMFragment handles a fragment of a fragmented RM. Once all fragments
+// are received, the original RM is reassembled and handled as if it had been
+// received in a single message.
+func (c *Client) handleRMFragment(ru *RemoteUser, frag rpc.RMFragment, ts time.Time) error {
+	var rm []byte
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		complete, err := c.db.StoreRMFragment(tx, ru.ID(), frag, time.Now(),
+			c.cfg.RMFragmentsMaxPending, c.cfg.RMFragmentsMaxPendingSize)
+		if err != nil || !complete {
+			return err
+		}
+		rm, err = c.db.AssembleRMFragments(tx, ru.ID(), frag.ID)
+		return err
+	})
+	if errors.Is(err, clientdb.ErrRMFragmentsLimit) {
+		ru.log.Warnf("Dropping fragment %d/%d of RM %s: %v", frag.Index+1,
+			frag.Count, frag.ID, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rm == nil {
+		ru.log.Debugf("Received fragment %d/%d of RM %s", frag.Index+1,
+			frag.Count, frag.ID)
+		return nil
+	}
+
+	h, p, err := rpc.DecomposeRM(ru.verifyMessage, rm, rpc.MaxFragmentedRMSize)
+	if err != nil {
+		return fmt.Errorf("unable to decompose reassembled RM %s: %v",
+			frag.ID, err)
+	}
+	if h.Version != rpc.RMHeaderVersion {
+		return fmt.Errorf("reassembled RM %s has header version %d, want %d",
+			frag.ID, h.Version, rpc.RMHeaderVersion)
+	}
+	if !isFragmentableRM(p) {
+		return fmt.Errorf("reassembled RM %s has unexpected payload %T",
+			frag.ID, p)
+	}
+
+	ru.log.Debugf("Reassembled %q from %d fragments", h.Command, frag.Count)
+	if done := c.handleUserRM(ru, h, p, ts); done != nil {
+		<-done
+	}
+	return nil
+}
+
+// cleanupStaleRMFragments removes fragmented RMs that have not been completely
+// received within the configured max lifetime.
+func (c *Client) cleanupStaleRMFragments() {
+	limit := time.Now().Add(-c.cfg.RMFragmentsMaxLifetime)
+	var removed int
+	err := c.dbUpdate(func(tx clientdb.ReadWriteTx) error {
+		var err error
+		removed, err = c.db.RemoveStaleRMFragments(tx, limit)
+		return err
+	})
+	if err != nil {
+		c.log.Warnf("Unable to cleanup stale RM fragments: %v", err)
+	} else if removed > 0 {
+		c.log.Infof("Removed %d incomplete fragmented messages", removed)
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// TestRMFragmentsLimits tests that the number and size of the incomplete
+// fragmented RMs stored per user are limited.
+func TestRMFragmentsLimits(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	db := testDB(t, id, nil)
+	runTestDB(t, db)
+	ctx := context.Background()
+
+	const maxPending, maxPendingSize = 2, 1000
+	bob, charlie := UserID{0: 0x01}, UserID{0: 0x02}
+	store := func(uid UserID, frag rpc.RMFragment) error {
+		return db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+			_, err := db.StoreRMFragment(tx, uid, frag, time.Now(),
+				maxPending, maxPendingSize)
+			return err
+		})
+	}
+	frag := func(id byte, index, size uint32, dataLen int) rpc.RMFragment {
+		return rpc.RMFragment{
+			ID:    zkidentity.ShortID{0: id},
+			Index: index,
+			Count: 3,
+			Size:  size,
+			Data:  make([]byte, dataLen),
+		}
+	}
+
+	// The pending size is limited.
+	assert.NilErr(t, store(bob, frag(1, 0, 600, 100)))
+	assert.ErrorIs(t, store(bob, frag(2, 0, 401, 100)), clientdb.ErrRMFragmentsLimit)
+
+	// The number of pending RMs is limited.
+	assert.NilErr(t, store(bob, frag(2, 0, 400, 100)))
+	assert.ErrorIs(t, store(bob, frag(3, 0, 10, 5)), clientdb.ErrRMFragmentsLimit)
+
+	// Fragments of already pending RMs are still accepted.
+	assert.NilErr(t, store(bob, frag(1, 1, 600, 100)))
+
+	// Fragments may not store more data than the declared size.
+	assert.NonNilErr(t, store(bob, frag(2, 1, 400, 301)))
+
+	// Limits are per user.
+	assert.NilErr(t, store(charlie, frag(3, 0, 10, 5)))
+
+	// Removing the stale RMs allows new ones to be stored.
+	err := db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
+		_, err := db.RemoveStaleRMFragments(tx, time.Now().Add(time.Hour))
+		return err
+	})
+	assert.NilErr(t, err)
+	assert.NilErr(t, store(bob, frag(3, 0, 10, 5)))
+}
@@ -50,8 +50,11 @@ func (c *Client) addToSendQ(typ string, rmOrFileChunk interface{}, priority uint
 		estSize = rpc.EstimateRoutedRMWireSize(len(blob))
 	}
 
+	// Large fragmentable messages are split into fragments when sent.
+	fragmentable := isFragmentableRM(rmOrFileChunk) &&
+		len(blob) <= rpc.MaxFragmentedRMSize
 	maxMsgSize := int(c.q.MaxMsgSize())
-	if estSize > maxMsgSize {
+	if estSize > maxMsgSize && !fragmentable {
 		return sendqID, fmt.Errorf("cannot enqueue message %T "+
 			"estimated as larger than max message size %d > %d: %w",
 			rmOrFileChunk, estSize, maxMsgSize, errRMTooLarge)
@@ -351,7 +354,7 @@ func (c *Client) runSendQ(ctx context.Context) error {
 			return err
 		} else {
 			_, sel.rm, err = rpc.DecomposeRM(c.localID.verifyMessage,
-				sel.qel.Msg, uint(c.q.MaxMsgSize()))
+				sel.qel.Msg, rpc.MaxFragmentedRMSize)
 			if err != nil {
 				return fmt.Errorf("unable to decompose queued RM %s: %v",
 					sel.qel.Type, err)
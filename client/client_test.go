@@ -42,3 +42,19 @@ func TestCanceledRunTerminates(t *testing.T) {
 		t.Fatal("timeout waiting for Run() to complete")
 	}
 }
+
+// TestNewInvalidConfig tests that creating a client with an invalid config
+// fails.
+func TestNewInvalidConfig(t *testing.T) {
+	rnd := testRand(t)
+	id := testID(t, rnd, "alice")
+	cfg := Config{
+		DB:                        testDB(t, id, nil),
+		LocalIDIniter:             fixedIDIniter(id),
+		Logger:                    func(string) slog.Logger { return slog.Disabled },
+		FeedBridgeMaxPostsPerPoll: -1,
+	}
+	if _, err := New(cfg); err == nil {
+		t.Fatal("unexpected success creating client with invalid config")
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
@@ -20,6 +22,8 @@ import (
 const (
 	sharedContentDir      = "shared"
 	sharedEveryone        = "everyone"
+	gcSharedContentDir    = "gcshared"
+	gcSharePrefix         = "gc:"
 	contentMetaExt        = ".cr-meta"
 	chunkDirSuffix        = ".chunks"
 	uploadsDir            = "uploads"
@@ -173,9 +177,39 @@ func (cb *DB) CalcFileChunks(tx ReadWriteTx, fname string, chunkSize uint64,
 
 // ShareFile registers the given file as a shared file.
 //
-// If uid is nil, then the file is registered as shared among all users.
+// If uid is nil, then the file is registered as shared among all users. The
+// attributes are only stored when the file is shared for the first time.
 func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
-	cost uint64, descr string, sign func([]byte) ([]byte, error)) (SharedFile, rpc.FileMetadata, error) {
+	cost uint64, descr string, attrs map[string]string,
+	sign func([]byte) ([]byte, error)) (SharedFile, rpc.FileMetadata, error) {
+
+	shareDir := sharedContentDir
+	thisShare := sharedEveryone
+	if uid != nil {
+		shareDir = filepath.Join(inboundDir, uid.String(), sharedContentDir)
+		thisShare = uid.String()
+	}
+	return db.shareFile(fname, shareDir, thisShare, cost, descr, attrs, sign)
+}
+
+// ShareFileWithGC registers the given file as shared with the members of the
+// given GC. Access to the file is determined by the GC membership at the time
+// the file is requested.
+func (db *DB) ShareFileWithGC(tx ReadWriteTx, fname string, gcID zkidentity.ShortID,
+	cost uint64, descr string, attrs map[string]string,
+	sign func([]byte) ([]byte, error)) (SharedFile, rpc.FileMetadata, error) {
+
+	shareDir := filepath.Join(gcSharedContentDir, gcID.String())
+	thisShare := gcSharePrefix + gcID.String()
+	return db.shareFile(fname, shareDir, thisShare, cost, descr, attrs, sign)
+}
+
+// shareFile registers the given file as a shared file in the given share dir
+// (relative to the db root). thisShare identifies the share in the list of
+// shares of the file.
+func (db *DB) shareFile(fname, shareDir, thisShare string,
+	cost uint64, descr string, attrs map[string]string,
+	sign func([]byte) ([]byte, error)) (SharedFile, rpc.FileMetadata, error) {
 
 	var f SharedFile
 	var md rpc.FileMetadata
@@ -218,6 +252,7 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 			Description: descr,
 			Cost:        cost,
 			Filename:    baseName,
+			Attributes:  attrs,
 		}
 
 		// File is being shared for the first time. Chunk the file.
@@ -248,10 +283,6 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 	f.FID = md.MetadataHash()
 	metaMetaFname := filepath.Join(chunksPath, f.FID.String()+contentMetaHashSuffix)
 	var shares []string
-	thisShare := sharedEveryone
-	if uid != nil {
-		thisShare = uid.String()
-	}
 	if fileExists(metaMetaFname) {
 		if err := db.readJsonFile(metaMetaFname, &shares); err != nil {
 			return f, md, err
@@ -273,12 +304,7 @@ func (db *DB) ShareFile(tx ReadWriteTx, fname string, uid *UserID,
 		return f, md, err
 	}
 
-	// Now deal with the actual sharing of the file. If it's a global share,
-	// put in the global share dir, otherwise put in the user's share dir.
-	shareDir := sharedContentDir
-	if uid != nil {
-		shareDir = filepath.Join(inboundDir, uid.String(), sharedContentDir)
-	}
+	// Now deal with the actual sharing of the file.
 	shareDir = filepath.Join(db.root, shareDir)
 	shareFname := filepath.Join(shareDir, f.FID.String())
 	if err := db.saveJsonFile(shareFname, f); err != nil {
@@ -316,13 +342,42 @@ func (db *DB) FindSharedFileID(tx ReadTx, fname string) (FileID, error) {
 // Unshare the file with the given user or globally. If the file is no longer
 // noted as shared with anyone, the content is removed.
 func (db *DB) UnshareFile(tx ReadWriteTx, fid FileID, uid *UserID) error {
-	// First, read the SharedFile metadata from the share.
 	shareDir := sharedContentDir
 	thisShare := sharedEveryone
 	if uid != nil {
 		shareDir = filepath.Join(inboundDir, uid.String(), sharedContentDir)
 		thisShare = uid.String()
 	}
+	return db.unshareFile(fid, shareDir, thisShare)
+}
+
+// UnshareFileWithGC stops sharing the file with the members of the given GC.
+// If the file is no longer noted as shared with anyone, the content is removed.
+func (db *DB) UnshareFileWithGC(tx ReadWriteTx, fid FileID, gcID zkidentity.ShortID) error {
+	shareDir := filepath.Join(gcSharedContentDir, gcID.String())
+	thisShare := gcSharePrefix + gcID.String()
+	return db.unshareFile(fid, shareDir, thisShare)
+}
+
+// unshareAllGCFiles stops sharing all files shared with the given GC.
+func (db *DB) unshareAllGCFiles(tx ReadWriteTx, gcID zkidentity.ShortID) error {
+	dir := filepath.Join(db.root, gcSharedContentDir, gcID.String())
+	shares, err := db.sharedFilesFromDirs([]string{dir})
+	if err != nil {
+		return err
+	}
+	for _, sf := range shares {
+		if err := db.UnshareFileWithGC(tx, sf.FID, gcID); err != nil {
+			return err
+		}
+	}
+	return removeIfExists(dir)
+}
+
+// unshareFile removes the share of the given file from the given share dir
+// (relative to the db root).
+func (db *DB) unshareFile(fid FileID, shareDir, thisShare string) error {
+	// First, read the SharedFile metadata from the share.
 	shareDir = filepath.Join(db.root, shareDir)
 	shareFname := filepath.Join(shareDir, fid.String())
 
@@ -410,6 +465,17 @@ func (db *DB) ListSharedFiles(tx ReadTx, uid *UserID) ([]rpc.FileMetadata, error
 	if uid != nil {
 		shareDir = filepath.Join(inboundDir, uid.String(), sharedContentDir)
 	}
+	return db.listSharedFilesInDir(shareDir)
+}
+
+// ListGCSharedFiles lists the files shared with the members of the given GC.
+func (db *DB) ListGCSharedFiles(tx ReadTx, gcID zkidentity.ShortID) ([]rpc.FileMetadata, error) {
+	return db.listSharedFilesInDir(filepath.Join(gcSharedContentDir, gcID.String()))
+}
+
+// listSharedFilesInDir lists the metadata of the files shared in the given
+// share dir (relative to the db root).
+func (db *DB) listSharedFilesInDir(shareDir string) ([]rpc.FileMetadata, error) {
 	shareDir = filepath.Join(db.root, shareDir)
 
 	// List shares.
@@ -478,6 +544,7 @@ func (db *DB) ListAllSharedFiles(tx ReadTx) ([]SharedFileAndShares, error) {
 		// Check if it was globally shared.
 		global := false
 		uids := make([]clientintf.ID, 0, len(shares))
+		var gcs []zkidentity.ShortID
 		for i := range shares {
 			if shares[i] == sharedEveryone {
 				// Globally shared! Remove from list.
@@ -485,6 +552,19 @@ func (db *DB) ListAllSharedFiles(tx ReadTx) ([]SharedFileAndShares, error) {
 				continue
 			}
 
+			// Shared to a GC.
+			if strings.HasPrefix(shares[i], gcSharePrefix) {
+				var gcID zkidentity.ShortID
+				err := gcID.FromString(shares[i][len(gcSharePrefix):])
+				if err != nil {
+					db.log.Warnf("Not a GC ID (%q) in shares file %s: %v",
+						shares[i], sharesFname, err)
+					continue
+				}
+				gcs = append(gcs, gcID)
+				continue
+			}
+
 			// Shared to a user.
 			var uid clientintf.ID
 			if err := uid.FromString(shares[i]); err != nil {
@@ -501,10 +581,11 @@ func (db *DB) ListAllSharedFiles(tx ReadTx) ([]SharedFileAndShares, error) {
 				FID:      fid,
 				Filename: fm.Filename,
 			},
-			Cost:   fm.Cost,
-			Size:   fm.Size,
-			Global: global,
-			Shares: uids,
+			Cost:     fm.Cost,
+			Size:     fm.Size,
+			Global:   global,
+			Shares:   uids,
+			GCShares: gcs,
 		})
 	}
 
@@ -546,7 +627,41 @@ func (db *DB) GetSharedFileForUpload(tx ReadTx, uid UserID, fid FileID) (SharedF
 	}
 
 	// Not globally shared. See if shared with user.
-	return db.GetSharedFile(tx, &uid, fid)
+	f, md, err = db.GetSharedFile(tx, &uid, fid)
+	if err == nil || !errors.Is(err, ErrNotFound) {
+		return f, md, err
+	}
+
+	// Not shared with user. See if shared with a GC the user is a member
+	// of.
+	pattern := filepath.Join(db.root, gcSharedContentDir, "*", fid.String())
+	files, globErr := filepath.Glob(pattern)
+	if globErr != nil {
+		return f, md, globErr
+	}
+	for _, fname := range files {
+		var gcID zkidentity.ShortID
+		if err := gcID.FromString(filepath.Base(filepath.Dir(fname))); err != nil {
+			continue
+		}
+		gc, err := db.GetGC(tx, gcID)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(gc.Metadata.Members, uid) {
+			continue
+		}
+		if blockList, _ := db.GetGCBlockList(tx, gcID); blockList.IsBlocked(uid) {
+			continue
+		}
+		if err := db.readJsonFile(fname, &f); err != nil {
+			return f, md, err
+		}
+		md, err = db.fileMetadataForSharedFile(&f)
+		return f, md, err
+	}
+
+	return f, md, err
 }
 
 // readOrNewChunkUpload reads an existing or creates a new chunk upload
@@ -798,6 +913,51 @@ func (db *DB) ReadFileDownload(tx ReadTx, uid UserID, fid FileID) (FileDownload,
 	return fd, nil
 }
 
+// ReadFileDownloadChunk reads the data of a chunk of a download. This works
+// for both in-progress and completed downloads. Returns ErrNotFound if the
+// chunk has not been downloaded yet.
+func (db *DB) ReadFileDownloadChunk(tx ReadTx, fd *FileDownload, chunkIdx int) ([]byte, error) {
+	if fd.Metadata == nil {
+		return nil, fmt.Errorf("file metadata is nil")
+	}
+	if chunkIdx < 0 || chunkIdx >= len(fd.Metadata.Manifest) {
+		return nil, fmt.Errorf("chunk index %d out of bounds", chunkIdx)
+	}
+	ch := fd.Metadata.Manifest[chunkIdx]
+
+	if fd.DiskPath != "" {
+		// Completed download. Read from the final file.
+		var offset int64
+		for _, mch := range fd.Metadata.Manifest[:chunkIdx] {
+			offset += int64(mch.Size)
+		}
+		f, err := os.Open(fd.DiskPath)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("downloaded file %s: %w",
+				fd.DiskPath, ErrNotFound)
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		data := make([]byte, ch.Size)
+		if _, err := f.ReadAt(data, offset); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	diskDir := filepath.Join(db.root, downloadingDir)
+	chunkDir := filepath.Join(diskDir, fd.FID.String()+chunkDirSuffix)
+	chunkPath := filepath.Join(chunkDir, hex.EncodeToString(ch.Hash))
+	data, err := os.ReadFile(chunkPath)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("chunk %d of file %s: %w", chunkIdx,
+			fd.FID, ErrNotFound)
+	}
+	return data, err
+}
+
 // CancelFileDownload removes the in-progress download from the DB.
 func (db *DB) CancelFileDownload(tx ReadWriteTx, fid FileID) error {
 	diskDir := filepath.Join(db.root, downloadingDir)
@@ -0,0 +1,35 @@
+package clientdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"path/filepath"
+)
+
+// importedFeedFname returns the file that tracks the imported items of the
+// given feed source. Sources are arbitrary URLs or paths, so their hash is
+// used as filename.
+func (db *DB) importedFeedFname(source string) string {
+	h := sha256.Sum256([]byte(source))
+	return filepath.Join(db.root, feedBridgeDir, hex.EncodeToString(h[:]))
+}
+
+// ReadImportedFeed returns the items already imported from the given feed
+// source. An empty feed is returned if no items were imported yet.
+func (db *DB) ReadImportedFeed(tx ReadTx, source string) (ImportedFeed, error) {
+	feed := ImportedFeed{Source: source}
+	err := db.readJsonFile(db.importedFeedFname(source), &feed)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	if feed.Items == nil {
+		feed.Items = make(map[string]ImportedFeedItem)
+	}
+	return feed, err
+}
+
+// SaveImportedFeed saves the list of items imported from a feed.
+func (db *DB) SaveImportedFeed(tx ReadWriteTx, feed ImportedFeed) error {
+	return db.saveJsonFile(db.importedFeedFname(feed.Source), feed)
+}
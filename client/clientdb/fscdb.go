@@ -43,6 +43,19 @@ const (
 	postsSubscribers    = "subscribers"
 	postsSubscriptions  = "subscriptns"
 	postsStatusExt      = ".status"
+	postsRevisionsExt   = ".revisions"
+	postAudiencesFile   = "audiences.json"
+	paidPostsDir        = "paidposts"
+	postModerationDir   = "postmoderation"
+	postTagsDir         = "posttags"
+	postExpirationsFile = "postexpirations.json"
+	feedBridgeDir       = "feedbridge"
+	postDraftsDir       = "postdrafts"
+	paymentRequestsDir  = "paymentrequests"
+	recurringPaysDir    = "recurringpayments"
+	recurringTiersFile  = "recurringtiers.json"
+	gcTipsDir           = "gctips"
+	onchainTipsDir      = "onchaintips"
 	kxDir               = "kx"
 	transResetFile      = "transreset.json"
 	sendqDir            = "sendqueue"
@@ -54,10 +67,13 @@ const (
 	postKXActionsDir    = "postkxactions"
 	initKXActionsDir    = "initkxactions"
 	payStatsFile        = "paystats.json"
+	payLedgerFile       = "payledger.json"
 	unackedRMsDir       = "unackedrms"
 	lastConnDateFile    = "lastconndate.json"
 	tipsDir             = "tips"
 	onboardStateFile    = "onboard.json"
+	lnLiquidityFile     = "lnliquidity.json"
+	payBudgetSpendsFile = "paybudgetspends.json"
 	reqResourcesDir     = "reqresources"
 	recvAddrForUserFile = "onchainrecvaddr.json"
 	cachedGCMsDir       = "cachedgcms"
@@ -67,6 +83,8 @@ const (
 	embedsDir           = "embeds"
 	serverCertsFile     = "knownservers.json"
 	suggestKXDir        = "suggestkx"
+	rmFragmentsDir      = "rmfragments"
+	rmFragmentsFile     = "fragments.json"
 
 	pageSessionsDir         = "pagesessions"
 	pageSessionOverviewFile = "overview.json"
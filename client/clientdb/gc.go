@@ -393,9 +393,13 @@ func (db *DB) DeleteGC(tx ReadWriteTx, gcID zkidentity.ShortID) error {
 	}
 	blockListFname := filename + gcBlockListExt
 	if fileExists(blockListFname) {
-		return os.Remove(blockListFname)
+		if err := os.Remove(blockListFname); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	// Files shared with the GC are no longer accessible.
+	return db.unshareAllGCFiles(tx, gcID)
 }
 
 func (db *DB) ListGCs(tx ReadTx) ([]GroupChat, error) {
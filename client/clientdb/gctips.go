@@ -0,0 +1,59 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// gcTipFname returns the file where the group chat tip with the given ID is
+// stored.
+func (db *DB) gcTipFname(id zkidentity.ShortID) string {
+	return filepath.Join(db.root, gcTipsDir, id.String())
+}
+
+// StoreGCTip creates or replaces a group chat tip.
+func (db *DB) StoreGCTip(tx ReadWriteTx, gt GCTip) error {
+	return db.saveJsonFile(db.gcTipFname(gt.ID), gt)
+}
+
+// ReadGCTip reads the group chat tip with the given ID.
+func (db *DB) ReadGCTip(tx ReadTx, id zkidentity.ShortID) (GCTip, error) {
+	var gt GCTip
+	err := db.readJsonFile(db.gcTipFname(id), &gt)
+	if errors.Is(err, ErrNotFound) {
+		err = fmt.Errorf("gc tip %s: %w", id, ErrNotFound)
+	}
+	return gt, err
+}
+
+// ListGCTips lists the group chat tips, oldest first.
+func (db *DB) ListGCTips(tx ReadTx) ([]GCTip, error) {
+	dir := filepath.Join(db.root, gcTipsDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res []GCTip
+	for _, entry := range entries {
+		fname := filepath.Join(dir, entry.Name())
+		var gt GCTip
+		if err := db.readJsonFile(fname, &gt); err != nil {
+			db.log.Warnf("Unable to read gc tip %s: %v", fname, err)
+			continue
+		}
+		res = append(res, gt)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Created.Before(res[j].Created)
+	})
+	return res, nil
+}
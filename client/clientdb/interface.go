@@ -13,6 +13,7 @@ import (
 	"github.com/companyzero/bisonrelay/ratchet/disk"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
 	"golang.org/x/exp/slices"
 )
 
@@ -44,6 +45,10 @@ const (
 	KXStageUnknown KXStage = iota
 	KXStageStep2IDKX
 	KXStageStep3IDKX
+
+	// KXStageStep2PayKX is the stage where the source user waits for the
+	// target user to pay the invoice required to complete the KX.
+	KXStageStep2PayKX
 )
 
 func (stage KXStage) String() string {
@@ -54,6 +59,8 @@ func (stage KXStage) String() string {
 		return "step2idkx"
 	case KXStageStep3IDKX:
 		return "step3idkx"
+	case KXStageStep2PayKX:
+		return "step2paykx"
 	default:
 		return fmt.Sprintf("[unknown %d]", stage)
 	}
@@ -64,8 +71,8 @@ func (stage KXStage) String() string {
 // invite and the "target" user is the one accepting the invite.
 type KXData struct {
 	// Public is the identity of the remote user. This is empty for the
-	// source user and filled with the source user's public id on the
-	// target user.
+	// source user (until a payment is requested from the target user) and
+	// filled with the source user's public id on the target user.
 	Public zkidentity.PublicIdentity `json:"public"`
 
 	// InitialRV is the random RV generated by the source user.
@@ -105,6 +112,25 @@ type KXData struct {
 	// MediatorID is the identity of a remote user that requested this
 	// invite be created (not the source user).
 	MediatorID *UserID `json:"mediator_id"`
+
+	// PaymentMAtoms is the amount required by the source user to complete
+	// the KX. On the source user, this is the price set when the invite
+	// was created. On the target user, this is the price advertised in
+	// the invite.
+	PaymentMAtoms int64 `json:"payment_matoms,omitempty"`
+
+	// Invoice is the invoice that the target user must pay to complete
+	// the KX.
+	Invoice string `json:"invoice,omitempty"`
+
+	// PayRV is the random RV generated by the source user, where the
+	// target user sends the RMOHalfKX after paying the invoice.
+	PayRV RawRVID `json:"pay_rv"`
+
+	// HalfKX is filled by the target user when the invite requires a
+	// payment, so that the RMOHalfKX may be sent again after the
+	// payment.
+	HalfKX *ratchet.KeyExchange `json:"half_kx,omitempty"`
 }
 
 // AddressBookEntry stores contact information of a remote user.
@@ -186,6 +212,7 @@ func (gc *GroupChat) DeepCopy() GroupChat {
 	// opposed to pointers).
 	res.Metadata.Members = slices.Clone(gc.Metadata.Members)
 	res.Metadata.ExtraAdmins = slices.Clone(gc.Metadata.ExtraAdmins)
+	res.Metadata.TipShares = slices.Clone(gc.Metadata.TipShares)
 	return res
 }
 
@@ -228,6 +255,9 @@ type SharedFileAndShares struct {
 	Size   uint64          `json:"size"`
 	Global bool            `json:"global"`
 	Shares []clientintf.ID `json:"shares"`
+
+	// GCShares is the list of GCs the file is shared with.
+	GCShares []zkidentity.ShortID `json:"gc_shares,omitempty"`
 }
 
 type ChunkState string
@@ -312,6 +342,419 @@ type PostSummary struct {
 	Date         time.Time `json:"date"`
 	LastStatusTS time.Time `json:"last_status_ts"`
 	Title        string    `json:"title"`
+
+	// LastRevision is the number of the latest revision of an edited post.
+	// It is zero for posts that were never edited.
+	LastRevision uint32 `json:"last_revision,omitempty"`
+
+	// Tags are the tags defined by the author of the post.
+	Tags []string `json:"tags,omitempty"`
+
+	// Expiration is the time after which the post is deleted. It is zero
+	// for posts that do not expire.
+	Expiration time.Time `json:"expiration,omitempty"`
+}
+
+// HasTag returns true if the post has the given tag.
+func (ps *PostSummary) HasTag(tag string) bool {
+	return slices.Contains(ps.Tags, tag)
+}
+
+// IsExpired returns true if the post has expired at the passed time.
+func (ps *PostSummary) IsExpired(now time.Time) bool {
+	return !ps.Expiration.IsZero() && !now.Before(ps.Expiration)
+}
+
+// PostsQuery selects posts from the list of posts received by the local client.
+// Empty fields do not restrict the selected posts.
+type PostsQuery struct {
+	// Tag selects posts with the given tag.
+	Tag string
+
+	// Author selects posts authored by the given user.
+	Author *UserID
+
+	// Since and Until select posts received (or created) in the given
+	// date range.
+	Since time.Time
+	Until time.Time
+}
+
+// PostAudience is a named list of post subscribers. Posts restricted to an
+// audience are only sent to the subscribers that are members of it.
+type PostAudience struct {
+	Name    string   `json:"name"`
+	Members []UserID `json:"members"`
+}
+
+// IsMember returns true if the given user is a member of the audience.
+func (pa *PostAudience) IsMember(uid UserID) bool {
+	for i := range pa.Members {
+		if pa.Members[i] == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// CommentHoldMode is the mode of holding comments received on local posts for
+// approval.
+type CommentHoldMode string
+
+const (
+	// CommentHoldNone does not hold comments for approval.
+	CommentHoldNone CommentHoldMode = "none"
+
+	// CommentHoldAll holds every comment for approval.
+	CommentHoldAll CommentHoldMode = "all"
+
+	// CommentHoldUnknown holds comments from users that never had a
+	// comment approved.
+	CommentHoldUnknown CommentHoldMode = "unknown"
+)
+
+// PostModerationConfig is the configuration for moderating comments on local
+// posts.
+type PostModerationConfig struct {
+	HoldMode CommentHoldMode `json:"hold_mode"`
+
+	// KnownCommenters are users that had a comment approved. Their
+	// comments are not held in the CommentHoldUnknown mode.
+	KnownCommenters []UserID `json:"known_commenters"`
+}
+
+// ShouldHold returns true if a comment from the given user should be held for
+// approval.
+func (cfg *PostModerationConfig) ShouldHold(uid UserID) bool {
+	switch cfg.HoldMode {
+	case CommentHoldAll:
+		return true
+	case CommentHoldUnknown:
+		for i := range cfg.KnownCommenters {
+			if cfg.KnownCommenters[i] == uid {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// HeldPostComment is a comment on a local post held for approval.
+type HeldPostComment struct {
+	PostID   PostID                 `json:"post_id"`
+	From     UserID                 `json:"from"`
+	Status   rpc.PostMetadataStatus `json:"status"`
+	Received time.Time              `json:"received"`
+}
+
+// PostCommentNode is a comment in the tree of comments of a post.
+type PostCommentNode struct {
+	ID     clientintf.ID
+	Parent *clientintf.ID
+	Status rpc.PostMetadataStatus
+
+	// Depth is the depth of the comment in the tree. Top-level comments
+	// have depth zero.
+	Depth int
+
+	// Children are the replies to the comment, in the order they were
+	// received.
+	Children []*PostCommentNode
+}
+
+// NumReplies returns the total number of replies in the thread started by the
+// comment.
+func (n *PostCommentNode) NumReplies() int {
+	res := len(n.Children)
+	for _, c := range n.Children {
+		res += c.NumReplies()
+	}
+	return res
+}
+
+// PostSale tracks an invoice generated by the local client for a subscriber
+// to unlock the full content of a paid post.
+type PostSale struct {
+	PostID     PostID     `json:"post_id"`
+	UID        UserID     `json:"uid"`
+	Invoice    string     `json:"invoice"`
+	MilliAtoms uint64     `json:"milli_atoms"`
+	Created    time.Time  `json:"created"`
+	Settled    *time.Time `json:"settled,omitempty"`
+	Expired    *time.Time `json:"expired,omitempty"`
+}
+
+// PostSalesStats summarizes the sales of a paid post.
+type PostSalesStats struct {
+	PostID      PostID    `json:"post_id"`
+	Sales       uint64    `json:"sales"`
+	Pending     uint64    `json:"pending"`
+	TotalMAtoms uint64    `json:"total_matoms"`
+	LastSale    time.Time `json:"last_sale"`
+}
+
+// PostPurchase tracks an attempt by the local client to unlock the full
+// content of a paid post.
+type PostPurchase struct {
+	Author     UserID     `json:"author"`
+	PostID     PostID     `json:"post_id"`
+	MilliAtoms uint64     `json:"milli_atoms"`
+	Created    time.Time  `json:"created"`
+	Invoice    string     `json:"invoice,omitempty"`
+	Paid       *time.Time `json:"paid,omitempty"`
+	Unlocked   *time.Time `json:"unlocked,omitempty"`
+	Error      *string    `json:"error,omitempty"`
+}
+
+// PaymentRequestStatus is the status of a payment request.
+type PaymentRequestStatus string
+
+const (
+	// PaymentRequestPending is the status of requests that are waiting
+	// for the payer to accept or decline them.
+	PaymentRequestPending PaymentRequestStatus = "pending"
+
+	// PaymentRequestPaying is the status of received requests that were
+	// accepted and for which the payment is in-flight.
+	PaymentRequestPaying PaymentRequestStatus = "paying"
+
+	// PaymentRequestPaid is the status of requests that were paid.
+	PaymentRequestPaid PaymentRequestStatus = "paid"
+
+	// PaymentRequestDeclined is the status of requests that the payer
+	// declined to pay.
+	PaymentRequestDeclined PaymentRequestStatus = "declined"
+
+	// PaymentRequestExpired is the status of requests that were not paid
+	// before their expiration.
+	PaymentRequestExpired PaymentRequestStatus = "expired"
+)
+
+// PaymentRequest is a request for a payment, either sent by the local client
+// to a remote user (Sent is true) or received from a remote user.
+type PaymentRequest struct {
+	ID         zkidentity.ShortID   `json:"id"`
+	UID        UserID               `json:"uid"`
+	Sent       bool                 `json:"sent"`
+	Invoice    string               `json:"invoice"`
+	MilliAtoms uint64               `json:"milli_atoms"`
+	Memo       string               `json:"memo,omitempty"`
+	Created    time.Time            `json:"created"`
+	Expires    time.Time            `json:"expires"`
+	Status     PaymentRequestStatus `json:"status"`
+	Updated    time.Time            `json:"updated"`
+
+	// Error is the error of the last attempt to pay a received request or
+	// the reason a request was declined.
+	Error string `json:"error,omitempty"`
+}
+
+// IsExpired returns true if the request is still pending after its
+// expiration time.
+func (pr *PaymentRequest) IsExpired(now time.Time) bool {
+	return pr.Status == PaymentRequestPending && now.After(pr.Expires)
+}
+
+// RecurringPaymentStatus is the status of a recurring payment agreement.
+type RecurringPaymentStatus string
+
+const (
+	// RecurringPaymentActive is the status of agreements for which
+	// payments are still being made.
+	RecurringPaymentActive RecurringPaymentStatus = "active"
+
+	// RecurringPaymentCompleted is the status of agreements for which all
+	// periods were paid.
+	RecurringPaymentCompleted RecurringPaymentStatus = "completed"
+
+	// RecurringPaymentCanceled is the status of agreements canceled by
+	// either party.
+	RecurringPaymentCanceled RecurringPaymentStatus = "canceled"
+
+	// RecurringPaymentLapsed is the status of agreements for which the
+	// payment of a period was not made in time.
+	RecurringPaymentLapsed RecurringPaymentStatus = "lapsed"
+)
+
+// RecurringPayment is an agreement to pay a fixed amount to a creator every
+// period. Paying is true when the local client is the supporter making the
+// payments and false when it is the creator receiving them.
+type RecurringPayment struct {
+	ID         zkidentity.ShortID     `json:"id"`
+	UID        UserID                 `json:"uid"`
+	Paying     bool                   `json:"paying"`
+	Tier       string                 `json:"tier,omitempty"`
+	MilliAtoms uint64                 `json:"milli_atoms"`
+	Period     time.Duration          `json:"period"`
+	MaxPeriods uint32                 `json:"max_periods,omitempty"`
+	Created    time.Time              `json:"created"`
+	Status     RecurringPaymentStatus `json:"status"`
+	Updated    time.Time              `json:"updated"`
+
+	// PeriodsPaid is the number of periods paid so far.
+	PeriodsPaid uint32 `json:"periods_paid"`
+
+	// NextPayment is when the payment for the next period is due. For
+	// agreements where the local client is the creator, this is the time
+	// until which the supporter has paid.
+	NextPayment time.Time `json:"next_payment"`
+
+	// PaymentInFlight is true when the local client started the payment
+	// of a period and it has not completed yet.
+	PaymentInFlight bool `json:"payment_in_flight,omitempty"`
+
+	// Error is the error of the last payment or the reason the agreement
+	// was canceled.
+	Error string `json:"error,omitempty"`
+}
+
+// AllPeriodsPaid returns true if the agreement has a max number of periods
+// and all of them have been paid.
+func (rp *RecurringPayment) AllPeriodsPaid() bool {
+	return rp.MaxPeriods > 0 && rp.PeriodsPaid >= rp.MaxPeriods
+}
+
+// IsMember returns true if the supporter of the agreement is a member of its
+// tier at the given time.
+func (rp *RecurringPayment) IsMember(now time.Time) bool {
+	switch rp.Status {
+	case RecurringPaymentActive:
+		return rp.PeriodsPaid > 0
+	case RecurringPaymentCompleted:
+		return now.Before(rp.NextPayment)
+	default:
+		return false
+	}
+}
+
+// RecurringPaymentTier is a tier offered by the local client (as a creator) to
+// supporters that make recurring payments. Agreements for the tier must pay at
+// least MinMilliAtoms at most every MaxPeriod.
+type RecurringPaymentTier struct {
+	Name          string        `json:"name"`
+	MinMilliAtoms uint64        `json:"min_milli_atoms"`
+	MaxPeriod     time.Duration `json:"max_period"`
+}
+
+// Accepts returns true if the given agreement meets the requirements of the
+// tier.
+func (t *RecurringPaymentTier) Accepts(rp *RecurringPayment) bool {
+	return rp.Tier == t.Name && rp.MilliAtoms >= t.MinMilliAtoms &&
+		rp.Period > 0 && rp.Period <= t.MaxPeriod
+}
+
+// GCTipSplitMode is how the total amount of a group chat tip is split among
+// its members.
+type GCTipSplitMode string
+
+const (
+	// GCTipSplitEqual splits the total equally among all members.
+	GCTipSplitEqual GCTipSplitMode = "equal"
+
+	// GCTipSplitShares splits the total among the members weighted by the
+	// shares defined by the admins of the group chat. Members without a
+	// share are not tipped.
+	GCTipSplitShares GCTipSplitMode = "shares"
+
+	// GCTipSplitAdmins splits the total equally among the admins of the
+	// group chat.
+	GCTipSplitAdmins GCTipSplitMode = "admins"
+)
+
+// GCTipMemberStatus is the status of the payout to one member of a group chat
+// tip.
+type GCTipMemberStatus string
+
+const (
+	GCTipMemberPending   GCTipMemberStatus = "pending"
+	GCTipMemberCompleted GCTipMemberStatus = "completed"
+	GCTipMemberFailed    GCTipMemberStatus = "failed"
+)
+
+// GCTipMember is the payout to one member of a group chat tip.
+type GCTipMember struct {
+	UID        UserID            `json:"uid"`
+	MilliAtoms uint64            `json:"milli_atoms"`
+	Status     GCTipMemberStatus `json:"status"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// GCTip is a tip to a whole group chat, split among its members.
+type GCTip struct {
+	ID         zkidentity.ShortID `json:"id"`
+	GCID       zkidentity.ShortID `json:"gcid"`
+	SplitMode  GCTipSplitMode     `json:"split_mode"`
+	MilliAtoms uint64             `json:"milli_atoms"`
+	Created    time.Time          `json:"created"`
+	Completed  *time.Time         `json:"completed,omitempty"`
+	Members    []GCTipMember      `json:"members"`
+}
+
+// Progress returns the number of members for which the payout completed,
+// failed and is still pending, along with the total amount paid so far.
+func (gt *GCTip) Progress() (completed, failed, pending int, paid uint64) {
+	for _, m := range gt.Members {
+		switch m.Status {
+		case GCTipMemberCompleted:
+			completed++
+			paid += m.MilliAtoms
+		case GCTipMemberFailed:
+			failed++
+		default:
+			pending++
+		}
+	}
+	return
+}
+
+// OnchainTipStatus is the status of a tip sent on-chain.
+type OnchainTipStatus string
+
+const (
+	// OnchainTipAddrRequested is the status of tips for which the payer
+	// requested an on-chain address from the payee.
+	OnchainTipAddrRequested OnchainTipStatus = "addrrequested"
+
+	// OnchainTipSent is the status of tips for which the transaction was
+	// sent but is not yet confirmed.
+	OnchainTipSent OnchainTipStatus = "sent"
+
+	// OnchainTipUnverified is the status of received tips for which the
+	// payer sent a receipt that was not yet verified against the local
+	// wallet.
+	OnchainTipUnverified OnchainTipStatus = "unverified"
+
+	// OnchainTipConfirmed is the status of tips for which the transaction
+	// was confirmed.
+	OnchainTipConfirmed OnchainTipStatus = "confirmed"
+
+	// OnchainTipFailed is the status of tips that could not be sent.
+	OnchainTipFailed OnchainTipStatus = "failed"
+)
+
+// OnchainTip is a tip sent through an on-chain transaction, after attempts to
+// pay it through LN failed. Sent is true when the local client is the payer.
+type OnchainTip struct {
+	ID         zkidentity.ShortID `json:"id"`
+	UID        UserID             `json:"uid"`
+	Sent       bool               `json:"sent"`
+	MilliAtoms uint64             `json:"milli_atoms"`
+	Created    time.Time          `json:"created"`
+	Updated    time.Time          `json:"updated"`
+	Status     OnchainTipStatus   `json:"status"`
+	Address    string             `json:"address,omitempty"`
+	TxID       string             `json:"txid,omitempty"`
+
+	// LNError is the error of the LN payment attempts that caused the
+	// fallback to an on-chain payment and LNAttempts is the number of
+	// those attempts.
+	LNError    string `json:"ln_error,omitempty"`
+	LNAttempts int32  `json:"ln_attempts,omitempty"`
+
+	// Error is the reason the tip failed.
+	Error string `json:"error,omitempty"`
 }
 
 type PostSubscription struct {
@@ -436,6 +879,32 @@ type PayStatEvent struct {
 	PayFee    int64  `json:"pay_fee"`
 }
 
+// LedgerEntry is an itemized entry of the payment ledger.
+type LedgerEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	// UID is the user the payment was made to, received from or made on
+	// behalf of (in the case of server fees).
+	UID      UserID                     `json:"uid"`
+	Event    string                     `json:"event"`
+	Category clientintf.PaymentCategory `json:"category"`
+
+	// Amount is the amount of the payment in milliatoms. It is negative for
+	// payments made and positive for payments received.
+	Amount int64 `json:"amount"`
+
+	// PayFee is the fee paid in milliatoms. It is zero or negative.
+	PayFee int64 `json:"pay_fee"`
+
+	// InvoiceHash is the hex-encoded payment hash of the invoice, if the
+	// payment was made or received through a known invoice.
+	InvoiceHash string `json:"invoice_hash,omitempty"`
+
+	// DCRUSDRate is the DCR/USD exchange rate at the time of the payment
+	// or zero if the rate was not known.
+	DCRUSDRate float64 `json:"dcr_usd_rate,omitempty"`
+}
+
 type UserPayStats struct {
 	TotalSent     int64 `json:"total_sent"`
 	TotalReceived int64 `json:"total_received"`
@@ -470,6 +939,14 @@ type TipUserAttempt struct {
 	PrevInvoices         []string   `json:"prev_invoices"`
 	LastInvoiceError     *string    `json:"last_invoice_error,omitempty"`
 	Completed            *time.Time `json:"completed,omitempty"`
+
+	// RecurringPaymentID is set when the tip is the payment of one period
+	// of a recurring payment agreement.
+	RecurringPaymentID *zkidentity.ShortID `json:"recurring_payment_id,omitempty"`
+
+	// GCTipID is set when the tip is the payout to one member of a tip
+	// to a whole group chat.
+	GCTipID *zkidentity.ShortID `json:"gc_tip_id,omitempty"`
 }
 
 // ResourceRequest is a serialized request for a resource.
@@ -759,6 +1236,10 @@ type GeneratedInvoiceForTip struct {
 	Created    time.Time `json:"created"`
 	Invoice    string    `json:"invoice"`
 	MilliAtoms uint64    `json:"milli_atoms"`
+
+	// RecurringPaymentID is set when the invoice was generated for the
+	// payment of one period of a recurring payment agreement.
+	RecurringPaymentID *zkidentity.ShortID `json:"recurring_payment_id,omitempty"`
 }
 
 // ContentFilter stores filtering rules for content.
@@ -787,6 +1268,16 @@ type ContentFilter struct {
 
 	// Regexp is the raw filter to apply.
 	Regexp string
+
+	// PostTag makes this a post tag rule, which only applies to posts. By
+	// default, tag rules mute (i.e. filter) the posts tagged with PostTag
+	// that match Regexp (an empty Regexp matches any post).
+	PostTag string
+
+	// SubscribeTag makes the tag rule a subscription to PostTag: while
+	// there are subscription rules that apply to a user, the posts from
+	// that user are filtered unless they have one of the subscribed tags.
+	SubscribeTag bool
 }
 
 // ReceiveReceipt stores receive receipt times.
@@ -916,6 +1407,139 @@ func (sess *RTDTSession) RMMembersList() []rpc.RMRTDTAdminMember {
 	return res
 }
 
+// ImportedFeedItem is an item of an external RSS/Atom feed that was
+// republished as a local post by the feed bridge.
+type ImportedFeedItem struct {
+	ItemID   string    `json:"item_id"`
+	PostID   PostID    `json:"post_id"`
+	Imported time.Time `json:"imported"`
+}
+
+// ImportedFeed tracks the items of an external feed that were already
+// republished as local posts.
+type ImportedFeed struct {
+	Source     string                      `json:"source"`
+	Title      string                      `json:"title"`
+	LastPolled time.Time                   `json:"last_polled"`
+	Items      map[string]ImportedFeedItem `json:"items"`
+}
+
+// PostDraft is a post being edited by the local client that was not yet
+// published. Drafts may be scheduled to be published at a later time.
+type PostDraft struct {
+	ID          zkidentity.ShortID `json:"id"`
+	Content     string             `json:"content"`
+	Description string             `json:"description,omitempty"`
+	Audience    string             `json:"audience,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Created     time.Time          `json:"created"`
+	Updated     time.Time          `json:"updated"`
+
+	// PublishAt is the time the draft is scheduled to be published. Zero
+	// if the draft is not scheduled.
+	PublishAt time.Time `json:"publish_at,omitempty"`
+
+	// PublishError is the error of the last attempt to publish the draft
+	// at its scheduled time.
+	PublishError string `json:"publish_error,omitempty"`
+
+	// Publishing is true while a post is being created from the draft.
+	Publishing bool `json:"publishing,omitempty"`
+}
+
+// IsDue returns true if the draft is scheduled to be published at or before
+// the passed time and is not already being published.
+func (pd *PostDraft) IsDue(now time.Time) bool {
+	return !pd.Publishing && !pd.PublishAt.IsZero() && !pd.PublishAt.After(now)
+}
+
+// LNLiquidityActionType is the type of an action taken to manage the liquidity
+// of the LN wallet.
+type LNLiquidityActionType string
+
+const (
+	// LNLiquidityRequestInbound is an action to request an inbound channel
+	// from the liquidity provider.
+	LNLiquidityRequestInbound LNLiquidityActionType = "requestinbound"
+
+	// LNLiquidityOpenOutbound is an action to open an outbound channel to
+	// the hub node.
+	LNLiquidityOpenOutbound LNLiquidityActionType = "openoutbound"
+)
+
+// LNLiquidityActionStatus is the status of an action taken to manage the
+// liquidity of the LN wallet.
+type LNLiquidityActionStatus string
+
+const (
+	LNLiquidityActionProposed   LNLiquidityActionStatus = "proposed"
+	LNLiquidityActionPerforming LNLiquidityActionStatus = "performing"
+	LNLiquidityActionCompleted  LNLiquidityActionStatus = "completed"
+	LNLiquidityActionFailed     LNLiquidityActionStatus = "failed"
+	LNLiquidityActionRejected   LNLiquidityActionStatus = "rejected"
+)
+
+// LNLiquidityAction is an action proposed or performed to manage the liquidity
+// of the LN wallet.
+type LNLiquidityAction struct {
+	ID      uint64                  `json:"id"`
+	Type    LNLiquidityActionType   `json:"type"`
+	Status  LNLiquidityActionStatus `json:"status"`
+	Amount  dcrutil.Amount          `json:"amount"`
+	Reason  string                  `json:"reason"`
+	Created time.Time               `json:"created"`
+	Updated time.Time               `json:"updated"`
+
+	// Cost is the amount spent on the action (the fee paid to the
+	// liquidity provider or the funds of the opened channel).
+	Cost dcrutil.Amount `json:"cost,omitempty"`
+
+	// ChannelPoint is the channel opened by the action.
+	ChannelPoint string `json:"channel_point,omitempty"`
+
+	// Error is the reason the action failed.
+	Error string `json:"error,omitempty"`
+}
+
+// IsPending returns true if the action was not yet finished.
+func (a *LNLiquidityAction) IsPending() bool {
+	return a.Status == LNLiquidityActionProposed ||
+		a.Status == LNLiquidityActionPerforming
+}
+
+// LNLiquiditySpend is an amount spent by the LN liquidity manager.
+type LNLiquiditySpend struct {
+	Time   time.Time      `json:"time"`
+	Amount dcrutil.Amount `json:"amount"`
+}
+
+// LNLiquidityState is the state of the LN liquidity manager.
+type LNLiquidityState struct {
+	NextID  uint64              `json:"next_id"`
+	Actions []LNLiquidityAction `json:"actions"`
+	Spends  []LNLiquiditySpend  `json:"spends"`
+}
+
+// PaymentBudgetSpend is an amount spent (or being spent) on a payment that is
+// accounted for in the payment budgets.
+type PaymentBudgetSpend struct {
+	Time       time.Time                  `json:"time"`
+	Category   clientintf.PaymentCategory `json:"category"`
+	MilliAtoms int64                      `json:"milliatoms"`
+}
+
+// InboundRMFragments tracks the fragments received from a remote user for a
+// fragmented RM.
+type InboundRMFragments struct {
+	UID        UserID             `json:"uid"`
+	ID         zkidentity.ShortID `json:"id"`
+	Count      uint32             `json:"count"`
+	Size       uint32             `json:"size"`
+	Received   uint32             `json:"received"`
+	Stored     uint32             `json:"stored"`
+	LastUpdate time.Time          `json:"last_update"`
+}
+
 var (
 	ErrLocalIDEmpty         = errors.New("local ID is not initialized")
 	ErrServerIDEmpty        = errors.New("server ID is not known")
@@ -925,4 +1549,5 @@ var (
 	ErrPostStatusValidation = errors.New("invalid post status update")
 	ErrAlreadyExists        = errors.New("already exists")
 	ErrDuplicatePostStatus  = errors.New("duplicate post status")
+	ErrRMFragmentsLimit     = errors.New("limit of pending fragmented RMs reached")
 )
@@ -40,6 +40,18 @@ func (db *DB) SaveKX(tx ReadWriteTx, kx KXData) error {
 	return os.WriteFile(fname, blob, 0o600)
 }
 
+// UpdateKX updates the data of an existing ongoing KX attempt.
+func (db *DB) UpdateKX(tx ReadWriteTx, kx KXData) error {
+	fname := filepath.Join(db.root, kxDir, kx.InitialRV.String())
+	if _, err := os.Stat(fname); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("kx with initial RV %s: %w", kx.InitialRV, ErrNotFound)
+		}
+		return err
+	}
+	return db.saveJsonFile(fname, kx)
+}
+
 // DeleteKX deletes the given ongoing KX attempt.
 func (db *DB) DeleteKX(tx ReadWriteTx, initialRV RawRVID) error {
 	fname := filepath.Join(db.root, kxDir, initialRV.String())
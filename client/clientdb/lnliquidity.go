@@ -0,0 +1,22 @@
+package clientdb
+
+import (
+	"errors"
+	"path/filepath"
+)
+
+// ReadLNLiquidityState reads the state of the LN liquidity manager. An empty
+// state is returned if none was stored yet.
+func (db *DB) ReadLNLiquidityState(tx ReadTx) (LNLiquidityState, error) {
+	var st LNLiquidityState
+	err := db.readJsonFile(filepath.Join(db.root, lnLiquidityFile), &st)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return st, err
+}
+
+// StoreLNLiquidityState stores the state of the LN liquidity manager.
+func (db *DB) StoreLNLiquidityState(tx ReadWriteTx, st LNLiquidityState) error {
+	return db.saveJsonFile(filepath.Join(db.root, lnLiquidityFile), st)
+}
@@ -0,0 +1,101 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// onchainTipFname returns the file where the on-chain tip with the given ID,
+// sent to or received from the given user, is stored.
+func (db *DB) onchainTipFname(uid UserID, id zkidentity.ShortID) string {
+	return filepath.Join(db.root, onchainTipsDir, uid.String(), id.String())
+}
+
+// StoreOnchainTip creates or replaces an on-chain tip.
+func (db *DB) StoreOnchainTip(tx ReadWriteTx, ot OnchainTip) error {
+	return db.saveJsonFile(db.onchainTipFname(ot.UID, ot.ID), ot)
+}
+
+// ReadOnchainTip reads the on-chain tip with the given ID, sent to or received
+// from the given user.
+func (db *DB) ReadOnchainTip(tx ReadTx, uid UserID, id zkidentity.ShortID) (OnchainTip, error) {
+	var ot OnchainTip
+	err := db.readJsonFile(db.onchainTipFname(uid, id), &ot)
+	if errors.Is(err, ErrNotFound) {
+		err = fmt.Errorf("onchain tip %s: %w", id, ErrNotFound)
+	}
+	return ot, err
+}
+
+// readOnchainTipsDir reads the on-chain tips stored in the given user dir.
+func (db *DB) readOnchainTipsDir(dir string) ([]OnchainTip, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	res := make([]OnchainTip, 0, len(entries))
+	for _, entry := range entries {
+		fname := filepath.Join(dir, entry.Name())
+		var ot OnchainTip
+		if err := db.readJsonFile(fname, &ot); err != nil {
+			db.log.Warnf("Unable to read onchain tip %s: %v",
+				fname, err)
+			continue
+		}
+		res = append(res, ot)
+	}
+	return res, nil
+}
+
+// ReceivedOnchainTipByTxID returns the on-chain tip received from the given
+// user on the given transaction.
+func (db *DB) ReceivedOnchainTipByTxID(tx ReadTx, uid UserID, txid string) (OnchainTip, error) {
+	ots, err := db.readOnchainTipsDir(filepath.Join(db.root, onchainTipsDir, uid.String()))
+	if err != nil {
+		return OnchainTip{}, err
+	}
+	for _, ot := range ots {
+		if !ot.Sent && ot.TxID == txid {
+			return ot, nil
+		}
+	}
+	return OnchainTip{}, fmt.Errorf("onchain tip with tx %s: %w", txid, ErrNotFound)
+}
+
+// ListOnchainTips lists the on-chain tips sent to and received from remote
+// users, oldest first.
+func (db *DB) ListOnchainTips(tx ReadTx) ([]OnchainTip, error) {
+	rootDir := filepath.Join(db.root, onchainTipsDir)
+	userDirs, err := os.ReadDir(rootDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res []OnchainTip
+	for _, userDir := range userDirs {
+		var uid UserID
+		if !userDir.IsDir() || uid.FromString(userDir.Name()) != nil {
+			continue
+		}
+
+		ots, err := db.readOnchainTipsDir(filepath.Join(rootDir, userDir.Name()))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ots...)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Created.Before(res[j].Created)
+	})
+	return res, nil
+}
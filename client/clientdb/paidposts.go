@@ -0,0 +1,235 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+const (
+	paidPostContentDir   = "content"
+	paidPostSalesDir     = "sales"
+	paidPostPurchasesDir = "purchases"
+)
+
+// paidPostContent is the full content of a paid post.
+type paidPostContent struct {
+	Content string    `json:"content"`
+	Stored  time.Time `json:"stored"`
+}
+
+// StorePaidPostContent stores the full content of the paid post pid, authored
+// by author. The content must match the content hash of the post.
+func (db *DB) StorePaidPostContent(tx ReadWriteTx, author UserID, pid PostID,
+	content string) error {
+
+	postFname := filepath.Join(db.root, postsDir, author.String(), pid.String())
+	post, err := db.readPost(postFname)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("post %s: %w", pid, ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	wantHash := post.Attributes[rpc.RMPContentHash]
+	if wantHash == "" || post.Version < rpc.PostMetadataVersionExpiration {
+		return fmt.Errorf("post %s is not a paid post", pid)
+	}
+	if gotHash := rpc.PaidPostContentHash(content); gotHash != wantHash {
+		return fmt.Errorf("paid content hash %s does not match the "+
+			"hash %s of post %s", gotHash, wantHash, pid)
+	}
+
+	fname := filepath.Join(db.root, paidPostsDir, paidPostContentDir,
+		author.String(), pid.String())
+	data := paidPostContent{Content: content, Stored: time.Now()}
+	return db.saveJsonFile(fname, data)
+}
+
+// ReadPaidPostContent returns the full content of a paid post. It returns
+// ErrNotFound if the content has not been unlocked.
+func (db *DB) ReadPaidPostContent(tx ReadTx, author UserID, pid PostID) (string, error) {
+	fname := filepath.Join(db.root, paidPostsDir, paidPostContentDir,
+		author.String(), pid.String())
+	var data paidPostContent
+	if err := db.readJsonFile(fname, &data); err != nil {
+		return "", err
+	}
+	return data.Content, nil
+}
+
+// PaidPostPrice returns the price (in milliatoms) to unlock the given post or
+// zero if the post is not a paid post. The price is only considered on posts
+// where it is part of the hash (and thus of the signature) of the post.
+func PaidPostPrice(post *rpc.PostMetadata) (uint64, error) {
+	s := post.Attributes[rpc.RMPPrice]
+	if s == "" || post.Version < rpc.PostMetadataVersionExpiration {
+		return 0, nil
+	}
+	price, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid post price %q: %v", s, err)
+	}
+	return price, nil
+}
+
+// postSalesFname returns the file that tracks the sales of the given local
+// paid post.
+func (db *DB) postSalesFname(pid PostID) string {
+	return filepath.Join(db.root, paidPostsDir, paidPostSalesDir,
+		pid.String())
+}
+
+// StorePostSale stores a new invoice generated for a subscriber to unlock a
+// paid post.
+func (db *DB) StorePostSale(tx ReadWriteTx, sale PostSale) error {
+	fname := db.postSalesFname(sale.PostID)
+	var sales []PostSale
+	err := db.readJsonFile(fname, &sales)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	for i := range sales {
+		if sales[i].Invoice == sale.Invoice {
+			return fmt.Errorf("sale with invoice: %w", ErrAlreadyExists)
+		}
+	}
+	sales = append(sales, sale)
+	return db.saveJsonFile(fname, sales)
+}
+
+// updatePostSale calls f on the sale with the given invoice and saves the
+// modified list of sales.
+func (db *DB) updatePostSale(pid PostID, invoice string, f func(*PostSale) error) (PostSale, error) {
+	fname := db.postSalesFname(pid)
+	var sales []PostSale
+	if err := db.readJsonFile(fname, &sales); err != nil {
+		return PostSale{}, err
+	}
+	for i := range sales {
+		if sales[i].Invoice != invoice {
+			continue
+		}
+		if err := f(&sales[i]); err != nil {
+			return PostSale{}, err
+		}
+		return sales[i], db.saveJsonFile(fname, sales)
+	}
+	return PostSale{}, fmt.Errorf("sale of post %s: %w", pid, ErrNotFound)
+}
+
+// MarkPostSaleSettled marks the sale of a paid post as settled.
+func (db *DB) MarkPostSaleSettled(tx ReadWriteTx, pid PostID, invoice string,
+	receivedMAtoms int64) (PostSale, error) {
+
+	return db.updatePostSale(pid, invoice, func(sale *PostSale) error {
+		if sale.Settled != nil {
+			return fmt.Errorf("sale already settled")
+		}
+		now := time.Now()
+		sale.Settled = &now
+		sale.MilliAtoms = uint64(receivedMAtoms)
+		return nil
+	})
+}
+
+// MarkPostSaleExpired marks the invoice of a sale of a paid post as expired.
+func (db *DB) MarkPostSaleExpired(tx ReadWriteTx, pid PostID, invoice string) error {
+	_, err := db.updatePostSale(pid, invoice, func(sale *PostSale) error {
+		now := time.Now()
+		sale.Expired = &now
+		return nil
+	})
+	return err
+}
+
+// ListPostSales lists all sales attempts of the given local paid post.
+func (db *DB) ListPostSales(tx ReadTx, pid PostID) ([]PostSale, error) {
+	var sales []PostSale
+	err := db.readJsonFile(db.postSalesFname(pid), &sales)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return sales, err
+}
+
+// ListPendingPostSales lists the sales of all local paid posts for which the
+// invoice has not yet been settled or expired.
+func (db *DB) ListPendingPostSales(tx ReadTx) ([]PostSale, error) {
+	dir := filepath.Join(db.root, paidPostsDir, paidPostSalesDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []PostSale
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		var pid PostID
+		if err := pid.FromString(entry.Name()); err != nil {
+			db.log.Warnf("Entry %s is not a PostID: %v",
+				entry.Name(), err)
+			continue
+		}
+		sales, err := db.ListPostSales(tx, pid)
+		if err != nil {
+			return nil, err
+		}
+		for _, sale := range sales {
+			if sale.Settled == nil && sale.Expired == nil {
+				res = append(res, sale)
+			}
+		}
+	}
+	return res, nil
+}
+
+// PostSalesStats returns a summary of the sales of the given local paid post.
+func (db *DB) PostSalesStats(tx ReadTx, pid PostID) (PostSalesStats, error) {
+	res := PostSalesStats{PostID: pid}
+	sales, err := db.ListPostSales(tx, pid)
+	if err != nil {
+		return res, err
+	}
+	for _, sale := range sales {
+		switch {
+		case sale.Settled != nil:
+			res.Sales += 1
+			res.TotalMAtoms += sale.MilliAtoms
+			if sale.Settled.After(res.LastSale) {
+				res.LastSale = *sale.Settled
+			}
+		case sale.Expired == nil:
+			res.Pending += 1
+		}
+	}
+	return res, nil
+}
+
+// StorePostPurchase stores the state of an attempt to unlock a paid post.
+func (db *DB) StorePostPurchase(tx ReadWriteTx, pp PostPurchase) error {
+	fname := filepath.Join(db.root, paidPostsDir, paidPostPurchasesDir,
+		pp.Author.String(), pp.PostID.String())
+	return db.saveJsonFile(fname, pp)
+}
+
+// ReadPostPurchase reads the state of an attempt to unlock a paid post.
+func (db *DB) ReadPostPurchase(tx ReadTx, author UserID, pid PostID) (PostPurchase, error) {
+	fname := filepath.Join(db.root, paidPostsDir, paidPostPurchasesDir,
+		author.String(), pid.String())
+	var pp PostPurchase
+	err := db.readJsonFile(fname, &pp)
+	return pp, err
+}
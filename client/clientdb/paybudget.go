@@ -0,0 +1,23 @@
+package clientdb
+
+import (
+	"errors"
+	"path/filepath"
+)
+
+// ReadPaymentBudgetSpends reads the spends accounted for in the payment
+// budgets.
+func (db *DB) ReadPaymentBudgetSpends(tx ReadTx) ([]PaymentBudgetSpend, error) {
+	var spends []PaymentBudgetSpend
+	err := db.readJsonFile(filepath.Join(db.root, payBudgetSpendsFile), &spends)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return spends, err
+}
+
+// StorePaymentBudgetSpends stores the spends accounted for in the payment
+// budgets.
+func (db *DB) StorePaymentBudgetSpends(tx ReadWriteTx, spends []PaymentBudgetSpend) error {
+	return db.saveJsonFile(filepath.Join(db.root, payBudgetSpendsFile), spends)
+}
@@ -0,0 +1,52 @@
+package clientdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AppendLedgerEntry appends an entry to the payment ledger. The ledger is
+// append-only: entries are never modified or removed.
+func (db *DB) AppendLedgerEntry(tx ReadWriteTx, entry LedgerEntry) error {
+	fname := filepath.Join(db.root, payLedgerFile)
+	return db.appendToJsonFile(fname, entry)
+}
+
+// ListLedgerEntries lists the entries of the payment ledger recorded in the
+// interval [start, end), in the order they were recorded. A zero start or end
+// time means the interval is unbounded on that side.
+func (db *DB) ListLedgerEntries(tx ReadTx, start, end time.Time) ([]LedgerEntry, error) {
+	fname := filepath.Join(db.root, payLedgerFile)
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res []LedgerEntry
+	dec := json.NewDecoder(f)
+	for {
+		var entry LedgerEntry
+		if err := dec.Decode(&entry); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to decode payment "+
+				"ledger entry: %w", err)
+		}
+		if !start.IsZero() && entry.Timestamp.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !entry.Timestamp.Before(end) {
+			continue
+		}
+		res = append(res, entry)
+	}
+	return res, nil
+}
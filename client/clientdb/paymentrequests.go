@@ -0,0 +1,74 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// paymentRequestFname returns the file where the payment request with the
+// given ID, sent to or received from the given user, is stored.
+func (db *DB) paymentRequestFname(uid UserID, id zkidentity.ShortID) string {
+	return filepath.Join(db.root, paymentRequestsDir, uid.String(), id.String())
+}
+
+// StorePaymentRequest creates or replaces a payment request.
+func (db *DB) StorePaymentRequest(tx ReadWriteTx, pr PaymentRequest) error {
+	return db.saveJsonFile(db.paymentRequestFname(pr.UID, pr.ID), pr)
+}
+
+// ReadPaymentRequest reads the payment request with the given ID, sent to or
+// received from the given user.
+func (db *DB) ReadPaymentRequest(tx ReadTx, uid UserID, id zkidentity.ShortID) (PaymentRequest, error) {
+	var pr PaymentRequest
+	err := db.readJsonFile(db.paymentRequestFname(uid, id), &pr)
+	if errors.Is(err, ErrNotFound) {
+		err = fmt.Errorf("payment request %s: %w", id, ErrNotFound)
+	}
+	return pr, err
+}
+
+// ListPaymentRequests lists the payment requests sent to and received from
+// remote users, newest first.
+func (db *DB) ListPaymentRequests(tx ReadTx) ([]PaymentRequest, error) {
+	rootDir := filepath.Join(db.root, paymentRequestsDir)
+	userDirs, err := os.ReadDir(rootDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res []PaymentRequest
+	for _, userDir := range userDirs {
+		var uid UserID
+		if !userDir.IsDir() || uid.FromString(userDir.Name()) != nil {
+			continue
+		}
+
+		dir := filepath.Join(rootDir, userDir.Name())
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			fname := filepath.Join(dir, entry.Name())
+			var pr PaymentRequest
+			if err := db.readJsonFile(fname, &pr); err != nil {
+				db.log.Warnf("Unable to read payment request %s: %v",
+					fname, err)
+				continue
+			}
+			res = append(res, pr)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Created.After(res[j].Created)
+	})
+	return res, nil
+}
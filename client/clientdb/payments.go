@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 )
 
@@ -187,14 +188,17 @@ func (db *DB) ListOldestValidTipUserAttempts(tx ReadTx, maxLifetime time.Duratio
 }
 
 // StoreGeneratedTipInvoice stores the specified invoice as one generated for
-// the remote client to pay the local client for a tip.
-func (db *DB) StoreGeneratedTipInvoice(tx ReadWriteTx, uid UserID, invoice string, amountMAtoms int64) error {
+// the remote client to pay the local client for a tip. recurringID is set when
+// the tip pays for a period of a recurring payment agreement.
+func (db *DB) StoreGeneratedTipInvoice(tx ReadWriteTx, uid UserID, invoice string,
+	amountMAtoms int64, recurringID *zkidentity.ShortID) error {
 	fname := filepath.Join(db.root, inboundDir, uid.String(), genTipInvoicesFile)
 	data := GeneratedInvoiceForTip{
-		UID:        uid,
-		Created:    time.Now(),
-		Invoice:    invoice,
-		MilliAtoms: uint64(amountMAtoms),
+		UID:                uid,
+		Created:            time.Now(),
+		Invoice:            invoice,
+		MilliAtoms:         uint64(amountMAtoms),
+		RecurringPaymentID: recurringID,
 	}
 	return db.appendToJsonFile(fname, data)
 }
@@ -0,0 +1,95 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// postDraftFname returns the file where the given post draft is stored.
+func (db *DB) postDraftFname(id zkidentity.ShortID) string {
+	return filepath.Join(db.root, postDraftsDir, id.String())
+}
+
+// SavePostDraft creates or replaces a post draft.
+func (db *DB) SavePostDraft(tx ReadWriteTx, draft *PostDraft) error {
+	if draft.ID.IsEmpty() {
+		return errors.New("post draft ID cannot be empty")
+	}
+	return db.saveJsonFile(db.postDraftFname(draft.ID), draft)
+}
+
+// ReadPostDraft returns the post draft with the given ID.
+func (db *DB) ReadPostDraft(tx ReadTx, id zkidentity.ShortID) (*PostDraft, error) {
+	draft := new(PostDraft)
+	if err := db.readJsonFile(db.postDraftFname(id), draft); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("post draft %s: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+	return draft, nil
+}
+
+// RemovePostDraft removes the post draft with the given ID.
+func (db *DB) RemovePostDraft(tx ReadWriteTx, id zkidentity.ShortID) error {
+	err := os.Remove(db.postDraftFname(id))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("post draft %s: %w", id, ErrNotFound)
+	}
+	return err
+}
+
+// ListPostDrafts lists the existing post drafts, most recently updated first.
+func (db *DB) ListPostDrafts(tx ReadTx) ([]PostDraft, error) {
+	dir := filepath.Join(db.root, postDraftsDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := make([]PostDraft, 0, len(entries))
+	for _, entry := range entries {
+		var id zkidentity.ShortID
+		if entry.IsDir() || id.FromString(entry.Name()) != nil {
+			continue
+		}
+		var draft PostDraft
+		fname := filepath.Join(dir, entry.Name())
+		if err := db.readJsonFile(fname, &draft); err != nil {
+			db.log.Warnf("Unable to read post draft %s: %v", fname, err)
+			continue
+		}
+		res = append(res, draft)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Updated.After(res[j].Updated)
+	})
+	return res, nil
+}
+
+// ListDuePostDrafts lists the scheduled post drafts that should be published
+// at the passed time, oldest schedule first.
+func (db *DB) ListDuePostDrafts(tx ReadTx, now time.Time) ([]PostDraft, error) {
+	drafts, err := db.ListPostDrafts(tx)
+	if err != nil {
+		return nil, err
+	}
+	res := drafts[:0]
+	for _, draft := range drafts {
+		if draft.IsDue(now) {
+			res = append(res, draft)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PublishAt.Before(res[j].PublishAt)
+	})
+	return res, nil
+}
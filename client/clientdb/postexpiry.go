@@ -0,0 +1,250 @@
+package clientdb
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// EmbedFilename returns the name of the file used to store embedded data of
+// the given mime type in the embeds dir.
+func EmbedFilename(data []byte, typ string) (string, error) {
+	sp := strings.Split(typ, "/")
+	if len(sp) != 2 {
+		return "", fmt.Errorf("invalid mimetype")
+	}
+	return fmt.Sprintf("%x.%s", sha256.Sum256(data), sp[1]), nil
+}
+
+// postExpirationEntry is an entry in the index of expiring posts.
+type postExpirationEntry struct {
+	From       UserID    `json:"from"`
+	ID         PostID    `json:"id"`
+	Expiration time.Time `json:"expiration"`
+}
+
+// readPostExpirations reads the index of expiring posts.
+func (db *DB) readPostExpirations() ([]postExpirationEntry, error) {
+	var entries []postExpirationEntry
+	err := db.readJsonFile(filepath.Join(db.root, postExpirationsFile), &entries)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return entries, err
+}
+
+// savePostExpirations saves the index of expiring posts.
+func (db *DB) savePostExpirations(entries []postExpirationEntry) error {
+	fname := filepath.Join(db.root, postExpirationsFile)
+	if len(entries) == 0 {
+		return removeIfExists(fname)
+	}
+	return db.saveJsonFile(fname, entries)
+}
+
+// indexPostExpiration adds the post received from the given user to the index
+// of expiring posts, if the post has an expiration.
+func (db *DB) indexPostExpiration(from UserID, post *rpc.PostMetadata) error {
+	expiration := rpc.PostExpiration(post)
+	if expiration.IsZero() {
+		return nil
+	}
+	var pid PostID
+	if err := pid.FromString(post.Attributes[rpc.RMPIdentifier]); err != nil {
+		return err
+	}
+	entries, err := db.readPostExpirations()
+	if err != nil {
+		return err
+	}
+	entry := postExpirationEntry{From: from, ID: pid, Expiration: expiration}
+	for i := range entries {
+		if entries[i].From == from && entries[i].ID == pid {
+			entries[i] = entry
+			return db.savePostExpirations(entries)
+		}
+	}
+	return db.savePostExpirations(append(entries, entry))
+}
+
+// unindexPostExpiration removes the post received from the given user from
+// the index of expiring posts.
+func (db *DB) unindexPostExpiration(from UserID, pid PostID) error {
+	entries, err := db.readPostExpirations()
+	if err != nil {
+		return err
+	}
+	res := entries[:0]
+	for _, entry := range entries {
+		if entry.From != from || entry.ID != pid {
+			res = append(res, entry)
+		}
+	}
+	if len(res) == len(entries) {
+		return nil
+	}
+	return db.savePostExpirations(res)
+}
+
+// addContentEmbeds adds the names of the files extracted from the embeds of
+// the given post content to names.
+func addContentEmbeds(content string, names map[string]struct{}) {
+	for _, idx := range mdembeds.FindAllStringIndex(content) {
+		args := mdembeds.ParseEmbedArgs(content[idx[0]:idx[1]])
+		if len(args.Data) == 0 {
+			continue
+		}
+		fname, err := EmbedFilename(args.Data, args.Typ)
+		if err != nil {
+			continue
+		}
+		names[fname] = struct{}{}
+	}
+}
+
+// addPostEmbeds adds the names of the files extracted from the embeds of the
+// post stored in postFname (including its revisions and unlocked paid content)
+// to names.
+func (db *DB) addPostEmbeds(postFname string, names map[string]struct{}) {
+	post, err := db.readPost(postFname)
+	if err != nil {
+		return
+	}
+	addContentEmbeds(post.Attributes[rpc.RMPMain], names)
+	if revs, err := db.readPostRevisions(postFname + postsRevisionsExt); err == nil {
+		for i := range revs {
+			addContentEmbeds(revs[i].Attributes[rpc.RMPMain], names)
+		}
+	}
+	from, pid := filepath.Base(filepath.Dir(postFname)), filepath.Base(postFname)
+	paidFname := filepath.Join(db.root, paidPostsDir, paidPostContentDir, from, pid)
+	var paid paidPostContent
+	if err := db.readJsonFile(paidFname, &paid); err == nil {
+		addContentEmbeds(paid.Content, names)
+	}
+}
+
+// removeUnusedEmbeds removes the given files extracted from embeds, except the
+// ones that are still referenced by posts other than the one stored in
+// skipFname. Embed files are named after the hash of their data, so the same
+// file may be referenced by multiple posts.
+func (db *DB) removeUnusedEmbeds(names map[string]struct{}, skipFname string) {
+	if db.embedsDir == "" || len(names) == 0 {
+		return
+	}
+
+	postFiles, err := filepath.Glob(filepath.Join(db.root, postsDir, "*", "*"))
+	if err != nil {
+		db.log.Warnf("Unable to list posts to remove embeds: %v", err)
+		return
+	}
+	inUse := make(map[string]struct{})
+	for _, fname := range postFiles {
+		var pid PostID
+		if fname == skipFname || pid.FromString(filepath.Base(fname)) != nil {
+			continue
+		}
+		db.addPostEmbeds(fname, inUse)
+	}
+
+	for fname := range names {
+		if _, ok := inUse[fname]; ok {
+			continue
+		}
+		if err := removeIfExists(filepath.Join(db.embedsDir, fname)); err != nil {
+			db.log.Warnf("Unable to remove embed %s: %v", fname, err)
+		}
+	}
+}
+
+// RemovePost removes the post received from the given user, along with its
+// revisions, comments, receive receipts, unlocked paid content and the
+// extracted embeds not referenced by other posts.
+func (db *DB) RemovePost(tx ReadWriteTx, from UserID, pid PostID) error {
+	if err := db.unindexPostExpiration(from, pid); err != nil {
+		return err
+	}
+
+	postFname := filepath.Join(db.root, postsDir, from.String(), pid.String())
+	post, err := db.readPost(postFname)
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := db.unindexPostTags(from, post); err != nil {
+		return err
+	}
+
+	embeds := make(map[string]struct{})
+	db.addPostEmbeds(postFname, embeds)
+	db.removeUnusedEmbeds(embeds, postFname)
+
+	paidFname := filepath.Join(db.root, paidPostsDir, paidPostContentDir,
+		from.String(), pid.String())
+	files := []string{
+		postFname + postsStatusExt,
+		postFname + postsRevisionsExt,
+		postFname + postRecvReceiptSuff,
+		paidFname,
+	}
+	for _, fname := range files {
+		if err := removeIfExists(fname); err != nil {
+			return err
+		}
+	}
+	dirs := []string{
+		postFname + postCommentRecvReceiptDir,
+		filepath.Join(db.root, postModerationDir, heldCommentsDir, pid.String()),
+	}
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+
+	// Remove the post itself last, so that a failure in the previous steps
+	// is retried.
+	return os.Remove(postFname)
+}
+
+// ListExpiredPosts lists the posts that have expired at the passed time. The
+// posts are found through the index of expiring posts, so that the posts
+// without an expiration are not read.
+func (db *DB) ListExpiredPosts(tx ReadTx, now time.Time) ([]PostSummary, error) {
+	entries, err := db.readPostExpirations()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []PostSummary
+	for _, entry := range entries {
+		if entry.Expiration.After(now) {
+			continue
+		}
+		postFname := filepath.Join(db.root, postsDir, entry.From.String(),
+			entry.ID.String())
+		post, err := db.readPost(postFname)
+		if err != nil {
+			// Still return the entry, so that it is removed from
+			// the index.
+			res = append(res, PostSummary{ID: entry.ID, From: entry.From,
+				Expiration: entry.Expiration})
+			continue
+		}
+		summ := PostSummFromMetadata(post, entry.From)
+		if finfo, err := os.Stat(postFname); err == nil {
+			summ.Date = finfo.ModTime()
+		}
+		res = append(res, summ)
+	}
+	return res, nil
+}
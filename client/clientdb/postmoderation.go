@@ -0,0 +1,132 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+)
+
+const (
+	postModerationCfgFile = "config.json"
+	heldCommentsDir       = "held"
+)
+
+// ReadPostModerationConfig returns the configuration for moderating comments
+// on local posts.
+func (db *DB) ReadPostModerationConfig(tx ReadTx) (PostModerationConfig, error) {
+	fname := filepath.Join(db.root, postModerationDir, postModerationCfgFile)
+	cfg := PostModerationConfig{HoldMode: CommentHoldNone}
+	err := db.readJsonFile(fname, &cfg)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return cfg, err
+}
+
+// SavePostModerationConfig saves the configuration for moderating comments on
+// local posts.
+func (db *DB) SavePostModerationConfig(tx ReadWriteTx, cfg PostModerationConfig) error {
+	switch cfg.HoldMode {
+	case CommentHoldNone, CommentHoldAll, CommentHoldUnknown:
+	default:
+		return fmt.Errorf("unknown comment hold mode %q", cfg.HoldMode)
+	}
+	fname := filepath.Join(db.root, postModerationDir, postModerationCfgFile)
+	return db.saveJsonFile(fname, cfg)
+}
+
+// AddKnownCommenter adds the user to the list of users whose comments are not
+// held in the CommentHoldUnknown mode.
+func (db *DB) AddKnownCommenter(tx ReadWriteTx, uid UserID) error {
+	cfg, err := db.ReadPostModerationConfig(tx)
+	if err != nil {
+		return err
+	}
+	for i := range cfg.KnownCommenters {
+		if cfg.KnownCommenters[i] == uid {
+			return nil
+		}
+	}
+	cfg.KnownCommenters = append(cfg.KnownCommenters, uid)
+	return db.SavePostModerationConfig(tx, cfg)
+}
+
+// heldCommentFname returns the file that stores the given held comment.
+func (db *DB) heldCommentFname(pid PostID, id clientintf.ID) string {
+	return filepath.Join(db.root, postModerationDir, heldCommentsDir,
+		pid.String(), id.String())
+}
+
+// HoldPostComment stores a comment on a local post for later approval.
+func (db *DB) HoldPostComment(tx ReadWriteTx, hc HeldPostComment) error {
+	id := clientintf.ID(hc.Status.Hash())
+	fname := db.heldCommentFname(hc.PostID, id)
+	if fileExists(fname) {
+		return ErrDuplicatePostStatus
+	}
+	return db.saveJsonFile(fname, hc)
+}
+
+// ListHeldPostComments lists the comments on local posts held for approval,
+// ordered by the time they were received.
+func (db *DB) ListHeldPostComments(tx ReadTx) ([]HeldPostComment, error) {
+	pattern := filepath.Join(db.root, postModerationDir, heldCommentsDir,
+		"*", "*")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]HeldPostComment, 0, len(files))
+	for _, fname := range files {
+		if strings.HasPrefix(filepath.Base(fname), ".") {
+			continue
+		}
+		var hc HeldPostComment
+		if err := db.readJsonFile(fname, &hc); err != nil {
+			db.log.Warnf("Unable to read held comment %s: %v",
+				fname, err)
+			continue
+		}
+		res = append(res, hc)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Received.Before(res[j].Received)
+	})
+	return res, nil
+}
+
+// ReadHeldPostComment reads the given comment held for approval.
+func (db *DB) ReadHeldPostComment(tx ReadTx, pid PostID, id clientintf.ID) (HeldPostComment, error) {
+	var hc HeldPostComment
+	err := db.readJsonFile(db.heldCommentFname(pid, id), &hc)
+	return hc, err
+}
+
+// RemoveHeldPostComment removes the given comment from the list of comments
+// held for approval and returns it.
+func (db *DB) RemoveHeldPostComment(tx ReadWriteTx, pid PostID, id clientintf.ID) (HeldPostComment, error) {
+	var hc HeldPostComment
+	fname := db.heldCommentFname(pid, id)
+	if err := db.readJsonFile(fname, &hc); err != nil {
+		return hc, err
+	}
+	if err := os.Remove(fname); err != nil {
+		return hc, err
+	}
+
+	// Remove the post dir if it's empty.
+	dir := filepath.Dir(fname)
+	if dirExistsEmpty(dir) {
+		if err := os.Remove(dir); err != nil {
+			db.log.Warnf("Unable to remove empty held comments dir %s: %v",
+				dir, err)
+		}
+	}
+	return hc, nil
+}
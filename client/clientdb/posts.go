@@ -53,6 +53,8 @@ func PostSummFromMetadata(post *rpc.PostMetadata, from UserID) PostSummary {
 		AuthorID:   authorID,
 		AuthorNick: authorNick,
 		Title:      title,
+		Tags:       rpc.PostTags(post),
+		Expiration: rpc.PostExpiration(post),
 	}
 }
 
@@ -297,6 +299,12 @@ func (db *DB) CreatePost(tx ReadWriteTx, post, descr string, fname string,
 	if err != nil {
 		return summ, p, err
 	}
+	if err := db.indexPostTags(me.Identity, &p); err != nil {
+		return summ, p, err
+	}
+	if err := db.indexPostExpiration(me.Identity, &p); err != nil {
+		return summ, p, err
+	}
 
 	summ = PostSummFromMetadata(&p, me.Identity)
 	summ.Date = time.Unix(timestamp, 0)
@@ -305,10 +313,11 @@ func (db *DB) CreatePost(tx ReadWriteTx, post, descr string, fname string,
 
 // verifyPostStatusUpdate verifies the status update sent by from on the given
 // post is valid. This can be called for both local and received posts.
-func (db *DB) verifyPostStatusUpdate(statusFname string, from UserID, pid PostID,
-	pms *rpc.PostMetadataStatus) error {
+func (db *DB) verifyPostStatusUpdate(postFname string, postAuthor, from UserID,
+	pid PostID, pms *rpc.PostMetadataStatus) error {
 
 	attr := pms.Attributes
+	isRevision := rpc.IsPostRevision(attr)
 
 	// Validate the individual status update.
 	for k, v := range attr {
@@ -327,6 +336,26 @@ func (db *DB) verifyPostStatusUpdate(statusFname string, from UserID, pid PostID
 				return fmt.Errorf("%w: empty comment", ErrPostStatusValidation)
 			}
 
+		case rpc.RMPSRevision:
+			if rev, err := strconv.ParseUint(v, 10, 32); err != nil || rev == 0 {
+				return fmt.Errorf("%w: %q is not a valid revision number",
+					ErrPostStatusValidation, v)
+			}
+
+		case rpc.RMPSModeration:
+			switch v {
+			case rpc.RMPSModRemove, rpc.RMPSModLockComms, rpc.RMPSModUnlockComms:
+			default:
+				return fmt.Errorf("%w: unknown moderation action %q",
+					ErrPostStatusValidation, v)
+			}
+
+		case rpc.RMPMain, rpc.RMPDescription, rpc.RMPTitle:
+			if !isRevision {
+				return fmt.Errorf("%w: %s is only allowed in revisions",
+					ErrPostStatusValidation, k)
+			}
+
 		case rpc.RMPSignature, rpc.RMPNonce, rpc.RMPFromNick, rpc.RMPTimestamp:
 			// Ignore.
 
@@ -350,11 +379,26 @@ func (db *DB) verifyPostStatusUpdate(statusFname string, from UserID, pid PostID
 		}
 	}
 
+	if isRevision {
+		return db.verifyPostRevision(postFname, postAuthor, from, pms)
+	}
+	if rpc.IsPostModeration(attr) {
+		if from != postAuthor {
+			return fmt.Errorf("%w: only the post author may moderate a post",
+				ErrPostStatusValidation)
+		}
+		if attr[rpc.RMPSModeration] == rpc.RMPSModRemove && attr[rpc.RMPParent] == "" {
+			return fmt.Errorf("%w: comment removal without target comment",
+				ErrPostStatusValidation)
+		}
+	}
+
 	// Validate this status update doesn't conflict with an existing one
 	// from the same user.
 	//
 	// TODO: this is slow as it involves loading the entire status update
 	// file. Please improve.
+	statusFname := postFname + postsStatusExt
 	f, err := os.Open(statusFname)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -410,6 +454,60 @@ func (db *DB) verifyPostStatusUpdate(statusFname string, from UserID, pid PostID
 	return nil
 }
 
+// verifyPostRevision verifies the revision sent by from on the given post is
+// valid. Only the author of a post may create revisions of it and revision
+// numbers must be strictly increasing.
+func (db *DB) verifyPostRevision(postFname string, postAuthor, from UserID,
+	pms *rpc.PostMetadataStatus) error {
+
+	if from != postAuthor {
+		return fmt.Errorf("%w: only the post author may revise a post",
+			ErrPostStatusValidation)
+	}
+	if strings.TrimSpace(pms.Attributes[rpc.RMPMain]) == "" {
+		return fmt.Errorf("%w: revision with empty content",
+			ErrPostStatusValidation)
+	}
+
+	revs, err := db.readPostRevisions(postFname + postsRevisionsExt)
+	if err != nil {
+		return err
+	}
+	if len(revs) == 0 {
+		return nil
+	}
+
+	hash := pms.Hash()
+	for i := range revs {
+		if revs[i].Hash() == hash {
+			return ErrDuplicatePostStatus
+		}
+	}
+	rev := PostRevisionNumber(pms)
+	lastRev := PostRevisionNumber(&revs[len(revs)-1])
+	if rev <= lastRev {
+		return fmt.Errorf("%w: revision %d is not newer than current "+
+			"revision %d", ErrPostStatusValidation, rev, lastRev)
+	}
+	return nil
+}
+
+// appendPostStatus appends the status update to the post stored in postFname.
+// Revisions are stored separately from other status updates.
+func (db *DB) appendPostStatus(postFname string, pms *rpc.PostMetadataStatus) error {
+	fname := postFname + postsStatusExt
+	if rpc.IsPostRevision(pms.Attributes) {
+		fname = postFname + postsRevisionsExt
+	}
+	f, err := os.OpenFile(fname, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	e := json.NewEncoder(f)
+	return e.Encode(pms)
+}
+
 // AddPostStatus adds a status update to a post. postFrom is the relayer of the
 // post, while statusFrom is who sent the status update.
 func (db *DB) AddPostStatus(tx ReadWriteTx, postFrom, statusFrom UserID, pid PostID,
@@ -432,23 +530,12 @@ func (db *DB) AddPostStatus(tx ReadWriteTx, postFrom, statusFrom UserID, pid Pos
 	}
 
 	// Verify this status update is valid when coming from the given user.
-	statusFname := filepath.Join(dir, pid.String()+postsStatusExt)
-	if err := db.verifyPostStatusUpdate(statusFname, statusFrom, pid, pms); err != nil {
+	if err := db.verifyPostStatusUpdate(postFname, postAuthor, statusFrom, pid, pms); err != nil {
 		return err
 	}
 
 	// Append to the status update of the post.
-	f, err := os.OpenFile(statusFname, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-	e := json.NewEncoder(f)
-	err = e.Encode(pms)
-	if err != nil {
-		return err
-	}
-	return nil
+	return db.appendPostStatus(postFname, pms)
 }
 
 func (db *DB) SaveReceivedPost(tx ReadWriteTx, from UserID, p rpc.PostMetadata) (PostID, PostSummary, error) {
@@ -481,6 +568,12 @@ func (db *DB) SaveReceivedPost(tx ReadWriteTx, from UserID, p rpc.PostMetadata)
 	if err != nil {
 		return pid, summ, err
 	}
+	if err := db.indexPostTags(from, &p); err != nil {
+		return pid, summ, err
+	}
+	if err := db.indexPostExpiration(from, &p); err != nil {
+		return pid, summ, err
+	}
 
 	summ = PostSummFromMetadata(&p, from)
 	summ.Date = finfo.ModTime()
@@ -524,21 +617,24 @@ func (db *DB) AddPostStatusUpdate(tx ReadWriteTx, from UserID, p rpc.PostMetadat
 		Attributes: p.Attributes,
 	}
 
-	// Verify this status update is valid when coming from the given user.
-	statusFname := filepath.Join(db.root, postsDir, from.String(), pid.String()+postsStatusExt)
-	if err := db.verifyPostStatusUpdate(statusFname, statusFrom, pid, &update); err != nil {
+	// Determine the post author, against which revisions are checked.
+	postFname := filepath.Join(db.root, postsDir, from.String(), pid.String())
+	post, err := db.readPost(postFname)
+	if err != nil {
 		return fail(err)
 	}
+	var postAuthor UserID
+	if id, ok := post.Attributes[rpc.RMPStatusFrom]; ok {
+		_ = postAuthor.FromString(id) // Ok to ingore error
+	}
 
-	// Append to the status update of the post.
-	f, err := os.OpenFile(statusFname, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
-	if err != nil {
+	// Verify this status update is valid when coming from the given user.
+	if err := db.verifyPostStatusUpdate(postFname, postAuthor, statusFrom, pid, &update); err != nil {
 		return fail(err)
 	}
-	defer f.Close()
-	e := json.NewEncoder(f)
-	err = e.Encode(update)
-	if err != nil {
+
+	// Append to the status update of the post.
+	if err := db.appendPostStatus(postFname, &update); err != nil {
 		return fail(err)
 	}
 
@@ -558,6 +654,76 @@ func (db *DB) readPost(fname string) (*rpc.PostMetadata, error) {
 	return pm, err
 }
 
+// readPostRevisions reads the list of revisions stored in the given file.
+func (db *DB) readPostRevisions(fname string) ([]rpc.PostMetadataStatus, error) {
+	f, err := os.Open(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d := json.NewDecoder(f)
+	var res []rpc.PostMetadataStatus
+	for {
+		var pms rpc.PostMetadataStatus
+		err := d.Decode(&pms)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		res = append(res, pms)
+	}
+	return res, nil
+}
+
+// readLatestPostRevision reads the post stored in fname, with the contents of
+// its latest revision applied. It also returns the latest revision number.
+func (db *DB) readLatestPostRevision(fname string) (*rpc.PostMetadata, uint32, error) {
+	post, err := db.readPost(fname)
+	if err != nil {
+		return nil, 0, err
+	}
+	revs, err := db.readPostRevisions(fname + postsRevisionsExt)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(revs) == 0 {
+		return post, 0, nil
+	}
+	lastRev := &revs[len(revs)-1]
+	*post = ApplyPostRevision(*post, lastRev)
+	return post, PostRevisionNumber(lastRev), nil
+}
+
+// PostRevisionNumber returns the revision number of the given post revision.
+// This returns zero if the status update is not a revision.
+func PostRevisionNumber(pms *rpc.PostMetadataStatus) uint32 {
+	rev, _ := strconv.ParseUint(pms.Attributes[rpc.RMPSRevision], 10, 32)
+	return uint32(rev)
+}
+
+// ApplyPostRevision returns a copy of the post with its contents replaced by
+// the ones of the given revision.
+func ApplyPostRevision(post rpc.PostMetadata, rev *rpc.PostMetadataStatus) rpc.PostMetadata {
+	attrs := make(map[string]string, len(post.Attributes))
+	for k, v := range post.Attributes {
+		attrs[k] = v
+	}
+	for _, k := range []string{rpc.RMPMain, rpc.RMPDescription, rpc.RMPTitle} {
+		if v, ok := rev.Attributes[k]; ok {
+			attrs[k] = v
+		} else {
+			delete(attrs, k)
+		}
+	}
+	post.Attributes = attrs
+	return post
+}
+
 // ListPosts returns a summary of all received posts.
 func (db *DB) ListPosts(tx ReadTx) ([]PostSummary, error) {
 	rootDir := filepath.Join(db.root, postsDir)
@@ -595,6 +761,9 @@ func (db *DB) ListPosts(tx ReadTx) ([]PostSummary, error) {
 			if strings.HasSuffix(postFile.Name(), postsStatusExt) {
 				continue
 			}
+			if strings.HasSuffix(postFile.Name(), postsRevisionsExt) {
+				continue
+			}
 			if strings.HasSuffix(postFile.Name(), postRecvReceiptSuff) {
 				continue
 			}
@@ -617,7 +786,7 @@ func (db *DB) ListPosts(tx ReadTx) ([]PostSummary, error) {
 				continue
 			}
 
-			post, err := db.readPost(fullPath)
+			post, lastRev, err := db.readLatestPostRevision(fullPath)
 			if err != nil {
 				db.log.Warnf("Unable to read post %s: %v", fullPath, err)
 				continue
@@ -633,6 +802,7 @@ func (db *DB) ListPosts(tx ReadTx) ([]PostSummary, error) {
 			summ := PostSummFromMetadata(post, *from)
 			summ.Date = finfo.ModTime()
 			summ.LastStatusTS = lastStatusTime
+			summ.LastRevision = lastRev
 			res = append(res, summ)
 		}
 	}
@@ -640,13 +810,16 @@ func (db *DB) ListPosts(tx ReadTx) ([]PostSummary, error) {
 	return res, nil
 }
 
-// ListUserPosts lists all posts made by the given user.
+// ListUserPosts lists all posts made by the given user. Edited posts are
+// returned with the contents of their latest revision.
 func (db *DB) ListUserPosts(tx ReadTx, from UserID) ([]rpc.PostMetadata, error) {
 	rootDir := filepath.Join(db.root, postsDir)
 	authorDir := filepath.Join(rootDir, from.String())
 
 	postFiles, err := os.ReadDir(authorDir)
-	if err != nil {
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
 		return nil, err
 	}
 	var res []rpc.PostMetadata
@@ -659,6 +832,12 @@ func (db *DB) ListUserPosts(tx ReadTx, from UserID) ([]rpc.PostMetadata, error)
 		if strings.HasSuffix(postFile.Name(), postsStatusExt) {
 			continue
 		}
+		if strings.HasSuffix(postFile.Name(), postsRevisionsExt) {
+			continue
+		}
+		if strings.HasSuffix(postFile.Name(), postRecvReceiptSuff) {
+			continue
+		}
 
 		fullPath := filepath.Join(authorDir, postFile.Name())
 		pid := new(PostID)
@@ -668,7 +847,7 @@ func (db *DB) ListUserPosts(tx ReadTx, from UserID) ([]rpc.PostMetadata, error)
 			continue
 		}
 
-		post, err := db.readPost(fullPath)
+		post, _, err := db.readLatestPostRevision(fullPath)
 		if err != nil {
 			db.log.Warnf("Unable to read post %s: %v", fullPath, err)
 			continue
@@ -693,6 +872,14 @@ func (db *DB) ReadPost(tx ReadTx, from UserID, post PostID) (rpc.PostMetadata, e
 	return *pm, nil
 }
 
+// ListPostRevisions lists the revisions of the given post, from oldest to
+// newest. The original version of the post is not included in the list.
+func (db *DB) ListPostRevisions(tx ReadTx, from UserID, post PostID) ([]rpc.PostMetadataStatus, error) {
+	fname := filepath.Join(db.root, postsDir, from.String(),
+		post.String()+postsRevisionsExt)
+	return db.readPostRevisions(fname)
+}
+
 // PostExists verifies whether the given received post already exists.
 func (db *DB) PostExists(tx ReadTx, from UserID, post PostID) (bool, error) {
 	filepath := filepath.Join(db.root, postsDir, from.String(),
@@ -730,7 +917,7 @@ func (db *DB) ListPostRelayers(tx ReadTx, post PostID) ([]UserID, error) {
 }
 
 // ListPostStatusUpdates lists the status updates of the currently received
-// posts.
+// posts. Comments removed by the post author are not returned.
 func (db *DB) ListPostStatusUpdates(tx ReadTx, from UserID,
 	post PostID) ([]rpc.PostMetadataStatus, error) {
 
@@ -757,7 +944,103 @@ func (db *DB) ListPostStatusUpdates(tx ReadTx, from UserID,
 		}
 		res = append(res, pms)
 	}
-	return res, nil
+	return RemoveModeratedComments(res), nil
+}
+
+// RemoveModeratedComments removes from the list of status updates the comments
+// that were removed by the post author. Moderation actions are only stored
+// after being verified as coming from the post author.
+func RemoveModeratedComments(updates []rpc.PostMetadataStatus) []rpc.PostMetadataStatus {
+	removed := make(map[string]struct{})
+	for i := range updates {
+		attr := updates[i].Attributes
+		if attr[rpc.RMPSModeration] == rpc.RMPSModRemove {
+			removed[attr[rpc.RMPParent]] = struct{}{}
+		}
+	}
+	if len(removed) == 0 {
+		return updates
+	}
+
+	res := updates[:0]
+	for _, pms := range updates {
+		if _, ok := pms.Attributes[rpc.RMPSComment]; ok {
+			id := clientintf.ID(pms.Hash())
+			if _, ok := removed[id.String()]; ok {
+				continue
+			}
+		}
+		res = append(res, pms)
+	}
+	return res
+}
+
+// PostCommentsLocked returns true if the author of the post locked its
+// comments, according to the given list of status updates.
+func PostCommentsLocked(updates []rpc.PostMetadataStatus) bool {
+	var locked bool
+	for i := range updates {
+		switch updates[i].Attributes[rpc.RMPSModeration] {
+		case rpc.RMPSModLockComms:
+			locked = true
+		case rpc.RMPSModUnlockComms:
+			locked = false
+		}
+	}
+	return locked
+}
+
+// PostCommentTree assembles the comments in the given list of status updates
+// into a tree of threads, returning the top-level comments. Replies to comments
+// that are not in the list (for example, because they were removed by the post
+// author) are returned as top-level comments.
+func PostCommentTree(updates []rpc.PostMetadataStatus) []*PostCommentNode {
+	nodes := make([]*PostCommentNode, 0, len(updates))
+	byID := make(map[clientintf.ID]*PostCommentNode, len(updates))
+	for _, pms := range updates {
+		if !rpc.IsPostComment(pms.Attributes) {
+			continue
+		}
+		node := &PostCommentNode{
+			ID:     pms.Hash(),
+			Status: pms,
+		}
+		if s, ok := pms.Attributes[rpc.RMPParent]; ok {
+			var parent clientintf.ID
+			if err := parent.FromString(s); err == nil {
+				node.Parent = &parent
+			}
+		}
+		nodes = append(nodes, node)
+		byID[node.ID] = node
+	}
+
+	// Link replies to their parents. This is done after all nodes are
+	// created so that replies received before their parent are still
+	// placed in the right thread.
+	var roots []*PostCommentNode
+	for _, node := range nodes {
+		var parent *PostCommentNode
+		if node.Parent != nil {
+			parent = byID[*node.Parent]
+		}
+		if parent == nil || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Fill the depth of every node.
+	var setDepth func(nodes []*PostCommentNode, depth int)
+	setDepth = func(nodes []*PostCommentNode, depth int) {
+		for _, node := range nodes {
+			node.Depth = depth
+			setDepth(node.Children, depth+1)
+		}
+	}
+	setDepth(roots, 0)
+	return roots
 }
 
 func (db *DB) replacePostSubscription(to UserID, add bool) error {
@@ -961,6 +1244,17 @@ func (db *DB) RemoveRelayedPostCopies(author UserID, pid PostID) error {
 			continue
 		}
 
+		if post, err := db.readPost(f); err == nil {
+			if err := db.unindexPostTags(fromID, post); err != nil {
+				db.log.Warnf("Unable to remove relayed post %s "+
+					"from tags index: %v", f, err)
+			}
+		}
+		if err := db.unindexPostExpiration(fromID, pid); err != nil {
+			db.log.Warnf("Unable to remove relayed post %s "+
+				"from expirations index: %v", f, err)
+		}
+
 		if err := os.Remove(f); err != nil {
 			db.log.Debugf("Unable to remove relayed post %s: %v",
 				f, err)
@@ -1032,3 +1326,144 @@ func (db *DB) PopEarlyPostStatusUpdate(tx ReadWriteTx, from UserID, pid PostID)
 
 	return res, nil
 }
+
+// postAudiences is the on-disk list of post audiences and the audience of each
+// restricted post created by the local client.
+type postAudiences struct {
+	Audiences []PostAudience    `json:"audiences"`
+	Posts     map[string]string `json:"posts"` // Post ID -> audience name
+}
+
+func (db *DB) readPostAudiences() (*postAudiences, error) {
+	fname := filepath.Join(db.root, postsDir, postAudiencesFile)
+	pa := new(postAudiences)
+	err := db.readJsonFile(fname, pa)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	if pa.Posts == nil {
+		pa.Posts = make(map[string]string)
+	}
+	return pa, nil
+}
+
+func (db *DB) savePostAudiences(pa *postAudiences) error {
+	fname := filepath.Join(db.root, postsDir, postAudiencesFile)
+	return db.saveJsonFile(fname, pa)
+}
+
+// SavePostAudience creates or replaces the post audience with the given name.
+func (db *DB) SavePostAudience(tx ReadWriteTx, name string, members []UserID) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("audience name cannot be empty")
+	}
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return err
+	}
+	audience := PostAudience{Name: name, Members: members}
+	found := false
+	for i := range pa.Audiences {
+		if pa.Audiences[i].Name == name {
+			pa.Audiences[i] = audience
+			found = true
+			break
+		}
+	}
+	if !found {
+		pa.Audiences = append(pa.Audiences, audience)
+	}
+	return db.savePostAudiences(pa)
+}
+
+// RemovePostAudience removes the post audience with the given name. Posts
+// previously restricted to the audience are not shared with anyone else.
+func (db *DB) RemovePostAudience(tx ReadWriteTx, name string) error {
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return err
+	}
+	for i := range pa.Audiences {
+		if pa.Audiences[i].Name == name {
+			pa.Audiences = append(pa.Audiences[:i], pa.Audiences[i+1:]...)
+			return db.savePostAudiences(pa)
+		}
+	}
+	return fmt.Errorf("audience %q: %w", name, ErrNotFound)
+}
+
+// ListPostAudiences lists the existing post audiences.
+func (db *DB) ListPostAudiences(tx ReadTx) ([]PostAudience, error) {
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return nil, err
+	}
+	return pa.Audiences, nil
+}
+
+// GetPostAudience returns the post audience with the given name.
+func (db *DB) GetPostAudience(tx ReadTx, name string) (PostAudience, error) {
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return PostAudience{}, err
+	}
+	for i := range pa.Audiences {
+		if pa.Audiences[i].Name == name {
+			return pa.Audiences[i], nil
+		}
+	}
+	return PostAudience{}, fmt.Errorf("audience %q: %w", name, ErrNotFound)
+}
+
+// RestrictPostToAudience records that the given post created by the local
+// client is restricted to the named audience.
+func (db *DB) RestrictPostToAudience(tx ReadWriteTx, pid PostID, name string) error {
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return err
+	}
+	pa.Posts[pid.String()] = name
+	return db.savePostAudiences(pa)
+}
+
+// PostAudienceOf returns the audience the given post created by the local
+// client is restricted to. It returns nil if the post is not restricted. If
+// the audience was removed, this returns an audience without members.
+func (db *DB) PostAudienceOf(tx ReadTx, pid PostID) (*PostAudience, error) {
+	pa, err := db.readPostAudiences()
+	if err != nil {
+		return nil, err
+	}
+	name, ok := pa.Posts[pid.String()]
+	if !ok {
+		return nil, nil
+	}
+	for i := range pa.Audiences {
+		if pa.Audiences[i].Name == name {
+			return &pa.Audiences[i], nil
+		}
+	}
+	return &PostAudience{Name: name}, nil
+}
+
+// ListPostRecipients lists the post subscribers that should receive the given
+// post created by the local client (and its status updates). For posts
+// restricted to an audience, only the subscribers that are members of the
+// audience are returned.
+func (db *DB) ListPostRecipients(tx ReadTx, pid PostID) ([]UserID, error) {
+	subs, err := db.ListPostSubscribers(tx)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := db.PostAudienceOf(tx, pid)
+	if err != nil || audience == nil {
+		return subs, err
+	}
+	res := make([]UserID, 0, len(subs))
+	for _, uid := range subs {
+		if audience.IsMember(uid) {
+			res = append(res, uid)
+		}
+	}
+	return res, nil
+}
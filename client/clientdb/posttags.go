@@ -0,0 +1,180 @@
+package clientdb
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// postTagEntry is an entry in the index of posts of a tag.
+type postTagEntry struct {
+	From UserID `json:"from"`
+	ID   PostID `json:"id"`
+}
+
+// postTagFname returns the file that indexes the posts with the given tag.
+// Tags are normalized, so they are safe to use as filenames.
+func (db *DB) postTagFname(tag string) string {
+	return filepath.Join(db.root, postTagsDir, tag)
+}
+
+// readPostTagIndex reads the list of posts with the given tag.
+func (db *DB) readPostTagIndex(tag string) ([]postTagEntry, error) {
+	var entries []postTagEntry
+	err := db.readJsonFile(db.postTagFname(tag), &entries)
+	if errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	return entries, err
+}
+
+// indexPostTags adds the post received from the given user to the index of
+// every one of its tags.
+func (db *DB) indexPostTags(from UserID, post *rpc.PostMetadata) error {
+	var pid PostID
+	if err := pid.FromString(post.Attributes[rpc.RMPIdentifier]); err != nil {
+		return err
+	}
+	entry := postTagEntry{From: from, ID: pid}
+	for _, tag := range rpc.PostTags(post) {
+		entries, err := db.readPostTagIndex(tag)
+		if err != nil {
+			return err
+		}
+		found := false
+		for i := range entries {
+			found = entries[i] == entry
+			if found {
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		entries = append(entries, entry)
+		if err := db.saveJsonFile(db.postTagFname(tag), entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexPostTags removes the post received from the given user from the
+// index of every one of its tags.
+func (db *DB) unindexPostTags(from UserID, post *rpc.PostMetadata) error {
+	var pid PostID
+	if err := pid.FromString(post.Attributes[rpc.RMPIdentifier]); err != nil {
+		return err
+	}
+	entry := postTagEntry{From: from, ID: pid}
+	for _, tag := range rpc.PostTags(post) {
+		entries, err := db.readPostTagIndex(tag)
+		if err != nil {
+			return err
+		}
+		res := entries[:0]
+		for i := range entries {
+			if entries[i] != entry {
+				res = append(res, entries[i])
+			}
+		}
+		if len(res) == len(entries) {
+			continue
+		}
+		if len(res) == 0 {
+			err = removeIfExists(db.postTagFname(tag))
+		} else {
+			err = db.saveJsonFile(db.postTagFname(tag), res)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListPostTags returns the tags of the posts received by the local client and
+// the number of posts with each tag.
+func (db *DB) ListPostTags(tx ReadTx) (map[string]int, error) {
+	dir := filepath.Join(db.root, postTagsDir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		posts, err := db.readPostTagIndex(entry.Name())
+		if err != nil {
+			db.log.Warnf("Unable to read index of tag %s: %v",
+				entry.Name(), err)
+			continue
+		}
+		if len(posts) > 0 {
+			res[entry.Name()] = len(posts)
+		}
+	}
+	return res, nil
+}
+
+// QueryPosts returns the summary of the posts selected by the query, ordered
+// by date.
+func (db *DB) QueryPosts(tx ReadTx, q PostsQuery) ([]PostSummary, error) {
+	var tagged map[postTagEntry]struct{}
+	if q.Tag != "" {
+		tag, err := rpc.NormalizePostTag(q.Tag)
+		if err != nil {
+			return nil, err
+		}
+		entries, err := db.readPostTagIndex(tag)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			return nil, nil
+		}
+		tagged = make(map[postTagEntry]struct{}, len(entries))
+		for _, entry := range entries {
+			tagged[entry] = struct{}{}
+		}
+	}
+
+	posts, err := db.ListPosts(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := posts[:0]
+	for _, summ := range posts {
+		if tagged != nil {
+			entry := postTagEntry{From: summ.From, ID: summ.ID}
+			if _, ok := tagged[entry]; !ok {
+				continue
+			}
+		}
+		if q.Author != nil && summ.AuthorID != *q.Author {
+			continue
+		}
+		if !q.Since.IsZero() && summ.Date.Before(q.Since) {
+			continue
+		}
+		if !q.Until.IsZero() && summ.Date.After(q.Until) {
+			continue
+		}
+		res = append(res, summ)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date.Before(res[j].Date)
+	})
+	return res, nil
+}
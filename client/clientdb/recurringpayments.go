@@ -0,0 +1,138 @@
+package clientdb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// recurringPaymentFname returns the file where the recurring payment
+// agreement with the given ID, made with the given user, is stored.
+func (db *DB) recurringPaymentFname(uid UserID, id zkidentity.ShortID) string {
+	return filepath.Join(db.root, recurringPaysDir, uid.String(), id.String())
+}
+
+// StoreRecurringPayment creates or replaces a recurring payment agreement.
+func (db *DB) StoreRecurringPayment(tx ReadWriteTx, rp RecurringPayment) error {
+	return db.saveJsonFile(db.recurringPaymentFname(rp.UID, rp.ID), rp)
+}
+
+// ReadRecurringPayment reads the recurring payment agreement with the given
+// ID, made with the given user.
+func (db *DB) ReadRecurringPayment(tx ReadTx, uid UserID, id zkidentity.ShortID) (RecurringPayment, error) {
+	var rp RecurringPayment
+	err := db.readJsonFile(db.recurringPaymentFname(uid, id), &rp)
+	if errors.Is(err, ErrNotFound) {
+		err = fmt.Errorf("recurring payment %s: %w", id, ErrNotFound)
+	}
+	return rp, err
+}
+
+// ListRecurringPayments lists the recurring payment agreements made with
+// remote users, oldest first.
+func (db *DB) ListRecurringPayments(tx ReadTx) ([]RecurringPayment, error) {
+	rootDir := filepath.Join(db.root, recurringPaysDir)
+	userDirs, err := os.ReadDir(rootDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res []RecurringPayment
+	for _, userDir := range userDirs {
+		var uid UserID
+		if !userDir.IsDir() || uid.FromString(userDir.Name()) != nil {
+			continue
+		}
+
+		dir := filepath.Join(rootDir, userDir.Name())
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			fname := filepath.Join(dir, entry.Name())
+			var rp RecurringPayment
+			if err := db.readJsonFile(fname, &rp); err != nil {
+				db.log.Warnf("Unable to read recurring payment %s: %v",
+					fname, err)
+				continue
+			}
+			res = append(res, rp)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Created.Before(res[j].Created)
+	})
+	return res, nil
+}
+
+// readRecurringPaymentTiers reads the tiers offered by the local client.
+func (db *DB) readRecurringPaymentTiers() (map[string]RecurringPaymentTier, error) {
+	tiers := make(map[string]RecurringPaymentTier)
+	fname := filepath.Join(db.root, recurringTiersFile)
+	err := db.readJsonFile(fname, &tiers)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// StoreRecurringPaymentTier creates or replaces a tier offered by the local
+// client.
+func (db *DB) StoreRecurringPaymentTier(tx ReadWriteTx, tier RecurringPaymentTier) error {
+	tiers, err := db.readRecurringPaymentTiers()
+	if err != nil {
+		return err
+	}
+	tiers[tier.Name] = tier
+	return db.saveJsonFile(filepath.Join(db.root, recurringTiersFile), tiers)
+}
+
+// RemoveRecurringPaymentTier removes a tier offered by the local client.
+func (db *DB) RemoveRecurringPaymentTier(tx ReadWriteTx, name string) error {
+	tiers, err := db.readRecurringPaymentTiers()
+	if err != nil {
+		return err
+	}
+	if _, ok := tiers[name]; !ok {
+		return fmt.Errorf("tier %q: %w", name, ErrNotFound)
+	}
+	delete(tiers, name)
+	return db.saveJsonFile(filepath.Join(db.root, recurringTiersFile), tiers)
+}
+
+// ReadRecurringPaymentTier reads the tier offered by the local client with
+// the given name.
+func (db *DB) ReadRecurringPaymentTier(tx ReadTx, name string) (RecurringPaymentTier, error) {
+	tiers, err := db.readRecurringPaymentTiers()
+	if err != nil {
+		return RecurringPaymentTier{}, err
+	}
+	tier, ok := tiers[name]
+	if !ok {
+		return tier, fmt.Errorf("tier %q: %w", name, ErrNotFound)
+	}
+	return tier, nil
+}
+
+// ListRecurringPaymentTiers lists the tiers offered by the local client,
+// sorted by name.
+func (db *DB) ListRecurringPaymentTiers(tx ReadTx) ([]RecurringPaymentTier, error) {
+	tiers, err := db.readRecurringPaymentTiers()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]RecurringPaymentTier, 0, len(tiers))
+	for _, tier := range tiers {
+		res = append(res, tier)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
@@ -0,0 +1,188 @@
+package clientdb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+)
+
+// rmFragmentsPath returns the dir where fragments of the given RM are stored.
+func (db *DB) rmFragmentsPath(uid UserID, id zkidentity.ShortID) string {
+	return filepath.Join(db.root, inboundDir, uid.String(), rmFragmentsDir,
+		id.String())
+}
+
+// pendingRMFragments returns the number of fragmented RMs from the given user
+// that are not yet complete and the sum of their declared sizes.
+func (db *DB) pendingRMFragments(uid UserID) (int, uint64, error) {
+	pattern := filepath.Join(db.root, inboundDir, uid.String(),
+		rmFragmentsDir, "*", rmFragmentsFile)
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var size uint64
+	for _, fname := range files {
+		var meta InboundRMFragments
+		if err := db.readJsonFile(fname, &meta); err != nil {
+			return 0, 0, err
+		}
+		size += uint64(meta.Size)
+	}
+	return len(files), size, nil
+}
+
+// StoreRMFragment stores a fragment of an RM received from the given user. It
+// returns true when all fragments of the RM have been received.
+//
+// The first fragment of a new RM is rejected with ErrRMFragmentsLimit if the
+// user already has maxPending incomplete RMs or if the declared sizes of the
+// incomplete RMs would add up to more than maxPendingSize. Duplicate fragments
+// are ignored.
+func (db *DB) StoreRMFragment(tx ReadWriteTx, uid UserID, frag rpc.RMFragment,
+	now time.Time, maxPending int, maxPendingSize uint64) (bool, error) {
+
+	if err := frag.Valid(); err != nil {
+		return false, err
+	}
+
+	dir := db.rmFragmentsPath(uid, frag.ID)
+	metaFname := filepath.Join(dir, rmFragmentsFile)
+	var meta InboundRMFragments
+	err := db.readJsonFile(metaFname, &meta)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		count, size, err := db.pendingRMFragments(uid)
+		if err != nil {
+			return false, err
+		}
+		if count >= maxPending {
+			return false, fmt.Errorf("%w: %d pending RMs",
+				ErrRMFragmentsLimit, count)
+		}
+		if size+uint64(frag.Size) > maxPendingSize {
+			return false, fmt.Errorf("%w: %d bytes pending, %d "+
+				"bytes in new RM", ErrRMFragmentsLimit, size,
+				frag.Size)
+		}
+		meta = InboundRMFragments{
+			UID:   uid,
+			ID:    frag.ID,
+			Count: frag.Count,
+			Size:  frag.Size,
+		}
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return false, err
+		}
+	case err != nil:
+		return false, err
+	case meta.Count != frag.Count || meta.Size != frag.Size:
+		return false, fmt.Errorf("fragment %d of RM %s has count %d and "+
+			"size %d, previous fragments had count %d and size %d",
+			frag.Index, frag.ID, frag.Count, frag.Size, meta.Count,
+			meta.Size)
+	}
+
+	fname := filepath.Join(dir, strconv.FormatUint(uint64(frag.Index), 10))
+	if fileExists(fname) {
+		return meta.Received == meta.Count, nil
+	}
+	if uint64(meta.Stored)+uint64(len(frag.Data)) > uint64(meta.Size) {
+		return false, fmt.Errorf("fragment %d of RM %s would exceed "+
+			"its declared size %d", frag.Index, frag.ID, meta.Size)
+	}
+	if err := os.WriteFile(fname, frag.Data, 0o600); err != nil {
+		return false, err
+	}
+
+	meta.Received += 1
+	meta.Stored += uint32(len(frag.Data))
+	meta.LastUpdate = now
+	if err := db.saveJsonFile(metaFname, &meta); err != nil {
+		return false, err
+	}
+	return meta.Received == meta.Count, nil
+}
+
+// AssembleRMFragments reassembles a fragmented RM for which all fragments have
+// been received. The fragments are removed from the DB, even if the
+// reassembled RM does not match its expected size and hash.
+func (db *DB) AssembleRMFragments(tx ReadWriteTx, uid UserID, id zkidentity.ShortID) ([]byte, error) {
+	dir := db.rmFragmentsPath(uid, id)
+	var meta InboundRMFragments
+	if err := db.readJsonFile(filepath.Join(dir, rmFragmentsFile), &meta); err != nil {
+		return nil, err
+	}
+	if meta.Received != meta.Count {
+		return nil, fmt.Errorf("RM %s has only %d of %d fragments",
+			id, meta.Received, meta.Count)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			db.log.Warnf("Unable to remove fragments dir %s: %v", dir, err)
+		}
+	}()
+
+	rm := make([]byte, 0, meta.Size)
+	for i := uint32(0); i < meta.Count; i++ {
+		fname := filepath.Join(dir, strconv.FormatUint(uint64(i), 10))
+		data, err := os.ReadFile(fname)
+		if err != nil {
+			return nil, err
+		}
+		if len(rm)+len(data) > int(meta.Size) {
+			return nil, fmt.Errorf("fragments of RM %s are larger than "+
+				"its declared size %d", id, meta.Size)
+		}
+		rm = append(rm, data...)
+	}
+	if len(rm) != int(meta.Size) {
+		return nil, fmt.Errorf("reassembled RM %s has size %d, want %d",
+			id, len(rm), meta.Size)
+	}
+	if hash := sha256.Sum256(rm); !bytes.Equal(hash[:], id[:]) {
+		return nil, fmt.Errorf("reassembled RM %s has wrong hash", id)
+	}
+	return rm, nil
+}
+
+// RemoveStaleRMFragments removes all fragmented RMs that have not received
+// any new fragment since the given limit time. It returns the number of
+// removed RMs.
+func (db *DB) RemoveStaleRMFragments(tx ReadWriteTx, limit time.Time) (int, error) {
+	pattern := filepath.Join(db.root, inboundDir, "*", rmFragmentsDir, "*",
+		rmFragmentsFile)
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return 0, err
+	}
+
+	var removed int
+	for _, fname := range files {
+		var meta InboundRMFragments
+		if err := db.readJsonFile(fname, &meta); err != nil {
+			db.log.Warnf("Unable to read fragments file %s: %v",
+				fname, err)
+			continue
+		}
+		if !meta.LastUpdate.Before(limit) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Dir(fname)); err != nil {
+			return removed, err
+		}
+		db.log.Debugf("Removed %d/%d stale fragments of RM %s from %s",
+			meta.Received, meta.Count, meta.ID, meta.UID)
+		removed++
+	}
+	return removed, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
 )
 
 // ID is a 32-byte global ID. This is used as an alias for all 32-byte arrays
@@ -178,6 +179,74 @@ type PaymentClient interface {
 	IsPaymentCompleted(context.Context, string) (int64, error)
 }
 
+// OnchainPaymentClient is the interface for payment clients that can also
+// receive and send on-chain payments.
+type OnchainPaymentClient interface {
+	// NewReceiveAddress returns a new on-chain address from the given
+	// wallet account.
+	NewReceiveAddress(ctx context.Context, acct string) (stdaddr.Address, error)
+
+	// SendOnchain sends the amount to the on-chain address and returns
+	// the hash of the transaction.
+	SendOnchain(ctx context.Context, addr string, amount dcrutil.Amount) (chainhash.Hash, error)
+
+	// WaitTxConfirmed blocks until the given wallet transaction is
+	// confirmed.
+	WaitTxConfirmed(ctx context.Context, tx chainhash.Hash) error
+
+	// VerifyTxPayment returns nil if the given wallet transaction is
+	// confirmed and pays at least the amount to the on-chain address.
+	VerifyTxPayment(ctx context.Context, tx chainhash.Hash, addr string, amount dcrutil.Amount) error
+}
+
+// PaymentCategory identifies the purpose of a payment made through a
+// PaymentClient.
+type PaymentCategory string
+
+const (
+	// PaymentCategoryServerFees is the category of payments made to the
+	// server to push messages and subscribe to RVs.
+	PaymentCategoryServerFees PaymentCategory = "serverfees"
+
+	// PaymentCategoryDownloads is the category of payments made for file
+	// downloads.
+	PaymentCategoryDownloads PaymentCategory = "downloads"
+
+	// PaymentCategoryTips is the category of payments made to tip remote
+	// users, including recurring payments.
+	PaymentCategoryTips PaymentCategory = "tips"
+
+	// PaymentCategoryRTDT is the category of payments made for realtime
+	// chat sessions.
+	PaymentCategoryRTDT PaymentCategory = "rtdt"
+
+	// PaymentCategoryOther is the category of payments that do not have a
+	// more specific category.
+	PaymentCategoryOther PaymentCategory = "other"
+)
+
+// PaymentCategories lists all payment categories.
+var PaymentCategories = []PaymentCategory{PaymentCategoryServerFees,
+	PaymentCategoryDownloads, PaymentCategoryTips, PaymentCategoryRTDT,
+	PaymentCategoryOther}
+
+type paymentCategoryCtxKey struct{}
+
+// WithPaymentCategory returns a context that identifies payments made with it
+// as being of the given category.
+func WithPaymentCategory(ctx context.Context, cat PaymentCategory) context.Context {
+	return context.WithValue(ctx, paymentCategoryCtxKey{}, cat)
+}
+
+// PaymentCategoryFromContext returns the category of payments made with the
+// given context. Defaults to PaymentCategoryOther.
+func PaymentCategoryFromContext(ctx context.Context) PaymentCategory {
+	if cat, ok := ctx.Value(paymentCategoryCtxKey{}).(PaymentCategory); ok {
+		return cat
+	}
+	return PaymentCategoryOther
+}
+
 // FreePaymentClient implements the PaymentClient interface for servers that
 // offer the "free" payment scheme: namely, invoices are requested but there is
 // nothing to pay for.
@@ -269,4 +338,8 @@ var (
 	ErrInvoiceExpired            = errors.New("invoice expired")
 	ErrOnboardNoFunds            = errors.New("onboarding invite does not have any funds")
 	ErrRetriablePayment          = errors.New("retriable payment error")
+
+	// ErrPaymentBudgetExceeded is generated when a payment that exceeds a
+	// payment budget is rejected by the user.
+	ErrPaymentBudgetExceeded = errors.New("payment budget exceeded")
 )
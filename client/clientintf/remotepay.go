@@ -0,0 +1,79 @@
+package clientintf
+
+import (
+	"errors"
+	"time"
+)
+
+// The following define the protocol used between a remote payment client and
+// a remote payment server. Each PaymentClient call is performed as an HTTP
+// POST request to <server url>/<method>, with a JSON encoded RemotePayRequest
+// body. The server replies with a JSON encoded RemotePayResponse. Requests are
+// authenticated by an "Authorization: Bearer <token>" header.
+const (
+	RemotePayMethodPayScheme          = "payscheme"
+	RemotePayMethodPayInvoice         = "payinvoice"
+	RemotePayMethodGetInvoice         = "getinvoice"
+	RemotePayMethodDecodeInvoice      = "decodeinvoice"
+	RemotePayMethodIsInvoicePaid      = "isinvoicepaid"
+	RemotePayMethodTrackInvoice       = "trackinvoice"
+	RemotePayMethodIsPaymentCompleted = "ispaymentcompleted"
+)
+
+// RemotePayRequest is the request sent to a remote payment server. Only the
+// fields relevant to the method are filled.
+type RemotePayRequest struct {
+	Invoice string `json:"invoice,omitempty"`
+	MAtoms  int64  `json:"matoms,omitempty"`
+}
+
+// RemotePayResponse is the response of a remote payment server. Only the
+// fields relevant to the method are filled.
+type RemotePayResponse struct {
+	PayScheme  string    `json:"pay_scheme,omitempty"`
+	Invoice    string    `json:"invoice,omitempty"`
+	ID         []byte    `json:"id,omitempty"`
+	MAtoms     int64     `json:"matoms,omitempty"`
+	FeesMAtoms int64     `json:"fees_matoms,omitempty"`
+	ExpiryTime time.Time `json:"expiry_time,omitempty"`
+
+	// Error is the error returned by the remote payment server.
+	// ErrorCode identifies well known errors.
+	Error     string `json:"error,omitempty"`
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// remotePayErrorCodes maps the well known payment errors to their codes in
+// the remote payment protocol.
+var remotePayErrorCodes = map[string]error{
+	"insufficientlypaid": ErrInvoiceInsufficientlyPaid,
+	"expired":            ErrInvoiceExpired,
+	"retriable":          ErrRetriablePayment,
+}
+
+// RemotePayErrorCode returns the remote payment protocol code of the given
+// error, or an empty string if it is not a well known error.
+func RemotePayErrorCode(err error) string {
+	for code, target := range remotePayErrorCodes {
+		if errors.Is(err, target) {
+			return code
+		}
+	}
+	return ""
+}
+
+// RemotePayError is an error returned by a remote payment server.
+type RemotePayError struct {
+	Code string
+	Msg  string
+}
+
+func (err RemotePayError) Error() string {
+	return "remote payment server error: " + err.Msg
+}
+
+// Is returns true if the target is the well known error that corresponds to
+// the code of the error.
+func (err RemotePayError) Is(target error) bool {
+	return err.Code != "" && remotePayErrorCodes[err.Code] == target
+}
@@ -104,7 +104,7 @@ func completeC2CKX(t testing.TB, alice, bob *Client) {
 	}
 
 	// Bob accepts the invite.
-	err = bob.AcceptInvite(bobInvite)
+	err = bob.AcceptInvite(bobInvite, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -28,6 +28,49 @@ var (
 	// ErrGCAlreadyHasRTDTSession is generated when a GC already has an
 	// associated RTDT session.
 	ErrGCAlreadyHasRTDTSession = errors.New("GC already has associated RTDT session")
+
+	// ErrRestrictedPost is generated when attempting to relay a post that
+	// is restricted to an audience or when receiving a relayed copy of
+	// such a post.
+	ErrRestrictedPost = errors.New("post is restricted to an audience")
+
+	// ErrExpiredPost is generated when attempting to relay a post that has
+	// expired.
+	ErrExpiredPost = errors.New("post has expired")
+
+	// ErrPostCommentsLocked is generated when attempting to comment on a
+	// post whose comments were locked by the author.
+	ErrPostCommentsLocked = errors.New("post comments are locked")
+
+	// ErrNotPaidPost is generated when attempting to buy a post that does
+	// not have a price.
+	ErrNotPaidPost = errors.New("post is not a paid post")
+
+	// ErrPaidPostUnlocked is generated when attempting to buy a post whose
+	// full content was already received.
+	ErrPaidPostUnlocked = errors.New("paid post already unlocked")
+
+	// ErrPostDraftPublishing is generated when attempting to change or
+	// publish a post draft that is already being published.
+	ErrPostDraftPublishing = errors.New("post draft is being published")
+
+	// ErrPaymentRequestExpired is generated when attempting to pay a
+	// payment request after its expiration.
+	ErrPaymentRequestExpired = errors.New("payment request has expired")
+
+	// ErrPaymentRequestNotPending is generated when attempting to change
+	// the status of a payment request that was already paid, declined or
+	// expired.
+	ErrPaymentRequestNotPending = errors.New("payment request is not pending")
+
+	// ErrRecurringPaymentNotActive is generated when attempting to change
+	// a recurring payment agreement that was already completed, canceled
+	// or lapsed.
+	ErrRecurringPaymentNotActive = errors.New("recurring payment is not active")
+
+	// ErrPaymentBudgetExceeded is generated when a payment that exceeds a
+	// payment budget is rejected by the user.
+	ErrPaymentBudgetExceeded = clientintf.ErrPaymentBudgetExceeded
 )
 
 type userNotFoundError struct {
@@ -100,6 +143,26 @@ func (err ErrKXSearchNeeded) Is(target error) bool {
 	return false
 }
 
+// ErrKXPaymentNotAllowed is returned when attempting to accept an invite that
+// requires a larger payment to complete the KX than what was allowed by the
+// caller.
+type ErrKXPaymentNotAllowed struct {
+	PaymentMAtoms    int64
+	MaxPaymentMAtoms int64
+}
+
+func (err ErrKXPaymentNotAllowed) Error() string {
+	return fmt.Sprintf("invite requires payment of %.8f DCR to complete "+
+		"KX (max allowed payment: %.8f DCR)",
+		float64(err.PaymentMAtoms)/1e11,
+		float64(err.MaxPaymentMAtoms)/1e11)
+}
+
+func (err ErrKXPaymentNotAllowed) Is(target error) bool {
+	_, ok := target.(ErrKXPaymentNotAllowed)
+	return ok
+}
+
 type errHasOngoingKX struct {
 	otherRV zkidentity.ShortID
 }
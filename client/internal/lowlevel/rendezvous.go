@@ -433,6 +433,7 @@ func (rmgr *RVManager) payForSubs(ctx context.Context, subsNeedPay map[ratchet.R
 	ctx, cancel := multiCtx(ctx, sess.Context())
 	rmgr.log.Debugf("Attempting to pay %d MAtoms for new subs %s", amt,
 		joinRVList(unpaidRVs))
+	ctx = clientintf.WithPaymentCategory(ctx, clientintf.PaymentCategoryServerFees)
 	totalFees, err := pc.PayInvoiceAmount(ctx, nextInvoice, int64(amt))
 	cancel()
 
@@ -383,6 +383,7 @@ func (q *RMQ) payForRM(ctx context.Context, rmm *rmmsg, invoice string,
 	// Pay for it.
 	q.log.Tracef("Attempting to pay %d MAtoms to push RM %s", amt, rmm.orm)
 	ctx, cancel := multiCtx(ctx, sess.Context())
+	ctx = clientintf.WithPaymentCategory(ctx, clientintf.PaymentCategoryServerFees)
 	fees, err := pc.PayInvoiceAmount(ctx, invoice, amt)
 	cancel()
 	if err == nil {
@@ -409,6 +410,23 @@ func (q *RMQ) sendToSession(ctx context.Context, rmm *rmmsg, sess clientintf.Ser
 	if err := q.payForRM(ctx, rmm, invoice, sess); err != nil {
 		q.log.Errorf("Unable to pay for RM %s: %v", rmm.orm, err)
 
+		// When the payment was rejected by the user due to
+		// exceeding a payment budget, fail this RM instead of
+		// reconnecting, which would only cause the payment to be
+		// attempted (and rejected) again.
+		if errors.Is(err, clientintf.ErrPaymentBudgetExceeded) {
+			if err := q.db.DeleteRVPaymentAttempt(rmm.rv); err != nil {
+				q.log.Warnf("Unable to delete payment to push RV %s: %v",
+					rmm.rv, err)
+			}
+			go rmm.sendReply(err)
+			select {
+			case replyChan <- rmmsgReply{rmm: rmm}:
+			case <-ctx.Done():
+			}
+			return
+		}
+
 		// Request connection close so that we reconnect and try to
 		// pay again.
 		sess.RequestClose(err)
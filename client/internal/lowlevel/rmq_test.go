@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/assert"
 	"github.com/companyzero/bisonrelay/rpc"
 )
@@ -508,6 +509,66 @@ func TestRMQEncryptErrorFailsRM(t *testing.T) {
 	}
 }
 
+// TestRMQBudgetRejectionFailsRM tests that when the payment for an RM is
+// rejected for exceeding a payment budget, the RM fails without disrupting
+// the sending of other RMs.
+func TestRMQBudgetRejectionFailsRM(t *testing.T) {
+	t.Parallel()
+
+	q := NewRMQ(nil, newMockRMQDB())
+	runErr := make(chan error)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { runErr <- q.Run(ctx) }()
+
+	// Bind to the server.
+	sess := newMockServerSession()
+	q.BindToSession(sess)
+
+	// Reject the first payment.
+	var payCount int
+	sess.mpc.HookPayInvoice(func(string) (int64, error) {
+		payCount++
+		if payCount == 1 {
+			return 0, clientintf.ErrPaymentBudgetExceeded
+		}
+		return 0, nil
+	})
+
+	// Send the RM.
+	rmErrChan := make(chan error)
+	go func() { rmErrChan <- q.SendRM(mockRM("test")) }()
+
+	// Reply to asking for a payload.
+	sess.replyNextPRPC(t, &rpc.GetInvoiceReply{})
+
+	// Expect the RM to fail.
+	select {
+	case err := <-rmErrChan:
+		assert.ErrorIs(t, err, clientintf.ErrPaymentBudgetExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+
+	// Send an RM that will be paid.
+	go func() { rmErrChan <- q.SendRM(mockRM("test2")) }()
+	sess.replyNextPRPC(t, &rpc.GetInvoiceReply{})
+	sess.replyNextPRPC(t, &rpc.RouteMessageReply{})
+	select {
+	case err := <-rmErrChan:
+		assert.NilErr(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+
+	// Assert no run errors occurred.
+	select {
+	case err := <-runErr:
+		t.Fatal(err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
 // TestRMQMaxMsgSizeErrors tests that attempting to queue a message larger then
 // the max size fails.
 func TestRMQMaxMsgSizeErrors(t *testing.T) {
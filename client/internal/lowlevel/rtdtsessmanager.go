@@ -351,6 +351,7 @@ func (rtsm *RTDTSessionManager) payInvoiceAmount(ctx context.Context,
 		return fmt.Errorf("unexpected reply type %T", reply)
 	}
 
+	ctx = clientintf.WithPaymentCategory(ctx, clientintf.PaymentCategoryRTDT)
 	_, err = pc.PayInvoiceAmount(ctx, invoice, amount) // TODO: track fees somewhere?
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -40,6 +41,27 @@ type kxList struct {
 	kxCompleted func(*zkidentity.PublicIdentity, *ratchet.Ratchet,
 		clientdb.RawRVID, clientdb.RawRVID, clientdb.RawRVID)
 
+	// recordPayEvent is called to record payments made during kx.
+	recordPayEvent payEventRecorder
+
+	// paymentMAtoms is the amount that target users of invites created
+	// by the local client must pay to complete the KX. Zero disables the
+	// payment requirement.
+	paymentMAtoms int64
+
+	// pc is used to generate and pay the invoices of KXs that require a
+	// payment.
+	pc clientintf.PaymentClient
+
+	// kxPaymentReceived is called after a KX that required a payment from
+	// the target user completes.
+	kxPaymentReceived func(uid UserID, amount int64)
+
+	// kxPaymentRejected is called when a KX is rejected due to the target
+	// user not paying for it.
+	kxPaymentRejected func(initialRV clientdb.RawRVID,
+		public zkidentity.PublicIdentity, err error)
+
 	log slog.Logger
 }
 
@@ -57,6 +79,8 @@ func newKXList(q rmqIntf, rmgr rdzvManagerIntf, id *localIdentity,
 		privKey:  &id.privKey,
 		identity: &id.id,
 		public:   public,
+
+		recordPayEvent: recordPayStatsOnly(db),
 	}
 }
 
@@ -69,7 +93,8 @@ func (kx *kxList) makePaidForRMCB(uid UserID, event string) func(int64, int64) {
 		fees = -fees
 
 		err := kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
-			return kx.db.RecordUserPayEvent(tx, uid, event, amount, fees)
+			return kx.recordPayEvent(tx, uid, event,
+				clientintf.PaymentCategoryServerFees, "", amount, fees)
 		})
 		if err != nil {
 			kx.log.Warnf("Unable to store payment %d of event %q: %v", amount,
@@ -98,6 +123,12 @@ func (kx *kxList) createInvite(w io.Writer, invitee *zkidentity.PublicIdentity,
 		ResetRendezvous:   resetRV,
 		Funds:             funds,
 	}
+
+	// Only invites created manually (i.e. not for resets or mediated KXs
+	// requested by existing contacts) require payment.
+	if invitee == nil && mediator == nil && !isForReset {
+		pii.KXPaymentMAtoms = kx.paymentMAtoms
+	}
 	if w != nil {
 		jw := json.NewEncoder(w)
 		if err := jw.Encode(pii); err != nil {
@@ -114,6 +145,8 @@ func (kx *kxList) createInvite(w io.Writer, invitee *zkidentity.PublicIdentity,
 		Invitee:    invitee,
 		MediatorID: mediator,
 		IsForReset: isForReset,
+
+		PaymentMAtoms: pii.KXPaymentMAtoms,
 	}
 	err := kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
 		return kx.db.SaveKX(tx, kxd)
@@ -223,12 +256,27 @@ func (kx *kxList) decodeInvite(r io.Reader) (rpc.OOBPublicIdentityInvite, error)
 
 // acceptInvite accepts the given invite from a remote party. It sends a
 // message on the initial RV and waits for a reply.
-func (kx *kxList) acceptInvite(pii rpc.OOBPublicIdentityInvite, isForReset, isAutoKX bool) error {
+//
+// Invites that require a payment larger than maxPaymentMAtoms to complete the
+// KX are rejected.
+func (kx *kxList) acceptInvite(pii rpc.OOBPublicIdentityInvite, isForReset,
+	isAutoKX bool, maxPaymentMAtoms int64) error {
+
 	// Make sure we don't add ourselves
 	identity := pii.Public.Identity
 	if kx.identity.ConstantTimeEq(&identity) {
 		return fmt.Errorf("can't perform kx with self")
 	}
+	if pii.KXPaymentMAtoms < 0 {
+		return fmt.Errorf("invalid kx payment amount %d",
+			pii.KXPaymentMAtoms)
+	}
+	if pii.KXPaymentMAtoms > maxPaymentMAtoms {
+		return ErrKXPaymentNotAllowed{
+			PaymentMAtoms:    pii.KXPaymentMAtoms,
+			MaxPaymentMAtoms: maxPaymentMAtoms,
+		}
+	}
 	err := kx.db.View(context.Background(), func(tx clientdb.ReadTx) error {
 		if kx.db.IsBlocked(tx, identity) {
 			return fmt.Errorf("%s: %w", identity, errUserBlocked)
@@ -309,6 +357,13 @@ func (kx *kxList) acceptInvite(pii rpc.OOBPublicIdentityInvite, isForReset, isAu
 		TheirResetRV: pii.ResetRendezvous,
 		Timestamp:    time.Now(),
 		IsForReset:   isForReset,
+
+		PaymentMAtoms: pii.KXPaymentMAtoms,
+	}
+	if pii.KXPaymentMAtoms > 0 {
+		// Keep the half kx so that it may be sent again after paying
+		// for the KX.
+		kxd.HalfKX = kxRatchet
 	}
 	err = kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
 		return kx.db.SaveKX(tx, kxd)
@@ -362,6 +417,26 @@ func (kx *kxList) handleStep2IDKX(kxid clientdb.RawRVID, blob lowlevel.RVBlob) e
 		return fmt.Errorf("can't kx with self")
 	}
 
+	// Check whether the KX requires a payment.
+	var kxd clientdb.KXData
+	err = kx.db.View(kx.dbCtx, func(tx clientdb.ReadTx) error {
+		var err error
+		kxd, err = kx.db.GetKX(tx, kxid)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	switch {
+	case kxd.Stage == clientdb.KXStageStep2IDKX && kxd.PaymentMAtoms > 0:
+		return kx.requestKXPayment(&kxd, blob, rmohk)
+
+	case kxd.Stage == clientdb.KXStageStep2PayKX:
+		if err := kx.checkKXPayment(&kxd, rmohk); err != nil {
+			return kx.rejectKX(&kxd, blob, err)
+		}
+	}
+
 	sendRV := rmohk.InitialRendezvous
 
 	// Create full ratchet from rmohk.
@@ -400,12 +475,19 @@ func (kx *kxList) handleStep2IDKX(kxid clientdb.RawRVID, blob lowlevel.RVBlob) e
 	}
 
 	// Remove completed kx from DB.
-	var kxd clientdb.KXData
+	isPaid := kxd.Stage == clientdb.KXStageStep2PayKX
+	var invHash string
+	if isPaid {
+		invHash = invoiceHash(kx.ctx, kx.pc, kx.log, kxd.Invoice)
+	}
 	err = kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
-		var err error
-		kxd, err = kx.db.GetKX(tx, kxid)
-		if err != nil {
-			return err
+		if isPaid {
+			err := kx.recordPayEvent(tx, rmohk.Public.Identity,
+				"kx.payment", clientintf.PaymentCategoryOther,
+				invHash, kxd.PaymentMAtoms, 0)
+			if err != nil {
+				return err
+			}
 		}
 		return kx.db.DeleteKX(tx, kxid)
 	})
@@ -417,14 +499,220 @@ func (kx *kxList) handleStep2IDKX(kxid clientdb.RawRVID, blob lowlevel.RVBlob) e
 	if kx.kxCompleted != nil {
 		kx.kxCompleted(&rmohk.Public, r, kxd.InitialRV, kxd.MyResetRV, rmohk.ResetRendezvous)
 	}
+	if isPaid && kx.kxPaymentReceived != nil {
+		kx.kxPaymentReceived(rmohk.Public.Identity, kxd.PaymentMAtoms)
+	}
+	return nil
+}
+
+// requestKXPayment replies to the RMOHalfKX sent by the target user of an
+// invite that requires a payment with the invoice that must be paid to
+// complete the KX.
+func (kx *kxList) requestKXPayment(kxd *clientdb.KXData, blob lowlevel.RVBlob,
+	rmohk *rpc.RMOHalfKX) error {
+
+	if kx.pc == nil {
+		return fmt.Errorf("no payment client to generate KX invoice")
+	}
+	invoice, err := kx.pc.GetInvoice(kx.ctx, kxd.PaymentMAtoms, nil)
+	if err != nil {
+		return fmt.Errorf("unable to generate KX invoice: %v", err)
+	}
+
+	var payRV [32]byte
+	if _, err := io.ReadFull(kx.randReader, payRV[:]); err != nil {
+		return err
+	}
+
+	// Wait for the paid half kx in the new RV.
+	kxd.Public = rmohk.Public
+	kxd.Stage = clientdb.KXStageStep2PayKX
+	kxd.Invoice = invoice
+	kxd.PayRV = payRV
+	err = kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		return kx.db.UpdateKX(tx, *kxd)
+	})
+	if err != nil {
+		return err
+	}
+	if err := kx.listenInvite(kxd); err != nil {
+		return fmt.Errorf("unable to listen to KX pay RV: %v", err)
+	}
+	if err := kx.rmgr.Unsub(blob.ID); err != nil {
+		kx.log.Warnf("Unable to unsubscribe from step2 kx RV: %v", err)
+	}
+
+	kx.log.Infof("KX %s: requesting payment of %.8f DCR from guest %q id %s",
+		kxd.InitialRV.ShortLogID(), float64(kxd.PaymentMAtoms)/1e11,
+		rmohk.Public.Nick, rmohk.Public.Identity)
+
+	rmopr := rpc.RMOKXPaymentRequired{Invoice: invoice, PayRendezvous: payRV}
+	rm := rawRM{
+		rv:       rmohk.InitialRendezvous,
+		paidRMCB: kx.makePaidForRMCB(rmohk.Public.Identity, "kx.requestpayment"),
+	}
+	rm.msg, err = rpc.EncryptRMO(rmopr, &rmohk.Public.Key, kx.compressLevel)
+	if err != nil {
+		return err
+	}
+	return kx.q.SendRM(rm)
+}
+
+// checkKXPayment checks whether the target user that sent the RMOHalfKX paid
+// the invoice required to complete the KX.
+func (kx *kxList) checkKXPayment(kxd *clientdb.KXData, rmohk *rpc.RMOHalfKX) error {
+	if rmohk.Public.Identity != kxd.Public.Identity {
+		return fmt.Errorf("KX payment requested from %s but half kx "+
+			"sent by %s", kxd.Public.Identity, rmohk.Public.Identity)
+	}
+	if kx.pc == nil {
+		return fmt.Errorf("no payment client to check KX invoice")
+	}
+	if err := kx.pc.IsInvoicePaid(kx.ctx, kxd.PaymentMAtoms, kxd.Invoice); err != nil {
+		return fmt.Errorf("KX invoice not paid: %w", err)
+	}
 	return nil
 }
 
+// rejectKX drops the KX that was not paid by the target user.
+func (kx *kxList) rejectKX(kxd *clientdb.KXData, blob lowlevel.RVBlob, rejectErr error) error {
+	if err := kx.rmgr.Unsub(blob.ID); err != nil {
+		kx.log.Warnf("Unable to unsubscribe from kx RV: %v", err)
+	}
+	err := kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		return kx.db.DeleteKX(tx, kxd.InitialRV)
+	})
+	if err != nil {
+		return err
+	}
+
+	kx.log.Warnf("KX %s: rejected KX with guest %q id %s: %v",
+		kxd.InitialRV.ShortLogID(), kxd.Public.Nick, kxd.Public.Identity,
+		rejectErr)
+	if kx.kxPaymentRejected != nil {
+		kx.kxPaymentRejected(kxd.InitialRV, kxd.Public, rejectErr)
+	}
+	return nil
+}
+
+// payKX pays the invoice required by the source user of an invite to complete
+// the KX, then sends the RMOHalfKX again to the source user.
+func (kx *kxList) payKX(kxid clientdb.RawRVID, blob lowlevel.RVBlob,
+	kxpr rpc.RMOKXPaymentRequired) error {
+
+	var kxd clientdb.KXData
+	err := kx.db.View(kx.dbCtx, func(tx clientdb.ReadTx) error {
+		var err error
+		kxd, err = kx.db.GetKX(tx, kxid)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// On any failure, give up on the KX.
+	fail := func(failErr error) error {
+		if err := kx.rmgr.Unsub(blob.ID); err != nil {
+			kx.log.Warnf("Unable to unsubscribe from step3 kx RV: %v", err)
+		}
+		err := kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
+			return kx.db.DeleteKX(tx, kxid)
+		})
+		if err != nil {
+			kx.log.Warnf("Unable to delete KX %s: %v", kxid, err)
+		}
+		return fmt.Errorf("unable to pay for KX: %w", failErr)
+	}
+
+	if kxd.PaymentMAtoms <= 0 || kxd.HalfKX == nil {
+		return fail(errors.New("invite did not require payment"))
+	}
+	if kx.pc == nil {
+		return fail(errors.New("no payment client to pay KX invoice"))
+	}
+	decoded, err := kx.pc.DecodeInvoice(kx.ctx, kxpr.Invoice)
+	if err != nil {
+		return fail(err)
+	}
+	if decoded.MAtoms > kxd.PaymentMAtoms {
+		return fail(fmt.Errorf("invoice amount %d > advertised KX "+
+			"payment amount %d", decoded.MAtoms, kxd.PaymentMAtoms))
+	}
+
+	kx.log.Infof("KX %s: paying %.8f DCR requested by host %q id %s",
+		kxid.ShortLogID(), float64(kxd.PaymentMAtoms)/1e11,
+		kxd.Public.Nick, kxd.Public.Identity)
+	ctx := clientintf.WithPaymentCategory(kx.ctx, clientintf.PaymentCategoryOther)
+	var fees int64
+	if decoded.MAtoms == 0 {
+		fees, err = kx.pc.PayInvoiceAmount(ctx, kxpr.Invoice, kxd.PaymentMAtoms)
+	} else {
+		fees, err = kx.pc.PayInvoice(ctx, kxpr.Invoice)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	// Listen for the reply in a new RV.
+	var rv [32]byte
+	if _, err := io.ReadFull(kx.randReader, rv[:]); err != nil {
+		return err
+	}
+	if err := kx.rmgr.Unsub(blob.ID); err != nil {
+		kx.log.Warnf("Unable to unsubscribe from step3 kx RV: %v", err)
+	}
+	kxd.Step3RV = rv
+	kxd.Invoice = kxpr.Invoice
+	invHash := hex.EncodeToString(decoded.ID)
+	err = kx.db.Update(kx.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		err := kx.recordPayEvent(tx, kxd.Public.Identity, "kx.payment",
+			clientintf.PaymentCategoryOther, invHash,
+			-kxd.PaymentMAtoms, -fees)
+		if err != nil {
+			return err
+		}
+		return kx.db.UpdateKX(tx, kxd)
+	})
+	if err != nil {
+		return err
+	}
+	if err := kx.listenInvite(&kxd); err != nil {
+		return fmt.Errorf("unable to listen to paid invite: %v", err)
+	}
+
+	// Send the half kx again, now to the pay RV.
+	rmohk := rpc.RMOHalfKX{
+		Public:            kx.public(),
+		HalfKX:            *kxd.HalfKX,
+		InitialRendezvous: rv,
+		ResetRendezvous:   kxd.MyResetRV,
+	}
+	rm := rawRM{
+		rv:       kxpr.PayRendezvous,
+		paidRMCB: kx.makePaidForRMCB(kxd.Public.Identity, "kx.paidhalfkx"),
+	}
+	rm.msg, err = rpc.EncryptRMO(rmohk, &kxd.Public.Key, kx.compressLevel)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt RMOHalfKX: %v", err)
+	}
+	return kx.q.SendRM(rm)
+}
+
 func (kx *kxList) handleStep3IDKX(kxid clientdb.RawRVID, blob lowlevel.RVBlob) error {
-	// Decrypt remote msg.
-	fullKX, err := rpc.DecryptOOBFullKXBlob(blob.Decoded, kx.privKey, uint(kx.q.MaxMsgSize()))
+	// Decrypt remote msg. The source user may require a payment before
+	// sending the full kx.
+	msg, err := rpc.DecryptOOB(blob.Decoded, kx.privKey, uint(kx.q.MaxMsgSize()))
 	if err != nil {
-		return fmt.Errorf("step3IDKX DecryptOOBFullKXBlob: %v", err)
+		return fmt.Errorf("step3IDKX DecryptOOB: %v", err)
+	}
+	var fullKX rpc.RMOFullKX
+	switch msg := msg.(type) {
+	case rpc.RMOFullKX:
+		fullKX = msg
+	case rpc.RMOKXPaymentRequired:
+		return kx.payKX(kxid, blob, msg)
+	default:
+		return fmt.Errorf("step3IDKX: unexpected message type %T", msg)
 	}
 
 	var r *ratchet.Ratchet
@@ -513,6 +801,10 @@ func (kx *kxList) listenInvite(kxd *clientdb.KXData) error {
 		rv = kxd.Step3RV
 		kxHandler = kx.handleStep3IDKX
 		subPaidHandler = kx.makePaidForRMCB(kxd.Public.Identity, "sub.step3IDKX")
+	case clientdb.KXStageStep2PayKX:
+		rv = kxd.PayRV
+		kxHandler = kx.handleStep2IDKX
+		subPaidHandler = kx.makePaidForRMCB(kxd.Public.Identity, "sub.step2PayKX")
 	default:
 		return fmt.Errorf("unknown kx stage to listen on: %d", kxd.Stage)
 	}
@@ -544,6 +836,8 @@ func (kx *kxList) unlistenInvite(kxd *clientdb.KXData) error {
 		rv = kxd.InitialRV
 	case clientdb.KXStageStep3IDKX:
 		rv = kxd.Step3RV
+	case clientdb.KXStageStep2PayKX:
+		rv = kxd.PayRV
 	default:
 		return fmt.Errorf("unknown kx stage to unlisten on: %d", kxd.Stage)
 	}
@@ -654,7 +948,7 @@ func (kx *kxList) handleReset(id *zkidentity.PublicIdentity, blob lowlevel.RVBlo
 		blob.ID, id.Identity, id.Nick)
 
 	// Kickstart a new kx process.
-	return kx.acceptInvite(*pii, true, false)
+	return kx.acceptInvite(*pii, true, false, 0)
 }
 
 // listenReset listens for a reset invite from the given user in the specified
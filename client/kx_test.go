@@ -11,6 +11,8 @@ import (
 
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/internal/lowlevel"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/testutils"
 	"github.com/companyzero/bisonrelay/ratchet"
 	"github.com/companyzero/bisonrelay/rpc"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -63,7 +65,7 @@ func TestKXSucceeds(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = bob.acceptInvite(bobInvite, false, false)
+	err = bob.acceptInvite(bobInvite, false, false, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -84,6 +86,80 @@ func TestKXSucceeds(t *testing.T) {
 	assertRatchetsSynced(t, aliceR, bobR)
 }
 
+// TestKXRequiresPayment tests that invites that require a payment are only
+// completed after the guest pays the host's invoice.
+func TestKXRequiresPayment(t *testing.T) {
+	t.Parallel()
+
+	rnd := testRand(t)
+	arnd := rand.New(rand.NewSource(rnd.Int63()))
+	brnd := rand.New(rand.NewSource(rnd.Int63()))
+	svr := newMockRMServer(t)
+
+	const price = 1000
+	alice := newTestKXList(t, svr, arnd, "alice")
+	bob := newTestKXList(t, svr, brnd, "bob")
+	alicePC, bobPC := &testutils.MockPayClient{}, &testutils.MockPayClient{}
+	alice.pc, bob.pc = alicePC, bobPC
+	alice.paymentMAtoms = price
+
+	aliceRChan, bobRChan := make(chan *ratchet.Ratchet, 1), make(chan *ratchet.Ratchet, 1)
+	alice.kxCompleted = func(id *zkidentity.PublicIdentity, r *ratchet.Ratchet, irrv, mrrv, trrv clientdb.RawRVID) {
+		aliceRChan <- r
+	}
+	bob.kxCompleted = func(id *zkidentity.PublicIdentity, r *ratchet.Ratchet, irrv, mrrv, trrv clientdb.RawRVID) {
+		bobRChan <- r
+	}
+	receivedChan := make(chan int64, 1)
+	alice.kxPaymentReceived = func(uid UserID, amount int64) {
+		receivedChan <- amount
+	}
+	rejectedChan := make(chan error, 1)
+	alice.kxPaymentRejected = func(_ clientdb.RawRVID, _ zkidentity.PublicIdentity, err error) {
+		rejectedChan <- err
+	}
+	paidChan := make(chan string, 1)
+	bobPC.HookPayInvoice(func(invoice string) (int64, error) {
+		paidChan <- invoice
+		return 0, nil
+	})
+
+	doKX := func(maxPayment int64) error {
+		t.Helper()
+		buff := new(bytes.Buffer)
+		invite, err := alice.createInvite(buff, nil, nil, false, nil)
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, invite.KXPaymentMAtoms, int64(price))
+		bobInvite, err := bob.decodeInvite(buff)
+		assert.NilErr(t, err)
+		return bob.acceptInvite(bobInvite, false, false, maxPayment)
+	}
+
+	// Bob does not agree to pay for the KX, so the invite is not accepted.
+	assert.ErrorIs(t, doKX(0), ErrKXPaymentNotAllowed{})
+	assert.ErrorIs(t, doKX(price-1), ErrKXPaymentNotAllowed{})
+	assert.ChanNotWritten(t, paidChan, 500*time.Millisecond)
+	assert.ChanNotWritten(t, aliceRChan, 0)
+
+	// Bob pays for the KX, which completes.
+	assert.NilErr(t, doKX(price))
+	assert.ChanWritten(t, paidChan)
+	aliceR := assert.ChanWritten(t, aliceRChan)
+	bobR := assert.ChanWritten(t, bobRChan)
+	assertRatchetsSynced(t, aliceR, bobR)
+	assert.ChanWrittenWithVal(t, receivedChan, int64(price))
+
+	// Alice does not see the invoice paid, so the KX is rejected.
+	alicePC.HookIsInvoicePaid(func(int64, string) error {
+		return errors.New("invoice not paid")
+	})
+	assert.NilErr(t, doKX(price))
+	assert.ChanWritten(t, paidChan)
+	assert.NonNilErr(t, assert.ChanWritten(t, rejectedChan))
+	assert.ChanNotWritten(t, bobRChan, 500*time.Millisecond)
+	assert.ChanNotWritten(t, aliceRChan, 0)
+}
+
 // TestRepeatedResetActivation tests that the kx list correctly handles repeated
 // activation of a reset RV.
 func TestRepeatedResetActivation(t *testing.T) {
@@ -0,0 +1,582 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/lnrpc"
+	lpclient "github.com/decred/dcrlnlpd/client"
+	"github.com/decred/slog"
+)
+
+// LNLiquidityConfig configures the automatic management of the liquidity of
+// the LN wallet.
+type LNLiquidityConfig struct {
+	// MinInbound is the minimum inbound (receive) capacity. When the
+	// inbound capacity is lower than this, an inbound channel of size
+	// InboundChanSize is requested from the liquidity provider.
+	MinInbound      dcrutil.Amount
+	InboundChanSize dcrutil.Amount
+
+	// MinOutbound is the minimum outbound (send) capacity. When the
+	// outbound capacity is lower than this, a channel of size
+	// OutboundChanSize is opened to the hub node, funded by the on-chain
+	// wallet.
+	MinOutbound      dcrutil.Amount
+	OutboundChanSize dcrutil.Amount
+
+	// Budget is the maximum amount that may be spent within BudgetWindow.
+	// Both the fees paid to the liquidity provider and the funds used to
+	// open outbound channels count towards the budget.
+	Budget       dcrutil.Amount
+	BudgetWindow time.Duration
+
+	// AutoPerform indicates whether actions are performed automatically.
+	// When false, actions are only proposed and must be approved with
+	// ApproveLNLiquidityAction.
+	AutoPerform bool
+
+	// CheckInterval is how often the channel balances are checked. If
+	// unspecified, balances are checked every 10 minutes.
+	CheckInterval time.Duration
+
+	// LPDServer and LPDCert are the address and TLS certificate of the
+	// liquidity provider. If empty, the default provider of the network
+	// is used.
+	LPDServer string
+	LPDCert   []byte
+
+	// HubPubKey and HubAddr are the pubkey and address of the node with
+	// which outbound channels are opened. If empty, the default hub of
+	// the network is used.
+	HubPubKey string
+	HubAddr   string
+}
+
+// maxLNLiquidityActions is the max number of finished actions kept by the
+// liquidity manager.
+const maxLNLiquidityActions = 100
+
+// lnLiquidityManager watches the channel balances of the LN wallet and
+// proposes or performs actions to keep them above the configured targets.
+// The actions and the amounts spent are persisted in the db.
+type lnLiquidityManager struct {
+	cfg   LNLiquidityConfig
+	lc    lnrpc.LightningClient
+	log   slog.Logger
+	db    *clientdb.DB
+	dbCtx context.Context
+
+	// requestInbound requests an inbound channel of the given size. The
+	// maxFee is the max amount that may be paid for the channel. It
+	// returns the channel point and the fee paid.
+	requestInbound func(ctx context.Context, chanSize uint64,
+		maxFee dcrutil.Amount) (string, dcrutil.Amount, error)
+
+	// actionUpdated is called whenever an action is created or updated.
+	actionUpdated func(clientdb.LNLiquidityAction)
+
+	mtx    sync.Mutex
+	loaded bool
+	state  clientdb.LNLiquidityState
+}
+
+func newLNLiquidityManager(cfg LNLiquidityConfig, lc lnrpc.LightningClient,
+	db *clientdb.DB, dbCtx context.Context, log slog.Logger) *lnLiquidityManager {
+
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = 10 * time.Minute
+	}
+	m := &lnLiquidityManager{
+		cfg:           cfg,
+		lc:            lc,
+		log:           log,
+		db:            db,
+		dbCtx:         dbCtx,
+		actionUpdated: func(clientdb.LNLiquidityAction) {},
+	}
+	m.requestInbound = m.lpRequestInbound
+	return m
+}
+
+// loadState loads the state from the db, if it was not loaded yet. Actions
+// that were being performed when the client was last stopped are marked as
+// failed. Must be called with the mutex held.
+func (m *lnLiquidityManager) loadState() error {
+	if m.loaded {
+		return nil
+	}
+	var interrupted bool
+	err := m.db.Update(m.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		var err error
+		m.state, err = m.db.ReadLNLiquidityState(tx)
+		if err != nil {
+			return err
+		}
+		for i := range m.state.Actions {
+			a := &m.state.Actions[i]
+			if a.Status != clientdb.LNLiquidityActionPerforming {
+				continue
+			}
+			a.Status = clientdb.LNLiquidityActionFailed
+			a.Error = "interrupted by client shutdown"
+			a.Updated = time.Now()
+			interrupted = true
+		}
+		if !interrupted {
+			return nil
+		}
+		return m.db.StoreLNLiquidityState(tx, m.state)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to load LN liquidity state: %w", err)
+	}
+	m.loaded = true
+	return nil
+}
+
+// storeState stores the state in the db. Must be called with the mutex held.
+func (m *lnLiquidityManager) storeState() error {
+	return m.db.Update(m.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		return m.db.StoreLNLiquidityState(tx, m.state)
+	})
+}
+
+// spent returns the amount spent within the budget window. Spends older than
+// the window are dropped. Must be called with the mutex held.
+func (m *lnLiquidityManager) spent(now time.Time) dcrutil.Amount {
+	limit := now.Add(-m.cfg.BudgetWindow)
+	m.state.Spends = slices.DeleteFunc(m.state.Spends, func(s clientdb.LNLiquiditySpend) bool {
+		return s.Time.Before(limit)
+	})
+	var total dcrutil.Amount
+	for _, s := range m.state.Spends {
+		total += s.Amount
+	}
+	return total
+}
+
+// remainingBudget returns how much may still be spent within the budget
+// window.
+func (m *lnLiquidityManager) remainingBudget() (dcrutil.Amount, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := m.loadState(); err != nil {
+		return 0, err
+	}
+	return m.cfg.Budget - m.spent(time.Now()), nil
+}
+
+// updateAction modifies the action with the given id. The amount spent on the
+// action, if any, is recorded along with the update. It returns a copy of the
+// modified action.
+func (m *lnLiquidityManager) updateAction(id uint64, f func(a *clientdb.LNLiquidityAction) error) (clientdb.LNLiquidityAction, error) {
+	m.mtx.Lock()
+	if err := m.loadState(); err != nil {
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, err
+	}
+	i := slices.IndexFunc(m.state.Actions, func(a clientdb.LNLiquidityAction) bool {
+		return a.ID == id
+	})
+	if i < 0 {
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, fmt.Errorf("liquidity action %d not found", id)
+	}
+	a := m.state.Actions[i]
+	oldCost := a.Cost
+	if err := f(&a); err != nil {
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, err
+	}
+	a.Updated = time.Now()
+	oldSpends := m.state.Spends
+	if a.Cost > oldCost {
+		m.state.Spends = append(slices.Clip(m.state.Spends), clientdb.LNLiquiditySpend{
+			Time:   a.Updated,
+			Amount: a.Cost - oldCost,
+		})
+	}
+	oldAction := m.state.Actions[i]
+	m.state.Actions[i] = a
+	if err := m.storeState(); err != nil {
+		m.state.Actions[i] = oldAction
+		m.state.Spends = oldSpends
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, err
+	}
+	m.mtx.Unlock()
+
+	m.actionUpdated(a)
+	return a, nil
+}
+
+// addAction adds a new proposed action, unless one of the same type is still
+// pending.
+func (m *lnLiquidityManager) addAction(typ clientdb.LNLiquidityActionType, amount dcrutil.Amount,
+	reason string) (clientdb.LNLiquidityAction, bool, error) {
+
+	m.mtx.Lock()
+	if err := m.loadState(); err != nil {
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, false, err
+	}
+	if slices.ContainsFunc(m.state.Actions, func(a clientdb.LNLiquidityAction) bool {
+		return a.Type == typ && a.IsPending()
+	}) {
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, false, nil
+	}
+
+	// Drop the oldest finished actions.
+	oldState := m.state
+	actions := slices.Clone(m.state.Actions)
+	for len(actions) >= maxLNLiquidityActions {
+		i := slices.IndexFunc(actions, func(a clientdb.LNLiquidityAction) bool {
+			return !a.IsPending()
+		})
+		if i < 0 {
+			break
+		}
+		actions = slices.Delete(actions, i, i+1)
+	}
+
+	now := time.Now()
+	a := clientdb.LNLiquidityAction{
+		ID:      m.state.NextID + 1,
+		Type:    typ,
+		Status:  clientdb.LNLiquidityActionProposed,
+		Amount:  amount,
+		Reason:  reason,
+		Created: now,
+		Updated: now,
+	}
+	m.state.NextID = a.ID
+	m.state.Actions = append(actions, a)
+	if err := m.storeState(); err != nil {
+		m.state = oldState
+		m.mtx.Unlock()
+		return clientdb.LNLiquidityAction{}, false, err
+	}
+	m.mtx.Unlock()
+
+	m.log.Infof("Proposing liquidity action %d: %s of %s (%s)", a.ID, typ,
+		amount, reason)
+	m.actionUpdated(a)
+	return a, true, nil
+}
+
+// listActions returns the actions proposed or performed by the manager.
+func (m *lnLiquidityManager) listActions() ([]clientdb.LNLiquidityAction, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := m.loadState(); err != nil {
+		return nil, err
+	}
+	return slices.Clone(m.state.Actions), nil
+}
+
+// check checks the channel balances against the configured targets and
+// proposes (and, if configured to, performs) the needed actions.
+func (m *lnLiquidityManager) check(ctx context.Context) error {
+	// Do not take any action while channels are pending, because the
+	// balances will change once they are opened.
+	pending, err := m.lc.PendingChannels(ctx, &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	if len(pending.PendingOpenChannels) > 0 {
+		m.log.Debugf("Skipping liquidity check due to %d pending channels",
+			len(pending.PendingOpenChannels))
+		return nil
+	}
+
+	balance, err := m.lc.ChannelBalance(ctx, &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	inbound := dcrutil.Amount(balance.MaxInboundAmount)
+	outbound := dcrutil.Amount(balance.MaxOutboundAmount)
+	m.log.Debugf("Checking liquidity: inbound %s, outbound %s", inbound,
+		outbound)
+
+	var proposed []clientdb.LNLiquidityAction
+	if m.cfg.MinOutbound > 0 && outbound < m.cfg.MinOutbound {
+		reason := fmt.Sprintf("outbound capacity %s < %s", outbound,
+			m.cfg.MinOutbound)
+		a, ok, err := m.addAction(clientdb.LNLiquidityOpenOutbound,
+			m.cfg.OutboundChanSize, reason)
+		if err != nil {
+			return err
+		}
+		if ok {
+			proposed = append(proposed, a)
+		}
+	}
+	if m.cfg.MinInbound > 0 && inbound < m.cfg.MinInbound {
+		reason := fmt.Sprintf("inbound capacity %s < %s", inbound,
+			m.cfg.MinInbound)
+		a, ok, err := m.addAction(clientdb.LNLiquidityRequestInbound,
+			m.cfg.InboundChanSize, reason)
+		if err != nil {
+			return err
+		}
+		if ok {
+			proposed = append(proposed, a)
+		}
+	}
+
+	if !m.cfg.AutoPerform {
+		return nil
+	}
+	for _, a := range proposed {
+		if err := m.perform(ctx, a.ID); err != nil {
+			m.log.Warnf("Unable to perform liquidity action %d: %v", a.ID, err)
+		}
+	}
+	return nil
+}
+
+// reject rejects a proposed action.
+func (m *lnLiquidityManager) reject(id uint64) error {
+	_, err := m.updateAction(id, func(a *clientdb.LNLiquidityAction) error {
+		if a.Status != clientdb.LNLiquidityActionProposed {
+			return fmt.Errorf("liquidity action %d is %s", id, a.Status)
+		}
+		a.Status = clientdb.LNLiquidityActionRejected
+		return nil
+	})
+	return err
+}
+
+// perform performs a proposed action. The amount spent on the action is
+// recorded even if the action fails.
+func (m *lnLiquidityManager) perform(ctx context.Context, id uint64) error {
+	a, err := m.updateAction(id, func(a *clientdb.LNLiquidityAction) error {
+		if a.Status != clientdb.LNLiquidityActionProposed {
+			return fmt.Errorf("liquidity action %d is %s", id, a.Status)
+		}
+		a.Status = clientdb.LNLiquidityActionPerforming
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.log.Infof("Performing liquidity action %d: %s of %s", a.ID, a.Type,
+		a.Amount)
+	var cp string
+	var cost dcrutil.Amount
+	var remaining dcrutil.Amount
+	remaining, err = m.remainingBudget()
+	switch {
+	case err != nil:
+	case a.Type == clientdb.LNLiquidityOpenOutbound:
+		cp, cost, err = m.openOutbound(ctx, a.Amount, remaining)
+	case a.Type == clientdb.LNLiquidityRequestInbound:
+		cp, cost, err = m.requestInbound(ctx, uint64(a.Amount), remaining)
+	default:
+		err = fmt.Errorf("unknown liquidity action type %q", a.Type)
+	}
+
+	_, updateErr := m.updateAction(id, func(a *clientdb.LNLiquidityAction) error {
+		a.Cost = cost
+		a.ChannelPoint = cp
+		if err != nil {
+			a.Status = clientdb.LNLiquidityActionFailed
+			a.Error = err.Error()
+		} else {
+			a.Status = clientdb.LNLiquidityActionCompleted
+		}
+		return nil
+	})
+	if updateErr != nil {
+		return updateErr
+	}
+	if err != nil {
+		return err
+	}
+	m.log.Infof("Completed liquidity action %d: %s of %s in channel %s",
+		a.ID, a.Type, a.Amount, cp)
+	return nil
+}
+
+// openOutbound opens an outbound channel with the hub node.
+func (m *lnLiquidityManager) openOutbound(ctx context.Context, amount,
+	remainingBudget dcrutil.Amount) (string, dcrutil.Amount, error) {
+
+	if amount > remainingBudget {
+		return "", 0, fmt.Errorf("channel size %s exceeds remaining "+
+			"budget %s", amount, remainingBudget)
+	}
+
+	wallet, err := m.lc.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return "", 0, err
+	}
+	if dcrutil.Amount(wallet.ConfirmedBalance) < amount {
+		return "", 0, fmt.Errorf("confirmed wallet balance %s is lower "+
+			"than channel size %s",
+			dcrutil.Amount(wallet.ConfirmedBalance), amount)
+	}
+
+	key, server := m.cfg.HubPubKey, m.cfg.HubAddr
+	if key == "" {
+		network, err := m.network(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+		key, server, err = defaultLNHub(network)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	npk, err := hex.DecodeString(key)
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to decode pubkey: %w", err)
+	}
+
+	req := &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: key,
+			Host:   server,
+		},
+	}
+	_, err = m.lc.ConnectPeer(ctx, req)
+	if err != nil && !strings.Contains(err.Error(), "already connected") {
+		return "", 0, err
+	}
+
+	ocr := &lnrpc.OpenChannelRequest{
+		NodePubkey:         npk,
+		LocalFundingAmount: int64(amount),
+	}
+	cp, err := m.lc.OpenChannelSync(ctx, ocr)
+	if err != nil {
+		return "", 0, err
+	}
+	return chanPointToStr(cp), amount, nil
+}
+
+// network returns the network of the LN wallet.
+func (m *lnLiquidityManager) network(ctx context.Context) (string, error) {
+	info, err := m.lc.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+	if len(info.Chains) == 0 || info.Chains[0].Chain != "decred" {
+		return "", fmt.Errorf("not connected to decred LN")
+	}
+	return info.Chains[0].Network, nil
+}
+
+// lpRequestInbound requests an inbound channel from the liquidity provider.
+func (m *lnLiquidityManager) lpRequestInbound(ctx context.Context, chanSize uint64,
+	maxFee dcrutil.Amount) (string, dcrutil.Amount, error) {
+
+	server, cert := m.cfg.LPDServer, m.cfg.LPDCert
+	if server == "" {
+		network, err := m.network(ctx)
+		if err != nil {
+			return "", 0, err
+		}
+		server, cert, err = defaultLPD(network)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	// The fee and whether it was paid are set by the callbacks, which
+	// may still be running if the request is interrupted.
+	var mtx sync.Mutex
+	var fee dcrutil.Amount
+	var paid bool
+	paidFee := func(opened bool) dcrutil.Amount {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if !paid && !opened {
+			return 0
+		}
+		return fee
+	}
+	pendingChan := make(chan string, 1)
+	lpcfg := lpclient.Config{
+		LC:           m.lc,
+		Address:      server,
+		Certificates: cert,
+
+		PolicyFetched: func(policy lpclient.ServerPolicy) error {
+			mtx.Lock()
+			fee = dcrutil.Amount(lpclient.EstimatedInvoiceAmount(chanSize,
+				policy.ChanInvoiceFeeRate))
+			mtx.Unlock()
+			if fee > maxFee {
+				return fmt.Errorf("estimated fee %s exceeds remaining "+
+					"budget %s", fee, maxFee)
+			}
+			m.log.Infof("Requesting inbound channel of %s for an "+
+				"estimated fee of %s", dcrutil.Amount(chanSize), fee)
+			return nil
+		},
+
+		InvoicePaid: func() {
+			mtx.Lock()
+			paid = true
+			mtx.Unlock()
+			m.log.Infof("Invoice for inbound channel paid. Waiting for " +
+				"channel to be opened")
+		},
+
+		PendingChannel: func(channelPoint string, capacity uint64) {
+			m.log.Infof("Detected new pending channel %s with LP node "+
+				"with capacity %s", channelPoint, dcrutil.Amount(capacity))
+			pendingChan <- channelPoint
+		},
+	}
+	lpc, err := lpclient.New(lpcfg)
+	if err != nil {
+		return "", 0, err
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- lpc.RequestChannel(ctx, chanSize)
+	}()
+
+	select {
+	case <-ctx.Done():
+		// The invoice may have been paid before the request was
+		// interrupted, so account for the fee anyway.
+		return "", paidFee(false), ctx.Err()
+	case cp := <-pendingChan:
+		return cp, paidFee(true), nil
+	case err := <-errChan:
+		if err == nil {
+			err = errors.New("liquidity provider did not open channel")
+		}
+		return "", paidFee(false), err
+	}
+}
+
+// run checks the channel balances every CheckInterval.
+func (m *lnLiquidityManager) run(ctx context.Context) error {
+	for {
+		if err := m.check(ctx); err != nil && ctx.Err() == nil {
+			m.log.Warnf("Unable to check LN liquidity: %v", err)
+		}
+
+		select {
+		case <-time.After(m.cfg.CheckInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
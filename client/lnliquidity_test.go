@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/slog"
+	"google.golang.org/grpc"
+)
+
+// mockLiquidityLNClient is a mock LN client that implements the calls needed
+// by the liquidity manager.
+type mockLiquidityLNClient struct {
+	lnrpc.LightningClient
+
+	inbound, outbound int64
+	confirmed         int64
+	pendingOpen       int
+	openChanReqs      chan *lnrpc.OpenChannelRequest
+}
+
+func (lc *mockLiquidityLNClient) ChannelBalance(context.Context, *lnrpc.ChannelBalanceRequest, ...grpc.CallOption) (*lnrpc.ChannelBalanceResponse, error) {
+	return &lnrpc.ChannelBalanceResponse{
+		MaxInboundAmount:  lc.inbound,
+		MaxOutboundAmount: lc.outbound,
+	}, nil
+}
+
+func (lc *mockLiquidityLNClient) PendingChannels(context.Context, *lnrpc.PendingChannelsRequest, ...grpc.CallOption) (*lnrpc.PendingChannelsResponse, error) {
+	return &lnrpc.PendingChannelsResponse{
+		PendingOpenChannels: make([]*lnrpc.PendingChannelsResponse_PendingOpenChannel, lc.pendingOpen),
+	}, nil
+}
+
+func (lc *mockLiquidityLNClient) WalletBalance(context.Context, *lnrpc.WalletBalanceRequest, ...grpc.CallOption) (*lnrpc.WalletBalanceResponse, error) {
+	return &lnrpc.WalletBalanceResponse{ConfirmedBalance: lc.confirmed}, nil
+}
+
+func (lc *mockLiquidityLNClient) ConnectPeer(context.Context, *lnrpc.ConnectPeerRequest, ...grpc.CallOption) (*lnrpc.ConnectPeerResponse, error) {
+	return &lnrpc.ConnectPeerResponse{}, nil
+}
+
+func (lc *mockLiquidityLNClient) OpenChannelSync(_ context.Context, req *lnrpc.OpenChannelRequest, _ ...grpc.CallOption) (*lnrpc.ChannelPoint, error) {
+	lc.openChanReqs <- req
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{FundingTxidStr: strings.Repeat("01", 32)},
+		OutputIndex: 1,
+	}, nil
+}
+
+// TestLNLiquidityManager tests that the liquidity manager proposes and
+// performs actions to keep the channel balances above the configured targets,
+// within the configured budget.
+func TestLNLiquidityManager(t *testing.T) {
+	t.Parallel()
+
+	const hubPubKey = "03" + "0102030405060708091011121314151617181920212223242526272829303132"
+	lc := &mockLiquidityLNClient{
+		inbound:      1e6,
+		outbound:     1e6,
+		confirmed:    1e9,
+		openChanReqs: make(chan *lnrpc.OpenChannelRequest, 5),
+	}
+	cfg := LNLiquidityConfig{
+		MinInbound:       1e7,
+		InboundChanSize:  1e8,
+		MinOutbound:      1e7,
+		OutboundChanSize: 1e8,
+		Budget:           15e7,
+		BudgetWindow:     time.Hour,
+		HubPubKey:        hubPubKey,
+		HubAddr:          "127.0.0.1:9735",
+	}
+	db := testDB(t, nil, slog.Disabled)
+	runTestDB(t, db)
+	ctx := context.Background()
+	m := newLNLiquidityManager(cfg, lc, db, ctx, slog.Disabled)
+	updatedChan := make(chan clientdb.LNLiquidityAction, 10)
+	m.actionUpdated = func(a clientdb.LNLiquidityAction) { updatedChan <- a }
+	inboundChan := make(chan uint64, 5)
+	m.requestInbound = func(_ context.Context, chanSize uint64, maxFee dcrutil.Amount) (string, dcrutil.Amount, error) {
+		inboundChan <- chanSize
+		if maxFee < 1e6 {
+			return "", 0, errors.New("fee exceeds budget")
+		}
+		return "inbound:0", 1e6, nil
+	}
+
+	// Balances below targets propose one action of each type.
+	assert.NilErr(t, m.check(ctx))
+	outAction := assert.ChanWritten(t, updatedChan)
+	inAction := assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, outAction.Type, clientdb.LNLiquidityOpenOutbound)
+	assert.DeepEqual(t, outAction.Status, clientdb.LNLiquidityActionProposed)
+	assert.DeepEqual(t, inAction.Type, clientdb.LNLiquidityRequestInbound)
+	assert.ChanNotWritten(t, lc.openChanReqs, 10*time.Millisecond)
+
+	// Checking again does not duplicate the pending actions.
+	assert.NilErr(t, m.check(ctx))
+	assert.ChanNotWritten(t, updatedChan, 10*time.Millisecond)
+
+	// Approving the outbound action opens the channel.
+	assert.NilErr(t, m.perform(ctx, outAction.ID))
+	req := assert.ChanWritten(t, lc.openChanReqs)
+	assert.DeepEqual(t, req.LocalFundingAmount, int64(cfg.OutboundChanSize))
+	assert.DeepEqual(t, assert.ChanWritten(t, updatedChan).Status, clientdb.LNLiquidityActionPerforming)
+	done := assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, done.Status, clientdb.LNLiquidityActionCompleted)
+	assert.DeepEqual(t, done.Cost, cfg.OutboundChanSize)
+	assert.True(t, done.ChannelPoint != "")
+
+	// Approving a completed action fails.
+	assert.NonNilErr(t, m.perform(ctx, outAction.ID))
+
+	// Rejecting the inbound action.
+	assert.NilErr(t, m.reject(inAction.ID))
+	assert.DeepEqual(t, assert.ChanWritten(t, updatedChan).Status, clientdb.LNLiquidityActionRejected)
+	assert.ChanNotWritten(t, inboundChan, 10*time.Millisecond)
+
+	// While channels are pending, no action is proposed.
+	lc.pendingOpen = 1
+	assert.NilErr(t, m.check(ctx))
+	assert.ChanNotWritten(t, updatedChan, 10*time.Millisecond)
+	lc.pendingOpen = 0
+
+	// Switch to auto perform. The new outbound channel exceeds the budget
+	// and fails, while the inbound request is within the remaining budget.
+	m.cfg.AutoPerform = true
+	assert.NilErr(t, m.check(ctx))
+	outAction = assert.ChanWritten(t, updatedChan)
+	inAction = assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, assert.ChanWritten(t, updatedChan).Status, clientdb.LNLiquidityActionPerforming)
+	failed := assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, failed.ID, outAction.ID)
+	assert.DeepEqual(t, failed.Status, clientdb.LNLiquidityActionFailed)
+	assert.ChanNotWritten(t, lc.openChanReqs, 10*time.Millisecond)
+	assert.DeepEqual(t, assert.ChanWritten(t, updatedChan).Status, clientdb.LNLiquidityActionPerforming)
+	done = assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, done.ID, inAction.ID)
+	assert.DeepEqual(t, done.Status, clientdb.LNLiquidityActionCompleted)
+	assert.DeepEqual(t, assert.ChanWritten(t, inboundChan), uint64(cfg.InboundChanSize))
+	remaining, err := m.remainingBudget()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, remaining, cfg.Budget-cfg.OutboundChanSize-1e6)
+
+	// Once the balances are above the targets, no more actions are
+	// proposed.
+	lc.inbound, lc.outbound = 1e8, 1e8
+	assert.NilErr(t, m.check(ctx))
+	assert.ChanNotWritten(t, updatedChan, 10*time.Millisecond)
+	actions, err := m.listActions()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(actions), 4)
+
+	// A failed inbound request still accounts for the fee that was paid.
+	lc.inbound = 1e6
+	m.requestInbound = func(context.Context, uint64, dcrutil.Amount) (string, dcrutil.Amount, error) {
+		return "", 1e6, context.Canceled
+	}
+	assert.NilErr(t, m.check(ctx))
+	assert.ChanWritten(t, updatedChan)
+	assert.ChanWritten(t, updatedChan)
+	failed = assert.ChanWritten(t, updatedChan)
+	assert.DeepEqual(t, failed.Status, clientdb.LNLiquidityActionFailed)
+	assert.DeepEqual(t, failed.Cost, dcrutil.Amount(1e6))
+	remaining, err = m.remainingBudget()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, remaining, cfg.Budget-cfg.OutboundChanSize-2e6)
+
+	// A new manager (e.g. after a restart) loads the actions and the
+	// amounts spent from the db.
+	m2 := newLNLiquidityManager(cfg, lc, db, ctx, slog.Disabled)
+	actions2, err := m2.listActions()
+	assert.NilErr(t, err)
+	actions, err = m.listActions()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(actions2), len(actions))
+	assert.DeepEqual(t, actions2[len(actions2)-1].ID, failed.ID)
+	assert.DeepEqual(t, actions2[len(actions2)-1].Cost, failed.Cost)
+	remaining2, err := m2.remainingBudget()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, remaining2, remaining)
+}
@@ -83,6 +83,14 @@ type OnKXCompleted func(*clientintf.RawRVID, *RemoteUser, bool)
 
 func (OnKXCompleted) typ() string { return onKXCompleted }
 
+const onKXPaymentRejected = "onKXPaymentRejected"
+
+// OnKXPaymentRejected is called when a KX with a remote user is rejected due
+// to the remote user not paying for an invite that required a payment.
+type OnKXPaymentRejected func(ir *clientintf.RawRVID, public zkidentity.PublicIdentity, err error)
+
+func (OnKXPaymentRejected) typ() string { return onKXPaymentRejected }
+
 const onKXSuggested = "onKXSuggested"
 
 // OnKXSuggested is called after a remote user suggests that this user should KX
@@ -169,6 +177,14 @@ type OnGCAdminsChangedNtfn func(ru *RemoteUser, gc rpc.RMGroupList, added, remov
 
 func (OnGCAdminsChangedNtfn) typ() string { return onGCAdminsChangedNtfnType }
 
+const onGCFileSharedNtfnType = "onGCFileShared"
+
+// OnGCFileSharedNtfn is a handler for files shared with a GC by one of its
+// members.
+type OnGCFileSharedNtfn func(ru *RemoteUser, gcid GCID, file clientdb.RemoteFile, ts time.Time)
+
+func (OnGCFileSharedNtfn) typ() string { return onGCFileSharedNtfnType }
+
 const onKXSearchCompletedNtfnType = "kxSearchCompleted"
 
 // OnKXSearchCompleted is a handler for completed KX search procedures.
@@ -310,6 +326,14 @@ type OnContentListReceived func(user *RemoteUser, files []clientdb.RemoteFile, l
 
 func (OnContentListReceived) typ() string { return onContentListReceived }
 
+const onGCContentListReceived = "onGCContentListReceived"
+
+// OnGCContentListReceived is called when the list of files shared by a GC
+// member with the GC is received.
+type OnGCContentListReceived func(user *RemoteUser, gcid GCID, files []clientdb.RemoteFile, listErr error)
+
+func (OnGCContentListReceived) typ() string { return onGCContentListReceived }
+
 const onFileDownloadCompleted = "onFileDownloadCompleted"
 
 // FileDownloadCompleted is called whenever a download of a file has
@@ -655,6 +679,160 @@ type OnRTDTJoinedInstantCall func(sessionRV zkidentity.ShortID)
 
 func (OnRTDTJoinedInstantCall) typ() string { return onRTDTJoinedInstantCallNtfnType }
 
+const onPostSoldNtfnType = "onPostSold"
+
+// OnPostSoldNtfn is called when a remote user pays the invoice to unlock the
+// full content of a local paid post.
+type OnPostSoldNtfn func(ru *RemoteUser, pid clientintf.PostID, amountMAtoms int64)
+
+func (OnPostSoldNtfn) typ() string { return onPostSoldNtfnType }
+
+const onPaidPostUnlockedNtfnType = "onPaidPostUnlocked"
+
+// OnPaidPostUnlockedNtfn is called when the full content of a paid post is
+// received after paying for it.
+type OnPaidPostUnlockedNtfn func(ru *RemoteUser, pid clientintf.PostID)
+
+func (OnPaidPostUnlockedNtfn) typ() string { return onPaidPostUnlockedNtfnType }
+
+const onPaidPostPurchaseFailedNtfnType = "onPaidPostPurchaseFailed"
+
+// OnPaidPostPurchaseFailedNtfn is called when an attempt to pay for the full
+// content of a paid post fails.
+type OnPaidPostPurchaseFailedNtfn func(ru *RemoteUser, pid clientintf.PostID, err error)
+
+func (OnPaidPostPurchaseFailedNtfn) typ() string { return onPaidPostPurchaseFailedNtfnType }
+
+const onPostCommentHeldNtfnType = "onPostCommentHeld"
+
+// OnPostCommentHeldNtfn is called when a comment on a local post is held for
+// approval. The remote user may be nil if the commenter is not known.
+type OnPostCommentHeldNtfn func(ru *RemoteUser, pid clientintf.PostID, status rpc.PostMetadataStatus)
+
+func (OnPostCommentHeldNtfn) typ() string { return onPostCommentHeldNtfnType }
+
+const onPostExpiredNtfnType = "onPostExpired"
+
+// OnPostExpiredNtfn is called when an expired post is deleted from the local
+// client.
+type OnPostExpiredNtfn func(summ clientdb.PostSummary)
+
+func (OnPostExpiredNtfn) typ() string { return onPostExpiredNtfnType }
+
+const onScheduledPostPublishedNtfnType = "onScheduledPostPublished"
+
+// OnScheduledPostPublishedNtfn is called after an attempt to publish a post
+// draft at its scheduled time. If the attempt failed, err is not nil and the
+// draft is kept unscheduled.
+type OnScheduledPostPublishedNtfn func(draft clientdb.PostDraft, summ clientdb.PostSummary, err error)
+
+func (OnScheduledPostPublishedNtfn) typ() string { return onScheduledPostPublishedNtfnType }
+
+const onPaymentRequestReceivedNtfnType = "onPaymentRequestReceived"
+
+// OnPaymentRequestReceivedNtfn is called when a remote user requests the local
+// client to pay an invoice.
+type OnPaymentRequestReceivedNtfn func(ru *RemoteUser, pr clientdb.PaymentRequest)
+
+func (OnPaymentRequestReceivedNtfn) typ() string { return onPaymentRequestReceivedNtfnType }
+
+const onPaymentRequestUpdatedNtfnType = "onPaymentRequestUpdated"
+
+// OnPaymentRequestUpdatedNtfn is called when the status of a payment request
+// sent to or received from a remote user changes.
+type OnPaymentRequestUpdatedNtfn func(ru *RemoteUser, pr clientdb.PaymentRequest)
+
+func (OnPaymentRequestUpdatedNtfn) typ() string { return onPaymentRequestUpdatedNtfnType }
+
+const onRecurringPaymentReceivedNtfnType = "onRecurringPaymentReceived"
+
+// OnRecurringPaymentReceivedNtfn is called when a remote user starts a
+// recurring payment agreement to support the local client.
+type OnRecurringPaymentReceivedNtfn func(ru *RemoteUser, rp clientdb.RecurringPayment)
+
+func (OnRecurringPaymentReceivedNtfn) typ() string { return onRecurringPaymentReceivedNtfnType }
+
+const onRecurringPaymentUpdatedNtfnType = "onRecurringPaymentUpdated"
+
+// OnRecurringPaymentUpdatedNtfn is called when a period of a recurring payment
+// agreement is paid or when the agreement is completed or canceled.
+type OnRecurringPaymentUpdatedNtfn func(ru *RemoteUser, rp clientdb.RecurringPayment)
+
+func (OnRecurringPaymentUpdatedNtfn) typ() string { return onRecurringPaymentUpdatedNtfnType }
+
+const onRecurringPaymentLapsedNtfnType = "onRecurringPaymentLapsed"
+
+// OnRecurringPaymentLapsedNtfn is called when the payment of a period of a
+// recurring payment agreement fails or is not received in time.
+type OnRecurringPaymentLapsedNtfn func(ru *RemoteUser, rp clientdb.RecurringPayment)
+
+func (OnRecurringPaymentLapsedNtfn) typ() string { return onRecurringPaymentLapsedNtfnType }
+
+const onPaymentBlockedNtfnType = "onPaymentBlocked"
+
+// OnPaymentBlockedNtfn is called when a payment is blocked for exceeding a
+// payment budget. The payment only proceeds after it is approved.
+type OnPaymentBlockedNtfn func(bp BlockedPayment)
+
+func (OnPaymentBlockedNtfn) typ() string { return onPaymentBlockedNtfnType }
+
+const onPaymentBudgetThresholdNtfnType = "onPaymentBudgetThreshold"
+
+// OnPaymentBudgetThresholdNtfn is called when the amount spent within the
+// window of a payment budget crosses the warning threshold.
+type OnPaymentBudgetThresholdNtfn func(status PaymentBudgetStatus)
+
+func (OnPaymentBudgetThresholdNtfn) typ() string { return onPaymentBudgetThresholdNtfnType }
+
+const onExchangeRateStaleNtfnType = "onExchangeRateStale"
+
+// OnExchangeRateStaleNtfn is called when the exchange rates could not be
+// updated for longer than the configured staleness duration.
+type OnExchangeRateStaleNtfn func(lastUpdated time.Time)
+
+func (OnExchangeRateStaleNtfn) typ() string { return onExchangeRateStaleNtfnType }
+
+const onLedgerEntryNtfnType = "onLedgerEntry"
+
+// OnLedgerEntryNtfn is called when a new entry is appended to the payment
+// ledger.
+type OnLedgerEntryNtfn func(entry clientdb.LedgerEntry)
+
+func (OnLedgerEntryNtfn) typ() string { return onLedgerEntryNtfnType }
+
+const onGCTipProgressNtfnType = "onGCTipProgress"
+
+// OnGCTipProgressNtfn is called when a tip to a group chat is started and
+// whenever the payout to one of its members completes or fails.
+type OnGCTipProgressNtfn func(gt clientdb.GCTip)
+
+func (OnGCTipProgressNtfn) typ() string { return onGCTipProgressNtfnType }
+
+const onOnchainTipNtfnType = "onOnchainTip"
+
+// OnOnchainTipNtfn is called when a tip that could not be paid through LN is
+// paid on-chain instead. It is called on both the payer and payee sides,
+// whenever the status of the on-chain tip changes.
+type OnOnchainTipNtfn func(ru *RemoteUser, ot clientdb.OnchainTip)
+
+func (OnOnchainTipNtfn) typ() string { return onOnchainTipNtfnType }
+
+const onFeedItemImportedNtfnType = "onFeedItemImported"
+
+// OnFeedItemImportedNtfn is called when an item of an external feed is
+// republished as a local post by the feed bridge.
+type OnFeedItemImportedNtfn func(source string, item clientdb.ImportedFeedItem, summ clientdb.PostSummary)
+
+func (OnFeedItemImportedNtfn) typ() string { return onFeedItemImportedNtfnType }
+
+const onLNLiquidityActionNtfnType = "onLNLiquidityAction"
+
+// OnLNLiquidityActionNtfn is called when an action to manage the liquidity of
+// the LN wallet is proposed or its status changes.
+type OnLNLiquidityActionNtfn func(action clientdb.LNLiquidityAction)
+
+func (OnLNLiquidityActionNtfn) typ() string { return onLNLiquidityActionNtfnType }
+
 // The following is used only in tests.
 
 const onTestNtfnType = "testNtfnType"
@@ -974,6 +1152,11 @@ func (nmgr *NotificationManager) notifyOnKXCompleted(ir *clientintf.RawRVID, use
 		visit(func(h OnKXCompleted) { h(ir, user, isNew) })
 }
 
+func (nmgr *NotificationManager) notifyKXPaymentRejected(ir clientintf.RawRVID, public zkidentity.PublicIdentity, err error) {
+	nmgr.handlers[onKXPaymentRejected].(*handlersFor[OnKXPaymentRejected]).
+		visit(func(h OnKXPaymentRejected) { h(&ir, public, err) })
+}
+
 func (nmgr *NotificationManager) notifyOnKXSearchCompleted(user *RemoteUser) {
 	nmgr.handlers[onKXSearchCompletedNtfnType].(*handlersFor[OnKXSearchCompleted]).
 		visit(func(h OnKXSearchCompleted) { h(user) })
@@ -1029,6 +1212,11 @@ func (nmgr *NotificationManager) notifyOnGCKilled(ru *RemoteUser, gcid GCID, rea
 		visit(func(h OnGCKilledNtfn) { h(ru, gcid, reason) })
 }
 
+func (nmgr *NotificationManager) notifyGCFileShared(ru *RemoteUser, gcid GCID, file clientdb.RemoteFile, ts time.Time) {
+	nmgr.handlers[onGCFileSharedNtfnType].(*handlersFor[OnGCFileSharedNtfn]).
+		visit(func(h OnGCFileSharedNtfn) { h(ru, gcid, file, ts) })
+}
+
 func (nmgr *NotificationManager) notifyGCAdminsChanged(ru *RemoteUser, gc rpc.RMGroupList,
 	added, removed []zkidentity.ShortID) {
 	nmgr.handlers[onGCAdminsChangedNtfnType].(*handlersFor[OnGCAdminsChangedNtfn]).
@@ -1117,6 +1305,11 @@ func (nmgr *NotificationManager) notifyContentListReceived(user *RemoteUser, fil
 
 }
 
+func (nmgr *NotificationManager) notifyGCContentListReceived(user *RemoteUser, gcid GCID, files []clientdb.RemoteFile, listErr error) {
+	nmgr.handlers[onGCContentListReceived].(*handlersFor[OnGCContentListReceived]).
+		visit(func(h OnGCContentListReceived) { h(user, gcid, files, listErr) })
+}
+
 func (nmgr *NotificationManager) notifyFileDownloadCompleted(user *RemoteUser, fm rpc.FileMetadata, diskPath string) {
 	nmgr.handlers[onFileDownloadCompleted].(*handlersFor[OnFileDownloadCompleted]).
 		visit(func(h OnFileDownloadCompleted) { h(user, fm, diskPath) })
@@ -1296,6 +1489,102 @@ func (nmgr *NotificationManager) notifyRTDTJoinedInstantCall(sessionRV zkidentit
 		visit(func(h OnRTDTJoinedInstantCall) { h(sessionRV) })
 }
 
+func (nmgr *NotificationManager) notifyPostSold(ru *RemoteUser, pid clientintf.PostID, amountMAtoms int64) {
+	nmgr.handlers[onPostSoldNtfnType].(*handlersFor[OnPostSoldNtfn]).
+		visit(func(h OnPostSoldNtfn) { h(ru, pid, amountMAtoms) })
+}
+
+func (nmgr *NotificationManager) notifyPaidPostUnlocked(ru *RemoteUser, pid clientintf.PostID) {
+	nmgr.handlers[onPaidPostUnlockedNtfnType].(*handlersFor[OnPaidPostUnlockedNtfn]).
+		visit(func(h OnPaidPostUnlockedNtfn) { h(ru, pid) })
+}
+
+func (nmgr *NotificationManager) notifyPaidPostPurchaseFailed(ru *RemoteUser, pid clientintf.PostID, err error) {
+	nmgr.handlers[onPaidPostPurchaseFailedNtfnType].(*handlersFor[OnPaidPostPurchaseFailedNtfn]).
+		visit(func(h OnPaidPostPurchaseFailedNtfn) { h(ru, pid, err) })
+}
+
+func (nmgr *NotificationManager) notifyPostCommentHeld(ru *RemoteUser, pid clientintf.PostID, status rpc.PostMetadataStatus) {
+	nmgr.handlers[onPostCommentHeldNtfnType].(*handlersFor[OnPostCommentHeldNtfn]).
+		visit(func(h OnPostCommentHeldNtfn) { h(ru, pid, status) })
+}
+
+func (nmgr *NotificationManager) notifyPostExpired(summ clientdb.PostSummary) {
+	nmgr.handlers[onPostExpiredNtfnType].(*handlersFor[OnPostExpiredNtfn]).
+		visit(func(h OnPostExpiredNtfn) { h(summ) })
+}
+
+func (nmgr *NotificationManager) notifyScheduledPostPublished(draft clientdb.PostDraft,
+	summ clientdb.PostSummary, err error) {
+	nmgr.handlers[onScheduledPostPublishedNtfnType].(*handlersFor[OnScheduledPostPublishedNtfn]).
+		visit(func(h OnScheduledPostPublishedNtfn) { h(draft, summ, err) })
+}
+
+func (nmgr *NotificationManager) notifyPaymentRequestReceived(ru *RemoteUser, pr clientdb.PaymentRequest) {
+	nmgr.handlers[onPaymentRequestReceivedNtfnType].(*handlersFor[OnPaymentRequestReceivedNtfn]).
+		visit(func(h OnPaymentRequestReceivedNtfn) { h(ru, pr) })
+}
+
+func (nmgr *NotificationManager) notifyPaymentRequestUpdated(ru *RemoteUser, pr clientdb.PaymentRequest) {
+	nmgr.handlers[onPaymentRequestUpdatedNtfnType].(*handlersFor[OnPaymentRequestUpdatedNtfn]).
+		visit(func(h OnPaymentRequestUpdatedNtfn) { h(ru, pr) })
+}
+
+func (nmgr *NotificationManager) notifyRecurringPaymentReceived(ru *RemoteUser, rp clientdb.RecurringPayment) {
+	nmgr.handlers[onRecurringPaymentReceivedNtfnType].(*handlersFor[OnRecurringPaymentReceivedNtfn]).
+		visit(func(h OnRecurringPaymentReceivedNtfn) { h(ru, rp) })
+}
+
+func (nmgr *NotificationManager) notifyRecurringPaymentUpdated(ru *RemoteUser, rp clientdb.RecurringPayment) {
+	nmgr.handlers[onRecurringPaymentUpdatedNtfnType].(*handlersFor[OnRecurringPaymentUpdatedNtfn]).
+		visit(func(h OnRecurringPaymentUpdatedNtfn) { h(ru, rp) })
+}
+
+func (nmgr *NotificationManager) notifyRecurringPaymentLapsed(ru *RemoteUser, rp clientdb.RecurringPayment) {
+	nmgr.handlers[onRecurringPaymentLapsedNtfnType].(*handlersFor[OnRecurringPaymentLapsedNtfn]).
+		visit(func(h OnRecurringPaymentLapsedNtfn) { h(ru, rp) })
+}
+
+func (nmgr *NotificationManager) notifyPaymentBlocked(bp BlockedPayment) {
+	nmgr.handlers[onPaymentBlockedNtfnType].(*handlersFor[OnPaymentBlockedNtfn]).
+		visit(func(h OnPaymentBlockedNtfn) { h(bp) })
+}
+
+func (nmgr *NotificationManager) notifyPaymentBudgetThreshold(status PaymentBudgetStatus) {
+	nmgr.handlers[onPaymentBudgetThresholdNtfnType].(*handlersFor[OnPaymentBudgetThresholdNtfn]).
+		visit(func(h OnPaymentBudgetThresholdNtfn) { h(status) })
+}
+
+func (nmgr *NotificationManager) notifyExchangeRateStale(lastUpdated time.Time) {
+	nmgr.handlers[onExchangeRateStaleNtfnType].(*handlersFor[OnExchangeRateStaleNtfn]).
+		visit(func(h OnExchangeRateStaleNtfn) { h(lastUpdated) })
+}
+
+func (nmgr *NotificationManager) notifyLedgerEntry(entry clientdb.LedgerEntry) {
+	nmgr.handlers[onLedgerEntryNtfnType].(*handlersFor[OnLedgerEntryNtfn]).
+		visit(func(h OnLedgerEntryNtfn) { h(entry) })
+}
+
+func (nmgr *NotificationManager) notifyGCTipProgress(gt clientdb.GCTip) {
+	nmgr.handlers[onGCTipProgressNtfnType].(*handlersFor[OnGCTipProgressNtfn]).
+		visit(func(h OnGCTipProgressNtfn) { h(gt) })
+}
+
+func (nmgr *NotificationManager) notifyOnchainTip(ru *RemoteUser, ot clientdb.OnchainTip) {
+	nmgr.handlers[onOnchainTipNtfnType].(*handlersFor[OnOnchainTipNtfn]).
+		visit(func(h OnOnchainTipNtfn) { h(ru, ot) })
+}
+
+func (nmgr *NotificationManager) notifyFeedItemImported(source string, item clientdb.ImportedFeedItem, summ clientdb.PostSummary) {
+	nmgr.handlers[onFeedItemImportedNtfnType].(*handlersFor[OnFeedItemImportedNtfn]).
+		visit(func(h OnFeedItemImportedNtfn) { h(source, item, summ) })
+}
+
+func (nmgr *NotificationManager) notifyLNLiquidityAction(action clientdb.LNLiquidityAction) {
+	nmgr.handlers[onLNLiquidityActionNtfnType].(*handlersFor[OnLNLiquidityActionNtfn]).
+		visit(func(h OnLNLiquidityActionNtfn) { h(action) })
+}
+
 func NewNotificationManager() *NotificationManager {
 	nmgr := &NotificationManager{
 		uiConfig: UINotificationsConfig{
@@ -1308,6 +1597,7 @@ func NewNotificationManager() *NotificationManager {
 			onPMNtfnType:             &handlersFor[OnPMNtfn]{},
 			onGCMNtfnType:            &handlersFor[OnGCMNtfn]{},
 			onKXCompleted:            &handlersFor[OnKXCompleted]{},
+			onKXPaymentRejected:      &handlersFor[OnKXPaymentRejected]{},
 			onKXSuggested:            &handlersFor[OnKXSuggested]{},
 			onBlockNtfnType:          &handlersFor[OnBlockNtfn]{},
 			onPostRcvdNtfnType:       &handlersFor[OnPostRcvdNtfn]{},
@@ -1335,7 +1625,9 @@ func NewNotificationManager() *NotificationManager {
 			onGCUserPartedNtfnType:     &handlersFor[OnGCUserPartedNtfn]{},
 			onGCKilledNtfnType:         &handlersFor[OnGCKilledNtfn]{},
 			onGCAdminsChangedNtfnType:  &handlersFor[OnGCAdminsChangedNtfn]{},
+			onGCFileSharedNtfnType:     &handlersFor[OnGCFileSharedNtfn]{},
 			onContentListReceived:      &handlersFor[OnContentListReceived]{},
+			onGCContentListReceived:    &handlersFor[OnGCContentListReceived]{},
 			onFileDownloadCompleted:    &handlersFor[OnFileDownloadCompleted]{},
 			onFileDownloadProgress:     &handlersFor[OnFileDownloadProgress]{},
 			onServerUnwelcomeError:     &handlersFor[OnServerUnwelcomeError]{},
@@ -1374,6 +1666,25 @@ func NewNotificationManager() *NotificationManager {
 			onRTDTAdminCookiesRcvdNtfnType:      &handlersFor[OnRTDTAdminCookiesReceived]{},
 			onRTDTRTTCalculatedNtfnType:         &handlersFor[OnRTDTRTTCalculated]{},
 			onRTDTJoinedInstantCallNtfnType:     &handlersFor[OnRTDTJoinedInstantCall]{},
+			onPostSoldNtfnType:                  &handlersFor[OnPostSoldNtfn]{},
+			onPaidPostUnlockedNtfnType:          &handlersFor[OnPaidPostUnlockedNtfn]{},
+			onPaidPostPurchaseFailedNtfnType:    &handlersFor[OnPaidPostPurchaseFailedNtfn]{},
+			onPostCommentHeldNtfnType:           &handlersFor[OnPostCommentHeldNtfn]{},
+			onPostExpiredNtfnType:               &handlersFor[OnPostExpiredNtfn]{},
+			onScheduledPostPublishedNtfnType:    &handlersFor[OnScheduledPostPublishedNtfn]{},
+			onPaymentRequestReceivedNtfnType:    &handlersFor[OnPaymentRequestReceivedNtfn]{},
+			onPaymentRequestUpdatedNtfnType:     &handlersFor[OnPaymentRequestUpdatedNtfn]{},
+			onRecurringPaymentReceivedNtfnType:  &handlersFor[OnRecurringPaymentReceivedNtfn]{},
+			onRecurringPaymentUpdatedNtfnType:   &handlersFor[OnRecurringPaymentUpdatedNtfn]{},
+			onRecurringPaymentLapsedNtfnType:    &handlersFor[OnRecurringPaymentLapsedNtfn]{},
+			onPaymentBlockedNtfnType:            &handlersFor[OnPaymentBlockedNtfn]{},
+			onPaymentBudgetThresholdNtfnType:    &handlersFor[OnPaymentBudgetThresholdNtfn]{},
+			onLedgerEntryNtfnType:               &handlersFor[OnLedgerEntryNtfn]{},
+			onExchangeRateStaleNtfnType:         &handlersFor[OnExchangeRateStaleNtfn]{},
+			onGCTipProgressNtfnType:             &handlersFor[OnGCTipProgressNtfn]{},
+			onOnchainTipNtfnType:                &handlersFor[OnOnchainTipNtfn]{},
+			onFeedItemImportedNtfnType:          &handlersFor[OnFeedItemImportedNtfn]{},
+			onLNLiquidityActionNtfnType:         &handlersFor[OnLNLiquidityActionNtfn]{},
 		},
 	}
 	if !nmgr.uiTimer.Stop() {
@@ -0,0 +1,499 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/slog"
+)
+
+// PaymentBudget limits the amount spent on payments of a category within a
+// time window.
+type PaymentBudget struct {
+	// Category is the category of payments limited by the budget. If
+	// empty, the budget limits the payments of all categories.
+	Category clientintf.PaymentCategory
+
+	// Window is the duration of the sliding window in which payments are
+	// accounted for (for example, one hour or one day).
+	Window time.Duration
+
+	// MaxMAtoms is the max amount that may be spent within the window.
+	MaxMAtoms int64
+}
+
+// String returns a human readable description of the budget.
+func (b PaymentBudget) String() string {
+	cat := string(b.Category)
+	if cat == "" {
+		cat = "all payments"
+	}
+	return fmt.Sprintf("%.8f DCR per %s for %s", float64(b.MaxMAtoms)/1e11,
+		b.Window, cat)
+}
+
+// matches returns true if payments of the given category are limited by the
+// budget.
+func (b PaymentBudget) matches(cat clientintf.PaymentCategory) bool {
+	return b.Category == "" || b.Category == cat
+}
+
+// PaymentBudgetStatus is the amount spent within the window of a budget.
+type PaymentBudgetStatus struct {
+	PaymentBudget
+	SpentMAtoms int64
+}
+
+// BlockedPayment is a payment that was blocked for exceeding a budget and is
+// waiting for the user to approve or reject it.
+type BlockedPayment struct {
+	ID         uint64
+	Category   clientintf.PaymentCategory
+	MilliAtoms int64
+	Created    time.Time
+
+	// Invoice is the invoice being paid or, for on-chain payments, the
+	// address being paid to.
+	Invoice string
+
+	// Budget is the budget that would be exceeded by the payment.
+	Budget PaymentBudget
+}
+
+// budgetSpend is an amount spent (or being spent) on a payment.
+type budgetSpend struct {
+	t   time.Time
+	cat clientintf.PaymentCategory
+	amt int64
+
+	// approved is true if the payment exceeded a budget and was approved
+	// by the user.
+	approved bool
+}
+
+// budgetApprovalLifetime is how long the approval of a blocked payment remains
+// valid after the payment attempt fails.
+const budgetApprovalLifetime = 10 * time.Minute
+
+// budgetApproval is the approval of a blocked payment that failed. It allows
+// a new attempt of the payment (for example, with a fresh invoice after the
+// original one expired while waiting for the approval) without blocking it
+// again.
+type budgetApproval struct {
+	cat     clientintf.PaymentCategory
+	amt     int64
+	expires time.Time
+}
+
+// blockedPaymentWaiter tracks a blocked payment while a call to pay it waits
+// for the user decision.
+type blockedPaymentWaiter struct {
+	bp       BlockedPayment
+	approved chan bool
+}
+
+// budgetPayClient is a PaymentClient that enforces the configured budgets on
+// the payments made through the wrapped PaymentClient.
+//
+// Payments that would exceed a budget block until the user approves or
+// rejects them. The amounts spent are stored in the db, so that budgets are
+// enforced across client restarts.
+type budgetPayClient struct {
+	clientintf.PaymentClient
+
+	budgets       []PaymentBudget
+	warnThreshold float64
+	log           slog.Logger
+	ntfns         *NotificationManager
+	db            *clientdb.DB
+	dbCtx         context.Context
+
+	mtx           sync.Mutex
+	loaded        bool
+	spends        []*budgetSpend
+	approvals     []budgetApproval
+	warned        map[int]time.Time
+	blocked       map[uint64]*blockedPaymentWaiter
+	lastBlockedID uint64
+}
+
+func newBudgetPayClient(pc clientintf.PaymentClient, budgets []PaymentBudget,
+	warnThreshold float64, db *clientdb.DB, log slog.Logger,
+	ntfns *NotificationManager) *budgetPayClient {
+
+	return &budgetPayClient{
+		PaymentClient: pc,
+		budgets:       budgets,
+		warnThreshold: warnThreshold,
+		log:           log,
+		ntfns:         ntfns,
+		db:            db,
+		dbCtx:         context.Background(),
+		warned:        make(map[int]time.Time),
+		blocked:       make(map[uint64]*blockedPaymentWaiter),
+	}
+}
+
+// loadSpends loads the spends stored in the db, if they were not loaded yet.
+// Must be called with the mutex held.
+func (bpc *budgetPayClient) loadSpends() error {
+	if bpc.loaded {
+		return nil
+	}
+	var stored []clientdb.PaymentBudgetSpend
+	err := bpc.db.View(bpc.dbCtx, func(tx clientdb.ReadTx) error {
+		var err error
+		stored, err = bpc.db.ReadPaymentBudgetSpends(tx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to load payment budget spends: %w", err)
+	}
+	spends := make([]*budgetSpend, 0, len(stored)+len(bpc.spends))
+	for _, s := range stored {
+		spends = append(spends, &budgetSpend{t: s.Time, cat: s.Category,
+			amt: s.MilliAtoms})
+	}
+	bpc.spends = append(spends, bpc.spends...)
+	bpc.loaded = true
+	return nil
+}
+
+// storeSpends stores the current spends in the db. Must be called with the
+// mutex held.
+func (bpc *budgetPayClient) storeSpends() error {
+	spends := make([]clientdb.PaymentBudgetSpend, len(bpc.spends))
+	for i, s := range bpc.spends {
+		spends[i] = clientdb.PaymentBudgetSpend{Time: s.t, Category: s.cat,
+			MilliAtoms: s.amt}
+	}
+	return bpc.db.Update(bpc.dbCtx, func(tx clientdb.ReadWriteTx) error {
+		return bpc.db.StorePaymentBudgetSpends(tx, spends)
+	})
+}
+
+// addSpend adds and stores a new spend. Must be called with the mutex held.
+func (bpc *budgetPayClient) addSpend(s *budgetSpend) error {
+	bpc.spends = append(bpc.spends, s)
+	if err := bpc.storeSpends(); err != nil {
+		bpc.removeSpend(s)
+		return fmt.Errorf("unable to store payment budget spend: %w", err)
+	}
+	return nil
+}
+
+// useApproval consumes a prior approval for a payment of the given category
+// and amount, if one exists. Must be called with the mutex held.
+func (bpc *budgetPayClient) useApproval(cat clientintf.PaymentCategory, amt int64, now time.Time) bool {
+	bpc.approvals = slices.DeleteFunc(bpc.approvals, func(a budgetApproval) bool {
+		return !now.Before(a.expires)
+	})
+	i := slices.IndexFunc(bpc.approvals, func(a budgetApproval) bool {
+		return a.cat == cat && amt <= a.amt
+	})
+	if i < 0 {
+		return false
+	}
+	bpc.approvals = slices.Delete(bpc.approvals, i, i+1)
+	return true
+}
+
+// spentSince returns the amount spent on payments limited by the given budget
+// since the given time. Must be called with the mutex held.
+func (bpc *budgetPayClient) spentSince(b PaymentBudget, since time.Time) int64 {
+	var total int64
+	for _, s := range bpc.spends {
+		if b.matches(s.cat) && s.t.After(since) {
+			total += s.amt
+		}
+	}
+	return total
+}
+
+// pruneSpends removes the spends that are older than the largest window.
+// Must be called with the mutex held.
+func (bpc *budgetPayClient) pruneSpends(now time.Time) {
+	var maxWindow time.Duration
+	for _, b := range bpc.budgets {
+		maxWindow = max(maxWindow, b.Window)
+	}
+	i := 0
+	for i < len(bpc.spends) && !bpc.spends[i].t.After(now.Add(-maxWindow)) {
+		i++
+	}
+	bpc.spends = bpc.spends[i:]
+}
+
+// reachedThresholds returns the budgets limiting the given spend that crossed
+// the warning threshold and were not yet warned about within their window.
+// Must be called with the mutex held.
+func (bpc *budgetPayClient) reachedThresholds(s *budgetSpend) []PaymentBudgetStatus {
+	var warnings []PaymentBudgetStatus
+	for i, b := range bpc.budgets {
+		if !b.matches(s.cat) {
+			continue
+		}
+		spent := bpc.spentSince(b, s.t.Add(-b.Window))
+		if float64(spent) < float64(b.MaxMAtoms)*bpc.warnThreshold {
+			continue
+		}
+		if last, ok := bpc.warned[i]; ok && s.t.Sub(last) < b.Window {
+			// Already warned within this window.
+			continue
+		}
+		bpc.warned[i] = s.t
+		warnings = append(warnings, PaymentBudgetStatus{PaymentBudget: b, SpentMAtoms: spent})
+	}
+	return warnings
+}
+
+// removeSpend removes a spend for a payment that failed. Must be called with
+// the mutex held.
+func (bpc *budgetPayClient) removeSpend(s *budgetSpend) {
+	for i := range bpc.spends {
+		if bpc.spends[i] == s {
+			bpc.spends = append(bpc.spends[:i], bpc.spends[i+1:]...)
+			return
+		}
+	}
+}
+
+// authorize checks that paying amt on a payment of the category of ctx does not
+// exceed any budgets. If it does, this blocks until the user approves or
+// rejects the payment.
+//
+// The returned spend must be passed to paymentDone() after the payment is
+// attempted.
+func (bpc *budgetPayClient) authorize(ctx context.Context, invoice string, amt int64) (*budgetSpend, error) {
+	cat := clientintf.PaymentCategoryFromContext(ctx)
+	now := time.Now()
+	s := &budgetSpend{t: now, cat: cat, amt: amt}
+
+	bpc.mtx.Lock()
+	if err := bpc.loadSpends(); err != nil {
+		bpc.mtx.Unlock()
+		return nil, err
+	}
+	bpc.pruneSpends(now)
+	var exceeded *PaymentBudget
+	for i, b := range bpc.budgets {
+		if b.matches(cat) && bpc.spentSince(b, now.Add(-b.Window))+amt > b.MaxMAtoms {
+			exceeded = &bpc.budgets[i]
+			break
+		}
+	}
+	if exceeded != nil && bpc.useApproval(cat, amt, now) {
+		bpc.log.Infof("Using prior approval for payment of %.8f DCR (%s)",
+			float64(amt)/1e11, cat)
+		s.approved = true
+		exceeded = nil
+	}
+	if exceeded == nil {
+		err := bpc.addSpend(s)
+		bpc.mtx.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
+	bpc.lastBlockedID += 1
+	w := &blockedPaymentWaiter{
+		bp: BlockedPayment{
+			ID:         bpc.lastBlockedID,
+			Category:   cat,
+			MilliAtoms: amt,
+			Invoice:    invoice,
+			Created:    now,
+			Budget:     *exceeded,
+		},
+		approved: make(chan bool, 1),
+	}
+	bpc.blocked[w.bp.ID] = w
+	bpc.mtx.Unlock()
+
+	bpc.log.Warnf("Blocked payment %d of %.8f DCR (%s) that would exceed "+
+		"budget of %s", w.bp.ID, float64(amt)/1e11, cat, w.bp.Budget)
+	bpc.ntfns.notifyPaymentBlocked(w.bp)
+
+	var approved bool
+	select {
+	case approved = <-w.approved:
+	case <-ctx.Done():
+		bpc.mtx.Lock()
+		delete(bpc.blocked, w.bp.ID)
+		bpc.mtx.Unlock()
+		return nil, ctx.Err()
+	}
+	if !approved {
+		return nil, fmt.Errorf("payment %d rejected: %w", w.bp.ID,
+			ErrPaymentBudgetExceeded)
+	}
+
+	bpc.log.Infof("Blocked payment %d approved", w.bp.ID)
+	s.t = time.Now()
+	s.approved = true
+	bpc.mtx.Lock()
+	err := bpc.addSpend(s)
+	bpc.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// paymentDone removes the spend of a payment that failed or warns about the
+// budgets that reached the warning threshold due to a successful payment.
+//
+// When an approved payment fails, its approval is kept for a new attempt of the
+// same payment.
+func (bpc *budgetPayClient) paymentDone(s *budgetSpend, payErr error) {
+	bpc.mtx.Lock()
+	if payErr != nil {
+		bpc.removeSpend(s)
+		if s.approved {
+			bpc.approvals = append(bpc.approvals, budgetApproval{
+				cat:     s.cat,
+				amt:     s.amt,
+				expires: time.Now().Add(budgetApprovalLifetime),
+			})
+		}
+		err := bpc.storeSpends()
+		bpc.mtx.Unlock()
+		if err != nil {
+			bpc.log.Warnf("Unable to store payment budget spends: %v", err)
+		}
+		return
+	}
+	warnings := bpc.reachedThresholds(s)
+	bpc.mtx.Unlock()
+
+	for _, w := range warnings {
+		bpc.log.Warnf("Payments reached %.8f DCR of budget of %s",
+			float64(w.SpentMAtoms)/1e11, w.PaymentBudget)
+		bpc.ntfns.notifyPaymentBudgetThreshold(w)
+	}
+}
+
+func (bpc *budgetPayClient) PayInvoice(ctx context.Context, invoice string) (int64, error) {
+	if len(bpc.budgets) == 0 {
+		return bpc.PaymentClient.PayInvoice(ctx, invoice)
+	}
+	decoded, err := bpc.PaymentClient.DecodeInvoice(ctx, invoice)
+	if err != nil {
+		return 0, err
+	}
+	s, err := bpc.authorize(ctx, invoice, decoded.MAtoms)
+	if err != nil {
+		return 0, err
+	}
+	fees, err := bpc.PaymentClient.PayInvoice(ctx, invoice)
+	bpc.paymentDone(s, err)
+	return fees, err
+}
+
+func (bpc *budgetPayClient) PayInvoiceAmount(ctx context.Context, invoice string, amt int64) (int64, error) {
+	if len(bpc.budgets) == 0 {
+		return bpc.PaymentClient.PayInvoiceAmount(ctx, invoice, amt)
+	}
+	s, err := bpc.authorize(ctx, invoice, amt)
+	if err != nil {
+		return 0, err
+	}
+	fees, err := bpc.PaymentClient.PayInvoiceAmount(ctx, invoice, amt)
+	bpc.paymentDone(s, err)
+	return fees, err
+}
+
+// SendOnchain sends an on-chain payment through the wrapped payment client,
+// enforcing the budgets as is done for LN payments.
+func (bpc *budgetPayClient) SendOnchain(ctx context.Context, addr string, amount dcrutil.Amount) (chainhash.Hash, error) {
+	opc, ok := bpc.PaymentClient.(clientintf.OnchainPaymentClient)
+	if !ok {
+		return chainhash.Hash{}, fmt.Errorf("payment client is not " +
+			"able to send on-chain payments")
+	}
+	if len(bpc.budgets) == 0 {
+		return opc.SendOnchain(ctx, addr, amount)
+	}
+	s, err := bpc.authorize(ctx, addr, int64(amount)*1000)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	txh, err := opc.SendOnchain(ctx, addr, amount)
+	bpc.paymentDone(s, err)
+	return txh, err
+}
+
+// listBlocked returns the payments currently blocked.
+func (bpc *budgetPayClient) listBlocked() []BlockedPayment {
+	bpc.mtx.Lock()
+	res := make([]BlockedPayment, 0, len(bpc.blocked))
+	for _, w := range bpc.blocked {
+		res = append(res, w.bp)
+	}
+	bpc.mtx.Unlock()
+	return res
+}
+
+// decide approves or rejects a blocked payment.
+func (bpc *budgetPayClient) decide(id uint64, approve bool) error {
+	bpc.mtx.Lock()
+	w, ok := bpc.blocked[id]
+	delete(bpc.blocked, id)
+	bpc.mtx.Unlock()
+	if !ok {
+		return fmt.Errorf("blocked payment %d not found", id)
+	}
+	w.approved <- approve
+	return nil
+}
+
+// status returns the amount spent within the window of each budget.
+func (bpc *budgetPayClient) status() []PaymentBudgetStatus {
+	now := time.Now()
+	bpc.mtx.Lock()
+	if err := bpc.loadSpends(); err != nil {
+		bpc.log.Warnf("Unable to load spends for budgets status: %v", err)
+	}
+	res := make([]PaymentBudgetStatus, len(bpc.budgets))
+	for i, b := range bpc.budgets {
+		res[i] = PaymentBudgetStatus{
+			PaymentBudget: b,
+			SpentMAtoms:   bpc.spentSince(b, now.Add(-b.Window)),
+		}
+	}
+	bpc.mtx.Unlock()
+	return res
+}
+
+// ListBlockedPayments lists the payments that were blocked for exceeding a
+// budget and are waiting for approval.
+func (c *Client) ListBlockedPayments() []BlockedPayment {
+	return c.budgetPC.listBlocked()
+}
+
+// ApproveBlockedPayment allows a blocked payment to proceed, even though it
+// exceeds a budget.
+func (c *Client) ApproveBlockedPayment(id uint64) error {
+	return c.budgetPC.decide(id, true)
+}
+
+// RejectBlockedPayment rejects a blocked payment. The call that attempted the
+// payment fails with ErrPaymentBudgetExceeded.
+func (c *Client) RejectBlockedPayment(id uint64) error {
+	return c.budgetPC.decide(id, false)
+}
+
+// PaymentBudgetsStatus returns the amount spent within the window of each of
+// the configured payment budgets.
+func (c *Client) PaymentBudgetsStatus() []PaymentBudgetStatus {
+	return c.budgetPC.status()
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/testutils"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/slog"
+)
+
+// TestBudgetPayClient tests that payments that exceed a budget are blocked
+// until approved or rejected.
+func TestBudgetPayClient(t *testing.T) {
+	t.Parallel()
+
+	pc := &testutils.MockPayClient{}
+	var payErr error
+	pc.HookPayInvoice(func(string) (int64, error) { return 0, payErr })
+
+	budgets := []PaymentBudget{{
+		Category:  clientintf.PaymentCategoryTips,
+		Window:    time.Hour,
+		MaxMAtoms: 1000,
+	}}
+	rnd := testRand(t)
+	db := testDB(t, testID(t, rnd, "alice"), nil)
+	runTestDB(t, db)
+	ntfns := NewNotificationManager()
+	bpc := newBudgetPayClient(pc, budgets, 0.8, db, slog.Disabled, ntfns)
+
+	blockedChan := make(chan BlockedPayment, 1)
+	ntfns.Register(OnPaymentBlockedNtfn(func(bp BlockedPayment) {
+		blockedChan <- bp
+	}))
+	warnChan := make(chan PaymentBudgetStatus, 1)
+	ntfns.Register(OnPaymentBudgetThresholdNtfn(func(st PaymentBudgetStatus) {
+		warnChan <- st
+	}))
+
+	tipsCtx := clientintf.WithPaymentCategory(context.Background(),
+		clientintf.PaymentCategoryTips)
+	payAsync := func(amt int64) chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := bpc.PayInvoiceAmount(tipsCtx, "invoice", amt)
+			errChan <- err
+		}()
+		return errChan
+	}
+
+	// Payments within the budget proceed.
+	_, err := bpc.PayInvoiceAmount(tipsCtx, "invoice", 500)
+	assert.NilErr(t, err)
+	assert.ChanNotWritten(t, warnChan, 50*time.Millisecond)
+
+	// Payments of other categories are not limited.
+	_, err = bpc.PayInvoiceAmount(context.Background(), "invoice", 5000)
+	assert.NilErr(t, err)
+
+	// Failed payments are not accounted for.
+	payErr = errors.New("failed payment")
+	_, err = bpc.PayInvoiceAmount(tipsCtx, "invoice", 400)
+	assert.ErrorIs(t, err, payErr)
+	payErr = nil
+	assert.ChanNotWritten(t, warnChan, 50*time.Millisecond)
+
+	// Crossing the warning threshold triggers a notification.
+	_, err = bpc.PayInvoiceAmount(tipsCtx, "invoice", 300)
+	assert.NilErr(t, err)
+	st := assert.ChanWritten(t, warnChan)
+	assert.DeepEqual(t, st.SpentMAtoms, 800)
+
+	// A payment that exceeds the budget is blocked until rejected.
+	errChan := payAsync(300)
+	bp := assert.ChanWritten(t, blockedChan)
+	assert.DeepEqual(t, bp.MilliAtoms, 300)
+	assert.DeepEqual(t, bp.Budget, budgets[0])
+	assert.DeepEqual(t, bpc.listBlocked(), []BlockedPayment{bp})
+	assert.ChanNotWritten(t, errChan, 50*time.Millisecond)
+	assert.NilErr(t, bpc.decide(bp.ID, false))
+	assert.ErrorIs(t, assert.ChanWritten(t, errChan), ErrPaymentBudgetExceeded)
+	assert.DeepEqual(t, len(bpc.listBlocked()), 0)
+
+	// An approved payment that fails may be attempted again without being
+	// blocked again.
+	payErr = errors.New("expired invoice")
+	errChan = payAsync(300)
+	bp = assert.ChanWritten(t, blockedChan)
+	assert.NilErr(t, bpc.decide(bp.ID, true))
+	assert.ErrorIs(t, assert.ChanWritten(t, errChan), payErr)
+	payErr = nil
+	assert.DeepEqual(t, bpc.status()[0].SpentMAtoms, 800)
+	_, err = bpc.PayInvoiceAmount(tipsCtx, "invoice", 300)
+	assert.NilErr(t, err)
+	assert.ChanNotWritten(t, blockedChan, 50*time.Millisecond)
+	assert.DeepEqual(t, bpc.status()[0].SpentMAtoms, 1100)
+
+	// The approval is used only once.
+	errChan = payAsync(100)
+	bp = assert.ChanWritten(t, blockedChan)
+	assert.NilErr(t, bpc.decide(bp.ID, false))
+	assert.ErrorIs(t, assert.ChanWritten(t, errChan), ErrPaymentBudgetExceeded)
+
+	// A blocked payment proceeds after being approved.
+	errChan = payAsync(300)
+	bp = assert.ChanWritten(t, blockedChan)
+	assert.NilErr(t, bpc.decide(bp.ID, true))
+	assert.NilErrFromChan(t, errChan)
+	assert.DeepEqual(t, bpc.status()[0].SpentMAtoms, 1400)
+
+	// The amounts spent are persisted across restarts.
+	bpc = newBudgetPayClient(pc, budgets, 0.8, db, slog.Disabled, ntfns)
+	assert.DeepEqual(t, bpc.status()[0].SpentMAtoms, 1400)
+	errChan = payAsync(1)
+	bp = assert.ChanWritten(t, blockedChan)
+	assert.NilErr(t, bpc.decide(bp.ID, false))
+	assert.ErrorIs(t, assert.ChanWritten(t, errChan), ErrPaymentBudgetExceeded)
+
+	// Deciding an unknown blocked payment errors.
+	assert.NonNilErr(t, bpc.decide(bp.ID, true))
+
+	// On-chain payments are also subject to the budget.
+	sentChan := make(chan string, 1)
+	pc.HookSendOnchain(func(addr string, _ dcrutil.Amount) (chainhash.Hash, error) {
+		sentChan <- addr
+		return chainhash.Hash{}, nil
+	})
+	errChan = make(chan error, 1)
+	go func() {
+		_, err := bpc.SendOnchain(tipsCtx, "addr", 1)
+		errChan <- err
+	}()
+	bp = assert.ChanWritten(t, blockedChan)
+	assert.DeepEqual(t, bp.Invoice, "addr")
+	assert.DeepEqual(t, bp.MilliAtoms, 1000)
+	assert.NilErr(t, bpc.decide(bp.ID, false))
+	assert.ErrorIs(t, assert.ChanWritten(t, errChan), ErrPaymentBudgetExceeded)
+	assert.ChanNotWritten(t, sentChan, 50*time.Millisecond)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
@@ -629,6 +630,68 @@ func (pc *DcrlnPaymentClient) WaitTxConfirmed(ctx context.Context, tx chainhash.
 	}
 }
 
+// SendOnchain sends the amount to the on-chain address from the default
+// account of the wallet. It returns the hash of the transaction.
+func (pc *DcrlnPaymentClient) SendOnchain(ctx context.Context, addr string, amount dcrutil.Amount) (chainhash.Hash, error) {
+	params, err := pc.ChainParams(ctx)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	if _, err := stdaddr.DecodeAddress(addr, params); err != nil {
+		return chainhash.Hash{}, fmt.Errorf("invalid address %q: %v", addr, err)
+	}
+
+	sentCoins, err := pc.lnRpc.SendCoins(ctx, &lnrpc.SendCoinsRequest{Addr: addr, Amount: int64(amount)})
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	txh, err := chainhash.NewHashFromStr(sentCoins.Txid)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	pc.log.Infof("Sent %s on-chain to %s on tx %s", amount, addr, txh)
+	return *txh, nil
+}
+
+// VerifyTxPayment returns nil if the given wallet transaction is confirmed and
+// its outputs pay at least the amount to the on-chain address.
+func (pc *DcrlnPaymentClient) VerifyTxPayment(ctx context.Context, tx chainhash.Hash, addr string, amount dcrutil.Amount) error {
+	params, err := pc.ChainParams(ctx)
+	if err != nil {
+		return err
+	}
+	decoded, err := stdaddr.DecodeAddress(addr, params)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %v", addr, err)
+	}
+	scriptVersion, script := decoded.PaymentScript()
+
+	res, err := pc.lnWallet.GetWalletTx(ctx, &walletrpc.GetWalletTxRequest{Txid: tx[:]})
+	if err != nil {
+		return err
+	}
+	if res.Confirmations < 1 {
+		return fmt.Errorf("tx %s is not confirmed", tx)
+	}
+	var msgTx wire.MsgTx
+	if err := msgTx.FromBytes(res.RawTx); err != nil {
+		return fmt.Errorf("unable to decode tx %s: %v", tx, err)
+	}
+
+	var paid dcrutil.Amount
+	for _, out := range msgTx.TxOut {
+		if out.Version == scriptVersion && bytes.Equal(out.PkScript, script) {
+			paid += dcrutil.Amount(out.Value)
+		}
+	}
+	if paid < amount {
+		return fmt.Errorf("tx %s pays %s to %s instead of %s", tx, paid,
+			addr, amount)
+	}
+	return nil
+}
+
 // getChainParams returns a chain params instance that matches the network of the
 // dcrlnd instance.
 func (pc *DcrlnPaymentClient) getChainParams(ctx context.Context) (*chaincfg.Params, error) {
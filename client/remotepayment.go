@@ -0,0 +1,255 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/decred/slog"
+)
+
+// RemotePaymentClientCfg is the configuration for a remote payment client.
+type RemotePaymentClientCfg struct {
+	// URL is the base URL of the remote payment server.
+	URL string
+
+	// TLSCertPath is the path to the TLS certificate of the remote payment
+	// server. If empty, the system certificates are used to verify the
+	// server.
+	TLSCertPath string
+
+	// AuthToken is sent as a bearer token in every request.
+	AuthToken string
+
+	// HTTPClient is used to perform requests. If nil, a new one is
+	// created.
+	HTTPClient *http.Client
+
+	Log slog.Logger
+}
+
+// RemotePaymentClient implements the PaymentClient interface by delegating
+// every payment operation to a remote payment server. This allows running
+// clients that do not have direct access to a wallet.
+//
+// See [clientintf.RemotePayRequest] for a description of the protocol used.
+type RemotePaymentClient struct {
+	url       string
+	token     string
+	hc        *http.Client
+	log       slog.Logger
+	payScheme string
+
+	// trackRetryDelay is the initial delay before retrying to track an
+	// invoice after a failure.
+	trackRetryDelay time.Duration
+}
+
+// maxTrackRetryDelay is the max delay between attempts to track an invoice.
+const maxTrackRetryDelay = time.Minute
+
+// NewRemotePaymentClient creates a new payment client that sends payments
+// through a remote payment server. This fetches the payment scheme of the
+// server, failing if the server cannot be reached.
+func NewRemotePaymentClient(ctx context.Context, cfg RemotePaymentClientCfg) (*RemotePaymentClient, error) {
+	hc := cfg.HTTPClient
+	if hc == nil {
+		hc = &http.Client{}
+		if cfg.TLSCertPath != "" {
+			certPEM, err := os.ReadFile(cfg.TLSCertPath)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read cert file: %v", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(certPEM) {
+				return nil, fmt.Errorf("unable to parse cert file %s",
+					cfg.TLSCertPath)
+			}
+			hc.Transport = &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: pool},
+			}
+		}
+	}
+
+	log := slog.Disabled
+	if cfg.Log != nil {
+		log = cfg.Log
+	}
+
+	pc := &RemotePaymentClient{
+		url:             strings.TrimSuffix(cfg.URL, "/"),
+		token:           cfg.AuthToken,
+		hc:              hc,
+		log:             log,
+		trackRetryDelay: time.Second,
+	}
+
+	res, err := pc.call(ctx, clientintf.RemotePayMethodPayScheme, clientintf.RemotePayRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pay scheme: %w", err)
+	}
+	if res.PayScheme == "" {
+		return nil, fmt.Errorf("remote payment server did not specify pay scheme")
+	}
+	pc.payScheme = res.PayScheme
+	log.Infof("Using remote payment server %s with pay scheme %s", pc.url,
+		pc.payScheme)
+	return pc, nil
+}
+
+// call performs a call to the remote payment server.
+func (pc *RemotePaymentClient) call(ctx context.Context, method string,
+	req clientintf.RemotePayRequest) (*clientintf.RemotePayResponse, error) {
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		pc.url+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if pc.token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+pc.token)
+	}
+
+	httpRes, err := pc.hc.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+
+	resBody, err := io.ReadAll(io.LimitReader(httpRes.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	var res clientintf.RemotePayResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		if httpRes.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("remote payment server returned "+
+				"status %s", httpRes.Status)
+		}
+		return nil, fmt.Errorf("unable to decode remote payment "+
+			"server response: %v", err)
+	}
+	if res.Error != "" || res.ErrorCode != "" {
+		return nil, clientintf.RemotePayError{Code: res.ErrorCode, Msg: res.Error}
+	}
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote payment server returned status %s",
+			httpRes.Status)
+	}
+	return &res, nil
+}
+
+func (pc *RemotePaymentClient) PayScheme() string {
+	return pc.payScheme
+}
+
+func (pc *RemotePaymentClient) PayInvoice(ctx context.Context, invoice string) (int64, error) {
+	return pc.PayInvoiceAmount(ctx, invoice, 0)
+}
+
+func (pc *RemotePaymentClient) PayInvoiceAmount(ctx context.Context, invoice string, amount int64) (int64, error) {
+	pc.log.Debugf("Requesting remote payment of invoice %s (amount %d)",
+		invoice, amount)
+	req := clientintf.RemotePayRequest{Invoice: invoice, MAtoms: amount}
+	res, err := pc.call(ctx, clientintf.RemotePayMethodPayInvoice, req)
+	if err != nil {
+		return 0, err
+	}
+	return res.FeesMAtoms, nil
+}
+
+func (pc *RemotePaymentClient) GetInvoice(ctx context.Context, mat int64, cb func(int64)) (string, error) {
+	req := clientintf.RemotePayRequest{MAtoms: mat}
+	res, err := pc.call(ctx, clientintf.RemotePayMethodGetInvoice, req)
+	if err != nil {
+		return "", err
+	}
+
+	if cb != nil {
+		go func() {
+			amt, err := pc.TrackInvoice(ctx, res.Invoice, 0)
+			if err != nil {
+				pc.log.Debugf("Unable to track invoice %s: %v",
+					res.Invoice, err)
+				return
+			}
+			cb(amt)
+		}()
+	}
+
+	return res.Invoice, nil
+}
+
+func (pc *RemotePaymentClient) DecodeInvoice(ctx context.Context, invoice string) (clientintf.DecodedInvoice, error) {
+	req := clientintf.RemotePayRequest{Invoice: invoice}
+	res, err := pc.call(ctx, clientintf.RemotePayMethodDecodeInvoice, req)
+	if err != nil {
+		return clientintf.DecodedInvoice{}, err
+	}
+	return clientintf.DecodedInvoice{
+		ID:         res.ID,
+		MAtoms:     res.MAtoms,
+		ExpiryTime: res.ExpiryTime,
+	}, nil
+}
+
+func (pc *RemotePaymentClient) IsInvoicePaid(ctx context.Context, minMatAmt int64, invoice string) error {
+	req := clientintf.RemotePayRequest{Invoice: invoice, MAtoms: minMatAmt}
+	_, err := pc.call(ctx, clientintf.RemotePayMethodIsInvoicePaid, req)
+	return err
+}
+
+// TrackInvoice waits until the invoice is paid. Tracking the invoice is a
+// long-running call, so failures other than well known payment errors (for
+// example, the connection to the remote payment server being dropped) are
+// retried with backoff until the context is canceled.
+func (pc *RemotePaymentClient) TrackInvoice(ctx context.Context, invoice string, minMAtoms int64) (int64, error) {
+	req := clientintf.RemotePayRequest{Invoice: invoice, MAtoms: minMAtoms}
+	delay := pc.trackRetryDelay
+	for {
+		res, err := pc.call(ctx, clientintf.RemotePayMethodTrackInvoice, req)
+		if err == nil {
+			return res.MAtoms, nil
+		}
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		if clientintf.RemotePayErrorCode(err) != "" {
+			return 0, err
+		}
+
+		pc.log.Debugf("Retrying to track invoice %s in %s after error: %v",
+			invoice, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		delay = min(delay*2, maxTrackRetryDelay)
+	}
+}
+
+func (pc *RemotePaymentClient) IsPaymentCompleted(ctx context.Context, invoice string) (int64, error) {
+	req := clientintf.RemotePayRequest{Invoice: invoice}
+	res, err := pc.call(ctx, clientintf.RemotePayMethodIsPaymentCompleted, req)
+	if err != nil {
+		return 0, err
+	}
+	return res.FeesMAtoms, nil
+}
+
+var _ clientintf.PaymentClient = (*RemotePaymentClient)(nil)
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/testutils"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// TestRemotePaymentClient tests that the remote payment client delegates calls
+// to a remote payment server.
+func TestRemotePaymentClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mpc := &testutils.MockPayClient{}
+	svr := testutils.NewMockRemotePayServer(t, mpc, "token")
+
+	// Connecting with the wrong token fails.
+	_, err := NewRemotePaymentClient(ctx, RemotePaymentClientCfg{
+		URL:       svr.URL(),
+		AuthToken: "wrong",
+	})
+	assert.NonNilErr(t, err)
+
+	pc, err := NewRemotePaymentClient(ctx, RemotePaymentClientCfg{
+		URL:       svr.URL(),
+		AuthToken: "token",
+	})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, pc.PayScheme(), rpc.PaySchemeFree)
+
+	// Payments.
+	payChan := make(chan string, 5)
+	mpc.HookPayInvoice(func(invoice string) (int64, error) {
+		payChan <- invoice
+		if invoice == "bad invoice" {
+			return 0, errors.New("payment failed")
+		}
+		return 10, nil
+	})
+	fees, err := pc.PayInvoice(ctx, "invoice")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, fees, int64(10))
+	assert.DeepEqual(t, assert.ChanWritten(t, payChan), "invoice")
+	fees, err = pc.PayInvoiceAmount(ctx, "invoice 2", 1000)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, fees, int64(10))
+	assert.DeepEqual(t, assert.ChanWritten(t, payChan), "invoice 2")
+	_, err = pc.PayInvoice(ctx, "bad invoice")
+	assert.NonNilErr(t, err)
+
+	// Generating invoices and tracking their payment.
+	trackChan := make(chan struct{})
+	mpc.HookTrackInvoice(func(invoice string, minMAtoms int64) (int64, error) {
+		<-trackChan
+		return 1000, nil
+	})
+	paidChan := make(chan int64, 1)
+	invoice, err := pc.GetInvoice(ctx, 1000, func(amt int64) { paidChan <- amt })
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, invoice, "free invoice for 1000 milliatoms")
+	assert.ChanNotWritten(t, paidChan, 50*time.Millisecond)
+	close(trackChan)
+	assert.DeepEqual(t, assert.ChanWritten(t, paidChan), int64(1000))
+
+	// Tracking invoices is retried after errors that are not well known
+	// payment errors.
+	pc.trackRetryDelay = time.Millisecond
+	var trackCalls int
+	mpc.HookTrackInvoice(func(invoice string, minMAtoms int64) (int64, error) {
+		trackCalls++
+		if trackCalls < 3 {
+			return 0, errors.New("temporary failure")
+		}
+		return 1000, nil
+	})
+	amt, err := pc.TrackInvoice(ctx, invoice, 0)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, amt, int64(1000))
+	assert.DeepEqual(t, trackCalls, 3)
+	mpc.HookTrackInvoice(func(invoice string, minMAtoms int64) (int64, error) {
+		return 0, clientintf.ErrInvoiceExpired
+	})
+	_, err = pc.TrackInvoice(ctx, invoice, 0)
+	assert.ErrorIs(t, err, clientintf.ErrInvoiceExpired)
+	mpc.HookTrackInvoice(func(invoice string, minMAtoms int64) (int64, error) {
+		return 0, errors.New("permanent failure")
+	})
+	cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	_, err = pc.TrackInvoice(cancelCtx, invoice, 0)
+	cancel()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Decoding invoices.
+	decoded, err := pc.DecodeInvoice(ctx, invoice)
+	assert.NilErr(t, err)
+	wantDecoded, _ := mpc.DefaultDecodeInvoice(invoice)
+	assert.DeepEqual(t, decoded.ID, wantDecoded.ID)
+	assert.True(t, decoded.ExpiryTime.Equal(wantDecoded.ExpiryTime))
+
+	// Well known errors are preserved.
+	mpc.HookIsInvoicePaid(func(minMAtoms int64, invoice string) error {
+		return clientintf.ErrInvoiceInsufficientlyPaid
+	})
+	err = pc.IsInvoicePaid(ctx, 1000, invoice)
+	assert.ErrorIs(t, err, clientintf.ErrInvoiceInsufficientlyPaid)
+	mpc.HookIsPayCompleted(func(invoice string) (int64, error) {
+		return 0, clientintf.ErrInvoiceExpired
+	})
+	_, err = pc.IsPaymentCompleted(ctx, invoice)
+	assert.ErrorIs(t, err, clientintf.ErrInvoiceExpired)
+}
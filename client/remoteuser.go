@@ -93,6 +93,10 @@ type RemoteUser struct {
 
 	ntfns *NotificationManager
 
+	// recordPayEvent is called to record payments made on behalf of this
+	// user.
+	recordPayEvent payEventRecorder
+
 	// handlerSema tracks how many handlers may be active for this user.
 	handlerSema chan struct{}
 
@@ -129,6 +133,7 @@ func newRemoteUser(q rmqIntf, rmgr rdzvManagerIntf, db *clientdb.DB,
 		stopped:       make(chan struct{}),
 		handlerSema:   filledSema(50),
 	}
+	ru.recordPayEvent = recordPayStatsOnly(db)
 	ru.setNick(remoteID.Nick)
 	return ru
 }
@@ -283,6 +288,11 @@ func (ru *RemoteUser) queueRMPriority(payload interface{}, priority uint,
 
 	estSize := rpc.EstimateRoutedRMWireSize(len(me))
 	maxMsgSize := int(ru.q.MaxMsgSize())
+	if estSize > maxMsgSize && isFragmentableRM(payload) &&
+		len(me) <= rpc.MaxFragmentedRMSize {
+		return ru.queueFragmentedRM(payload, me, priority, replyChan,
+			payEvent, sendqId)
+	}
 	if estSize > maxMsgSize {
 		return fmt.Errorf("message %T estimated as larger than "+
 			"max message size %d > %d: %w", payload,
@@ -426,7 +436,8 @@ func (ru *RemoteUser) paidForRM(event string, amount, fees int64) {
 	ctx, cancel := ru.cancelableCtx()
 	defer cancel()
 	err := ru.db.Update(ctx, func(tx clientdb.ReadWriteTx) error {
-		return ru.db.RecordUserPayEvent(tx, ru.ID(), event, amount, fees)
+		return ru.recordPayEvent(tx, ru.ID(), event,
+			clientintf.PaymentCategoryServerFees, "", amount, fees)
 	})
 	if err != nil {
 		ru.log.Warnf("Unable to store payment %d of event %q: %v", amount,
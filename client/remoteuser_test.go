@@ -216,10 +216,25 @@ func TestAttemptMaxRMSize(t *testing.T) {
 	err = aliceRemote.sendRM(rm, "")
 	assert.ErrorIs(t, err, errRMTooLarge)
 
-	// Send a very large PM.
+	// Send a very large PM. This is split into fragments.
 	pm := rpc.RMPrivateMessage{
-		Message: strings.Repeat(" ", (1024+357)*1024+703),
+		Message: strings.Repeat(" ", (1024+512)*1024),
 	}
+	gotFrag := make(chan rpc.RMFragment, 10)
+	bobRemote.rmHandler = func(_ *RemoteUser, h *rpc.RMHeader, p interface{}, ts time.Time) <-chan struct{} {
+		if frag, ok := p.(rpc.RMFragment); ok {
+			gotFrag <- frag
+		}
+		return nil
+	}
+	err = aliceRemote.sendRM(pm, "")
+	assert.NilErr(t, err)
+	frag := assert.ChanWritten(t, gotFrag)
+	assert.DeepEqual(t, frag.Count, 2)
+	assert.ChanWritten(t, gotFrag)
+
+	// Send a PM larger than the max fragmented RM size.
+	pm.Message = strings.Repeat(" ", rpc.MaxFragmentedRMSize)
 	err = aliceRemote.sendRM(pm, "")
 	assert.ErrorIs(t, err, errRMTooLarge)
 }
@@ -0,0 +1,220 @@
+package postsfeed
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/internal/mdembeds"
+)
+
+var (
+	mdHeadingRegex   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdHRuleRegex     = regexp.MustCompile(`^(\*\s*){3,}$|^(-\s*){3,}$|^(_\s*){3,}$`)
+	mdULItemRegex    = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	mdOLItemRegex    = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	mdQuoteRegex     = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdImageRegex     = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)\)`)
+	mdLinkRegex      = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	mdBoldRegex      = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	mdItalicRegex    = regexp.MustCompile(`\*([^*]+)\*|\b_([^_]+)_\b`)
+	mdURLSchemeRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// safeURL returns the url if it is safe to include in a link or image in the
+// generated HTML. Relative, http(s) and mailto URLs are allowed in links and
+// data URLs are only allowed for images.
+func safeURL(url string, isImage bool) string {
+	scheme := strings.ToLower(mdURLSchemeRegex.FindString(url))
+	switch {
+	case scheme == "", scheme == "http:", scheme == "https:":
+		return url
+	case scheme == "mailto:" && !isImage:
+		return url
+	case scheme == "data:" && isImage && strings.HasPrefix(url[5:], "image/"):
+		return url
+	default:
+		return "#"
+	}
+}
+
+// emphasisToHTML converts bold and italic markdown to HTML.
+func emphasisToHTML(s string) string {
+	s = mdBoldRegex.ReplaceAllString(s, "<strong>$1$2</strong>")
+	return mdItalicRegex.ReplaceAllString(s, "<em>$1$2</em>")
+}
+
+// inlineMarkdownToHTML converts the inline markdown elements of a line of text
+// (code spans, images, links and emphasis) to HTML. The text is escaped, so raw
+// HTML is not supported.
+func inlineMarkdownToHTML(s string) string {
+	var b strings.Builder
+
+	// Odd elements are code spans, which are not further processed.
+	parts := strings.Split(s, "`")
+	if len(parts)%2 == 0 {
+		// Unmatched backtick. Treat it as text.
+		parts[len(parts)-2] += "`" + parts[len(parts)-1]
+		parts = parts[:len(parts)-1]
+	}
+	for i, part := range parts {
+		part = html.EscapeString(part)
+		if i%2 == 1 {
+			b.WriteString("<code>" + part + "</code>")
+			continue
+		}
+
+		// Links and images are replaced by placeholders, so that their
+		// URLs are not modified when processing emphasis.
+		var elements []string
+		placeholder := func(el string) string {
+			elements = append(elements, el)
+			return fmt.Sprintf("\x00%d\x00", len(elements)-1)
+		}
+		part = mdImageRegex.ReplaceAllStringFunc(part, func(m string) string {
+			sub := mdImageRegex.FindStringSubmatch(m)
+			return placeholder(fmt.Sprintf(`<img src="%s" alt="%s">`,
+				safeURL(sub[2], true), sub[1]))
+		})
+		part = mdLinkRegex.ReplaceAllStringFunc(part, func(m string) string {
+			sub := mdLinkRegex.FindStringSubmatch(m)
+			return placeholder(fmt.Sprintf(`<a href="%s">%s</a>`,
+				safeURL(sub[2], false), emphasisToHTML(sub[1])))
+		})
+		part = emphasisToHTML(part)
+		for i, el := range elements {
+			part = strings.Replace(part, fmt.Sprintf("\x00%d\x00", i), el, 1)
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+// markdownToHTML converts the subset of markdown commonly used in posts to
+// HTML: headings, paragraphs, emphasis, code spans and blocks, links, images,
+// lists, block quotes and horizontal rules. Raw HTML is escaped.
+func markdownToHTML(md string) string {
+	var b strings.Builder
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+
+	var para []string
+	var listTag string
+	flushPara := func() {
+		if len(para) > 0 {
+			b.WriteString("<p>" + inlineMarkdownToHTML(strings.Join(para, "\n")) + "</p>\n")
+			para = para[:0]
+		}
+	}
+	closeList := func() {
+		if listTag != "" {
+			b.WriteString("</" + listTag + ">\n")
+			listTag = ""
+		}
+	}
+	openList := func(tag string) {
+		if listTag != tag {
+			closeList()
+			b.WriteString("<" + tag + ">\n")
+			listTag = tag
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flushPara()
+			closeList()
+			var code []string
+			for i += 1; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>")
+			b.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			b.WriteString("</code></pre>\n")
+
+		case trimmed == "":
+			flushPara()
+			closeList()
+
+		case mdHeadingRegex.MatchString(trimmed):
+			flushPara()
+			closeList()
+			sub := mdHeadingRegex.FindStringSubmatch(trimmed)
+			fmt.Fprintf(&b, "<h%d>%s</h%d>\n", len(sub[1]),
+				inlineMarkdownToHTML(sub[2]), len(sub[1]))
+
+		case mdHRuleRegex.MatchString(trimmed):
+			flushPara()
+			closeList()
+			b.WriteString("<hr>\n")
+
+		case mdQuoteRegex.MatchString(line):
+			flushPara()
+			closeList()
+			var quote []string
+			for ; i < len(lines) && mdQuoteRegex.MatchString(lines[i]); i++ {
+				quote = append(quote, mdQuoteRegex.FindStringSubmatch(lines[i])[1])
+			}
+			i -= 1
+			b.WriteString("<blockquote>\n")
+			b.WriteString(markdownToHTML(strings.Join(quote, "\n")))
+			b.WriteString("</blockquote>\n")
+
+		case mdULItemRegex.MatchString(line):
+			flushPara()
+			openList("ul")
+			item := mdULItemRegex.FindStringSubmatch(line)[1]
+			b.WriteString("<li>" + inlineMarkdownToHTML(item) + "</li>\n")
+
+		case mdOLItemRegex.MatchString(line):
+			flushPara()
+			openList("ol")
+			item := mdOLItemRegex.FindStringSubmatch(line)[1]
+			b.WriteString("<li>" + inlineMarkdownToHTML(item) + "</li>\n")
+
+		default:
+			closeList()
+			para = append(para, trimmed)
+		}
+	}
+	flushPara()
+	closeList()
+	return b.String()
+}
+
+// replaceEmbeds replaces the embedded content of a post with markdown that
+// can be rendered outside of Bison Relay. Embedded images are converted to
+// data URLs, while other embedded and shared files are replaced by a note.
+func replaceEmbeds(post string) string {
+	return mdembeds.ReplaceEmbeds(post, func(args mdembeds.EmbeddedArgs) string {
+		switch {
+		case len(args.Data) > 0 && strings.HasPrefix(args.Typ, "image/"):
+			alt := args.Alt
+			if alt == "" {
+				alt = args.Name
+			}
+			return fmt.Sprintf("![%s](data:%s;base64,%s)", alt, args.Typ,
+				base64.StdEncoding.EncodeToString(args.Data))
+		case !args.Download.IsEmpty():
+			name := args.Filename
+			if name == "" {
+				name = args.Download.String()
+			}
+			return fmt.Sprintf("*[File %s shared in Bison Relay]*", name)
+		default:
+			name := args.Name
+			if name == "" {
+				name = args.Typ
+			}
+			return fmt.Sprintf("*[Embedded %s]*", name)
+		}
+	})
+}
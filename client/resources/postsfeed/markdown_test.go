@@ -0,0 +1,98 @@
+package postsfeed
+
+import (
+	"testing"
+)
+
+// TestMarkdownToHTML tests converting post markdown to HTML.
+func TestMarkdownToHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{{
+		name: "paragraphs",
+		md:   "first\nline\n\nsecond",
+		want: "<p>first\nline</p>\n<p>second</p>\n",
+	}, {
+		name: "heading",
+		md:   "## Title ##",
+		want: "<h2>Title</h2>\n",
+	}, {
+		name: "emphasis",
+		md:   "**bold** and *italic*",
+		want: "<p><strong>bold</strong> and <em>italic</em></p>\n",
+	}, {
+		name: "escaped html",
+		md:   "<script>alert(1)</script>",
+		want: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+	}, {
+		name: "code span",
+		md:   "run `a *b* c`",
+		want: "<p>run <code>a *b* c</code></p>\n",
+	}, {
+		name: "link",
+		md:   "see [the *site*](https://example.com/a_b_c)",
+		want: "<p>see <a href=\"https://example.com/a_b_c\">the <em>site</em></a></p>\n",
+	}, {
+		name: "unsafe link",
+		md:   "[x](javascript:alert(1))",
+		want: "<p><a href=\"#\">x</a>)</p>\n",
+	}, {
+		name: "image",
+		md:   "![alt](data:image/png;base64,AAAA)",
+		want: "<p><img src=\"data:image/png;base64,AAAA\" alt=\"alt\"></p>\n",
+	}, {
+		name: "lists",
+		md:   "- a\n- b\n1. c",
+		want: "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n<ol>\n<li>c</li>\n</ol>\n",
+	}, {
+		name: "quote",
+		md:   "> quoted\n> text",
+		want: "<blockquote>\n<p>quoted\ntext</p>\n</blockquote>\n",
+	}, {
+		name: "code block",
+		md:   "```\n<a>\n  b\n```",
+		want: "<pre><code>&lt;a&gt;\n  b</code></pre>\n",
+	}, {
+		name: "horizontal rule",
+		md:   "---",
+		want: "<hr>\n",
+	}}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := markdownToHTML(tc.md)
+			if got != tc.want {
+				t.Fatalf("unexpected html: got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestSafeURL tests that only safe URLs are included in the generated HTML.
+func TestSafeURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		isImage bool
+		want    string
+	}{
+		{"/feed/posts/x", false, "/feed/posts/x"},
+		{"https://example.com", false, "https://example.com"},
+		{"mailto:a@example.com", false, "mailto:a@example.com"},
+		{"mailto:a@example.com", true, "#"},
+		{"JavaScript:alert(1)", false, "#"},
+		{"data:image/png;base64,AA", true, "data:image/png;base64,AA"},
+		{"data:image/png;base64,AA", false, "#"},
+		{"data:text/html;base64,AA", true, "#"},
+	}
+
+	for _, tc := range tests {
+		got := safeURL(tc.url, tc.isImage)
+		if got != tc.want {
+			t.Fatalf("unexpected url for %q (image %v): got %q, want %q",
+				tc.url, tc.isImage, got, tc.want)
+		}
+	}
+}
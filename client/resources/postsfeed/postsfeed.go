@@ -0,0 +1,302 @@
+// Package postsfeed implements a resources provider that renders the posts of
+// the local client as Atom and RSS feeds and as markdown and HTML pages, so
+// that they can be followed by regular feed readers.
+package postsfeed
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
+	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/decred/slog"
+)
+
+const (
+	indexMDPath   = "index.md"
+	indexHTMLPath = "index.html"
+	atomPath      = "atom.xml"
+	rssPath       = "rss.xml"
+	postsPath     = "posts"
+	htmlExt       = ".html"
+
+	contentTypeMeta = "Content-Type"
+
+	defaultMaxPosts = 50
+)
+
+// Config holds the configuration for a posts feed provider.
+type Config struct {
+	Client *client.Client
+	Log    slog.Logger
+
+	// Prefix is the path under which the provider is bound in the
+	// resources router. Defaults to "feed".
+	Prefix []string
+
+	// Title is the title of the feed. Defaults to the nick of the local
+	// client.
+	Title string
+
+	// BaseURL is the external URL under which the feed is served over HTTP.
+	// When set, it is used to generate absolute links in the feeds.
+	BaseURL string
+
+	// Authors are the nicks or ids of users (besides the local client)
+	// whose posts are also included in the feed.
+	Authors []string
+
+	// MaxPosts is the max number of posts included in the feed. Defaults
+	// to 50.
+	MaxPosts int
+}
+
+// Provider is a resources provider that renders the posts of the local client
+// (and of selected subscriptions) as Atom and RSS feeds and as markdown and
+// HTML pages.
+type Provider struct {
+	cfg Config
+	c   *client.Client
+	log slog.Logger
+}
+
+// New creates a new posts feed provider.
+func New(cfg Config) *Provider {
+	log := slog.Disabled
+	if cfg.Log != nil {
+		log = cfg.Log
+	}
+	if cfg.Prefix == nil {
+		cfg.Prefix = []string{"feed"}
+	}
+	if cfg.MaxPosts <= 0 {
+		cfg.MaxPosts = defaultMaxPosts
+	}
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	return &Provider{
+		cfg: cfg,
+		c:   cfg.Client,
+		log: log,
+	}
+}
+
+// Prefix returns the path prefix under which the provider should be bound in a
+// resources router.
+func (p *Provider) Prefix() []string {
+	return p.cfg.Prefix
+}
+
+// entry is a post included in the feed.
+type entry struct {
+	ID         clientintf.PostID
+	AuthorNick string
+	Title      string
+	Content    string
+	Tags       []string
+	Date       time.Time
+	Paid       bool
+}
+
+// entries returns the posts included in the feed, newest first. Posts
+// restricted to an audience are never included.
+func (p *Provider) entries() ([]entry, error) {
+	authors := []clientintf.UserID{p.c.PublicID()}
+	for _, s := range p.cfg.Authors {
+		var uid clientintf.UserID
+		if err := uid.FromString(s); err != nil {
+			uid, err = p.c.UIDByNick(s)
+			if err != nil {
+				p.log.Warnf("Unable to include posts of %q in feed: %v",
+					s, err)
+				continue
+			}
+		}
+		authors = append(authors, uid)
+	}
+
+	var res []entry
+	for _, uid := range authors {
+		posts, err := p.c.ListLocalUserPosts(uid)
+		if err != nil {
+			return nil, err
+		}
+		for i := range posts {
+			post := &posts[i]
+			if rpc.IsPostRestricted(post) {
+				continue
+			}
+			var e entry
+			if err := e.ID.FromString(post.Attributes[rpc.RMPIdentifier]); err != nil {
+				continue
+			}
+			e.AuthorNick = post.Attributes[rpc.RMPFromNick]
+			// Titles are plain text in feeds, so drop the heading
+			// marker commonly used in the first line of posts.
+			e.Title = strings.TrimSpace(strings.TrimLeft(clientintf.PostTitle(post), "#"))
+			e.Content = post.Attributes[rpc.RMPMain]
+			e.Tags = rpc.PostTags(post)
+			price, _ := clientdb.PaidPostPrice(post)
+			e.Paid = price > 0
+			if ts, err := strconv.ParseInt(post.Attributes[rpc.RMPTimestamp], 16, 64); err == nil {
+				e.Date = time.Unix(ts, 0)
+			}
+			res = append(res, e)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date.After(res[j].Date)
+	})
+	if len(res) > p.cfg.MaxPosts {
+		res = res[:p.cfg.MaxPosts]
+	}
+	return res, nil
+}
+
+// feedTitle returns the title of the feed.
+func (p *Provider) feedTitle() string {
+	if p.cfg.Title != "" {
+		return p.cfg.Title
+	}
+	return p.c.LocalNick() + "'s posts"
+}
+
+// Fulfill is part of the resources.Provider interface.
+func (p *Provider) Fulfill(ctx context.Context, uid clientintf.UserID,
+	req *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
+
+	notFound := &rpc.RMFetchResourceReply{
+		Tag:    req.Tag,
+		Status: rpc.ResourceStatusNotFound,
+	}
+	if len(req.Path) < len(p.cfg.Prefix) {
+		return notFound, nil
+	}
+	for i := range p.cfg.Prefix {
+		if req.Path[i] != p.cfg.Prefix[i] {
+			return notFound, nil
+		}
+	}
+	path := req.Path[len(p.cfg.Prefix):]
+
+	entries, err := p.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	var contentType string
+	switch {
+	case len(path) == 0, len(path) == 1 && path[0] == indexMDPath:
+		data, contentType = p.renderIndexMD(entries), "text/markdown"
+	case len(path) == 1 && path[0] == indexHTMLPath:
+		data, contentType = p.renderIndexHTML(entries), "text/html; charset=utf-8"
+	case len(path) == 1 && path[0] == atomPath:
+		data, err = p.renderAtom(entries)
+		contentType = "application/atom+xml"
+	case len(path) == 1 && path[0] == rssPath:
+		data, err = p.renderRSS(entries)
+		contentType = "application/rss+xml"
+	case len(path) == 2 && path[0] == postsPath:
+		isHTML := strings.HasSuffix(path[1], htmlExt)
+		var pid clientintf.PostID
+		if err := pid.FromString(strings.TrimSuffix(path[1], htmlExt)); err != nil {
+			return notFound, nil
+		}
+		var e *entry
+		for i := range entries {
+			if entries[i].ID == pid {
+				e = &entries[i]
+				break
+			}
+		}
+		if e == nil {
+			return notFound, nil
+		}
+		if isHTML {
+			data, contentType = p.renderPostHTML(e), "text/html; charset=utf-8"
+		} else {
+			data, contentType = p.renderPostMD(e), "text/markdown"
+		}
+	default:
+		return notFound, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.RMFetchResourceReply{
+		Tag:    req.Tag,
+		Status: rpc.ResourceStatusOk,
+		Meta:   map[string]string{contentTypeMeta: contentType},
+		Data:   data,
+	}, nil
+}
+
+// ServeHTTP serves the feed over HTTP. Request paths are relative to the
+// provider prefix.
+func (p *Provider) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := append([]string(nil), p.cfg.Prefix...)
+	for _, el := range strings.Split(r.URL.Path, "/") {
+		if el != "" {
+			path = append(path, el)
+		}
+	}
+	if len(path) == len(p.cfg.Prefix) {
+		// Browsers get the HTML version of the index.
+		path = append(path, indexHTMLPath)
+	}
+
+	req := &rpc.RMFetchResource{Path: path}
+	res, err := p.Fulfill(r.Context(), clientintf.UserID{}, req)
+	if err != nil {
+		p.log.Errorf("Unable to fulfill HTTP feed request %s: %v",
+			r.URL.Path, err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	for k, v := range res.Meta {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(int(res.Status))
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(res.Data)
+	}
+}
+
+// ListenAndServe serves the feed over HTTP on the given address until the
+// context is canceled.
+func (p *Provider) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	p.log.Infof("Serving posts feed over HTTP on %s", l.Addr())
+
+	srv := &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	err = srv.Serve(l)
+	if errors.Is(err, http.ErrServerClosed) {
+		err = ctx.Err()
+	}
+	return err
+}
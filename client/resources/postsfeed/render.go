@@ -0,0 +1,275 @@
+package postsfeed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+const paidPostNote = "*This is the preview of a paid post. The full post can be unlocked in Bison Relay.*"
+
+// postLink returns the link to the HTML version of a post. The link is
+// absolute if the base URL of the feed is configured.
+func (p *Provider) postLink(e *entry) string {
+	link := postsPath + "/" + e.ID.String() + htmlExt
+	if p.cfg.BaseURL != "" {
+		link = p.cfg.BaseURL + "/" + link
+	}
+	return link
+}
+
+// entryMarkdown returns the markdown content of the post.
+func entryMarkdown(e *entry) string {
+	content := e.Content
+	if e.Paid {
+		content += "\n\n" + paidPostNote
+	}
+	return content
+}
+
+// entryHTML returns the content of the post converted to HTML.
+func entryHTML(e *entry) string {
+	return markdownToHTML(replaceEmbeds(entryMarkdown(e)))
+}
+
+// entryTags returns the tags of the post formatted as hashtags.
+func entryTags(e *entry) string {
+	if len(e.Tags) == 0 {
+		return ""
+	}
+	return "#" + strings.Join(e.Tags, " #")
+}
+
+// renderIndexMD renders the index of posts as a markdown page, to be viewed
+// inside Bison Relay.
+func (p *Provider) renderIndexMD(entries []entry) []byte {
+	var b strings.Builder
+	prefix := "/" + strings.Join(p.cfg.Prefix, "/")
+	fmt.Fprintf(&b, "# %s\n\n", p.feedTitle())
+	fmt.Fprintf(&b, "Feeds: [Atom](%s/%s) [RSS](%s/%s)\n\n", prefix, atomPath,
+		prefix, rssPath)
+	if len(entries) == 0 {
+		b.WriteString("No posts.\n")
+	}
+	for i := range entries {
+		e := &entries[i]
+		fmt.Fprintf(&b, "- [%s](%s/%s/%s) by %s on %s %s\n", e.Title, prefix,
+			postsPath, e.ID, e.AuthorNick, e.Date.Format("2006-01-02"),
+			entryTags(e))
+	}
+	return []byte(b.String())
+}
+
+// renderPostMD renders a post as a markdown page, to be viewed inside Bison
+// Relay.
+func (p *Provider) renderPostMD(e *entry) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Post by %s on %s %s\n\n", e.AuthorNick,
+		e.Date.Format("2006-01-02 15:04"), entryTags(e))
+	b.WriteString(entryMarkdown(e))
+	b.WriteString("\n")
+	return []byte(b.String())
+}
+
+// writeHTMLPage writes a full HTML page with the given title and body.
+func writeHTMLPage(b *strings.Builder, title string, body func()) {
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(b, "<title>%s</title>\n", html.EscapeString(title))
+	b.WriteString("</head>\n<body>\n")
+	body()
+	b.WriteString("</body>\n</html>\n")
+}
+
+// renderIndexHTML renders the index of posts as an HTML page.
+func (p *Provider) renderIndexHTML(entries []entry) []byte {
+	var b strings.Builder
+	title := p.feedTitle()
+	writeHTMLPage(&b, title, func() {
+		fmt.Fprintf(&b, "<h1>%s</h1>\n", html.EscapeString(title))
+		fmt.Fprintf(&b, "<p>Feeds: <a href=\"%s\">Atom</a> <a href=\"%s\">RSS</a></p>\n",
+			atomPath, rssPath)
+		b.WriteString("<ul>\n")
+		for i := range entries {
+			e := &entries[i]
+			fmt.Fprintf(&b, "<li><a href=\"%s\">%s</a> by %s on %s %s</li>\n",
+				html.EscapeString(p.postLink(e)),
+				html.EscapeString(e.Title),
+				html.EscapeString(e.AuthorNick),
+				e.Date.Format("2006-01-02"),
+				html.EscapeString(entryTags(e)))
+		}
+		b.WriteString("</ul>\n")
+	})
+	return []byte(b.String())
+}
+
+// renderPostHTML renders a post as an HTML page.
+func (p *Provider) renderPostHTML(e *entry) []byte {
+	var b strings.Builder
+	writeHTMLPage(&b, e.Title, func() {
+		fmt.Fprintf(&b, "<p>Post by %s on %s %s</p>\n",
+			html.EscapeString(e.AuthorNick),
+			e.Date.Format("2006-01-02 15:04"),
+			html.EscapeString(entryTags(e)))
+		b.WriteString(entryHTML(e))
+	})
+	return []byte(b.String())
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomPerson     `xml:"author"`
+	Link       *atomLink      `xml:"link,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomPerson  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// lastUpdate returns the date of the most recent post.
+func lastUpdate(entries []entry) time.Time {
+	var res time.Time
+	for i := range entries {
+		if entries[i].Date.After(res) {
+			res = entries[i].Date
+		}
+	}
+	return res
+}
+
+// renderAtom renders the posts as an Atom feed.
+func (p *Provider) renderAtom(entries []entry) ([]byte, error) {
+	feed := atomFeed{
+		ID:      "urn:bisonrelay:feed:" + p.c.PublicID().String(),
+		Title:   p.feedTitle(),
+		Updated: lastUpdate(entries).UTC().Format(time.RFC3339),
+		Author:  atomPerson{Name: p.c.LocalNick()},
+		Entries: make([]atomEntry, 0, len(entries)),
+	}
+	if p.cfg.BaseURL != "" {
+		feed.Links = []atomLink{
+			{Href: p.cfg.BaseURL + "/" + atomPath, Rel: "self"},
+			{Href: p.cfg.BaseURL + "/"},
+		}
+	}
+	for i := range entries {
+		e := &entries[i]
+		date := e.Date.UTC().Format(time.RFC3339)
+		ae := atomEntry{
+			ID:        "urn:bisonrelay:post:" + e.ID.String(),
+			Title:     e.Title,
+			Published: date,
+			Updated:   date,
+			Author:    atomPerson{Name: e.AuthorNick},
+			Content:   atomContent{Type: "html", Body: entryHTML(e)},
+		}
+		if p.cfg.BaseURL != "" {
+			ae.Link = &atomLink{Href: p.postLink(e)}
+		}
+		for _, tag := range e.Tags {
+			ae.Categories = append(ae.Categories, atomCategory{Term: tag})
+		}
+		feed.Entries = append(feed.Entries, ae)
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Author      string   `xml:"author,omitempty"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+// renderRSS renders the posts as an RSS 2.0 feed.
+func (p *Provider) renderRSS(entries []entry) ([]byte, error) {
+	title := p.feedTitle()
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         title,
+			Link:          p.cfg.BaseURL + "/",
+			Description:   title + " on Bison Relay",
+			LastBuildDate: lastUpdate(entries).UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(entries)),
+		},
+	}
+	for i := range entries {
+		e := &entries[i]
+		item := rssItem{
+			Title:       e.Title,
+			GUID:        rssGUID{Value: "urn:bisonrelay:post:" + e.ID.String()},
+			PubDate:     e.Date.UTC().Format(time.RFC1123Z),
+			Categories:  e.Tags,
+			Description: entryHTML(e),
+		}
+		if p.cfg.BaseURL != "" {
+			item.Link = p.postLink(e)
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -292,7 +292,9 @@ func (c *chatServer) AcceptInvite(_ context.Context, req *types.AcceptInviteRequ
 	if err != nil {
 		return err
 	}
-	err = c.c.AcceptInvite(invite)
+	// Invites that require payment to complete the KX are not
+	// accepted through clientrpc.
+	err = c.c.AcceptInvite(invite, 0)
 	if err != nil {
 		return err
 	}
@@ -2,10 +2,14 @@ package rpcserver
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/slog"
 )
 
@@ -30,6 +34,18 @@ type PaymentsServerCfg struct {
 	// request from propagating (by returning a non-nil error).
 
 	OnTipUser func(uid clientintf.UserID, dcrAmount float64) error
+
+	// OnAcceptPaymentRequest is called before paying a payment request
+	// received from a remote user.
+	OnAcceptPaymentRequest func(uid clientintf.UserID, amtMAtoms int64) error
+
+	// OnCreateRecurringPayment is called before starting a recurring
+	// payment agreement to pay a remote user.
+	OnCreateRecurringPayment func(uid clientintf.UserID, dcrAmount float64) error
+
+	// OnTipGroupChat is called before starting a tip to the members of a
+	// GC.
+	OnTipGroupChat func(gcid zkidentity.ShortID, dcrAmount float64) error
 }
 
 type paymentsServer struct {
@@ -39,6 +55,10 @@ type paymentsServer struct {
 
 	tipProgressStreams *serverStreams[*types.TipProgressEvent]
 	tipStreams         *serverStreams[*types.ReceivedTip]
+	payReqStreams      *serverStreams[*types.PaymentRequestEvent]
+	recurringStreams   *serverStreams[*types.RecurringPaymentEvent]
+	ledgerStreams      *serverStreams[*types.LedgerEntry]
+	gcTipStreams       *serverStreams[*types.GCTipProgressEvent]
 }
 
 func (p *paymentsServer) TipUser(ctx context.Context, req *types.TipUserRequest, _ *types.TipUserResponse) error {
@@ -97,10 +117,313 @@ func (p *paymentsServer) AckTipReceived(_ context.Context, req *types.AckRequest
 	return p.tipStreams.ack(req.SequenceId)
 }
 
+func (p *paymentsServer) RequestPayment(_ context.Context, req *types.RequestPaymentRequest, res *types.RequestPaymentResponse) error {
+	user, err := p.c.UserByNick(req.User)
+	if err != nil {
+		return err
+	}
+	if req.AmountMatoms <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if req.ExpirySeconds < 0 {
+		return errors.New("expiry cannot be negative")
+	}
+	expiry := time.Duration(req.ExpirySeconds) * time.Second
+	pr, err := p.c.RequestPayment(user.ID(), uint64(req.AmountMatoms),
+		req.Memo, expiry)
+	if err != nil {
+		return err
+	}
+	res.Request = marshalPaymentRequest(&pr)
+	return nil
+}
+
+func (p *paymentsServer) ListPaymentRequests(_ context.Context, _ *types.ListPaymentRequestsRequest, res *types.ListPaymentRequestsResponse) error {
+	prs, err := p.c.ListPaymentRequests()
+	if err != nil {
+		return err
+	}
+	res.Requests = make([]*types.PaymentRequest, len(prs))
+	for i := range prs {
+		res.Requests[i] = marshalPaymentRequest(&prs[i])
+	}
+	return nil
+}
+
+func (p *paymentsServer) AcceptPaymentRequest(_ context.Context, req *types.AcceptPaymentRequestRequest, res *types.AcceptPaymentRequestResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	var id zkidentity.ShortID
+	if err := id.FromBytes(req.Id); err != nil {
+		return err
+	}
+	if p.cfg.OnAcceptPaymentRequest != nil {
+		pr, err := p.c.PaymentRequest(uid, id)
+		if err != nil {
+			return err
+		}
+		err = p.cfg.OnAcceptPaymentRequest(uid, int64(pr.MilliAtoms))
+		if err != nil {
+			return err
+		}
+	}
+	pr, err := p.c.AcceptPaymentRequest(uid, id)
+	if err != nil {
+		return err
+	}
+	res.Request = marshalPaymentRequest(&pr)
+	return nil
+}
+
+func (p *paymentsServer) DeclinePaymentRequest(_ context.Context, req *types.DeclinePaymentRequestRequest, _ *types.DeclinePaymentRequestResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	var id zkidentity.ShortID
+	if err := id.FromBytes(req.Id); err != nil {
+		return err
+	}
+	return p.c.DeclinePaymentRequest(uid, id, req.Reason)
+}
+
+func (p *paymentsServer) PaymentRequestsStream(ctx context.Context, req *types.PaymentRequestsStreamRequest, stream types.PaymentsService_PaymentRequestsStreamServer) error {
+	return p.payReqStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (p *paymentsServer) AckPaymentRequestEvent(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return p.payReqStreams.ack(req.SequenceId)
+}
+
+func (p *paymentsServer) payReqReceivedNtfnHandler(_ *client.RemoteUser, pr clientdb.PaymentRequest) {
+	p.payReqStreams.send(&types.PaymentRequestEvent{
+		Request:  marshalPaymentRequest(&pr),
+		Received: true,
+	})
+}
+
+func (p *paymentsServer) payReqUpdatedNtfnHandler(_ *client.RemoteUser, pr clientdb.PaymentRequest) {
+	p.payReqStreams.send(&types.PaymentRequestEvent{
+		Request: marshalPaymentRequest(&pr),
+	})
+}
+
+func (p *paymentsServer) CreateRecurringPayment(_ context.Context, req *types.CreateRecurringPaymentRequest, res *types.CreateRecurringPaymentResponse) error {
+	user, err := p.c.UserByNick(req.User)
+	if err != nil {
+		return err
+	}
+	if req.PeriodSeconds <= 0 {
+		return errors.New("period must be positive")
+	}
+	if p.cfg.OnCreateRecurringPayment != nil {
+		err := p.cfg.OnCreateRecurringPayment(user.ID(), req.DcrAmount)
+		if err != nil {
+			return err
+		}
+	}
+	period := time.Duration(req.PeriodSeconds) * time.Second
+	rp, err := p.c.CreateRecurringPayment(user.ID(), req.DcrAmount, period,
+		req.MaxPeriods, req.Tier)
+	if err != nil {
+		return err
+	}
+	res.RecurringPayment = marshalRecurringPayment(&rp)
+	return nil
+}
+
+func (p *paymentsServer) ListRecurringPayments(_ context.Context, _ *types.ListRecurringPaymentsRequest, res *types.ListRecurringPaymentsResponse) error {
+	rps, err := p.c.ListRecurringPayments()
+	if err != nil {
+		return err
+	}
+	res.RecurringPayments = make([]*types.RecurringPayment, len(rps))
+	for i := range rps {
+		res.RecurringPayments[i] = marshalRecurringPayment(&rps[i])
+	}
+	return nil
+}
+
+func (p *paymentsServer) CancelRecurringPayment(_ context.Context, req *types.CancelRecurringPaymentRequest, _ *types.CancelRecurringPaymentResponse) error {
+	var uid clientintf.UserID
+	if err := uid.FromBytes(req.Uid); err != nil {
+		return err
+	}
+	var id zkidentity.ShortID
+	if err := id.FromBytes(req.Id); err != nil {
+		return err
+	}
+	return p.c.CancelRecurringPayment(uid, id, req.Reason)
+}
+
+func (p *paymentsServer) ListTierMembers(_ context.Context, req *types.ListTierMembersRequest, res *types.ListTierMembersResponse) error {
+	members, err := p.c.ListTierMembers(req.Tier)
+	if err != nil {
+		return err
+	}
+	res.Members = make([]*types.RecurringPayment, len(members))
+	for i := range members {
+		res.Members[i] = marshalRecurringPayment(&members[i])
+	}
+	return nil
+}
+
+func (p *paymentsServer) RecurringPaymentsStream(ctx context.Context, req *types.RecurringPaymentsStreamRequest, stream types.PaymentsService_RecurringPaymentsStreamServer) error {
+	return p.recurringStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (p *paymentsServer) AckRecurringPaymentEvent(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return p.recurringStreams.ack(req.SequenceId)
+}
+
+func (p *paymentsServer) recurringReceivedNtfnHandler(_ *client.RemoteUser, rp clientdb.RecurringPayment) {
+	p.recurringStreams.send(&types.RecurringPaymentEvent{
+		RecurringPayment: marshalRecurringPayment(&rp),
+		Received:         true,
+	})
+}
+
+func (p *paymentsServer) recurringUpdatedNtfnHandler(_ *client.RemoteUser, rp clientdb.RecurringPayment) {
+	p.recurringStreams.send(&types.RecurringPaymentEvent{
+		RecurringPayment: marshalRecurringPayment(&rp),
+	})
+}
+
+func (p *paymentsServer) LedgerStream(ctx context.Context, req *types.LedgerStreamRequest, stream types.PaymentsService_LedgerStreamServer) error {
+	return p.ledgerStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (p *paymentsServer) AckLedgerEntry(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return p.ledgerStreams.ack(req.SequenceId)
+}
+
+func (p *paymentsServer) ledgerEntryNtfnHandler(entry clientdb.LedgerEntry) {
+	p.ledgerStreams.send(&types.LedgerEntry{
+		Timestamp:    entry.Timestamp.Unix(),
+		Uid:          entry.UID[:],
+		Event:        entry.Event,
+		Category:     string(entry.Category),
+		AmountMatoms: entry.Amount,
+		FeeMatoms:    entry.PayFee,
+		InvoiceHash:  entry.InvoiceHash,
+		DcrUsdRate:   entry.DCRUSDRate,
+	})
+}
+
+func (p *paymentsServer) TipGroupChat(ctx context.Context, req *types.TipGroupChatRequest, res *types.TipGroupChatResponse) error {
+	gcid, err := p.c.GCIDByName(req.Gc)
+	if err != nil {
+		return err
+	}
+	mode := clientdb.GCTipSplitMode(req.SplitMode)
+	if mode == "" {
+		mode = clientdb.GCTipSplitEqual
+	}
+	if p.cfg.OnTipGroupChat != nil {
+		err := p.cfg.OnTipGroupChat(gcid, req.DcrAmount)
+		if err != nil {
+			return err
+		}
+	}
+	gt, err := p.c.TipGroupChat(gcid, req.DcrAmount, mode, req.MaxAttempts)
+	if err != nil {
+		return err
+	}
+	res.Tip = marshalGCTip(&gt)
+	return nil
+}
+
+func (p *paymentsServer) GCTipProgressStream(ctx context.Context, req *types.GCTipProgressStreamRequest, stream types.PaymentsService_GCTipProgressStreamServer) error {
+	return p.gcTipStreams.runStream(ctx, req.UnackedFrom, stream)
+}
+
+func (p *paymentsServer) AckGCTipProgress(_ context.Context, req *types.AckRequest, _ *types.AckResponse) error {
+	return p.gcTipStreams.ack(req.SequenceId)
+}
+
+func (p *paymentsServer) gcTipProgressNtfnHandler(gt clientdb.GCTip) {
+	completed, failed, pending, paid := gt.Progress()
+	p.gcTipStreams.send(&types.GCTipProgressEvent{
+		Tip:            marshalGCTip(&gt),
+		Completed:      uint32(completed),
+		Failed:         uint32(failed),
+		Pending:        uint32(pending),
+		PaidMilliAtoms: paid,
+	})
+}
+
 func (p *paymentsServer) registerOfflineMessageStorageHandlers() {
 	nmgr := p.c.NotificationManager()
 	nmgr.RegisterSync(client.OnTipAttemptProgressNtfn(p.tipProgressNtfnHandler))
 	nmgr.RegisterSync(client.OnTipReceivedNtfn(p.tipNtfnHandler))
+	nmgr.RegisterSync(client.OnPaymentRequestReceivedNtfn(p.payReqReceivedNtfnHandler))
+	nmgr.RegisterSync(client.OnPaymentRequestUpdatedNtfn(p.payReqUpdatedNtfnHandler))
+	nmgr.RegisterSync(client.OnRecurringPaymentReceivedNtfn(p.recurringReceivedNtfnHandler))
+	nmgr.RegisterSync(client.OnRecurringPaymentUpdatedNtfn(p.recurringUpdatedNtfnHandler))
+	nmgr.RegisterSync(client.OnRecurringPaymentLapsedNtfn(p.recurringUpdatedNtfnHandler))
+	nmgr.RegisterSync(client.OnLedgerEntryNtfn(p.ledgerEntryNtfnHandler))
+	nmgr.RegisterSync(client.OnGCTipProgressNtfn(p.gcTipProgressNtfnHandler))
+}
+
+// marshalRecurringPayment converts a recurring payment into its clientrpc
+// type.
+func marshalRecurringPayment(rp *clientdb.RecurringPayment) *types.RecurringPayment {
+	return &types.RecurringPayment{
+		Id:            rp.ID[:],
+		Uid:           rp.UID[:],
+		Paying:        rp.Paying,
+		Tier:          rp.Tier,
+		AmountMatoms:  int64(rp.MilliAtoms),
+		PeriodSeconds: int64(rp.Period / time.Second),
+		MaxPeriods:    rp.MaxPeriods,
+		PeriodsPaid:   rp.PeriodsPaid,
+		Created:       rp.Created.Unix(),
+		NextPayment:   rp.NextPayment.Unix(),
+		Status:        string(rp.Status),
+		Error:         rp.Error,
+	}
+}
+
+// marshalGCTip converts a GC tip into its clientrpc type.
+func marshalGCTip(gt *clientdb.GCTip) *types.GCTip {
+	res := &types.GCTip{
+		Id:         gt.ID[:],
+		Gcid:       gt.GCID[:],
+		SplitMode:  string(gt.SplitMode),
+		MilliAtoms: gt.MilliAtoms,
+		Created:    gt.Created.Unix(),
+		Members:    make([]*types.GCTipMember, len(gt.Members)),
+	}
+	if gt.Completed != nil {
+		res.Completed = gt.Completed.Unix()
+	}
+	for i, m := range gt.Members {
+		res.Members[i] = &types.GCTipMember{
+			Uid:        m.UID[:],
+			MilliAtoms: m.MilliAtoms,
+			Status:     string(m.Status),
+			Error:      m.Error,
+		}
+	}
+	return res
+}
+
+// marshalPaymentRequest converts a payment request into its clientrpc type.
+func marshalPaymentRequest(pr *clientdb.PaymentRequest) *types.PaymentRequest {
+	return &types.PaymentRequest{
+		Id:           pr.ID[:],
+		Uid:          pr.UID[:],
+		Sent:         pr.Sent,
+		Invoice:      pr.Invoice,
+		AmountMatoms: int64(pr.MilliAtoms),
+		Memo:         pr.Memo,
+		Created:      pr.Created.Unix(),
+		Expires:      pr.Expires.Unix(),
+		Status:       string(pr.Status),
+		Error:        pr.Error,
+	}
 }
 
 var _ types.PaymentsServiceServer = (*paymentsServer)(nil)
@@ -116,6 +439,26 @@ func (s *Server) InitPaymentsService(cfg PaymentsServerCfg) error {
 		return err
 	}
 
+	payReqStreams, err := newServerStreams[*types.PaymentRequestEvent](cfg.RootReplayMsgLogs, "paymentrequests", cfg.Log)
+	if err != nil {
+		return err
+	}
+
+	recurringStreams, err := newServerStreams[*types.RecurringPaymentEvent](cfg.RootReplayMsgLogs, "recurringpayments", cfg.Log)
+	if err != nil {
+		return err
+	}
+
+	ledgerStreams, err := newServerStreams[*types.LedgerEntry](cfg.RootReplayMsgLogs, "payledger", cfg.Log)
+	if err != nil {
+		return err
+	}
+
+	gcTipStreams, err := newServerStreams[*types.GCTipProgressEvent](cfg.RootReplayMsgLogs, "gctipprogress", cfg.Log)
+	if err != nil {
+		return err
+	}
+
 	ps := &paymentsServer{
 		cfg: cfg,
 		log: cfg.Log,
@@ -123,6 +466,10 @@ func (s *Server) InitPaymentsService(cfg PaymentsServerCfg) error {
 
 		tipProgressStreams: tipProgressStreams,
 		tipStreams:         tipStreams,
+		payReqStreams:      payReqStreams,
+		recurringStreams:   recurringStreams,
+		ledgerStreams:      ledgerStreams,
+		gcTipStreams:       gcTipStreams,
 	}
 	ps.registerOfflineMessageStorageHandlers()
 	s.services.Bind("PaymentsService", types.PaymentsServiceDefn(), ps)
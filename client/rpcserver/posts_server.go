@@ -2,12 +2,15 @@ package rpcserver
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/slog"
 )
 
@@ -59,15 +62,7 @@ func (p *postsServer) postsNtfnHandler(ru *client.RemoteUser, summ clientdb.Post
 	}
 	ntfn := &types.ReceivedPost{
 		RelayerId: relayerID,
-		Summary: &types.PostSummary{
-			Id:           summ.ID[:],
-			From:         summ.From[:],
-			AuthorId:     summ.AuthorID[:],
-			AuthorNick:   summ.AuthorNick,
-			Date:         summ.Date.Unix(),
-			LastStatusTs: summ.LastStatusTS.Unix(),
-			Title:        summ.Title,
-		},
+		Summary:   marshalPostSummary(&summ),
 		Post: &types.PostMetadata{
 			Version:    pm.Version,
 			Attributes: pm.Attributes,
@@ -106,11 +101,22 @@ func (p *postsServer) postStatusNtfnHandler(user *client.RemoteUser, pid clienti
 		statusFromNick = statusFrom.ShortLogID()
 	}
 
+	var parentCommentID []byte
+	if s, ok := status.Attributes[rpc.RMPParent]; ok && rpc.IsPostComment(status.Attributes) {
+		var parent clientintf.ID
+		if err := parent.FromString(s); err == nil {
+			parentCommentID = parent[:]
+		}
+	}
+
+	statusID := status.Hash()
 	ntfn := &types.ReceivedPostStatus{
-		RelayerId:      relayerID,
-		PostId:         pid[:],
-		StatusFrom:     statusFrom[:],
-		StatusFromNick: statusFromNick,
+		RelayerId:       relayerID,
+		PostId:          pid[:],
+		StatusFrom:      statusFrom[:],
+		StatusFromNick:  statusFromNick,
+		StatusId:        statusID[:],
+		ParentCommentId: parentCommentID,
 		Status: &types.PostMetadataStatus{
 			Version:    status.Version,
 			From:       status.From,
@@ -126,6 +132,154 @@ func (p *postsServer) AckReceivedPostStatus(_ context.Context, req *types.AckReq
 	return p.statusStreams.ack(req.SequenceId)
 }
 
+func (p *postsServer) CreatePost(_ context.Context, req *types.CreatePostRequest, res *types.CreatePostResponse) error {
+	opts := client.CreatePostOpts{
+		Audience: req.Audience,
+		Tags:     req.Tags,
+	}
+	if req.Expiration != 0 {
+		opts.Expiration = time.Unix(req.Expiration, 0)
+	}
+	summ, err := p.c.CreatePostWithOpts(req.Content, req.Description, opts)
+	if err != nil {
+		return err
+	}
+	res.Summary = marshalPostSummary(&summ)
+	return nil
+}
+
+func (p *postsServer) SavePostAudience(_ context.Context, req *types.SavePostAudienceRequest, _ *types.SavePostAudienceResponse) error {
+	members := make([]clientintf.UserID, 0, len(req.Members))
+	for _, m := range req.Members {
+		user, err := p.c.UserByNick(m)
+		if err != nil {
+			return err
+		}
+		members = append(members, user.ID())
+	}
+	return p.c.SavePostAudience(req.Name, members)
+}
+
+func (p *postsServer) RemovePostAudience(_ context.Context, req *types.RemovePostAudienceRequest, _ *types.RemovePostAudienceResponse) error {
+	return p.c.RemovePostAudience(req.Name)
+}
+
+func (p *postsServer) ListPostAudiences(_ context.Context, _ *types.ListPostAudiencesRequest, res *types.ListPostAudiencesResponse) error {
+	audiences, err := p.c.ListPostAudiences()
+	if err != nil {
+		return err
+	}
+	res.Audiences = make([]*types.PostAudience, len(audiences))
+	for i, a := range audiences {
+		members := make([][]byte, len(a.Members))
+		for j := range a.Members {
+			members[j] = a.Members[j].Bytes()
+		}
+		res.Audiences[i] = &types.PostAudience{
+			Name:    a.Name,
+			Members: members,
+		}
+	}
+	return nil
+}
+
+func (p *postsServer) SavePostDraft(_ context.Context, req *types.SavePostDraftRequest, res *types.SavePostDraftResponse) error {
+	if req.Draft == nil {
+		return fmt.Errorf("draft cannot be empty")
+	}
+	draft := clientdb.PostDraft{
+		Content:     req.Draft.Content,
+		Description: req.Draft.Description,
+		Audience:    req.Draft.Audience,
+		Tags:        req.Draft.Tags,
+	}
+	if len(req.Draft.Id) > 0 {
+		if err := draft.ID.FromBytes(req.Draft.Id); err != nil {
+			return err
+		}
+	}
+	if req.Draft.PublishAt != 0 {
+		draft.PublishAt = time.Unix(req.Draft.PublishAt, 0)
+	}
+	draft, err := p.c.SavePostDraft(draft)
+	if err != nil {
+		return err
+	}
+	res.Draft = marshalPostDraft(&draft)
+	return nil
+}
+
+func (p *postsServer) ListPostDrafts(_ context.Context, _ *types.ListPostDraftsRequest, res *types.ListPostDraftsResponse) error {
+	drafts, err := p.c.ListPostDrafts()
+	if err != nil {
+		return err
+	}
+	res.Drafts = make([]*types.PostDraft, len(drafts))
+	for i := range drafts {
+		res.Drafts[i] = marshalPostDraft(&drafts[i])
+	}
+	return nil
+}
+
+func (p *postsServer) RemovePostDraft(_ context.Context, req *types.RemovePostDraftRequest, _ *types.RemovePostDraftResponse) error {
+	var id zkidentity.ShortID
+	if err := id.FromBytes(req.Id); err != nil {
+		return err
+	}
+	return p.c.RemovePostDraft(id)
+}
+
+func (p *postsServer) PublishPostDraft(_ context.Context, req *types.PublishPostDraftRequest, res *types.PublishPostDraftResponse) error {
+	var id zkidentity.ShortID
+	if err := id.FromBytes(req.Id); err != nil {
+		return err
+	}
+	summ, err := p.c.PublishPostDraft(id)
+	if err != nil {
+		return err
+	}
+	res.Summary = marshalPostSummary(&summ)
+	return nil
+}
+
+// marshalPostDraft converts a post draft into its clientrpc type.
+func marshalPostDraft(draft *clientdb.PostDraft) *types.PostDraft {
+	var publishAt int64
+	if !draft.PublishAt.IsZero() {
+		publishAt = draft.PublishAt.Unix()
+	}
+	return &types.PostDraft{
+		Id:           draft.ID[:],
+		Content:      draft.Content,
+		Description:  draft.Description,
+		Audience:     draft.Audience,
+		Tags:         draft.Tags,
+		Created:      draft.Created.Unix(),
+		Updated:      draft.Updated.Unix(),
+		PublishAt:    publishAt,
+		PublishError: draft.PublishError,
+	}
+}
+
+// marshalPostSummary converts a post summary into its clientrpc type.
+func marshalPostSummary(summ *clientdb.PostSummary) *types.PostSummary {
+	var expiration int64
+	if !summ.Expiration.IsZero() {
+		expiration = summ.Expiration.Unix()
+	}
+	return &types.PostSummary{
+		Id:           summ.ID[:],
+		From:         summ.From[:],
+		AuthorId:     summ.AuthorID[:],
+		AuthorNick:   summ.AuthorNick,
+		Date:         summ.Date.Unix(),
+		LastStatusTs: summ.LastStatusTS.Unix(),
+		Title:        summ.Title,
+		Tags:         summ.Tags,
+		Expiration:   expiration,
+	}
+}
+
 // registerOfflineMessageStorageHandlers registers the handlers for streams on
 // the client's notification manager.
 func (p *postsServer) registerOfflineMessageStorageHandlers() {
@@ -189,3 +189,29 @@ func sliceDiff[T comparable](oldSlice, newSlice []T) sliceChanges[T] {
 	}
 	return sliceChanges[T]{added: added, removed: removed}
 }
+
+// limitFileThumbnails removes the thumbnail attribute from the files once the
+// total size of thumbnails exceeds budget. The attributes of files that have
+// their thumbnails removed are copied, so that the original attribute maps are
+// not modified. Returns the remaining budget.
+func limitFileThumbnails(files []rpc.FileMetadata, budget int) int {
+	for i := range files {
+		thumb, ok := files[i].Attributes[rpc.FileAttrThumbnail]
+		if !ok {
+			continue
+		}
+		if len(thumb) <= budget {
+			budget -= len(thumb)
+			continue
+		}
+
+		attrs := make(map[string]string, len(files[i].Attributes))
+		for k, v := range files[i].Attributes {
+			if k != rpc.FileAttrThumbnail {
+				attrs[k] = v
+			}
+		}
+		files[i].Attributes = attrs
+	}
+	return budget
+}
@@ -1036,6 +1036,11 @@ type PostSummary struct {
 	LastStatusTs int64 `protobuf:"varint,6,opt,name=last_status_ts,json=lastStatusTs,proto3" json:"last_status_ts,omitempty"`
 	// title is either the included or suggested title of the post.
 	Title string `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	// tags are the tags defined by the author of the post.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// expiration is the unix timestamp after which the post is deleted. Zero
+	// if the post does not expire.
+	Expiration int64 `protobuf:"varint,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
 func (x *PostSummary) Reset() {
@@ -1119,6 +1124,20 @@ func (x *PostSummary) GetTitle() string {
 	return ""
 }
 
+func (x *PostSummary) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostSummary) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
 // PostsStreamRequest is the request to establish a stream of received post events.
 type PostsStreamRequest struct {
 	state         protoimpl.MessageState
@@ -1317,6 +1336,12 @@ type ReceivedPostStatus struct {
 	Status *PostMetadataStatus `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	// status_from_nick is the nick of the original author of the status.
 	StatusFromNick string `protobuf:"bytes,6,opt,name=status_from_nick,json=statusFromNick,proto3" json:"status_from_nick,omitempty"`
+	// status_id is the id of the status update. Replies to a comment reference
+	// the status_id of the comment in their parent_comment_id.
+	StatusId []byte `protobuf:"bytes,7,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	// parent_comment_id is the id of the comment this status update replies
+	// to. It is empty for top-level comments and other status updates.
+	ParentCommentId []byte `protobuf:"bytes,8,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
 }
 
 func (x *ReceivedPostStatus) Reset() {
@@ -1393,22 +1418,44 @@ func (x *ReceivedPostStatus) GetStatusFromNick() string {
 	return ""
 }
 
-// TipUserRequest is a request to tip a remote user.
-type TipUserRequest struct {
+func (x *ReceivedPostStatus) GetStatusId() []byte {
+	if x != nil {
+		return x.StatusId
+	}
+	return nil
+}
+
+func (x *ReceivedPostStatus) GetParentCommentId() []byte {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return nil
+}
+
+// CreatePostRequest is a request to create a new post.
+type CreatePostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// user is the remote user nick or hex-encoded ID.
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	// dcr_amount is the DCR amount to send as tip.
-	DcrAmount float64 `protobuf:"fixed64,2,opt,name=dcr_amount,json=dcrAmount,proto3" json:"dcr_amount,omitempty"`
-	// max_attempts is the number of max attempts to try to send the tip.
-	MaxAttempts int32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
-}
-
-func (x *TipUserRequest) Reset() {
-	*x = TipUserRequest{}
+	// content is the main content of the post.
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// description is an optional description of the post.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// audience is the optional name of the audience the post is restricted
+	// to. Restricted posts are only shared with subscribers in the audience
+	// and cannot be relayed.
+	Audience string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	// tags is the optional list of tags of the post. Tags are normalized to
+	// lower case and may only contain letters, digits, '-' and '_'.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// expiration is the optional unix timestamp after which the post is
+	// deleted by the local client and its subscribers.
+	Expiration int64 `protobuf:"varint,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1416,13 +1463,13 @@ func (x *TipUserRequest) Reset() {
 	}
 }
 
-func (x *TipUserRequest) String() string {
+func (x *CreatePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipUserRequest) ProtoMessage() {}
+func (*CreatePostRequest) ProtoMessage() {}
 
-func (x *TipUserRequest) ProtoReflect() protoreflect.Message {
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1434,41 +1481,58 @@ func (x *TipUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipUserRequest.ProtoReflect.Descriptor instead.
-func (*TipUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TipUserRequest) GetUser() string {
+func (x *CreatePostRequest) GetContent() string {
 	if x != nil {
-		return x.User
+		return x.Content
 	}
 	return ""
 }
 
-func (x *TipUserRequest) GetDcrAmount() float64 {
+func (x *CreatePostRequest) GetDescription() string {
 	if x != nil {
-		return x.DcrAmount
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *TipUserRequest) GetMaxAttempts() int32 {
+func (x *CreatePostRequest) GetAudience() string {
 	if x != nil {
-		return x.MaxAttempts
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
 	}
 	return 0
 }
 
-// TipUserResponse is the response to a tip user request.
-type TipUserResponse struct {
+// CreatePostResponse is the response to a create post request.
+type CreatePostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// summary is the summary information about the new post.
+	Summary *PostSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (x *TipUserResponse) Reset() {
-	*x = TipUserResponse{}
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,13 +1540,13 @@ func (x *TipUserResponse) Reset() {
 	}
 }
 
-func (x *TipUserResponse) String() string {
+func (x *CreatePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipUserResponse) ProtoMessage() {}
+func (*CreatePostResponse) ProtoMessage() {}
 
-func (x *TipUserResponse) ProtoReflect() protoreflect.Message {
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1494,27 +1558,32 @@ func (x *TipUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipUserResponse.ProtoReflect.Descriptor instead.
-func (*TipUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{24}
 }
 
-// MediateKXRequest is the request to perform a transitive KX with a given
-// user.
-type MediateKXRequest struct {
+func (x *CreatePostResponse) GetSummary() *PostSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// PostAudience is a named list of post subscribers.
+type PostAudience struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// mediator is the nick or hex ID of the mediator user (which must already be
-	// KX'd with).
-	Mediator string `protobuf:"bytes,1,opt,name=mediator,proto3" json:"mediator,omitempty"`
-	// target is the hex ID of the target user to KX with.
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// name is the name of the audience.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members are the ids of the members of the audience.
+	Members [][]byte `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *MediateKXRequest) Reset() {
-	*x = MediateKXRequest{}
+func (x *PostAudience) Reset() {
+	*x = PostAudience{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1522,13 +1591,13 @@ func (x *MediateKXRequest) Reset() {
 	}
 }
 
-func (x *MediateKXRequest) String() string {
+func (x *PostAudience) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediateKXRequest) ProtoMessage() {}
+func (*PostAudience) ProtoMessage() {}
 
-func (x *MediateKXRequest) ProtoReflect() protoreflect.Message {
+func (x *PostAudience) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1540,34 +1609,39 @@ func (x *MediateKXRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediateKXRequest.ProtoReflect.Descriptor instead.
-func (*MediateKXRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostAudience.ProtoReflect.Descriptor instead.
+func (*PostAudience) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MediateKXRequest) GetMediator() string {
+func (x *PostAudience) GetName() string {
 	if x != nil {
-		return x.Mediator
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MediateKXRequest) GetTarget() string {
+func (x *PostAudience) GetMembers() [][]byte {
 	if x != nil {
-		return x.Target
+		return x.Members
 	}
-	return ""
+	return nil
 }
 
-// MediateKXResponse is the response to a mediate KX request.
-type MediateKXResponse struct {
+// SavePostAudienceRequest is a request to create or replace a post audience.
+type SavePostAudienceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the audience.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members are the nicks or hex-encoded IDs of the members of the audience.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *MediateKXResponse) Reset() {
-	*x = MediateKXResponse{}
+func (x *SavePostAudienceRequest) Reset() {
+	*x = SavePostAudienceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1575,13 +1649,13 @@ func (x *MediateKXResponse) Reset() {
 	}
 }
 
-func (x *MediateKXResponse) String() string {
+func (x *SavePostAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediateKXResponse) ProtoMessage() {}
+func (*SavePostAudienceRequest) ProtoMessage() {}
 
-func (x *MediateKXResponse) ProtoReflect() protoreflect.Message {
+func (x *SavePostAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,25 +1667,34 @@ func (x *MediateKXResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediateKXResponse.ProtoReflect.Descriptor instead.
-func (*MediateKXResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SavePostAudienceRequest.ProtoReflect.Descriptor instead.
+func (*SavePostAudienceRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{26}
 }
 
-// KXStreamRequest is the request sent when obtaining a stream of KX notifications.
-type KXStreamRequest struct {
+func (x *SavePostAudienceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavePostAudienceRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SavePostAudienceResponse is the response to a save post audience request.
+type SavePostAudienceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// unacked_from specifies to the server the sequence_id of the last completed
-	// KX. KXs completed by the server that have a higher sequence_id will be
-	// streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *KXStreamRequest) Reset() {
-	*x = KXStreamRequest{}
+func (x *SavePostAudienceResponse) Reset() {
+	*x = SavePostAudienceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1619,13 +1702,13 @@ func (x *KXStreamRequest) Reset() {
 	}
 }
 
-func (x *KXStreamRequest) String() string {
+func (x *SavePostAudienceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KXStreamRequest) ProtoMessage() {}
+func (*SavePostAudienceResponse) ProtoMessage() {}
 
-func (x *KXStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *SavePostAudienceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1637,36 +1720,23 @@ func (x *KXStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KXStreamRequest.ProtoReflect.Descriptor instead.
-func (*KXStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SavePostAudienceResponse.ProtoReflect.Descriptor instead.
+func (*SavePostAudienceResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *KXStreamRequest) GetUnackedFrom() uint64 {
-	if x != nil {
-		return x.UnackedFrom
-	}
-	return 0
-}
-
-// KXCompleted is the information about a completed KX event.
-type KXCompleted struct {
+// RemovePostAudienceRequest is a request to remove a post audience.
+type RemovePostAudienceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// uid is the raw ID of the KX'd user.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the KX'd user.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// initial_rendezvous is the initial random RV the key exchange happened.
-	InitialRendezvous []byte `protobuf:"bytes,4,opt,name=initial_rendezvous,json=initialRendezvous,proto3" json:"initial_rendezvous,omitempty"`
+	// name is the name of the audience.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *KXCompleted) Reset() {
-	*x = KXCompleted{}
+func (x *RemovePostAudienceRequest) Reset() {
+	*x = RemovePostAudienceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1674,13 +1744,13 @@ func (x *KXCompleted) Reset() {
 	}
 }
 
-func (x *KXCompleted) String() string {
+func (x *RemovePostAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KXCompleted) ProtoMessage() {}
+func (*RemovePostAudienceRequest) ProtoMessage() {}
 
-func (x *KXCompleted) ProtoReflect() protoreflect.Message {
+func (x *RemovePostAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1692,54 +1762,27 @@ func (x *KXCompleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KXCompleted.ProtoReflect.Descriptor instead.
-func (*KXCompleted) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePostAudienceRequest.ProtoReflect.Descriptor instead.
+func (*RemovePostAudienceRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *KXCompleted) GetSequenceId() uint64 {
-	if x != nil {
-		return x.SequenceId
-	}
-	return 0
-}
-
-func (x *KXCompleted) GetUid() []byte {
-	if x != nil {
-		return x.Uid
-	}
-	return nil
-}
-
-func (x *KXCompleted) GetNick() string {
+func (x *RemovePostAudienceRequest) GetName() string {
 	if x != nil {
-		return x.Nick
+		return x.Name
 	}
 	return ""
 }
 
-func (x *KXCompleted) GetInitialRendezvous() []byte {
-	if x != nil {
-		return x.InitialRendezvous
-	}
-	return nil
-}
-
-// WriteNewInviteRequest is the request to add a new invite.
-type WriteNewInviteRequest struct {
+// RemovePostAudienceResponse is the response to a remove post audience request.
+type RemovePostAudienceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// gc is the hex-encoded ID or the alias of the GC in the local client.
-	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
-	// fund_amount is how much to send as funds in the invite. If zero, no funds
-	// are included. Amount is in atoms.
-	FundAmount uint64 `protobuf:"varint,2,opt,name=fund_amount,json=fundAmount,proto3" json:"fund_amount,omitempty"`
 }
 
-func (x *WriteNewInviteRequest) Reset() {
-	*x = WriteNewInviteRequest{}
+func (x *RemovePostAudienceResponse) Reset() {
+	*x = RemovePostAudienceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1747,13 +1790,13 @@ func (x *WriteNewInviteRequest) Reset() {
 	}
 }
 
-func (x *WriteNewInviteRequest) String() string {
+func (x *RemovePostAudienceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteNewInviteRequest) ProtoMessage() {}
+func (*RemovePostAudienceResponse) ProtoMessage() {}
 
-func (x *WriteNewInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *RemovePostAudienceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1765,43 +1808,20 @@ func (x *WriteNewInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteNewInviteRequest.ProtoReflect.Descriptor instead.
-func (*WriteNewInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePostAudienceResponse.ProtoReflect.Descriptor instead.
+func (*RemovePostAudienceResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *WriteNewInviteRequest) GetGc() string {
-	if x != nil {
-		return x.Gc
-	}
-	return ""
-}
-
-func (x *WriteNewInviteRequest) GetFundAmount() uint64 {
-	if x != nil {
-		return x.FundAmount
-	}
-	return 0
-}
-
-// WriteNewInviteResponse is an invite that can be sent (out-of-band) to an
-// user the local client wishes to KX with.
-type WriteNewInviteResponse struct {
+// ListPostAudiencesRequest is a request to list the post audiences.
+type ListPostAudiencesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// invite_bytes is the raw invite.
-	InviteBytes []byte `protobuf:"bytes,1,opt,name=invite_bytes,json=inviteBytes,proto3" json:"invite_bytes,omitempty"`
-	// invite is the data contained in the raw invite.
-	Invite *OOBPublicIdentityInvite `protobuf:"bytes,2,opt,name=invite,proto3" json:"invite,omitempty"`
-	// invite_key is the pre-paid invite key string that can be used to fetch the
-	// invite from the server.
-	InviteKey string `protobuf:"bytes,3,opt,name=invite_key,json=inviteKey,proto3" json:"invite_key,omitempty"`
 }
 
-func (x *WriteNewInviteResponse) Reset() {
-	*x = WriteNewInviteResponse{}
+func (x *ListPostAudiencesRequest) Reset() {
+	*x = ListPostAudiencesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,13 +1829,13 @@ func (x *WriteNewInviteResponse) Reset() {
 	}
 }
 
-func (x *WriteNewInviteResponse) String() string {
+func (x *ListPostAudiencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteNewInviteResponse) ProtoMessage() {}
+func (*ListPostAudiencesRequest) ProtoMessage() {}
 
-func (x *WriteNewInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPostAudiencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1827,44 +1847,23 @@ func (x *WriteNewInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteNewInviteResponse.ProtoReflect.Descriptor instead.
-func (*WriteNewInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPostAudiencesRequest.ProtoReflect.Descriptor instead.
+func (*ListPostAudiencesRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *WriteNewInviteResponse) GetInviteBytes() []byte {
-	if x != nil {
-		return x.InviteBytes
-	}
-	return nil
-}
-
-func (x *WriteNewInviteResponse) GetInvite() *OOBPublicIdentityInvite {
-	if x != nil {
-		return x.Invite
-	}
-	return nil
-}
-
-func (x *WriteNewInviteResponse) GetInviteKey() string {
-	if x != nil {
-		return x.InviteKey
-	}
-	return ""
-}
-
-// AcceptInviteRequest is the request to accept an invite to KX with an user.
-type AcceptInviteRequest struct {
+// ListPostAudiencesResponse is the list of post audiences.
+type ListPostAudiencesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// invite_bytes is the raw invite.
-	InviteBytes []byte `protobuf:"bytes,1,opt,name=invite_bytes,json=inviteBytes,proto3" json:"invite_bytes,omitempty"`
+	// audiences is the list of post audiences.
+	Audiences []*PostAudience `protobuf:"bytes,1,rep,name=audiences,proto3" json:"audiences,omitempty"`
 }
 
-func (x *AcceptInviteRequest) Reset() {
-	*x = AcceptInviteRequest{}
+func (x *ListPostAudiencesResponse) Reset() {
+	*x = ListPostAudiencesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1872,13 +1871,13 @@ func (x *AcceptInviteRequest) Reset() {
 	}
 }
 
-func (x *AcceptInviteRequest) String() string {
+func (x *ListPostAudiencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInviteRequest) ProtoMessage() {}
+func (*ListPostAudiencesResponse) ProtoMessage() {}
 
-func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPostAudiencesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1890,30 +1889,49 @@ func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
-func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPostAudiencesResponse.ProtoReflect.Descriptor instead.
+func (*ListPostAudiencesResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AcceptInviteRequest) GetInviteBytes() []byte {
+func (x *ListPostAudiencesResponse) GetAudiences() []*PostAudience {
 	if x != nil {
-		return x.InviteBytes
+		return x.Audiences
 	}
 	return nil
 }
 
-// AcceptInviteResponse is the response to accepting an invite to KX with an user.
-type AcceptInviteResponse struct {
+// PostDraft is a post that was not yet published.
+type PostDraft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// invite is the decoded invite accepted.
-	Invite *OOBPublicIdentityInvite `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
-}
-
-func (x *AcceptInviteResponse) Reset() {
-	*x = AcceptInviteResponse{}
+	// id is the id of the draft.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// content is the main content of the post.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// description is an optional description of the post.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// audience is the optional name of the audience the post will be
+	// restricted to.
+	Audience string `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty"`
+	// tags is the optional list of tags of the post.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// created is the unix timestamp of when the draft was created.
+	Created int64 `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
+	// updated is the unix timestamp of the last update of the draft.
+	Updated int64 `protobuf:"varint,7,opt,name=updated,proto3" json:"updated,omitempty"`
+	// publish_at is the unix timestamp of when the draft is scheduled to be
+	// published. Zero if the draft is not scheduled.
+	PublishAt int64 `protobuf:"varint,8,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// publish_error is the error of the last attempt to publish the draft at
+	// its scheduled time. Failed drafts are unscheduled.
+	PublishError string `protobuf:"bytes,9,opt,name=publish_error,json=publishError,proto3" json:"publish_error,omitempty"`
+}
+
+func (x *PostDraft) Reset() {
+	*x = PostDraft{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1921,13 +1939,13 @@ func (x *AcceptInviteResponse) Reset() {
 	}
 }
 
-func (x *AcceptInviteResponse) String() string {
+func (x *PostDraft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInviteResponse) ProtoMessage() {}
+func (*PostDraft) ProtoMessage() {}
 
-func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *PostDraft) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1939,32 +1957,87 @@ func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInviteResponse.ProtoReflect.Descriptor instead.
-func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostDraft.ProtoReflect.Descriptor instead.
+func (*PostDraft) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AcceptInviteResponse) GetInvite() *OOBPublicIdentityInvite {
+func (x *PostDraft) GetId() []byte {
 	if x != nil {
-		return x.Invite
+		return x.Id
 	}
 	return nil
 }
 
-// InviteToGCRequest is the request to invite an user to a GC.
-type InviteToGCRequest struct {
+func (x *PostDraft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostDraft) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *PostDraft) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PostDraft) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *PostDraft) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *PostDraft) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *PostDraft) GetPublishError() string {
+	if x != nil {
+		return x.PublishError
+	}
+	return ""
+}
+
+// SavePostDraftRequest is a request to create or update a post draft.
+type SavePostDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// gc is the hex-encoded ID or the alias of the GC in the local client.
-	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
-	// user is the hex-encoded ID of the user or its nick.
-	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// draft is the draft to save. If its id is empty, a new draft is
+	// created. The created, updated and publish_error fields are ignored.
+	Draft *PostDraft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
 }
 
-func (x *InviteToGCRequest) Reset() {
-	*x = InviteToGCRequest{}
+func (x *SavePostDraftRequest) Reset() {
+	*x = SavePostDraftRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1972,13 +2045,13 @@ func (x *InviteToGCRequest) Reset() {
 	}
 }
 
-func (x *InviteToGCRequest) String() string {
+func (x *SavePostDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteToGCRequest) ProtoMessage() {}
+func (*SavePostDraftRequest) ProtoMessage() {}
 
-func (x *InviteToGCRequest) ProtoReflect() protoreflect.Message {
+func (x *SavePostDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1990,34 +2063,30 @@ func (x *InviteToGCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteToGCRequest.ProtoReflect.Descriptor instead.
-func (*InviteToGCRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SavePostDraftRequest.ProtoReflect.Descriptor instead.
+func (*SavePostDraftRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *InviteToGCRequest) GetGc() string {
-	if x != nil {
-		return x.Gc
-	}
-	return ""
-}
-
-func (x *InviteToGCRequest) GetUser() string {
+func (x *SavePostDraftRequest) GetDraft() *PostDraft {
 	if x != nil {
-		return x.User
+		return x.Draft
 	}
-	return ""
+	return nil
 }
 
-// InviteToGCResponse is the response to the request to invite an user to a GC.
-type InviteToGCResponse struct {
+// SavePostDraftResponse is the response to a save post draft request.
+type SavePostDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// draft is the saved draft.
+	Draft *PostDraft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
 }
 
-func (x *InviteToGCResponse) Reset() {
-	*x = InviteToGCResponse{}
+func (x *SavePostDraftResponse) Reset() {
+	*x = SavePostDraftResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2025,13 +2094,13 @@ func (x *InviteToGCResponse) Reset() {
 	}
 }
 
-func (x *InviteToGCResponse) String() string {
+func (x *SavePostDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteToGCResponse) ProtoMessage() {}
+func (*SavePostDraftResponse) ProtoMessage() {}
 
-func (x *InviteToGCResponse) ProtoReflect() protoreflect.Message {
+func (x *SavePostDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2043,23 +2112,27 @@ func (x *InviteToGCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteToGCResponse.ProtoReflect.Descriptor instead.
-func (*InviteToGCResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SavePostDraftResponse.ProtoReflect.Descriptor instead.
+func (*SavePostDraftResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{34}
 }
 
-// AcceptGCInviteRequest is the request to accept an invite to join a GC.
-type AcceptGCInviteRequest struct {
+func (x *SavePostDraftResponse) GetDraft() *PostDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+// ListPostDraftsRequest is a request to list the post drafts.
+type ListPostDraftsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// invite_id is the id to use to join the invite.
-	InviteId uint64 `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
 }
 
-func (x *AcceptGCInviteRequest) Reset() {
-	*x = AcceptGCInviteRequest{}
+func (x *ListPostDraftsRequest) Reset() {
+	*x = ListPostDraftsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2067,13 +2140,13 @@ func (x *AcceptGCInviteRequest) Reset() {
 	}
 }
 
-func (x *AcceptGCInviteRequest) String() string {
+func (x *ListPostDraftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptGCInviteRequest) ProtoMessage() {}
+func (*ListPostDraftsRequest) ProtoMessage() {}
 
-func (x *AcceptGCInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPostDraftsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2085,27 +2158,23 @@ func (x *AcceptGCInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptGCInviteRequest.ProtoReflect.Descriptor instead.
-func (*AcceptGCInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPostDraftsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostDraftsRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AcceptGCInviteRequest) GetInviteId() uint64 {
-	if x != nil {
-		return x.InviteId
-	}
-	return 0
-}
-
-// AcceptGCInviteResponse is the response to accept an invite to join a GC.
-type AcceptGCInviteResponse struct {
+// ListPostDraftsResponse is the list of post drafts.
+type ListPostDraftsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// drafts is the list of drafts, most recently updated first.
+	Drafts []*PostDraft `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
 }
 
-func (x *AcceptGCInviteResponse) Reset() {
-	*x = AcceptGCInviteResponse{}
+func (x *ListPostDraftsResponse) Reset() {
+	*x = ListPostDraftsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2113,13 +2182,13 @@ func (x *AcceptGCInviteResponse) Reset() {
 	}
 }
 
-func (x *AcceptGCInviteResponse) String() string {
+func (x *ListPostDraftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptGCInviteResponse) ProtoMessage() {}
+func (*ListPostDraftsResponse) ProtoMessage() {}
 
-func (x *AcceptGCInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPostDraftsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2131,25 +2200,30 @@ func (x *AcceptGCInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptGCInviteResponse.ProtoReflect.Descriptor instead.
-func (*AcceptGCInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPostDraftsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostDraftsResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{36}
 }
 
-// SendFileRequest is the request to send a file to a user.
-type SendFileRequest struct {
+func (x *ListPostDraftsResponse) GetDrafts() []*PostDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+// RemovePostDraftRequest is a request to remove a post draft.
+type RemovePostDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// user is the hex-encoded ID of the user or its nick.
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	// filename is the absolute path to the file.
-	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// id is the id of the draft.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SendFileRequest) Reset() {
-	*x = SendFileRequest{}
+func (x *RemovePostDraftRequest) Reset() {
+	*x = RemovePostDraftRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2157,13 +2231,13 @@ func (x *SendFileRequest) Reset() {
 	}
 }
 
-func (x *SendFileRequest) String() string {
+func (x *RemovePostDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFileRequest) ProtoMessage() {}
+func (*RemovePostDraftRequest) ProtoMessage() {}
 
-func (x *SendFileRequest) ProtoReflect() protoreflect.Message {
+func (x *RemovePostDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2175,34 +2249,27 @@ func (x *SendFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFileRequest.ProtoReflect.Descriptor instead.
-func (*SendFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePostDraftRequest.ProtoReflect.Descriptor instead.
+func (*RemovePostDraftRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SendFileRequest) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-func (x *SendFileRequest) GetFilename() string {
+func (x *RemovePostDraftRequest) GetId() []byte {
 	if x != nil {
-		return x.Filename
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-// SendFileResponse is the response to sending a file to a user.
-type SendFileResponse struct {
+// RemovePostDraftResponse is the response to a remove post draft request.
+type RemovePostDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SendFileResponse) Reset() {
-	*x = SendFileResponse{}
+func (x *RemovePostDraftResponse) Reset() {
+	*x = RemovePostDraftResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2210,13 +2277,13 @@ func (x *SendFileResponse) Reset() {
 	}
 }
 
-func (x *SendFileResponse) String() string {
+func (x *RemovePostDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFileResponse) ProtoMessage() {}
+func (*RemovePostDraftResponse) ProtoMessage() {}
 
-func (x *SendFileResponse) ProtoReflect() protoreflect.Message {
+func (x *RemovePostDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2228,25 +2295,23 @@ func (x *SendFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFileResponse.ProtoReflect.Descriptor instead.
-func (*SendFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePostDraftResponse.ProtoReflect.Descriptor instead.
+func (*RemovePostDraftResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{38}
 }
 
-// UserNickRequest is the request to fetch a user's nick.
-type UserNickRequest struct {
+// PublishPostDraftRequest is a request to publish a post draft.
+type PublishPostDraftRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uid is the uid in raw bytes format.
-	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	// hex_uid is the uid in hex format.
-	HexUid string `protobuf:"bytes,2,opt,name=hex_uid,json=hexUid,proto3" json:"hex_uid,omitempty"`
+	// id is the id of the draft.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UserNickRequest) Reset() {
-	*x = UserNickRequest{}
+func (x *PublishPostDraftRequest) Reset() {
+	*x = PublishPostDraftRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2254,13 +2319,13 @@ func (x *UserNickRequest) Reset() {
 	}
 }
 
-func (x *UserNickRequest) String() string {
+func (x *PublishPostDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNickRequest) ProtoMessage() {}
+func (*PublishPostDraftRequest) ProtoMessage() {}
 
-func (x *UserNickRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishPostDraftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2272,37 +2337,30 @@ func (x *UserNickRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNickRequest.ProtoReflect.Descriptor instead.
-func (*UserNickRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishPostDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostDraftRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UserNickRequest) GetUid() []byte {
+func (x *PublishPostDraftRequest) GetId() []byte {
 	if x != nil {
-		return x.Uid
+		return x.Id
 	}
 	return nil
 }
 
-func (x *UserNickRequest) GetHexUid() string {
-	if x != nil {
-		return x.HexUid
-	}
-	return ""
-}
-
-// UserNickResponse is the response to fetch a user's nick.
-type UserNickResponse struct {
+// PublishPostDraftResponse is the response to a publish post draft request.
+type PublishPostDraftResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// nick is the nick of the user.
-	Nick string `protobuf:"bytes,1,opt,name=nick,proto3" json:"nick,omitempty"`
+	// summary is the summary information about the new post.
+	Summary *PostSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
 }
 
-func (x *UserNickResponse) Reset() {
-	*x = UserNickResponse{}
+func (x *PublishPostDraftResponse) Reset() {
+	*x = PublishPostDraftResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2310,13 +2368,13 @@ func (x *UserNickResponse) Reset() {
 	}
 }
 
-func (x *UserNickResponse) String() string {
+func (x *PublishPostDraftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNickResponse) ProtoMessage() {}
+func (*PublishPostDraftResponse) ProtoMessage() {}
 
-func (x *UserNickResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishPostDraftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2328,34 +2386,34 @@ func (x *UserNickResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNickResponse.ProtoReflect.Descriptor instead.
-func (*UserNickResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishPostDraftResponse.ProtoReflect.Descriptor instead.
+func (*PublishPostDraftResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UserNickResponse) GetNick() string {
+func (x *PublishPostDraftResponse) GetSummary() *PostSummary {
 	if x != nil {
-		return x.Nick
+		return x.Summary
 	}
-	return ""
+	return nil
 }
 
-// KickFromGCRequest is the request to kick an user from a GC.
-type KickFromGCRequest struct {
+// TipUserRequest is a request to tip a remote user.
+type TipUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// gc is the hex-encoded ID or alias of the target GC.
-	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
-	// user is the hex-encoded ID or nick of the target user.
-	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	// reason is an optional reason to send for the kick.
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// user is the remote user nick or hex-encoded ID.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// dcr_amount is the DCR amount to send as tip.
+	DcrAmount float64 `protobuf:"fixed64,2,opt,name=dcr_amount,json=dcrAmount,proto3" json:"dcr_amount,omitempty"`
+	// max_attempts is the number of max attempts to try to send the tip.
+	MaxAttempts int32 `protobuf:"varint,3,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
 }
 
-func (x *KickFromGCRequest) Reset() {
-	*x = KickFromGCRequest{}
+func (x *TipUserRequest) Reset() {
+	*x = TipUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2363,13 +2421,13 @@ func (x *KickFromGCRequest) Reset() {
 	}
 }
 
-func (x *KickFromGCRequest) String() string {
+func (x *TipUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickFromGCRequest) ProtoMessage() {}
+func (*TipUserRequest) ProtoMessage() {}
 
-func (x *KickFromGCRequest) ProtoReflect() protoreflect.Message {
+func (x *TipUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2381,41 +2439,41 @@ func (x *KickFromGCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickFromGCRequest.ProtoReflect.Descriptor instead.
-func (*KickFromGCRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipUserRequest.ProtoReflect.Descriptor instead.
+func (*TipUserRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *KickFromGCRequest) GetGc() string {
+func (x *TipUserRequest) GetUser() string {
 	if x != nil {
-		return x.Gc
+		return x.User
 	}
 	return ""
 }
 
-func (x *KickFromGCRequest) GetUser() string {
+func (x *TipUserRequest) GetDcrAmount() float64 {
 	if x != nil {
-		return x.User
+		return x.DcrAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *KickFromGCRequest) GetReason() string {
+func (x *TipUserRequest) GetMaxAttempts() int32 {
 	if x != nil {
-		return x.Reason
+		return x.MaxAttempts
 	}
-	return ""
+	return 0
 }
 
-// KickFromGCResponse is the response to a kick request.
-type KickFromGCResponse struct {
+// TipUserResponse is the response to a tip user request.
+type TipUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *KickFromGCResponse) Reset() {
-	*x = KickFromGCResponse{}
+func (x *TipUserResponse) Reset() {
+	*x = TipUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2423,13 +2481,13 @@ func (x *KickFromGCResponse) Reset() {
 	}
 }
 
-func (x *KickFromGCResponse) String() string {
+func (x *TipUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickFromGCResponse) ProtoMessage() {}
+func (*TipUserResponse) ProtoMessage() {}
 
-func (x *KickFromGCResponse) ProtoReflect() protoreflect.Message {
+func (x *TipUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2441,23 +2499,27 @@ func (x *KickFromGCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickFromGCResponse.ProtoReflect.Descriptor instead.
-func (*KickFromGCResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipUserResponse.ProtoReflect.Descriptor instead.
+func (*TipUserResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{42}
 }
 
-// GetGCRequest is the request to get GC datails.
-type GetGCRequest struct {
+// MediateKXRequest is the request to perform a transitive KX with a given
+// user.
+type MediateKXRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// gc is the hex-encoded ID or alias of the target GC.
-	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// mediator is the nick or hex ID of the mediator user (which must already be
+	// KX'd with).
+	Mediator string `protobuf:"bytes,1,opt,name=mediator,proto3" json:"mediator,omitempty"`
+	// target is the hex ID of the target user to KX with.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *GetGCRequest) Reset() {
-	*x = GetGCRequest{}
+func (x *MediateKXRequest) Reset() {
+	*x = MediateKXRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2465,13 +2527,13 @@ func (x *GetGCRequest) Reset() {
 	}
 }
 
-func (x *GetGCRequest) String() string {
+func (x *MediateKXRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGCRequest) ProtoMessage() {}
+func (*MediateKXRequest) ProtoMessage() {}
 
-func (x *GetGCRequest) ProtoReflect() protoreflect.Message {
+func (x *MediateKXRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2483,30 +2545,34 @@ func (x *GetGCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGCRequest.ProtoReflect.Descriptor instead.
-func (*GetGCRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MediateKXRequest.ProtoReflect.Descriptor instead.
+func (*MediateKXRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetGCRequest) GetGc() string {
+func (x *MediateKXRequest) GetMediator() string {
 	if x != nil {
-		return x.Gc
+		return x.Mediator
 	}
 	return ""
 }
 
-// GetGCResponse is the response to a request to get GC details.
-type GetGCResponse struct {
+func (x *MediateKXRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// MediateKXResponse is the response to a mediate KX request.
+type MediateKXResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// gc is the gc definition.
-	Gc *RMGroupList `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
 }
 
-func (x *GetGCResponse) Reset() {
-	*x = GetGCResponse{}
+func (x *MediateKXResponse) Reset() {
+	*x = MediateKXResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2514,13 +2580,13 @@ func (x *GetGCResponse) Reset() {
 	}
 }
 
-func (x *GetGCResponse) String() string {
+func (x *MediateKXResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGCResponse) ProtoMessage() {}
+func (*MediateKXResponse) ProtoMessage() {}
 
-func (x *GetGCResponse) ProtoReflect() protoreflect.Message {
+func (x *MediateKXResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2532,27 +2598,25 @@ func (x *GetGCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGCResponse.ProtoReflect.Descriptor instead.
-func (*GetGCResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MediateKXResponse.ProtoReflect.Descriptor instead.
+func (*MediateKXResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetGCResponse) GetGc() *RMGroupList {
-	if x != nil {
-		return x.Gc
-	}
-	return nil
-}
-
-// ListGCsRequest is the request to list GC data.
-type ListGCsRequest struct {
+// KXStreamRequest is the request sent when obtaining a stream of KX notifications.
+type KXStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last completed
+	// KX. KXs completed by the server that have a higher sequence_id will be
+	// streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *ListGCsRequest) Reset() {
-	*x = ListGCsRequest{}
+func (x *KXStreamRequest) Reset() {
+	*x = KXStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2560,13 +2624,13 @@ func (x *ListGCsRequest) Reset() {
 	}
 }
 
-func (x *ListGCsRequest) String() string {
+func (x *KXStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGCsRequest) ProtoMessage() {}
+func (*KXStreamRequest) ProtoMessage() {}
 
-func (x *ListGCsRequest) ProtoReflect() protoreflect.Message {
+func (x *KXStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2578,23 +2642,36 @@ func (x *ListGCsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGCsRequest.ProtoReflect.Descriptor instead.
-func (*ListGCsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KXStreamRequest.ProtoReflect.Descriptor instead.
+func (*KXStreamRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{45}
 }
 
-// ListGCsResponse is the response to a request to list GC data.
-type ListGCsResponse struct {
+func (x *KXStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// KXCompleted is the information about a completed KX event.
+type KXCompleted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// gcs is the list of GCs for the local client.
-	Gcs []*ListGCsResponse_GCInfo `protobuf:"bytes,1,rep,name=gcs,proto3" json:"gcs,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the raw ID of the KX'd user.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the KX'd user.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// initial_rendezvous is the initial random RV the key exchange happened.
+	InitialRendezvous []byte `protobuf:"bytes,4,opt,name=initial_rendezvous,json=initialRendezvous,proto3" json:"initial_rendezvous,omitempty"`
 }
 
-func (x *ListGCsResponse) Reset() {
-	*x = ListGCsResponse{}
+func (x *KXCompleted) Reset() {
+	*x = KXCompleted{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2602,13 +2679,13 @@ func (x *ListGCsResponse) Reset() {
 	}
 }
 
-func (x *ListGCsResponse) String() string {
+func (x *KXCompleted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGCsResponse) ProtoMessage() {}
+func (*KXCompleted) ProtoMessage() {}
 
-func (x *ListGCsResponse) ProtoReflect() protoreflect.Message {
+func (x *KXCompleted) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2620,32 +2697,54 @@ func (x *ListGCsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGCsResponse.ProtoReflect.Descriptor instead.
-func (*ListGCsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KXCompleted.ProtoReflect.Descriptor instead.
+func (*KXCompleted) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListGCsResponse) GetGcs() []*ListGCsResponse_GCInfo {
+func (x *KXCompleted) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Gcs
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *KXCompleted) GetUid() []byte {
+	if x != nil {
+		return x.Uid
 	}
 	return nil
 }
 
-// ReceivedGCInvitesRequest is the request to start receiving GC invite events.
-type ReceivedGCInvitesRequest struct {
+func (x *KXCompleted) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *KXCompleted) GetInitialRendezvous() []byte {
+	if x != nil {
+		return x.InitialRendezvous
+	}
+	return nil
+}
+
+// WriteNewInviteRequest is the request to add a new invite.
+type WriteNewInviteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last received
-	// GC invite. Invites received by the server that have a higher sequence_id
-	// will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// gc is the hex-encoded ID or the alias of the GC in the local client.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// fund_amount is how much to send as funds in the invite. If zero, no funds
+	// are included. Amount is in atoms.
+	FundAmount uint64 `protobuf:"varint,2,opt,name=fund_amount,json=fundAmount,proto3" json:"fund_amount,omitempty"`
 }
 
-func (x *ReceivedGCInvitesRequest) Reset() {
-	*x = ReceivedGCInvitesRequest{}
+func (x *WriteNewInviteRequest) Reset() {
+	*x = WriteNewInviteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2653,13 +2752,13 @@ func (x *ReceivedGCInvitesRequest) Reset() {
 	}
 }
 
-func (x *ReceivedGCInvitesRequest) String() string {
+func (x *WriteNewInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceivedGCInvitesRequest) ProtoMessage() {}
+func (*WriteNewInviteRequest) ProtoMessage() {}
 
-func (x *ReceivedGCInvitesRequest) ProtoReflect() protoreflect.Message {
+func (x *WriteNewInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2671,39 +2770,43 @@ func (x *ReceivedGCInvitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceivedGCInvitesRequest.ProtoReflect.Descriptor instead.
-func (*ReceivedGCInvitesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteNewInviteRequest.ProtoReflect.Descriptor instead.
+func (*WriteNewInviteRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ReceivedGCInvitesRequest) GetUnackedFrom() uint64 {
+func (x *WriteNewInviteRequest) GetGc() string {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Gc
+	}
+	return ""
+}
+
+func (x *WriteNewInviteRequest) GetFundAmount() uint64 {
+	if x != nil {
+		return x.FundAmount
 	}
 	return 0
 }
 
-// ReceivedGCInvite is the event sent when an invitation to join a GC is received.
-type ReceivedGCInvite struct {
+// WriteNewInviteResponse is an invite that can be sent (out-of-band) to an
+// user the local client wishes to KX with.
+type WriteNewInviteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// inviter_uid is the UID of the user that sent the invitation.
-	InviterUid []byte `protobuf:"bytes,2,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
-	// inviter_nick is the nick of the user that sent the invitation.
-	InviterNick string `protobuf:"bytes,3,opt,name=inviter_nick,json=inviterNick,proto3" json:"inviter_nick,omitempty"`
-	// invite_id is the unique invite ID that must be spcecified when accepting
-	// the invitation.
-	InviteId uint64 `protobuf:"varint,4,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
-	// invite is the invite information.
-	Invite *RMGroupInvite `protobuf:"bytes,5,opt,name=invite,proto3" json:"invite,omitempty"`
+	// invite_bytes is the raw invite.
+	InviteBytes []byte `protobuf:"bytes,1,opt,name=invite_bytes,json=inviteBytes,proto3" json:"invite_bytes,omitempty"`
+	// invite is the data contained in the raw invite.
+	Invite *OOBPublicIdentityInvite `protobuf:"bytes,2,opt,name=invite,proto3" json:"invite,omitempty"`
+	// invite_key is the pre-paid invite key string that can be used to fetch the
+	// invite from the server.
+	InviteKey string `protobuf:"bytes,3,opt,name=invite_key,json=inviteKey,proto3" json:"invite_key,omitempty"`
 }
 
-func (x *ReceivedGCInvite) Reset() {
-	*x = ReceivedGCInvite{}
+func (x *WriteNewInviteResponse) Reset() {
+	*x = WriteNewInviteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2711,13 +2814,13 @@ func (x *ReceivedGCInvite) Reset() {
 	}
 }
 
-func (x *ReceivedGCInvite) String() string {
+func (x *WriteNewInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceivedGCInvite) ProtoMessage() {}
+func (*WriteNewInviteResponse) ProtoMessage() {}
 
-func (x *ReceivedGCInvite) ProtoReflect() protoreflect.Message {
+func (x *WriteNewInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2729,62 +2832,44 @@ func (x *ReceivedGCInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceivedGCInvite.ProtoReflect.Descriptor instead.
-func (*ReceivedGCInvite) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteNewInviteResponse.ProtoReflect.Descriptor instead.
+func (*WriteNewInviteResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ReceivedGCInvite) GetSequenceId() uint64 {
+func (x *WriteNewInviteResponse) GetInviteBytes() []byte {
 	if x != nil {
-		return x.SequenceId
+		return x.InviteBytes
 	}
-	return 0
+	return nil
 }
 
-func (x *ReceivedGCInvite) GetInviterUid() []byte {
+func (x *WriteNewInviteResponse) GetInvite() *OOBPublicIdentityInvite {
 	if x != nil {
-		return x.InviterUid
+		return x.Invite
 	}
 	return nil
 }
 
-func (x *ReceivedGCInvite) GetInviterNick() string {
+func (x *WriteNewInviteResponse) GetInviteKey() string {
 	if x != nil {
-		return x.InviterNick
+		return x.InviteKey
 	}
 	return ""
 }
 
-func (x *ReceivedGCInvite) GetInviteId() uint64 {
-	if x != nil {
-		return x.InviteId
-	}
-	return 0
-}
-
-func (x *ReceivedGCInvite) GetInvite() *RMGroupInvite {
-	if x != nil {
-		return x.Invite
-	}
-	return nil
-}
-
-// UserAndNick groups users and nicks when used in lists.
-type UserAndNick struct {
+// AcceptInviteRequest is the request to accept an invite to KX with an user.
+type AcceptInviteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uid is the unique user ID.
-	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the local alias or nick of the user.
-	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
-	// known flags whether the local client is KX'd with this user.
-	Known bool `protobuf:"varint,3,opt,name=known,proto3" json:"known,omitempty"`
+	// invite_bytes is the raw invite.
+	InviteBytes []byte `protobuf:"bytes,1,opt,name=invite_bytes,json=inviteBytes,proto3" json:"invite_bytes,omitempty"`
 }
 
-func (x *UserAndNick) Reset() {
-	*x = UserAndNick{}
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2792,13 +2877,13 @@ func (x *UserAndNick) Reset() {
 	}
 }
 
-func (x *UserAndNick) String() string {
+func (x *AcceptInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAndNick) ProtoMessage() {}
+func (*AcceptInviteRequest) ProtoMessage() {}
 
-func (x *UserAndNick) ProtoReflect() protoreflect.Message {
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2810,47 +2895,30 @@ func (x *UserAndNick) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAndNick.ProtoReflect.Descriptor instead.
-func (*UserAndNick) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UserAndNick) GetUid() []byte {
+func (x *AcceptInviteRequest) GetInviteBytes() []byte {
 	if x != nil {
-		return x.Uid
+		return x.InviteBytes
 	}
 	return nil
 }
 
-func (x *UserAndNick) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *UserAndNick) GetKnown() bool {
-	if x != nil {
-		return x.Known
-	}
-	return false
-}
-
-// GCMembersAddedRequest is the request sent to create a stream that receives
-// GC members added events.
-type GCMembersAddedRequest struct {
+// AcceptInviteResponse is the response to accepting an invite to KX with an user.
+type AcceptInviteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last received
-	// GC members added event. Events received by the server that have a higher
-	// sequence_id will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// invite is the decoded invite accepted.
+	Invite *OOBPublicIdentityInvite `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 }
 
-func (x *GCMembersAddedRequest) Reset() {
-	*x = GCMembersAddedRequest{}
+func (x *AcceptInviteResponse) Reset() {
+	*x = AcceptInviteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2858,13 +2926,13 @@ func (x *GCMembersAddedRequest) Reset() {
 	}
 }
 
-func (x *GCMembersAddedRequest) String() string {
+func (x *AcceptInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCMembersAddedRequest) ProtoMessage() {}
+func (*AcceptInviteResponse) ProtoMessage() {}
 
-func (x *GCMembersAddedRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2876,36 +2944,32 @@ func (x *GCMembersAddedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCMembersAddedRequest.ProtoReflect.Descriptor instead.
-func (*GCMembersAddedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GCMembersAddedRequest) GetUnackedFrom() uint64 {
+func (x *AcceptInviteResponse) GetInvite() *OOBPublicIdentityInvite {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Invite
 	}
-	return 0
+	return nil
 }
 
-// GCMembersAddedEvent are events received when a GC has new members.
-type GCMembersAddedEvent struct {
+// InviteToGCRequest is the request to invite an user to a GC.
+type InviteToGCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// gc is the ID of the GC.
-	Gc []byte `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
-	// gc_name is the local alias of the GC.
-	GcName string `protobuf:"bytes,3,opt,name=gc_name,json=gcName,proto3" json:"gc_name,omitempty"`
-	// users is the list of users added to the GC.
-	Users []*UserAndNick `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	// gc is the hex-encoded ID or the alias of the GC in the local client.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// user is the hex-encoded ID of the user or its nick.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *GCMembersAddedEvent) Reset() {
-	*x = GCMembersAddedEvent{}
+func (x *InviteToGCRequest) Reset() {
+	*x = InviteToGCRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2913,13 +2977,13 @@ func (x *GCMembersAddedEvent) Reset() {
 	}
 }
 
-func (x *GCMembersAddedEvent) String() string {
+func (x *InviteToGCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCMembersAddedEvent) ProtoMessage() {}
+func (*InviteToGCRequest) ProtoMessage() {}
 
-func (x *GCMembersAddedEvent) ProtoReflect() protoreflect.Message {
+func (x *InviteToGCRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2931,54 +2995,34 @@ func (x *GCMembersAddedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCMembersAddedEvent.ProtoReflect.Descriptor instead.
-func (*GCMembersAddedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteToGCRequest.ProtoReflect.Descriptor instead.
+func (*InviteToGCRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GCMembersAddedEvent) GetSequenceId() uint64 {
-	if x != nil {
-		return x.SequenceId
-	}
-	return 0
-}
-
-func (x *GCMembersAddedEvent) GetGc() []byte {
+func (x *InviteToGCRequest) GetGc() string {
 	if x != nil {
 		return x.Gc
 	}
-	return nil
-}
-
-func (x *GCMembersAddedEvent) GetGcName() string {
-	if x != nil {
-		return x.GcName
-	}
 	return ""
 }
 
-func (x *GCMembersAddedEvent) GetUsers() []*UserAndNick {
+func (x *InviteToGCRequest) GetUser() string {
 	if x != nil {
-		return x.Users
+		return x.User
 	}
-	return nil
+	return ""
 }
 
-// GCMembersRemovedRequest is the request to create a stream to receive GC
-// members removed events.
-type GCMembersRemovedRequest struct {
+// InviteToGCResponse is the response to the request to invite an user to a GC.
+type InviteToGCResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// unacked_from specifies to the server the sequence_id of the last received
-	// GC members removed event. Events received by the server that have a higher
-	// sequence_id will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *GCMembersRemovedRequest) Reset() {
-	*x = GCMembersRemovedRequest{}
+func (x *InviteToGCResponse) Reset() {
+	*x = InviteToGCResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2986,13 +3030,13 @@ func (x *GCMembersRemovedRequest) Reset() {
 	}
 }
 
-func (x *GCMembersRemovedRequest) String() string {
+func (x *InviteToGCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCMembersRemovedRequest) ProtoMessage() {}
+func (*InviteToGCResponse) ProtoMessage() {}
 
-func (x *GCMembersRemovedRequest) ProtoReflect() protoreflect.Message {
+func (x *InviteToGCResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3004,36 +3048,23 @@ func (x *GCMembersRemovedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCMembersRemovedRequest.ProtoReflect.Descriptor instead.
-func (*GCMembersRemovedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteToGCResponse.ProtoReflect.Descriptor instead.
+func (*InviteToGCResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GCMembersRemovedRequest) GetUnackedFrom() uint64 {
-	if x != nil {
-		return x.UnackedFrom
-	}
-	return 0
-}
-
-// GCMembersRemovedEvent is an event received when members are removed from a GC.
-type GCMembersRemovedEvent struct {
+// AcceptGCInviteRequest is the request to accept an invite to join a GC.
+type AcceptGCInviteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// gc is the ID of the GC.
-	Gc []byte `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
-	// gc_name is the local alias of the GC.
-	GcName string `protobuf:"bytes,3,opt,name=gc_name,json=gcName,proto3" json:"gc_name,omitempty"`
-	// users is the list of users removed from the GC.
-	Users []*UserAndNick `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	// invite_id is the id to use to join the invite.
+	InviteId uint64 `protobuf:"varint,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
 }
 
-func (x *GCMembersRemovedEvent) Reset() {
-	*x = GCMembersRemovedEvent{}
+func (x *AcceptGCInviteRequest) Reset() {
+	*x = AcceptGCInviteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3041,13 +3072,13 @@ func (x *GCMembersRemovedEvent) Reset() {
 	}
 }
 
-func (x *GCMembersRemovedEvent) String() string {
+func (x *AcceptGCInviteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCMembersRemovedEvent) ProtoMessage() {}
+func (*AcceptGCInviteRequest) ProtoMessage() {}
 
-func (x *GCMembersRemovedEvent) ProtoReflect() protoreflect.Message {
+func (x *AcceptGCInviteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3059,54 +3090,27 @@ func (x *GCMembersRemovedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCMembersRemovedEvent.ProtoReflect.Descriptor instead.
-func (*GCMembersRemovedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptGCInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptGCInviteRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GCMembersRemovedEvent) GetSequenceId() uint64 {
+func (x *AcceptGCInviteRequest) GetInviteId() uint64 {
 	if x != nil {
-		return x.SequenceId
+		return x.InviteId
 	}
 	return 0
 }
 
-func (x *GCMembersRemovedEvent) GetGc() []byte {
-	if x != nil {
-		return x.Gc
-	}
-	return nil
-}
-
-func (x *GCMembersRemovedEvent) GetGcName() string {
-	if x != nil {
-		return x.GcName
-	}
-	return ""
-}
-
-func (x *GCMembersRemovedEvent) GetUsers() []*UserAndNick {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-// JoinedGCsRequest is the request to create a stream that receives events about
-// GCs the local client has joined.
-type JoinedGCsRequest struct {
+// AcceptGCInviteResponse is the response to accept an invite to join a GC.
+type AcceptGCInviteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// unacked_from specifies to the server the sequence_id of the last received
-	// GC joined event. Events received by the server that have a higher
-	// sequence_id will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *JoinedGCsRequest) Reset() {
-	*x = JoinedGCsRequest{}
+func (x *AcceptGCInviteResponse) Reset() {
+	*x = AcceptGCInviteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3114,13 +3118,13 @@ func (x *JoinedGCsRequest) Reset() {
 	}
 }
 
-func (x *JoinedGCsRequest) String() string {
+func (x *AcceptGCInviteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinedGCsRequest) ProtoMessage() {}
+func (*AcceptGCInviteResponse) ProtoMessage() {}
 
-func (x *JoinedGCsRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptGCInviteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3132,32 +3136,25 @@ func (x *JoinedGCsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinedGCsRequest.ProtoReflect.Descriptor instead.
-func (*JoinedGCsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptGCInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptGCInviteResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *JoinedGCsRequest) GetUnackedFrom() uint64 {
-	if x != nil {
-		return x.UnackedFrom
-	}
-	return 0
-}
-
-// JoinedGCEvent is the event received when the local client joins a GC.
-type JoinedGCEvent struct {
+// SendFileRequest is the request to send a file to a user.
+type SendFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// gc is the GC definition.
-	Gc *RMGroupList `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
+	// user is the hex-encoded ID of the user or its nick.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// filename is the absolute path to the file.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 }
 
-func (x *JoinedGCEvent) Reset() {
-	*x = JoinedGCEvent{}
+func (x *SendFileRequest) Reset() {
+	*x = SendFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3165,13 +3162,13 @@ func (x *JoinedGCEvent) Reset() {
 	}
 }
 
-func (x *JoinedGCEvent) String() string {
+func (x *SendFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinedGCEvent) ProtoMessage() {}
+func (*SendFileRequest) ProtoMessage() {}
 
-func (x *JoinedGCEvent) ProtoReflect() protoreflect.Message {
+func (x *SendFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3183,40 +3180,34 @@ func (x *JoinedGCEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinedGCEvent.ProtoReflect.Descriptor instead.
-func (*JoinedGCEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendFileRequest.ProtoReflect.Descriptor instead.
+func (*SendFileRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *JoinedGCEvent) GetSequenceId() uint64 {
+func (x *SendFileRequest) GetUser() string {
 	if x != nil {
-		return x.SequenceId
+		return x.User
 	}
-	return 0
+	return ""
 }
 
-func (x *JoinedGCEvent) GetGc() *RMGroupList {
+func (x *SendFileRequest) GetFilename() string {
 	if x != nil {
-		return x.Gc
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-// TipProgressRequest is the request to create a stream that receives events
-// about the progress of TipUser requests.
-type TipProgressRequest struct {
+// SendFileResponse is the response to sending a file to a user.
+type SendFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// unacked_from specifies to the server the sequence_id of the last received
-	// tip progress event. Events received by the server that have a higher
-	// sequence_id will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *TipProgressRequest) Reset() {
-	*x = TipProgressRequest{}
+func (x *SendFileResponse) Reset() {
+	*x = SendFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,13 +3215,13 @@ func (x *TipProgressRequest) Reset() {
 	}
 }
 
-func (x *TipProgressRequest) String() string {
+func (x *SendFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipProgressRequest) ProtoMessage() {}
+func (*SendFileResponse) ProtoMessage() {}
 
-func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
+func (x *SendFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3242,47 +3233,25 @@ func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipProgressRequest.ProtoReflect.Descriptor instead.
-func (*TipProgressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendFileResponse.ProtoReflect.Descriptor instead.
+func (*SendFileResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TipProgressRequest) GetUnackedFrom() uint64 {
-	if x != nil {
-		return x.UnackedFrom
-	}
-	return 0
-}
-
-// TipProgressEvent details the progress of a TipUser request.
-type TipProgressEvent struct {
+// UserNickRequest is the request to fetch a user's nick.
+type UserNickRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// uid is the User ID of the TipUser target.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the TipUser target.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// amount_matoms is the amount being tipped in milli-atoms.
-	AmountMatoms int64 `protobuf:"varint,4,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
-	// completed flags whether the TipUser request was successfully completed
-	// (i.e. if the tip was paid).
-	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	// attempt is the attempt number.
-	Attempt int32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	// attempt_err is filled when the attempt to fetch an invoice or perform
-	// the payment for a received invoice failed.
-	AttemptErr string `protobuf:"bytes,7,opt,name=attempt_err,json=attemptErr,proto3" json:"attempt_err,omitempty"`
-	// will_retry flags whether a new attempt to request an invoice and perform
-	// a payment will be done or if no more attempts will happen.
-	WillRetry bool `protobuf:"varint,8,opt,name=will_retry,json=willRetry,proto3" json:"will_retry,omitempty"`
+	// uid is the uid in raw bytes format.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// hex_uid is the uid in hex format.
+	HexUid string `protobuf:"bytes,2,opt,name=hex_uid,json=hexUid,proto3" json:"hex_uid,omitempty"`
 }
 
-func (x *TipProgressEvent) Reset() {
-	*x = TipProgressEvent{}
+func (x *UserNickRequest) Reset() {
+	*x = UserNickRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3290,13 +3259,13 @@ func (x *TipProgressEvent) Reset() {
 	}
 }
 
-func (x *TipProgressEvent) String() string {
+func (x *UserNickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipProgressEvent) ProtoMessage() {}
+func (*UserNickRequest) ProtoMessage() {}
 
-func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
+func (x *UserNickRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3308,77 +3277,37 @@ func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipProgressEvent.ProtoReflect.Descriptor instead.
-func (*TipProgressEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserNickRequest.ProtoReflect.Descriptor instead.
+func (*UserNickRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *TipProgressEvent) GetSequenceId() uint64 {
-	if x != nil {
-		return x.SequenceId
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetUid() []byte {
+func (x *UserNickRequest) GetUid() []byte {
 	if x != nil {
 		return x.Uid
 	}
 	return nil
 }
 
-func (x *TipProgressEvent) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *TipProgressEvent) GetAmountMatoms() int64 {
-	if x != nil {
-		return x.AmountMatoms
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetCompleted() bool {
-	if x != nil {
-		return x.Completed
-	}
-	return false
-}
-
-func (x *TipProgressEvent) GetAttempt() int32 {
-	if x != nil {
-		return x.Attempt
-	}
-	return 0
-}
-
-func (x *TipProgressEvent) GetAttemptErr() string {
+func (x *UserNickRequest) GetHexUid() string {
 	if x != nil {
-		return x.AttemptErr
+		return x.HexUid
 	}
 	return ""
 }
 
-func (x *TipProgressEvent) GetWillRetry() bool {
-	if x != nil {
-		return x.WillRetry
-	}
-	return false
-}
-
-// ResourceRequestsStreamRequest is the request for a stream to receive resource
-// requests.
-type ResourceRequestsStreamRequest struct {
+// UserNickResponse is the response to fetch a user's nick.
+type UserNickResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// nick is the nick of the user.
+	Nick string `protobuf:"bytes,1,opt,name=nick,proto3" json:"nick,omitempty"`
 }
 
-func (x *ResourceRequestsStreamRequest) Reset() {
-	*x = ResourceRequestsStreamRequest{}
+func (x *UserNickResponse) Reset() {
+	*x = UserNickResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3386,13 +3315,13 @@ func (x *ResourceRequestsStreamRequest) Reset() {
 	}
 }
 
-func (x *ResourceRequestsStreamRequest) String() string {
+func (x *UserNickResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceRequestsStreamRequest) ProtoMessage() {}
+func (*UserNickResponse) ProtoMessage() {}
 
-func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *UserNickResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3404,31 +3333,34 @@ func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceRequestsStreamRequest.ProtoReflect.Descriptor instead.
-func (*ResourceRequestsStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserNickResponse.ProtoReflect.Descriptor instead.
+func (*UserNickResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{58}
 }
 
-// ResourceRequestsStreamResponse is the a request made by a remote client for
-// one of the local client's resources.
-type ResourceRequestsStreamResponse struct {
+func (x *UserNickResponse) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+// KickFromGCRequest is the request to kick an user from a GC.
+type KickFromGCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is an opaque ID to link requests and responses made through the clientrpc
-	// interface.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// uid is the User ID of the client that requested the resource.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the client that requested the resource.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// request is the actual request made by the remote client.
-	Request *RMFetchResource `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	// gc is the hex-encoded ID or alias of the target GC.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// user is the hex-encoded ID or nick of the target user.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// reason is an optional reason to send for the kick.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ResourceRequestsStreamResponse) Reset() {
-	*x = ResourceRequestsStreamResponse{}
+func (x *KickFromGCRequest) Reset() {
+	*x = KickFromGCRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3436,13 +3368,13 @@ func (x *ResourceRequestsStreamResponse) Reset() {
 	}
 }
 
-func (x *ResourceRequestsStreamResponse) String() string {
+func (x *KickFromGCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceRequestsStreamResponse) ProtoMessage() {}
+func (*KickFromGCRequest) ProtoMessage() {}
 
-func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *KickFromGCRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3454,55 +3386,41 @@ func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceRequestsStreamResponse.ProtoReflect.Descriptor instead.
-func (*ResourceRequestsStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KickFromGCRequest.ProtoReflect.Descriptor instead.
+func (*KickFromGCRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ResourceRequestsStreamResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ResourceRequestsStreamResponse) GetUid() []byte {
+func (x *KickFromGCRequest) GetGc() string {
 	if x != nil {
-		return x.Uid
+		return x.Gc
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceRequestsStreamResponse) GetNick() string {
+func (x *KickFromGCRequest) GetUser() string {
 	if x != nil {
-		return x.Nick
+		return x.User
 	}
 	return ""
 }
 
-func (x *ResourceRequestsStreamResponse) GetRequest() *RMFetchResource {
+func (x *KickFromGCRequest) GetReason() string {
 	if x != nil {
-		return x.Request
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-// FulfillResourceRequest fulfills a remote request for a local client resource.
-type FulfillResourceRequest struct {
+// KickFromGCResponse is the response to a kick request.
+type KickFromGCResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// id must be the corresponding request received by the clientrpc client.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// error_msg is an optional error message to fail the request with.
-	ErrorMsg string `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
-	// response is the actual resource that should be sent to the remote client.
-	Response *RMFetchResourceReply `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-}
-
-func (x *FulfillResourceRequest) Reset() {
-	*x = FulfillResourceRequest{}
+func (x *KickFromGCResponse) Reset() {
+	*x = KickFromGCResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3510,13 +3428,13 @@ func (x *FulfillResourceRequest) Reset() {
 	}
 }
 
-func (x *FulfillResourceRequest) String() string {
+func (x *KickFromGCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FulfillResourceRequest) ProtoMessage() {}
+func (*KickFromGCResponse) ProtoMessage() {}
 
-func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
+func (x *KickFromGCResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3528,42 +3446,23 @@ func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FulfillResourceRequest.ProtoReflect.Descriptor instead.
-func (*FulfillResourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KickFromGCResponse.ProtoReflect.Descriptor instead.
+func (*KickFromGCResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *FulfillResourceRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *FulfillResourceRequest) GetErrorMsg() string {
-	if x != nil {
-		return x.ErrorMsg
-	}
-	return ""
-}
-
-func (x *FulfillResourceRequest) GetResponse() *RMFetchResourceReply {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-// FulfillResourceRequestResponse is the response to a FulfillResourceResquest
-// call.
-type FulfillResourceRequestResponse struct {
+// GetGCRequest is the request to get GC datails.
+type GetGCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// gc is the hex-encoded ID or alias of the target GC.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
 }
 
-func (x *FulfillResourceRequestResponse) Reset() {
-	*x = FulfillResourceRequestResponse{}
+func (x *GetGCRequest) Reset() {
+	*x = GetGCRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,13 +3470,13 @@ func (x *FulfillResourceRequestResponse) Reset() {
 	}
 }
 
-func (x *FulfillResourceRequestResponse) String() string {
+func (x *GetGCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FulfillResourceRequestResponse) ProtoMessage() {}
+func (*GetGCRequest) ProtoMessage() {}
 
-func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
+func (x *GetGCRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3589,26 +3488,30 @@ func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FulfillResourceRequestResponse.ProtoReflect.Descriptor instead.
-func (*FulfillResourceRequestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGCRequest.ProtoReflect.Descriptor instead.
+func (*GetGCRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{61}
 }
 
-// DownloadsCompletedRequest is the request sent when obtaining a stream of
-// completed downloads notifications.
-type DownloadsCompletedStreamRequest struct {
+func (x *GetGCRequest) GetGc() string {
+	if x != nil {
+		return x.Gc
+	}
+	return ""
+}
+
+// GetGCResponse is the response to a request to get GC details.
+type GetGCResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last completed
-	// download. Downloads completed by the server that have a higher sequence_id
-	// will be streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// gc is the gc definition.
+	Gc *RMGroupList `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
 }
 
-func (x *DownloadsCompletedStreamRequest) Reset() {
-	*x = DownloadsCompletedStreamRequest{}
+func (x *GetGCResponse) Reset() {
+	*x = GetGCResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3616,13 +3519,13 @@ func (x *DownloadsCompletedStreamRequest) Reset() {
 	}
 }
 
-func (x *DownloadsCompletedStreamRequest) String() string {
+func (x *GetGCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadsCompletedStreamRequest) ProtoMessage() {}
+func (*GetGCResponse) ProtoMessage() {}
 
-func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGCResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3634,38 +3537,27 @@ func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadsCompletedStreamRequest.ProtoReflect.Descriptor instead.
-func (*DownloadsCompletedStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGCResponse.ProtoReflect.Descriptor instead.
+func (*GetGCResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DownloadsCompletedStreamRequest) GetUnackedFrom() uint64 {
+func (x *GetGCResponse) GetGc() *RMGroupList {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Gc
 	}
-	return 0
+	return nil
 }
 
-// DownloadCompletedResponse is the data about one completed file download.
-type DownloadCompletedResponse struct {
+// ListGCsRequest is the request to list GC data.
+type ListGCsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
-	// uid is the User ID of the client that sent the file.
-	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	// nick is the nick of the client that sent the resource.
-	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
-	// disk_path is the path of the file in the local client's disk.
-	DiskPath string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
-	// file_metadata is the metadata about the file.
-	FileMetadata *FileMetadata `protobuf:"bytes,5,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
 }
 
-func (x *DownloadCompletedResponse) Reset() {
-	*x = DownloadCompletedResponse{}
+func (x *ListGCsRequest) Reset() {
+	*x = ListGCsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3673,13 +3565,13 @@ func (x *DownloadCompletedResponse) Reset() {
 	}
 }
 
-func (x *DownloadCompletedResponse) String() string {
+func (x *ListGCsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadCompletedResponse) ProtoMessage() {}
+func (*ListGCsRequest) ProtoMessage() {}
 
-func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
+func (x *ListGCsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3691,60 +3583,23 @@ func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadCompletedResponse.ProtoReflect.Descriptor instead.
-func (*DownloadCompletedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGCsRequest.ProtoReflect.Descriptor instead.
+func (*ListGCsRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DownloadCompletedResponse) GetSequenceId() uint64 {
-	if x != nil {
-		return x.SequenceId
-	}
-	return 0
-}
-
-func (x *DownloadCompletedResponse) GetUid() []byte {
-	if x != nil {
-		return x.Uid
-	}
-	return nil
-}
-
-func (x *DownloadCompletedResponse) GetNick() string {
-	if x != nil {
-		return x.Nick
-	}
-	return ""
-}
-
-func (x *DownloadCompletedResponse) GetDiskPath() string {
-	if x != nil {
-		return x.DiskPath
-	}
-	return ""
-}
-
-func (x *DownloadCompletedResponse) GetFileMetadata() *FileMetadata {
-	if x != nil {
-		return x.FileMetadata
-	}
-	return nil
-}
-
-// RMPrivateMessage is the network-level routed private message.
-type RMPrivateMessage struct {
+// ListGCsResponse is the response to a request to list GC data.
+type ListGCsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// message is the private message payload.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// mode is the message mode.
-	Mode MessageMode `protobuf:"varint,2,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+	// gcs is the list of GCs for the local client.
+	Gcs []*ListGCsResponse_GCInfo `protobuf:"bytes,1,rep,name=gcs,proto3" json:"gcs,omitempty"`
 }
 
-func (x *RMPrivateMessage) Reset() {
-	*x = RMPrivateMessage{}
+func (x *ListGCsResponse) Reset() {
+	*x = ListGCsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3752,13 +3607,13 @@ func (x *RMPrivateMessage) Reset() {
 	}
 }
 
-func (x *RMPrivateMessage) String() string {
+func (x *ListGCsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMPrivateMessage) ProtoMessage() {}
+func (*ListGCsResponse) ProtoMessage() {}
 
-func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
+func (x *ListGCsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3770,44 +3625,32 @@ func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMPrivateMessage.ProtoReflect.Descriptor instead.
-func (*RMPrivateMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGCsResponse.ProtoReflect.Descriptor instead.
+func (*ListGCsResponse) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *RMPrivateMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *RMPrivateMessage) GetMode() MessageMode {
+func (x *ListGCsResponse) GetGcs() []*ListGCsResponse_GCInfo {
 	if x != nil {
-		return x.Mode
+		return x.Gcs
 	}
-	return MessageMode_MESSAGE_MODE_NORMAL
+	return nil
 }
 
-// RMGroupMessage is the network-level routed group message.
-type RMGroupMessage struct {
+// ReceivedGCInvitesRequest is the request to start receiving GC invite events.
+type ReceivedGCInvitesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the group chat id where the message was sent.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// generation is the internal generation of the group chat metadata when the
-	// sender sent this message.
-	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
-	// message is the textual content.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// mode is the mode of the message.
-	Mode MessageMode `protobuf:"varint,4,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+	// unacked_from specifies to the server the sequence_id of the last received
+	// GC invite. Invites received by the server that have a higher sequence_id
+	// will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *RMGroupMessage) Reset() {
-	*x = RMGroupMessage{}
+func (x *ReceivedGCInvitesRequest) Reset() {
+	*x = ReceivedGCInvitesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3815,13 +3658,13 @@ func (x *RMGroupMessage) Reset() {
 	}
 }
 
-func (x *RMGroupMessage) String() string {
+func (x *ReceivedGCInvitesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupMessage) ProtoMessage() {}
+func (*ReceivedGCInvitesRequest) ProtoMessage() {}
 
-func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
+func (x *ReceivedGCInvitesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3833,53 +3676,39 @@ func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupMessage.ProtoReflect.Descriptor instead.
-func (*RMGroupMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReceivedGCInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ReceivedGCInvitesRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RMGroupMessage) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *RMGroupMessage) GetGeneration() uint64 {
+func (x *ReceivedGCInvitesRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Generation
+		return x.UnackedFrom
 	}
 	return 0
 }
 
-func (x *RMGroupMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
+// ReceivedGCInvite is the event sent when an invitation to join a GC is received.
+type ReceivedGCInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *RMGroupMessage) GetMode() MessageMode {
-	if x != nil {
-		return x.Mode
-	}
-	return MessageMode_MESSAGE_MODE_NORMAL
-}
-
-// PostMetadata is the network-level post data.
-type PostMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// version defines the available fields within attributes.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// attributes defines the available post attributes.
-	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// inviter_uid is the UID of the user that sent the invitation.
+	InviterUid []byte `protobuf:"bytes,2,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	// inviter_nick is the nick of the user that sent the invitation.
+	InviterNick string `protobuf:"bytes,3,opt,name=inviter_nick,json=inviterNick,proto3" json:"inviter_nick,omitempty"`
+	// invite_id is the unique invite ID that must be spcecified when accepting
+	// the invitation.
+	InviteId uint64 `protobuf:"varint,4,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	// invite is the invite information.
+	Invite *RMGroupInvite `protobuf:"bytes,5,opt,name=invite,proto3" json:"invite,omitempty"`
 }
 
-func (x *PostMetadata) Reset() {
-	*x = PostMetadata{}
+func (x *ReceivedGCInvite) Reset() {
+	*x = ReceivedGCInvite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3887,13 +3716,13 @@ func (x *PostMetadata) Reset() {
 	}
 }
 
-func (x *PostMetadata) String() string {
+func (x *ReceivedGCInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostMetadata) ProtoMessage() {}
+func (*ReceivedGCInvite) ProtoMessage() {}
 
-func (x *PostMetadata) ProtoReflect() protoreflect.Message {
+func (x *ReceivedGCInvite) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3905,43 +3734,62 @@ func (x *PostMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostMetadata.ProtoReflect.Descriptor instead.
-func (*PostMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReceivedGCInvite.ProtoReflect.Descriptor instead.
+func (*ReceivedGCInvite) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *PostMetadata) GetVersion() uint64 {
+func (x *ReceivedGCInvite) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Version
+		return x.SequenceId
 	}
 	return 0
 }
 
-func (x *PostMetadata) GetAttributes() map[string]string {
+func (x *ReceivedGCInvite) GetInviterUid() []byte {
 	if x != nil {
-		return x.Attributes
+		return x.InviterUid
 	}
 	return nil
 }
 
-// PostMetadataStatus is the network-level post status update data.
-type PostMetadataStatus struct {
+func (x *ReceivedGCInvite) GetInviterNick() string {
+	if x != nil {
+		return x.InviterNick
+	}
+	return ""
+}
+
+func (x *ReceivedGCInvite) GetInviteId() uint64 {
+	if x != nil {
+		return x.InviteId
+	}
+	return 0
+}
+
+func (x *ReceivedGCInvite) GetInvite() *RMGroupInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+// UserAndNick groups users and nicks when used in lists.
+type UserAndNick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// version defines the available fields within attributes.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// from is the UID of the original status creator.
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	// link is the ID of the post.
-	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
-	// attributes is the list of post update attributes.
-	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// uid is the unique user ID.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the local alias or nick of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// known flags whether the local client is KX'd with this user.
+	Known bool `protobuf:"varint,3,opt,name=known,proto3" json:"known,omitempty"`
 }
 
-func (x *PostMetadataStatus) Reset() {
-	*x = PostMetadataStatus{}
+func (x *UserAndNick) Reset() {
+	*x = UserAndNick{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3949,13 +3797,13 @@ func (x *PostMetadataStatus) Reset() {
 	}
 }
 
-func (x *PostMetadataStatus) String() string {
+func (x *UserAndNick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostMetadataStatus) ProtoMessage() {}
+func (*UserAndNick) ProtoMessage() {}
 
-func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
+func (x *UserAndNick) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3967,48 +3815,47 @@ func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostMetadataStatus.ProtoReflect.Descriptor instead.
-func (*PostMetadataStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAndNick.ProtoReflect.Descriptor instead.
+func (*UserAndNick) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *PostMetadataStatus) GetVersion() uint64 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *PostMetadataStatus) GetFrom() string {
+func (x *UserAndNick) GetUid() []byte {
 	if x != nil {
-		return x.From
+		return x.Uid
 	}
-	return ""
+	return nil
 }
 
-func (x *PostMetadataStatus) GetLink() string {
+func (x *UserAndNick) GetNick() string {
 	if x != nil {
-		return x.Link
+		return x.Nick
 	}
 	return ""
 }
 
-func (x *PostMetadataStatus) GetAttributes() map[string]string {
+func (x *UserAndNick) GetKnown() bool {
 	if x != nil {
-		return x.Attributes
+		return x.Known
 	}
-	return nil
+	return false
 }
 
-// PublicIdentityReq is a request to get PublicIdentity.
-type PublicIdentityReq struct {
+// GCMembersAddedRequest is the request sent to create a stream that receives
+// GC members added events.
+type GCMembersAddedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last received
+	// GC members added event. Events received by the server that have a higher
+	// sequence_id will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *PublicIdentityReq) Reset() {
-	*x = PublicIdentityReq{}
+func (x *GCMembersAddedRequest) Reset() {
+	*x = GCMembersAddedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4016,13 +3863,13 @@ func (x *PublicIdentityReq) Reset() {
 	}
 }
 
-func (x *PublicIdentityReq) String() string {
+func (x *GCMembersAddedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicIdentityReq) ProtoMessage() {}
+func (*GCMembersAddedRequest) ProtoMessage() {}
 
-func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
+func (x *GCMembersAddedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4034,35 +3881,36 @@ func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicIdentityReq.ProtoReflect.Descriptor instead.
-func (*PublicIdentityReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCMembersAddedRequest.ProtoReflect.Descriptor instead.
+func (*GCMembersAddedRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{68}
 }
 
-// PublicIdentity is the lowlevel public identity.
-type PublicIdentity struct {
+func (x *GCMembersAddedRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// GCMembersAddedEvent are events received when a GC has new members.
+type GCMembersAddedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the user.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// nick is the short name/alias of the user.
-	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
-	// sig_key is the signature key used to authenticate messages from the user.
-	SigKey []byte `protobuf:"bytes,3,opt,name=sig_key,json=sigKey,proto3" json:"sig_key,omitempty"`
-	// key is the NTRU public key of the user.
-	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	// identity is the public ID of the user.
-	Identity []byte `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
-	// digest is a hash of the user's public information.
-	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
-	// signature is a signature of the user's public information.
-	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// gc is the ID of the GC.
+	Gc []byte `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
+	// gc_name is the local alias of the GC.
+	GcName string `protobuf:"bytes,3,opt,name=gc_name,json=gcName,proto3" json:"gc_name,omitempty"`
+	// users is the list of users added to the GC.
+	Users []*UserAndNick `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *PublicIdentity) Reset() {
-	*x = PublicIdentity{}
+func (x *GCMembersAddedEvent) Reset() {
+	*x = GCMembersAddedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4070,13 +3918,13 @@ func (x *PublicIdentity) Reset() {
 	}
 }
 
-func (x *PublicIdentity) String() string {
+func (x *GCMembersAddedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublicIdentity) ProtoMessage() {}
+func (*GCMembersAddedEvent) ProtoMessage() {}
 
-func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
+func (x *GCMembersAddedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4088,97 +3936,68 @@ func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublicIdentity.ProtoReflect.Descriptor instead.
-func (*PublicIdentity) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCMembersAddedEvent.ProtoReflect.Descriptor instead.
+func (*GCMembersAddedEvent) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *PublicIdentity) GetName() string {
+func (x *GCMembersAddedEvent) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.SequenceId
 	}
-	return ""
+	return 0
 }
 
-func (x *PublicIdentity) GetNick() string {
+func (x *GCMembersAddedEvent) GetGc() []byte {
 	if x != nil {
-		return x.Nick
+		return x.Gc
 	}
-	return ""
+	return nil
 }
 
-func (x *PublicIdentity) GetSigKey() []byte {
+func (x *GCMembersAddedEvent) GetGcName() string {
 	if x != nil {
-		return x.SigKey
+		return x.GcName
 	}
-	return nil
+	return ""
 }
 
-func (x *PublicIdentity) GetKey() []byte {
+func (x *GCMembersAddedEvent) GetUsers() []*UserAndNick {
 	if x != nil {
-		return x.Key
+		return x.Users
 	}
 	return nil
 }
 
-func (x *PublicIdentity) GetIdentity() []byte {
-	if x != nil {
-		return x.Identity
-	}
-	return nil
+// GCMembersRemovedRequest is the request to create a stream to receive GC
+// members removed events.
+type GCMembersRemovedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last received
+	// GC members removed event. Events received by the server that have a higher
+	// sequence_id will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *PublicIdentity) GetDigest() []byte {
-	if x != nil {
-		return x.Digest
+func (x *GCMembersRemovedRequest) Reset() {
+	*x = GCMembersRemovedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PublicIdentity) GetSignature() []byte {
-	if x != nil {
-		return x.Signature
-	}
-	return nil
+func (x *GCMembersRemovedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// InviteFunds is the lowlevel information about funds added to an invite.
-type InviteFunds struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (*GCMembersRemovedRequest) ProtoMessage() {}
 
-	// tx is the hash of the transaction that includes the funds.
-	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// index is the output index of the transaction that includes the funds.
-	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	// tree is the tree of the transaction that includes the funds.
-	Tree int32 `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
-	// private_key is the private key needed to redeem the funds in the transaction.
-	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,proto3" json:"private_key,omitempty"`
-	// height_hint is a height at or before the transaction that includes the funds
-	// is mined in.
-	HeightHint uint32 `protobuf:"varint,5,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
-	// address is the public address that the funds were sent to.
-	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
-}
-
-func (x *InviteFunds) Reset() {
-	*x = InviteFunds{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[70]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *InviteFunds) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*InviteFunds) ProtoMessage() {}
-
-func (x *InviteFunds) ProtoReflect() protoreflect.Message {
+func (x *GCMembersRemovedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4190,72 +4009,36 @@ func (x *InviteFunds) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteFunds.ProtoReflect.Descriptor instead.
-func (*InviteFunds) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCMembersRemovedRequest.ProtoReflect.Descriptor instead.
+func (*GCMembersRemovedRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *InviteFunds) GetTx() string {
-	if x != nil {
-		return x.Tx
-	}
-	return ""
-}
-
-func (x *InviteFunds) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *InviteFunds) GetTree() int32 {
-	if x != nil {
-		return x.Tree
-	}
-	return 0
-}
-
-func (x *InviteFunds) GetPrivateKey() string {
-	if x != nil {
-		return x.PrivateKey
-	}
-	return ""
-}
-
-func (x *InviteFunds) GetHeightHint() uint32 {
+func (x *GCMembersRemovedRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.HeightHint
+		return x.UnackedFrom
 	}
 	return 0
 }
 
-func (x *InviteFunds) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-// OOBPublicIdentityInvite is an out-of-band invite to perform a KX.
-type OOBPublicIdentityInvite struct {
+// GCMembersRemovedEvent is an event received when members are removed from a GC.
+type GCMembersRemovedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// public is the public identity of the inviter.
-	Public *PublicIdentity `protobuf:"bytes,1,opt,name=public,proto3" json:"public,omitempty"`
-	// initial_rendezvous is the initial random RV where key exchange will happen.
-	InitialRendezvous []byte `protobuf:"bytes,2,opt,name=initial_rendezvous,json=initialrendezvous,proto3" json:"initial_rendezvous,omitempty"`
-	// reset_rendezvous is the inviter's reset RV in case of ratchet reset actions.
-	ResetRendezvous []byte `protobuf:"bytes,3,opt,name=reset_rendezvous,json=resetrendezvous,proto3" json:"reset_rendezvous,omitempty"`
-	// funds are funds that may be included in the invite and are redeemable by
-	// the invitee.
-	Funds *InviteFunds `protobuf:"bytes,4,opt,name=funds,proto3" json:"funds,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// gc is the ID of the GC.
+	Gc []byte `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
+	// gc_name is the local alias of the GC.
+	GcName string `protobuf:"bytes,3,opt,name=gc_name,json=gcName,proto3" json:"gc_name,omitempty"`
+	// users is the list of users removed from the GC.
+	Users []*UserAndNick `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *OOBPublicIdentityInvite) Reset() {
-	*x = OOBPublicIdentityInvite{}
+func (x *GCMembersRemovedEvent) Reset() {
+	*x = GCMembersRemovedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4263,13 +4046,13 @@ func (x *OOBPublicIdentityInvite) Reset() {
 	}
 }
 
-func (x *OOBPublicIdentityInvite) String() string {
+func (x *GCMembersRemovedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OOBPublicIdentityInvite) ProtoMessage() {}
+func (*GCMembersRemovedEvent) ProtoMessage() {}
 
-func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
+func (x *GCMembersRemovedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4281,61 +4064,54 @@ func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OOBPublicIdentityInvite.ProtoReflect.Descriptor instead.
-func (*OOBPublicIdentityInvite) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCMembersRemovedEvent.ProtoReflect.Descriptor instead.
+func (*GCMembersRemovedEvent) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *OOBPublicIdentityInvite) GetPublic() *PublicIdentity {
+func (x *GCMembersRemovedEvent) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Public
+		return x.SequenceId
 	}
-	return nil
+	return 0
 }
 
-func (x *OOBPublicIdentityInvite) GetInitialRendezvous() []byte {
+func (x *GCMembersRemovedEvent) GetGc() []byte {
 	if x != nil {
-		return x.InitialRendezvous
+		return x.Gc
 	}
 	return nil
 }
 
-func (x *OOBPublicIdentityInvite) GetResetRendezvous() []byte {
+func (x *GCMembersRemovedEvent) GetGcName() string {
 	if x != nil {
-		return x.ResetRendezvous
+		return x.GcName
 	}
-	return nil
+	return ""
 }
 
-func (x *OOBPublicIdentityInvite) GetFunds() *InviteFunds {
+func (x *GCMembersRemovedEvent) GetUsers() []*UserAndNick {
 	if x != nil {
-		return x.Funds
+		return x.Users
 	}
 	return nil
 }
 
-// RMGroupInvite is the information about an invite to join a GC.
-type RMGroupInvite struct {
+// JoinedGCsRequest is the request to create a stream that receives events about
+// GCs the local client has joined.
+type JoinedGCsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the ID of the GC.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// token is a random number that must be sent back by the invitee to the inviter.
-	Token uint64 `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
-	// description is a description of the GC.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// expires is a timestamp for when the invitation expires.
-	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
-	// version is the version of the current definition of the GC.
-	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// unacked_from specifies to the server the sequence_id of the last received
+	// GC joined event. Events received by the server that have a higher
+	// sequence_id will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *RMGroupInvite) Reset() {
-	*x = RMGroupInvite{}
+func (x *JoinedGCsRequest) Reset() {
+	*x = JoinedGCsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4343,13 +4119,13 @@ func (x *RMGroupInvite) Reset() {
 	}
 }
 
-func (x *RMGroupInvite) String() string {
+func (x *JoinedGCsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupInvite) ProtoMessage() {}
+func (*JoinedGCsRequest) ProtoMessage() {}
 
-func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
+func (x *JoinedGCsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4361,77 +4137,32 @@ func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupInvite.ProtoReflect.Descriptor instead.
-func (*RMGroupInvite) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinedGCsRequest.ProtoReflect.Descriptor instead.
+func (*JoinedGCsRequest) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *RMGroupInvite) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *RMGroupInvite) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *RMGroupInvite) GetToken() uint64 {
-	if x != nil {
-		return x.Token
-	}
-	return 0
-}
-
-func (x *RMGroupInvite) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *RMGroupInvite) GetExpires() int64 {
-	if x != nil {
-		return x.Expires
-	}
-	return 0
-}
-
-func (x *RMGroupInvite) GetVersion() uint32 {
+func (x *JoinedGCsRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Version
+		return x.UnackedFrom
 	}
 	return 0
 }
 
-// RMGroupList is the full definition of a GC.
-type RMGroupList struct {
+// JoinedGCEvent is the event received when the local client joins a GC.
+type JoinedGCEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the ID of the GC.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// generation is a monotonically increasing count of GC changes.
-	Generation uint64 `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
-	// timestamp is the timestamp for the last modification of the GC defintions.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// version is the GC rules version.
-	Version uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	// members is the list of user IDs that are in the GC.
-	Members [][]byte `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
-	// extra_admins is the list of user IDs that are additional admins of the GC.
-	ExtraAdmins [][]byte `protobuf:"bytes,7,rep,name=extra_admins,proto3" json:"extra_admins,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// gc is the GC definition.
+	Gc *RMGroupList `protobuf:"bytes,2,opt,name=gc,proto3" json:"gc,omitempty"`
 }
 
-func (x *RMGroupList) Reset() {
-	*x = RMGroupList{}
+func (x *JoinedGCEvent) Reset() {
+	*x = JoinedGCEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4439,13 +4170,13 @@ func (x *RMGroupList) Reset() {
 	}
 }
 
-func (x *RMGroupList) String() string {
+func (x *JoinedGCEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMGroupList) ProtoMessage() {}
+func (*JoinedGCEvent) ProtoMessage() {}
 
-func (x *RMGroupList) ProtoReflect() protoreflect.Message {
+func (x *JoinedGCEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_clientrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4457,97 +4188,121 @@ func (x *RMGroupList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMGroupList.ProtoReflect.Descriptor instead.
-func (*RMGroupList) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinedGCEvent.ProtoReflect.Descriptor instead.
+func (*JoinedGCEvent) Descriptor() ([]byte, []int) {
 	return file_clientrpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *RMGroupList) GetId() []byte {
+func (x *JoinedGCEvent) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SequenceId
 	}
-	return nil
+	return 0
 }
 
-func (x *RMGroupList) GetName() string {
+func (x *JoinedGCEvent) GetGc() *RMGroupList {
 	if x != nil {
-		return x.Name
+		return x.Gc
 	}
-	return ""
+	return nil
 }
 
-func (x *RMGroupList) GetGeneration() uint64 {
-	if x != nil {
-		return x.Generation
-	}
-	return 0
-}
+// TipProgressRequest is the request to create a stream that receives events
+// about the progress of TipUser requests.
+type TipProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *RMGroupList) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+	// unacked_from specifies to the server the sequence_id of the last received
+	// tip progress event. Events received by the server that have a higher
+	// sequence_id will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *RMGroupList) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
+func (x *TipProgressRequest) Reset() {
+	*x = TipProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *RMGroupList) GetMembers() [][]byte {
-	if x != nil {
-		return x.Members
+func (x *TipProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipProgressRequest) ProtoMessage() {}
+
+func (x *TipProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RMGroupList) GetExtraAdmins() [][]byte {
+// Deprecated: Use TipProgressRequest.ProtoReflect.Descriptor instead.
+func (*TipProgressRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TipProgressRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.ExtraAdmins
+		return x.UnackedFrom
 	}
-	return nil
+	return 0
 }
 
-// RMFetchResource is the lowlevel request to fetch a resource.
-type RMFetchResource struct {
+// TipProgressEvent details the progress of a TipUser request.
+type TipProgressEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// path is the resource's path (already split into segments).
-	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
-	// meta is metadata associated with the request.
-	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// tag is a unique tag that should be relayed back on the reply.
-	Tag uint64 `protobuf:"varint,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	// data is raw request data.
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	// index is used in chunked/multipart requests.
-	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	// count is the total number of chunks in multipart requests.
-	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the User ID of the TipUser target.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the TipUser target.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// amount_matoms is the amount being tipped in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,4,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// completed flags whether the TipUser request was successfully completed
+	// (i.e. if the tip was paid).
+	Completed bool `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// attempt is the attempt number.
+	Attempt int32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// attempt_err is filled when the attempt to fetch an invoice or perform
+	// the payment for a received invoice failed.
+	AttemptErr string `protobuf:"bytes,7,opt,name=attempt_err,json=attemptErr,proto3" json:"attempt_err,omitempty"`
+	// will_retry flags whether a new attempt to request an invoice and perform
+	// a payment will be done or if no more attempts will happen.
+	WillRetry bool `protobuf:"varint,8,opt,name=will_retry,json=willRetry,proto3" json:"will_retry,omitempty"`
 }
 
-func (x *RMFetchResource) Reset() {
-	*x = RMFetchResource{}
+func (x *TipProgressEvent) Reset() {
+	*x = TipProgressEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[74]
+		mi := &file_clientrpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMFetchResource) String() string {
+func (x *TipProgressEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMFetchResource) ProtoMessage() {}
+func (*TipProgressEvent) ProtoMessage() {}
 
-func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[74]
+func (x *TipProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4558,90 +4313,117 @@ func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMFetchResource.ProtoReflect.Descriptor instead.
-func (*RMFetchResource) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use TipProgressEvent.ProtoReflect.Descriptor instead.
+func (*TipProgressEvent) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *RMFetchResource) GetPath() []string {
+func (x *TipProgressEvent) GetSequenceId() uint64 {
 	if x != nil {
-		return x.Path
+		return x.SequenceId
 	}
-	return nil
+	return 0
 }
 
-func (x *RMFetchResource) GetMeta() map[string]string {
+func (x *TipProgressEvent) GetUid() []byte {
 	if x != nil {
-		return x.Meta
+		return x.Uid
 	}
 	return nil
 }
 
-func (x *RMFetchResource) GetTag() uint64 {
+func (x *TipProgressEvent) GetNick() string {
 	if x != nil {
-		return x.Tag
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *TipProgressEvent) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
 	}
 	return 0
 }
 
-func (x *RMFetchResource) GetData() []byte {
+func (x *TipProgressEvent) GetCompleted() bool {
 	if x != nil {
-		return x.Data
+		return x.Completed
 	}
-	return nil
+	return false
 }
 
-func (x *RMFetchResource) GetIndex() uint32 {
+func (x *TipProgressEvent) GetAttempt() int32 {
 	if x != nil {
-		return x.Index
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *RMFetchResource) GetCount() uint32 {
+func (x *TipProgressEvent) GetAttemptErr() string {
 	if x != nil {
-		return x.Count
+		return x.AttemptErr
 	}
-	return 0
+	return ""
 }
 
-// RMFetchResourceReply is the lowlevel resource response.
-type RMFetchResourceReply struct {
+func (x *TipProgressEvent) GetWillRetry() bool {
+	if x != nil {
+		return x.WillRetry
+	}
+	return false
+}
+
+// PaymentRequest is a request for a payment sent to or received from a
+// remote user.
+type PaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tag is the same as the request tag.
-	Tag uint64 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	// status is the general status of the response.
-	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	// meta is additional response metadata.
-	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// data is the raw response data.
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	// index is used in chunked/multipart responses.
-	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	// count is the total number of chunks in multipart responses.
-	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
-}
-
-func (x *RMFetchResourceReply) Reset() {
-	*x = RMFetchResourceReply{}
+	// id is the id of the request.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// uid is the id of the remote user.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// sent is true if the request was sent by the local client.
+	Sent bool `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	// invoice is the invoice to pay.
+	Invoice string `protobuf:"bytes,4,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	// amount_matoms is the requested amount in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,5,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// memo is the description of the request.
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// created is the unix timestamp of when the request was created or
+	// received.
+	Created int64 `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	// expires is the unix timestamp after which the request can no longer be
+	// paid.
+	Expires int64 `protobuf:"varint,8,opt,name=expires,proto3" json:"expires,omitempty"`
+	// status is one of pending, paying, paid, declined or
+	// expired.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// error is the error of the last attempt to pay the request or the reason
+	// it was declined.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[75]
+		mi := &file_clientrpc_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RMFetchResourceReply) String() string {
+func (x *PaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RMFetchResourceReply) ProtoMessage() {}
+func (*PaymentRequest) ProtoMessage() {}
 
-func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[75]
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4652,84 +4434,116 @@ func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RMFetchResourceReply.ProtoReflect.Descriptor instead.
-func (*RMFetchResourceReply) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *RMFetchResourceReply) GetTag() uint64 {
+func (x *PaymentRequest) GetId() []byte {
 	if x != nil {
-		return x.Tag
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *RMFetchResourceReply) GetStatus() uint32 {
+func (x *PaymentRequest) GetUid() []byte {
 	if x != nil {
-		return x.Status
+		return x.Uid
 	}
-	return 0
+	return nil
 }
 
-func (x *RMFetchResourceReply) GetMeta() map[string]string {
+func (x *PaymentRequest) GetSent() bool {
 	if x != nil {
-		return x.Meta
+		return x.Sent
 	}
-	return nil
+	return false
 }
 
-func (x *RMFetchResourceReply) GetData() []byte {
+func (x *PaymentRequest) GetInvoice() string {
 	if x != nil {
-		return x.Data
+		return x.Invoice
 	}
-	return nil
+	return ""
 }
 
-func (x *RMFetchResourceReply) GetIndex() uint32 {
+func (x *PaymentRequest) GetAmountMatoms() int64 {
 	if x != nil {
-		return x.Index
+		return x.AmountMatoms
 	}
 	return 0
 }
 
-func (x *RMFetchResourceReply) GetCount() uint32 {
+func (x *PaymentRequest) GetMemo() string {
 	if x != nil {
-		return x.Count
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetCreated() int64 {
+	if x != nil {
+		return x.Created
 	}
 	return 0
 }
 
-// FileManifest is metadata about a chunk of the file.
-type FileManifest struct {
+func (x *PaymentRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *PaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RequestPaymentRequest is a request to send a payment request to a remote
+// user.
+type RequestPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// index is the position of this manifest item on the file metadata manifest.
-	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// size is the size of this chunk.
-	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	// hash is the hash of the chunk data.
-	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// user is the remote user nick or hex-encoded ID.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// amount_matoms is the requested amount in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// memo is an optional description of the request.
+	Memo string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// expiry_seconds is the number of seconds after which the request
+	// expires. If zero, a default of 24 hours is used.
+	ExpirySeconds int64 `protobuf:"varint,4,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty"`
 }
 
-func (x *FileManifest) Reset() {
-	*x = FileManifest{}
+func (x *RequestPaymentRequest) Reset() {
+	*x = RequestPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[76]
+		mi := &file_clientrpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileManifest) String() string {
+func (x *RequestPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileManifest) ProtoMessage() {}
+func (*RequestPaymentRequest) ProtoMessage() {}
 
-func (x *FileManifest) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[76]
+func (x *RequestPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4740,77 +4554,66 @@ func (x *FileManifest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
-func (*FileManifest) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use RequestPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RequestPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *FileManifest) GetIndex() uint64 {
+func (x *RequestPaymentRequest) GetUser() string {
 	if x != nil {
-		return x.Index
+		return x.User
 	}
-	return 0
+	return ""
 }
 
-func (x *FileManifest) GetSize() uint64 {
+func (x *RequestPaymentRequest) GetAmountMatoms() int64 {
 	if x != nil {
-		return x.Size
+		return x.AmountMatoms
 	}
 	return 0
 }
 
-func (x *FileManifest) GetHash() []byte {
+func (x *RequestPaymentRequest) GetMemo() string {
 	if x != nil {
-		return x.Hash
+		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-// FileMetadata is metadata about a file in the file transfer subsystem.
-type FileMetadata struct {
+func (x *RequestPaymentRequest) GetExpirySeconds() int64 {
+	if x != nil {
+		return x.ExpirySeconds
+	}
+	return 0
+}
+
+// RequestPaymentResponse is the response to a request payment request.
+type RequestPaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// version of the metadata.
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// cost (in milliatoms) to download the file.
-	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// size (in bytes) of the file.
-	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	// directory where the file is stored in the source.
-	Directory string `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
-	// filename of the file.
-	Filename string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
-	// description of the file.
-	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// hash of the file contents.
-	Hash string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
-	// manifest of the chunks that compose the file.
-	Manifest []*FileManifest `protobuf:"bytes,8,rep,name=manifest,proto3" json:"manifest,omitempty"`
-	// signature of the file by the host.
-	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
-	// attributes of the file.
-	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// request is the sent payment request.
+	Request *PaymentRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *FileMetadata) Reset() {
-	*x = FileMetadata{}
+func (x *RequestPaymentResponse) Reset() {
+	*x = RequestPaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[77]
+		mi := &file_clientrpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileMetadata) String() string {
+func (x *RequestPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMetadata) ProtoMessage() {}
+func (*RequestPaymentResponse) ProtoMessage() {}
 
-func (x *FileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[77]
+func (x *RequestPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4821,110 +4624,193 @@ func (x *FileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
-func (*FileMetadata) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use RequestPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RequestPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *FileMetadata) GetVersion() uint64 {
+func (x *RequestPaymentResponse) GetRequest() *PaymentRequest {
 	if x != nil {
-		return x.Version
+		return x.Request
 	}
-	return 0
+	return nil
 }
 
-func (x *FileMetadata) GetCost() uint64 {
-	if x != nil {
-		return x.Cost
+// ListPaymentRequestsRequest is a request to list payment requests.
+type ListPaymentRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPaymentRequestsRequest) Reset() {
+	*x = ListPaymentRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FileMetadata) GetSize() uint64 {
-	if x != nil {
-		return x.Size
+func (x *ListPaymentRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsRequest) ProtoMessage() {}
+
+func (x *ListPaymentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FileMetadata) GetDirectory() string {
-	if x != nil {
-		return x.Directory
+// Deprecated: Use ListPaymentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{79}
+}
+
+// ListPaymentRequestsResponse is the list of payment requests.
+type ListPaymentRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// requests is the list of payment requests, newest first.
+	Requests []*PaymentRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListPaymentRequestsResponse) Reset() {
+	*x = ListPaymentRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *FileMetadata) GetFilename() string {
-	if x != nil {
-		return x.Filename
+func (x *ListPaymentRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsResponse) ProtoMessage() {}
+
+func (x *ListPaymentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FileMetadata) GetDescription() string {
+// Deprecated: Use ListPaymentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListPaymentRequestsResponse) GetRequests() []*PaymentRequest {
 	if x != nil {
-		return x.Description
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-func (x *FileMetadata) GetHash() string {
-	if x != nil {
-		return x.Hash
+// AcceptPaymentRequestRequest is a request to pay a received payment
+// request.
+type AcceptPaymentRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the id of the user that sent the request.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// id is the id of the request.
+	Id []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AcceptPaymentRequestRequest) Reset() {
+	*x = AcceptPaymentRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *FileMetadata) GetManifest() []*FileManifest {
-	if x != nil {
-		return x.Manifest
+func (x *AcceptPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestRequest) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FileMetadata) GetSignature() string {
+// Deprecated: Use AcceptPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AcceptPaymentRequestRequest) GetUid() []byte {
 	if x != nil {
-		return x.Signature
+		return x.Uid
 	}
-	return ""
+	return nil
 }
 
-func (x *FileMetadata) GetAttributes() map[string]string {
+func (x *AcceptPaymentRequestRequest) GetId() []byte {
 	if x != nil {
-		return x.Attributes
+		return x.Id
 	}
 	return nil
 }
 
-// TipStreamRequest is the request for a new tip reception stream.
-type TipStreamRequest struct {
+// AcceptPaymentRequestResponse is the response to an accept payment request
+// request.
+type AcceptPaymentRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// unacked_from specifies to the server the sequence_id of the last processed
-	// PM. PMs received by the server that have a higher sequence_id will be
-	// streamed back to the client.
-	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+	// request is the updated payment request.
+	Request *PaymentRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *TipStreamRequest) Reset() {
-	*x = TipStreamRequest{}
+func (x *AcceptPaymentRequestResponse) Reset() {
+	*x = AcceptPaymentRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[78]
+		mi := &file_clientrpc_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TipStreamRequest) String() string {
+func (x *AcceptPaymentRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipStreamRequest) ProtoMessage() {}
+func (*AcceptPaymentRequestResponse) ProtoMessage() {}
 
-func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[78]
+func (x *AcceptPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4935,49 +4821,50 @@ func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipStreamRequest.ProtoReflect.Descriptor instead.
-func (*TipStreamRequest) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use AcceptPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *TipStreamRequest) GetUnackedFrom() uint64 {
+func (x *AcceptPaymentRequestResponse) GetRequest() *PaymentRequest {
 	if x != nil {
-		return x.UnackedFrom
+		return x.Request
 	}
-	return 0
+	return nil
 }
 
-// ReceivedPM is a private message received by the client.
-type ReceivedTip struct {
+// DeclinePaymentRequestRequest is a request to decline a received payment
+// request.
+type DeclinePaymentRequestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uid is the source user ID in raw format.
+	// uid is the id of the user that sent the request.
 	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	// amount_matoms is the amount being tipped in milli-atoms.
-	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
-	// sequence_id is an opaque sequential ID.
-	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// id is the id of the request.
+	Id []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// reason is an optional reason sent to the user.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ReceivedTip) Reset() {
-	*x = ReceivedTip{}
+func (x *DeclinePaymentRequestRequest) Reset() {
+	*x = DeclinePaymentRequestRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[79]
+		mi := &file_clientrpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceivedTip) String() string {
+func (x *DeclinePaymentRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceivedTip) ProtoMessage() {}
+func (*DeclinePaymentRequestRequest) ProtoMessage() {}
 
-func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[79]
+func (x *DeclinePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4988,67 +4875,57 @@ func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceivedTip.ProtoReflect.Descriptor instead.
-func (*ReceivedTip) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use DeclinePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReceivedTip) GetUid() []byte {
+func (x *DeclinePaymentRequestRequest) GetUid() []byte {
 	if x != nil {
 		return x.Uid
 	}
 	return nil
 }
 
-func (x *ReceivedTip) GetAmountMatoms() int64 {
+func (x *DeclinePaymentRequestRequest) GetId() []byte {
 	if x != nil {
-		return x.AmountMatoms
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *ReceivedTip) GetSequenceId() uint64 {
+func (x *DeclinePaymentRequestRequest) GetReason() string {
 	if x != nil {
-		return x.SequenceId
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-// GCInfo is the summary info for a GC.
-type ListGCsResponse_GCInfo struct {
+// DeclinePaymentRequestResponse is the response to a decline payment request
+// request.
+type DeclinePaymentRequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// id is the unique GC ID.
-	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the local name/alias for the GC.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// version is the current version the GC definition is on.
-	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	// timestamp is the timestamp of the last modification to the GC definition.
-	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// nb_members is the total number of members in the GC.
-	NbMembers uint32 `protobuf:"varint,5,opt,name=nb_members,json=nbMembers,proto3" json:"nb_members,omitempty"`
 }
 
-func (x *ListGCsResponse_GCInfo) Reset() {
-	*x = ListGCsResponse_GCInfo{}
+func (x *DeclinePaymentRequestResponse) Reset() {
+	*x = DeclinePaymentRequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientrpc_proto_msgTypes[80]
+		mi := &file_clientrpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListGCsResponse_GCInfo) String() string {
+func (x *DeclinePaymentRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGCsResponse_GCInfo) ProtoMessage() {}
+func (*DeclinePaymentRequestResponse) ProtoMessage() {}
 
-func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_clientrpc_proto_msgTypes[80]
+func (x *DeclinePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5059,928 +4936,5112 @@ func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGCsResponse_GCInfo.ProtoReflect.Descriptor instead.
-func (*ListGCsResponse_GCInfo) Descriptor() ([]byte, []int) {
-	return file_clientrpc_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use DeclinePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ListGCsResponse_GCInfo) GetId() []byte {
-	if x != nil {
-		return x.Id
-	}
-	return nil
+// PaymentRequestsStreamRequest is the request to start a stream of payment
+// request events.
+type PaymentRequestsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// event. Events received by the server that have a higher sequence_id will
+	// be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
 }
 
-func (x *ListGCsResponse_GCInfo) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *PaymentRequestsStreamRequest) Reset() {
+	*x = PaymentRequestsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListGCsResponse_GCInfo) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
+func (x *PaymentRequestsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestsStreamRequest) ProtoMessage() {}
+
+func (x *PaymentRequestsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListGCsResponse_GCInfo) GetTimestamp() int64 {
+// Deprecated: Use PaymentRequestsStreamRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequestsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PaymentRequestsStreamRequest) GetUnackedFrom() uint64 {
 	if x != nil {
-		return x.Timestamp
+		return x.UnackedFrom
 	}
 	return 0
 }
 
-func (x *ListGCsResponse_GCInfo) GetNbMembers() uint32 {
+// PaymentRequestEvent is sent when a payment request is received or when the
+// status of a payment request changes.
+type PaymentRequestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request is the received or updated payment request.
+	Request *PaymentRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// received is true if the request was just received from a remote user.
+	Received bool `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *PaymentRequestEvent) Reset() {
+	*x = PaymentRequestEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequestEvent) ProtoMessage() {}
+
+func (x *PaymentRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequestEvent.ProtoReflect.Descriptor instead.
+func (*PaymentRequestEvent) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PaymentRequestEvent) GetRequest() *PaymentRequest {
 	if x != nil {
-		return x.NbMembers
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PaymentRequestEvent) GetReceived() bool {
+	if x != nil {
+		return x.Received
+	}
+	return false
+}
+
+func (x *PaymentRequestEvent) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
 	}
 	return 0
 }
 
-var File_clientrpc_proto protoreflect.FileDescriptor
+// RecurringPayment is an agreement to pay a fixed amount every period.
+type RecurringPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_clientrpc_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x34, 0x0a, 0x16, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x0e, 0x4b, 0x65, 0x65, 0x70, 0x61,
-	0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2d, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x09, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x0c, 0x0a, 0x0a, 0x50,
-	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0f, 0x50, 0x4d, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22,
-	0x9b, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x4d, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2e, 0x0a,
-	0x0a, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67,
-	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x0d, 0x0a,
-	0x0b, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x10,
-	0x47, 0x43, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x47, 0x43, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x63, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
-	0x63, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a,
-	0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x55, 0x6e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x6e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x54, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x37, 0x0a, 0x12, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72,
-	0x6f, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x3d,
-	0x0a, 0x18, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
-	0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xe5, 0x01,
-	0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2b,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x72, 0x6f,
-	0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x22, 0x66, 0x0a, 0x0e, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
-	0x63, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x64, 0x63, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
-	0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x11, 0x0a,
-	0x0f, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x46, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a,
-	0x0f, 0x4b, 0x58, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x4b, 0x58, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x15, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x67, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x4f, 0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4b,
-	0x65, 0x79, 0x22, 0x38, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x14,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x4f, 0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67,
-	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x14, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47,
-	0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0f,
-	0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x65, 0x78, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x78, 0x55, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x55, 0x73,
-	0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69,
-	0x63, 0x6b, 0x22, 0x4f, 0x0a, 0x11, 0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47,
-	0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x22, 0x2d, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
-	0x0a, 0x03, 0x67, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x43,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x67, 0x63, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x06, 0x47, 0x43,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x62, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
-	0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
-	0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xbc,
-	0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f,
-	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x49, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69,
-	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x46, 0x72, 0x6f, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x67, 0x63, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e,
-	0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x43,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61,
-	0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x43, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x67, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x35, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63,
-	0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x4e, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x47, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22, 0x37, 0x0a, 0x12, 0x54, 0x69, 0x70, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
-	0x22, 0xf6, 0x01, 0x0a, 0x10, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x72, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69,
-	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x77, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x1e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x69, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x78, 0x0a, 0x16, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x46, 0x75, 0x6c,
-	0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x1f, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x10, 0x52, 0x4d, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x52, 0x4d, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda,
-	0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x50, 0x6f,
-	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x73,
-	0x69, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69,
-	0x67, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x72,
-	0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68,
-	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0xc0, 0x01, 0x0a, 0x17, 0x4f, 0x4f, 0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76,
-	0x6f, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6e,
-	0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x12, 0x22,
-	0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e,
-	0x64, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0xe0,
-	0x01, 0x0a, 0x0f, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xee, 0x01, 0x0a, 0x14, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x22, 0x87, 0x03, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
-	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x10, 0x54, 0x69,
-	0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x22, 0x65, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75,
-	0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74,
-	0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x2a, 0x3b, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53, 0x53, 0x41,
-	0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x4d, 0x45, 0x10, 0x01, 0x32, 0x7d, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69,
-	0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x61,
-	0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0f, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x30, 0x01, 0x32, 0xb2, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0f,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x1d, 0x0a, 0x02, 0x50, 0x4d, 0x12, 0x0a, 0x2e, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x08, 0x50, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x50, 0x4d, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x4d, 0x30, 0x01, 0x12, 0x2a, 0x0a, 0x0d, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x4d, 0x12, 0x0b, 0x2e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x47, 0x43, 0x4d, 0x12, 0x0b,
-	0x2e, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x43,
-	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x47, 0x43, 0x4d,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x47, 0x43, 0x4d, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x43, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x4d, 0x12, 0x0b, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x74, 0x65, 0x4b, 0x58, 0x12, 0x11, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b,
-	0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08,
-	0x4b, 0x58, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x4b, 0x58, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4b, 0x58, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63,
-	0x6b, 0x4b, 0x58, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc8, 0x05, 0x0a, 0x09, 0x47, 0x43,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x54, 0x6f, 0x47, 0x43, 0x12, 0x12, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f,
-	0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x12, 0x16, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x12,
-	0x12, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x47,
-	0x43, 0x12, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47,
-	0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x12, 0x19, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x30, 0x01,
-	0x12, 0x31, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47,
-	0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64,
-	0x64, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41,
-	0x64, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x43,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x11, 0x41, 0x63, 0x6b, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x47, 0x43, 0x73, 0x12, 0x11, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
-	0x47, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x29, 0x0a, 0x0c, 0x41, 0x63, 0x6b,
-	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x84, 0x03, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
-	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
-	0x0b, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74,
-	0x30, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x45, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x82, 0x02, 0x0a, 0x0f,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x2c, 0x0a, 0x07, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x54, 0x69, 0x70,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x54, 0x69,
-	0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x0b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x13, 0x2e, 0x54,
-	0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x70,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69,
-	0x70, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x70, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xb3, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0e, 0x46, 0x75,
-	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x46,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9f, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x14, 0x41, 0x63, 0x6b, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0b, 0x2e,
-	0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x7a, 0x65,
-	0x72, 0x6f, 0x2f, 0x62, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// id is the id of the agreement.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// uid is the id of the remote user.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// paying is true if the local client makes the payments.
+	Paying bool `protobuf:"varint,3,opt,name=paying,proto3" json:"paying,omitempty"`
+	// tier is the name of the tier of the agreement.
+	Tier string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	// amount_matoms is the amount paid every period in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,5,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// period_seconds is the duration of each period.
+	PeriodSeconds int64 `protobuf:"varint,6,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// max_periods is the max number of periods to pay. If zero, periods are
+	// paid until the agreement is canceled.
+	MaxPeriods uint32 `protobuf:"varint,7,opt,name=max_periods,json=maxPeriods,proto3" json:"max_periods,omitempty"`
+	// periods_paid is the number of periods paid so far.
+	PeriodsPaid uint32 `protobuf:"varint,8,opt,name=periods_paid,json=periodsPaid,proto3" json:"periods_paid,omitempty"`
+	// created is the unix timestamp of when the agreement was created.
+	Created int64 `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`
+	// next_payment is the unix timestamp of when the next payment is due.
+	NextPayment int64 `protobuf:"varint,10,opt,name=next_payment,json=nextPayment,proto3" json:"next_payment,omitempty"`
+	// status is one of active, completed, canceled or lapsed.
+	Status string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	// error is the error of the last payment or the reason the agreement was
+	// canceled.
+	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RecurringPayment) Reset() {
+	*x = RecurringPayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_clientrpc_proto_rawDescOnce sync.Once
-	file_clientrpc_proto_rawDescData = file_clientrpc_proto_rawDesc
-)
+func (x *RecurringPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_clientrpc_proto_rawDescGZIP() []byte {
-	file_clientrpc_proto_rawDescOnce.Do(func() {
-		file_clientrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_clientrpc_proto_rawDescData)
-	})
-	return file_clientrpc_proto_rawDescData
+func (*RecurringPayment) ProtoMessage() {}
+
+func (x *RecurringPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_clientrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_clientrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
-var file_clientrpc_proto_goTypes = []interface{}{
-	(MessageMode)(0),                        // 0: MessageMode
-	(*VersionRequest)(nil),                  // 1: VersionRequest
-	(*VersionResponse)(nil),                 // 2: VersionResponse
-	(*KeepaliveStreamRequest)(nil),          // 3: KeepaliveStreamRequest
-	(*KeepaliveEvent)(nil),                  // 4: KeepaliveEvent
-	(*AckRequest)(nil),                      // 5: AckRequest
-	(*AckResponse)(nil),                     // 6: AckResponse
-	(*PMRequest)(nil),                       // 7: PMRequest
-	(*PMResponse)(nil),                      // 8: PMResponse
-	(*PMStreamRequest)(nil),                 // 9: PMStreamRequest
-	(*ReceivedPM)(nil),                      // 10: ReceivedPM
-	(*GCMRequest)(nil),                      // 11: GCMRequest
-	(*GCMResponse)(nil),                     // 12: GCMResponse
-	(*GCMStreamRequest)(nil),                // 13: GCMStreamRequest
-	(*GCReceivedMsg)(nil),                   // 14: GCReceivedMsg
-	(*SubscribeToPostsRequest)(nil),         // 15: SubscribeToPostsRequest
-	(*SubscribeToPostsResponse)(nil),        // 16: SubscribeToPostsResponse
-	(*UnsubscribeToPostsRequest)(nil),       // 17: UnsubscribeToPostsRequest
-	(*UnsubscribeToPostsResponse)(nil),      // 18: UnsubscribeToPostsResponse
-	(*PostSummary)(nil),                     // 19: PostSummary
-	(*PostsStreamRequest)(nil),              // 20: PostsStreamRequest
-	(*ReceivedPost)(nil),                    // 21: ReceivedPost
-	(*PostsStatusStreamRequest)(nil),        // 22: PostsStatusStreamRequest
-	(*ReceivedPostStatus)(nil),              // 23: ReceivedPostStatus
-	(*TipUserRequest)(nil),                  // 24: TipUserRequest
-	(*TipUserResponse)(nil),                 // 25: TipUserResponse
-	(*MediateKXRequest)(nil),                // 26: MediateKXRequest
-	(*MediateKXResponse)(nil),               // 27: MediateKXResponse
-	(*KXStreamRequest)(nil),                 // 28: KXStreamRequest
-	(*KXCompleted)(nil),                     // 29: KXCompleted
-	(*WriteNewInviteRequest)(nil),           // 30: WriteNewInviteRequest
-	(*WriteNewInviteResponse)(nil),          // 31: WriteNewInviteResponse
-	(*AcceptInviteRequest)(nil),             // 32: AcceptInviteRequest
-	(*AcceptInviteResponse)(nil),            // 33: AcceptInviteResponse
-	(*InviteToGCRequest)(nil),               // 34: InviteToGCRequest
-	(*InviteToGCResponse)(nil),              // 35: InviteToGCResponse
-	(*AcceptGCInviteRequest)(nil),           // 36: AcceptGCInviteRequest
-	(*AcceptGCInviteResponse)(nil),          // 37: AcceptGCInviteResponse
-	(*SendFileRequest)(nil),                 // 38: SendFileRequest
-	(*SendFileResponse)(nil),                // 39: SendFileResponse
-	(*UserNickRequest)(nil),                 // 40: UserNickRequest
-	(*UserNickResponse)(nil),                // 41: UserNickResponse
-	(*KickFromGCRequest)(nil),               // 42: KickFromGCRequest
-	(*KickFromGCResponse)(nil),              // 43: KickFromGCResponse
-	(*GetGCRequest)(nil),                    // 44: GetGCRequest
-	(*GetGCResponse)(nil),                   // 45: GetGCResponse
-	(*ListGCsRequest)(nil),                  // 46: ListGCsRequest
-	(*ListGCsResponse)(nil),                 // 47: ListGCsResponse
-	(*ReceivedGCInvitesRequest)(nil),        // 48: ReceivedGCInvitesRequest
-	(*ReceivedGCInvite)(nil),                // 49: ReceivedGCInvite
-	(*UserAndNick)(nil),                     // 50: UserAndNick
-	(*GCMembersAddedRequest)(nil),           // 51: GCMembersAddedRequest
-	(*GCMembersAddedEvent)(nil),             // 52: GCMembersAddedEvent
-	(*GCMembersRemovedRequest)(nil),         // 53: GCMembersRemovedRequest
-	(*GCMembersRemovedEvent)(nil),           // 54: GCMembersRemovedEvent
-	(*JoinedGCsRequest)(nil),                // 55: JoinedGCsRequest
-	(*JoinedGCEvent)(nil),                   // 56: JoinedGCEvent
-	(*TipProgressRequest)(nil),              // 57: TipProgressRequest
-	(*TipProgressEvent)(nil),                // 58: TipProgressEvent
-	(*ResourceRequestsStreamRequest)(nil),   // 59: ResourceRequestsStreamRequest
-	(*ResourceRequestsStreamResponse)(nil),  // 60: ResourceRequestsStreamResponse
-	(*FulfillResourceRequest)(nil),          // 61: FulfillResourceRequest
-	(*FulfillResourceRequestResponse)(nil),  // 62: FulfillResourceRequestResponse
-	(*DownloadsCompletedStreamRequest)(nil), // 63: DownloadsCompletedStreamRequest
-	(*DownloadCompletedResponse)(nil),       // 64: DownloadCompletedResponse
-	(*RMPrivateMessage)(nil),                // 65: RMPrivateMessage
-	(*RMGroupMessage)(nil),                  // 66: RMGroupMessage
-	(*PostMetadata)(nil),                    // 67: PostMetadata
-	(*PostMetadataStatus)(nil),              // 68: PostMetadataStatus
-	(*PublicIdentityReq)(nil),               // 69: PublicIdentityReq
-	(*PublicIdentity)(nil),                  // 70: PublicIdentity
-	(*InviteFunds)(nil),                     // 71: InviteFunds
-	(*OOBPublicIdentityInvite)(nil),         // 72: OOBPublicIdentityInvite
-	(*RMGroupInvite)(nil),                   // 73: RMGroupInvite
-	(*RMGroupList)(nil),                     // 74: RMGroupList
-	(*RMFetchResource)(nil),                 // 75: RMFetchResource
-	(*RMFetchResourceReply)(nil),            // 76: RMFetchResourceReply
-	(*FileManifest)(nil),                    // 77: FileManifest
-	(*FileMetadata)(nil),                    // 78: FileMetadata
-	(*TipStreamRequest)(nil),                // 79: TipStreamRequest
-	(*ReceivedTip)(nil),                     // 80: ReceivedTip
-	(*ListGCsResponse_GCInfo)(nil),          // 81: ListGCsResponse.GCInfo
-	nil,                                     // 82: PostMetadata.AttributesEntry
-	nil,                                     // 83: PostMetadataStatus.AttributesEntry
-	nil,                                     // 84: RMFetchResource.MetaEntry
-	nil,                                     // 85: RMFetchResourceReply.MetaEntry
-	nil,                                     // 86: FileMetadata.AttributesEntry
+// Deprecated: Use RecurringPayment.ProtoReflect.Descriptor instead.
+func (*RecurringPayment) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{87}
 }
-var file_clientrpc_proto_depIdxs = []int32{
-	65, // 0: PMRequest.msg:type_name -> RMPrivateMessage
-	65, // 1: ReceivedPM.msg:type_name -> RMPrivateMessage
-	66, // 2: GCReceivedMsg.msg:type_name -> RMGroupMessage
-	19, // 3: ReceivedPost.summary:type_name -> PostSummary
-	67, // 4: ReceivedPost.post:type_name -> PostMetadata
-	68, // 5: ReceivedPostStatus.status:type_name -> PostMetadataStatus
-	72, // 6: WriteNewInviteResponse.invite:type_name -> OOBPublicIdentityInvite
-	72, // 7: AcceptInviteResponse.invite:type_name -> OOBPublicIdentityInvite
-	74, // 8: GetGCResponse.gc:type_name -> RMGroupList
-	81, // 9: ListGCsResponse.gcs:type_name -> ListGCsResponse.GCInfo
-	73, // 10: ReceivedGCInvite.invite:type_name -> RMGroupInvite
-	50, // 11: GCMembersAddedEvent.users:type_name -> UserAndNick
-	50, // 12: GCMembersRemovedEvent.users:type_name -> UserAndNick
-	74, // 13: JoinedGCEvent.gc:type_name -> RMGroupList
-	75, // 14: ResourceRequestsStreamResponse.request:type_name -> RMFetchResource
-	76, // 15: FulfillResourceRequest.response:type_name -> RMFetchResourceReply
-	78, // 16: DownloadCompletedResponse.file_metadata:type_name -> FileMetadata
-	0,  // 17: RMPrivateMessage.mode:type_name -> MessageMode
-	0,  // 18: RMGroupMessage.mode:type_name -> MessageMode
-	82, // 19: PostMetadata.attributes:type_name -> PostMetadata.AttributesEntry
-	83, // 20: PostMetadataStatus.attributes:type_name -> PostMetadataStatus.AttributesEntry
-	70, // 21: OOBPublicIdentityInvite.public:type_name -> PublicIdentity
-	71, // 22: OOBPublicIdentityInvite.funds:type_name -> InviteFunds
-	84, // 23: RMFetchResource.meta:type_name -> RMFetchResource.MetaEntry
-	85, // 24: RMFetchResourceReply.meta:type_name -> RMFetchResourceReply.MetaEntry
-	77, // 25: FileMetadata.manifest:type_name -> FileManifest
-	86, // 26: FileMetadata.attributes:type_name -> FileMetadata.AttributesEntry
-	1,  // 27: VersionService.Version:input_type -> VersionRequest
-	3,  // 28: VersionService.KeepaliveStream:input_type -> KeepaliveStreamRequest
-	69, // 29: ChatService.UserPublicIdentity:input_type -> PublicIdentityReq
-	7,  // 30: ChatService.PM:input_type -> PMRequest
-	9,  // 31: ChatService.PMStream:input_type -> PMStreamRequest
-	5,  // 32: ChatService.AckReceivedPM:input_type -> AckRequest
-	11, // 33: ChatService.GCM:input_type -> GCMRequest
-	13, // 34: ChatService.GCMStream:input_type -> GCMStreamRequest
-	5,  // 35: ChatService.AckReceivedGCM:input_type -> AckRequest
-	26, // 36: ChatService.MediateKX:input_type -> MediateKXRequest
-	28, // 37: ChatService.KXStream:input_type -> KXStreamRequest
-	5,  // 38: ChatService.AckKXCompleted:input_type -> AckRequest
-	30, // 39: ChatService.WriteNewInvite:input_type -> WriteNewInviteRequest
-	32, // 40: ChatService.AcceptInvite:input_type -> AcceptInviteRequest
-	38, // 41: ChatService.SendFile:input_type -> SendFileRequest
-	40, // 42: ChatService.UserNick:input_type -> UserNickRequest
-	34, // 43: GCService.InviteToGC:input_type -> InviteToGCRequest
-	36, // 44: GCService.AcceptGCInvite:input_type -> AcceptGCInviteRequest
-	42, // 45: GCService.KickFromGC:input_type -> KickFromGCRequest
-	44, // 46: GCService.GetGC:input_type -> GetGCRequest
-	46, // 47: GCService.List:input_type -> ListGCsRequest
-	48, // 48: GCService.ReceivedGCInvites:input_type -> ReceivedGCInvitesRequest
-	5,  // 49: GCService.AckReceivedGCInvites:input_type -> AckRequest
-	51, // 50: GCService.MembersAdded:input_type -> GCMembersAddedRequest
-	5,  // 51: GCService.AckMembersAdded:input_type -> AckRequest
-	53, // 52: GCService.MembersRemoved:input_type -> GCMembersRemovedRequest
-	5,  // 53: GCService.AckMembersRemoved:input_type -> AckRequest
-	55, // 54: GCService.JoinedGCs:input_type -> JoinedGCsRequest
-	5,  // 55: GCService.AckJoinedGCs:input_type -> AckRequest
-	15, // 56: PostsService.SubscribeToPosts:input_type -> SubscribeToPostsRequest
-	17, // 57: PostsService.UnsubscribeToPosts:input_type -> UnsubscribeToPostsRequest
-	20, // 58: PostsService.PostsStream:input_type -> PostsStreamRequest
-	5,  // 59: PostsService.AckReceivedPost:input_type -> AckRequest
-	22, // 60: PostsService.PostsStatusStream:input_type -> PostsStatusStreamRequest
-	5,  // 61: PostsService.AckReceivedPostStatus:input_type -> AckRequest
-	24, // 62: PaymentsService.TipUser:input_type -> TipUserRequest
-	57, // 63: PaymentsService.TipProgress:input_type -> TipProgressRequest
-	5,  // 64: PaymentsService.AckTipProgress:input_type -> AckRequest
-	79, // 65: PaymentsService.TipStream:input_type -> TipStreamRequest
-	5,  // 66: PaymentsService.AckTipReceived:input_type -> AckRequest
-	59, // 67: ResourcesService.RequestsStream:input_type -> ResourceRequestsStreamRequest
-	61, // 68: ResourcesService.FulfillRequest:input_type -> FulfillResourceRequest
-	63, // 69: ContentService.DownloadsCompletedStream:input_type -> DownloadsCompletedStreamRequest
-	5,  // 70: ContentService.AckDownloadCompleted:input_type -> AckRequest
-	2,  // 71: VersionService.Version:output_type -> VersionResponse
-	4,  // 72: VersionService.KeepaliveStream:output_type -> KeepaliveEvent
-	70, // 73: ChatService.UserPublicIdentity:output_type -> PublicIdentity
-	8,  // 74: ChatService.PM:output_type -> PMResponse
-	10, // 75: ChatService.PMStream:output_type -> ReceivedPM
-	6,  // 76: ChatService.AckReceivedPM:output_type -> AckResponse
-	12, // 77: ChatService.GCM:output_type -> GCMResponse
-	14, // 78: ChatService.GCMStream:output_type -> GCReceivedMsg
-	6,  // 79: ChatService.AckReceivedGCM:output_type -> AckResponse
-	27, // 80: ChatService.MediateKX:output_type -> MediateKXResponse
-	29, // 81: ChatService.KXStream:output_type -> KXCompleted
-	6,  // 82: ChatService.AckKXCompleted:output_type -> AckResponse
-	31, // 83: ChatService.WriteNewInvite:output_type -> WriteNewInviteResponse
-	33, // 84: ChatService.AcceptInvite:output_type -> AcceptInviteResponse
-	39, // 85: ChatService.SendFile:output_type -> SendFileResponse
-	41, // 86: ChatService.UserNick:output_type -> UserNickResponse
-	35, // 87: GCService.InviteToGC:output_type -> InviteToGCResponse
-	37, // 88: GCService.AcceptGCInvite:output_type -> AcceptGCInviteResponse
-	43, // 89: GCService.KickFromGC:output_type -> KickFromGCResponse
-	45, // 90: GCService.GetGC:output_type -> GetGCResponse
-	47, // 91: GCService.List:output_type -> ListGCsResponse
-	49, // 92: GCService.ReceivedGCInvites:output_type -> ReceivedGCInvite
-	6,  // 93: GCService.AckReceivedGCInvites:output_type -> AckResponse
-	52, // 94: GCService.MembersAdded:output_type -> GCMembersAddedEvent
-	6,  // 95: GCService.AckMembersAdded:output_type -> AckResponse
-	54, // 96: GCService.MembersRemoved:output_type -> GCMembersRemovedEvent
-	6,  // 97: GCService.AckMembersRemoved:output_type -> AckResponse
-	56, // 98: GCService.JoinedGCs:output_type -> JoinedGCEvent
-	6,  // 99: GCService.AckJoinedGCs:output_type -> AckResponse
-	16, // 100: PostsService.SubscribeToPosts:output_type -> SubscribeToPostsResponse
-	18, // 101: PostsService.UnsubscribeToPosts:output_type -> UnsubscribeToPostsResponse
-	21, // 102: PostsService.PostsStream:output_type -> ReceivedPost
-	6,  // 103: PostsService.AckReceivedPost:output_type -> AckResponse
-	23, // 104: PostsService.PostsStatusStream:output_type -> ReceivedPostStatus
-	6,  // 105: PostsService.AckReceivedPostStatus:output_type -> AckResponse
-	25, // 106: PaymentsService.TipUser:output_type -> TipUserResponse
-	58, // 107: PaymentsService.TipProgress:output_type -> TipProgressEvent
-	6,  // 108: PaymentsService.AckTipProgress:output_type -> AckResponse
-	80, // 109: PaymentsService.TipStream:output_type -> ReceivedTip
-	6,  // 110: PaymentsService.AckTipReceived:output_type -> AckResponse
-	60, // 111: ResourcesService.RequestsStream:output_type -> ResourceRequestsStreamResponse
-	62, // 112: ResourcesService.FulfillRequest:output_type -> FulfillResourceRequestResponse
-	64, // 113: ContentService.DownloadsCompletedStream:output_type -> DownloadCompletedResponse
-	6,  // 114: ContentService.AckDownloadCompleted:output_type -> AckResponse
-	71, // [71:115] is the sub-list for method output_type
-	27, // [27:71] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+
+func (x *RecurringPayment) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
 }
 
-func init() { file_clientrpc_proto_init() }
-func file_clientrpc_proto_init() {
-	if File_clientrpc_proto != nil {
-		return
+func (x *RecurringPayment) GetUid() []byte {
+	if x != nil {
+		return x.Uid
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_clientrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
+	return nil
+}
+
+func (x *RecurringPayment) GetPaying() bool {
+	if x != nil {
+		return x.Paying
+	}
+	return false
+}
+
+func (x *RecurringPayment) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *RecurringPayment) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetPeriodSeconds() int64 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetMaxPeriods() uint32 {
+	if x != nil {
+		return x.MaxPeriods
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetPeriodsPaid() uint32 {
+	if x != nil {
+		return x.PeriodsPaid
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetNextPayment() int64 {
+	if x != nil {
+		return x.NextPayment
+	}
+	return 0
+}
+
+func (x *RecurringPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecurringPayment) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CreateRecurringPaymentRequest is a request to start a recurring payment
+// agreement.
+type CreateRecurringPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user is the remote user nick or hex-encoded ID.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// dcr_amount is the amount to pay every period.
+	DcrAmount float64 `protobuf:"fixed64,2,opt,name=dcr_amount,json=dcrAmount,proto3" json:"dcr_amount,omitempty"`
+	// period_seconds is the duration of each period.
+	PeriodSeconds int64 `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	// max_periods is the max number of periods to pay. If zero, periods are
+	// paid until the agreement is canceled.
+	MaxPeriods uint32 `protobuf:"varint,4,opt,name=max_periods,json=maxPeriods,proto3" json:"max_periods,omitempty"`
+	// tier is an optional name of the tier of the agreement.
+	Tier string `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (x *CreateRecurringPaymentRequest) Reset() {
+	*x = CreateRecurringPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringPaymentRequest) ProtoMessage() {}
+
+func (x *CreateRecurringPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateRecurringPaymentRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CreateRecurringPaymentRequest) GetDcrAmount() float64 {
+	if x != nil {
+		return x.DcrAmount
+	}
+	return 0
+}
+
+func (x *CreateRecurringPaymentRequest) GetPeriodSeconds() int64 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+func (x *CreateRecurringPaymentRequest) GetMaxPeriods() uint32 {
+	if x != nil {
+		return x.MaxPeriods
+	}
+	return 0
+}
+
+func (x *CreateRecurringPaymentRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+// CreateRecurringPaymentResponse is the response to a create recurring
+// payment request.
+type CreateRecurringPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recurring_payment is the created agreement.
+	RecurringPayment *RecurringPayment `protobuf:"bytes,1,opt,name=recurring_payment,json=recurringPayment,proto3" json:"recurring_payment,omitempty"`
+}
+
+func (x *CreateRecurringPaymentResponse) Reset() {
+	*x = CreateRecurringPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRecurringPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringPaymentResponse) ProtoMessage() {}
+
+func (x *CreateRecurringPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreateRecurringPaymentResponse) GetRecurringPayment() *RecurringPayment {
+	if x != nil {
+		return x.RecurringPayment
+	}
+	return nil
+}
+
+// ListRecurringPaymentsRequest is a request to list recurring payments.
+type ListRecurringPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListRecurringPaymentsRequest) Reset() {
+	*x = ListRecurringPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecurringPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringPaymentsRequest) ProtoMessage() {}
+
+func (x *ListRecurringPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{90}
+}
+
+// ListRecurringPaymentsResponse is the list of recurring payments.
+type ListRecurringPaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recurring_payments is the list of agreements, oldest first.
+	RecurringPayments []*RecurringPayment `protobuf:"bytes,1,rep,name=recurring_payments,json=recurringPayments,proto3" json:"recurring_payments,omitempty"`
+}
+
+func (x *ListRecurringPaymentsResponse) Reset() {
+	*x = ListRecurringPaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRecurringPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringPaymentsResponse) ProtoMessage() {}
+
+func (x *ListRecurringPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListRecurringPaymentsResponse) GetRecurringPayments() []*RecurringPayment {
+	if x != nil {
+		return x.RecurringPayments
+	}
+	return nil
+}
+
+// CancelRecurringPaymentRequest is a request to cancel a recurring payment
+// agreement.
+type CancelRecurringPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the id of the remote user of the agreement.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// id is the id of the agreement.
+	Id []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// reason is an optional reason sent to the remote user.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelRecurringPaymentRequest) Reset() {
+	*x = CancelRecurringPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecurringPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecurringPaymentRequest) ProtoMessage() {}
+
+func (x *CancelRecurringPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecurringPaymentRequest.ProtoReflect.Descriptor instead.
+func (*CancelRecurringPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CancelRecurringPaymentRequest) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *CancelRecurringPaymentRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CancelRecurringPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CancelRecurringPaymentResponse is the response to a cancel recurring
+// payment request.
+type CancelRecurringPaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelRecurringPaymentResponse) Reset() {
+	*x = CancelRecurringPaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecurringPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecurringPaymentResponse) ProtoMessage() {}
+
+func (x *CancelRecurringPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecurringPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CancelRecurringPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{93}
+}
+
+// ListTierMembersRequest is a request to list the members of a tier.
+type ListTierMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tier is the name of the tier.
+	Tier string `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (x *ListTierMembersRequest) Reset() {
+	*x = ListTierMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTierMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTierMembersRequest) ProtoMessage() {}
+
+func (x *ListTierMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTierMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTierMembersRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListTierMembersRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+// ListTierMembersResponse is the list of members of a tier.
+type ListTierMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// members is the list of agreements of the members of the tier.
+	Members []*RecurringPayment `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListTierMembersResponse) Reset() {
+	*x = ListTierMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTierMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTierMembersResponse) ProtoMessage() {}
+
+func (x *ListTierMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTierMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTierMembersResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListTierMembersResponse) GetMembers() []*RecurringPayment {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// RecurringPaymentsStreamRequest is the request to start a stream of
+// recurring payment events.
+type RecurringPaymentsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// event. Events received by the server that have a higher sequence_id will
+	// be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *RecurringPaymentsStreamRequest) Reset() {
+	*x = RecurringPaymentsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecurringPaymentsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringPaymentsStreamRequest) ProtoMessage() {}
+
+func (x *RecurringPaymentsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringPaymentsStreamRequest.ProtoReflect.Descriptor instead.
+func (*RecurringPaymentsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RecurringPaymentsStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// RecurringPaymentEvent is sent when a recurring payment agreement is
+// received from a remote user, when a period is paid or when the agreement is
+// completed, canceled or lapses.
+type RecurringPaymentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recurring_payment is the received or updated agreement.
+	RecurringPayment *RecurringPayment `protobuf:"bytes,1,opt,name=recurring_payment,json=recurringPayment,proto3" json:"recurring_payment,omitempty"`
+	// received is true if the agreement was just received from a remote
+	// user.
+	Received bool `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *RecurringPaymentEvent) Reset() {
+	*x = RecurringPaymentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecurringPaymentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringPaymentEvent) ProtoMessage() {}
+
+func (x *RecurringPaymentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringPaymentEvent.ProtoReflect.Descriptor instead.
+func (*RecurringPaymentEvent) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RecurringPaymentEvent) GetRecurringPayment() *RecurringPayment {
+	if x != nil {
+		return x.RecurringPayment
+	}
+	return nil
+}
+
+func (x *RecurringPaymentEvent) GetReceived() bool {
+	if x != nil {
+		return x.Received
+	}
+	return false
+}
+
+func (x *RecurringPaymentEvent) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+// LedgerStreamRequest is the request to start a stream of payment ledger
+// entries.
+type LedgerStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// entry. Entries received by the server that have a higher sequence_id will
+	// be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *LedgerStreamRequest) Reset() {
+	*x = LedgerStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerStreamRequest) ProtoMessage() {}
+
+func (x *LedgerStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerStreamRequest.ProtoReflect.Descriptor instead.
+func (*LedgerStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *LedgerStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// LedgerEntry is an itemized entry of the payment ledger.
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamp is the unix timestamp of the payment.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// uid is the user the payment was made to, received from or made on behalf
+	// of (in the case of server fees).
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// event identifies what the payment was for.
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// category is the payment category (serverfees, downloads, tips, rtdt or
+	// other).
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// amount_matoms is the amount of the payment in milliatoms. It is negative
+	// for payments made and positive for payments received.
+	AmountMatoms int64 `protobuf:"varint,5,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// fee_matoms is the fee paid in milliatoms. It is zero or negative.
+	FeeMatoms int64 `protobuf:"varint,6,opt,name=fee_matoms,json=feeMatoms,proto3" json:"fee_matoms,omitempty"`
+	// invoice_hash is the hex-encoded payment hash of the invoice, if known.
+	InvoiceHash string `protobuf:"bytes,7,opt,name=invoice_hash,json=invoiceHash,proto3" json:"invoice_hash,omitempty"`
+	// dcr_usd_rate is the DCR/USD exchange rate at the time of the payment or
+	// zero if unknown.
+	DcrUsdRate float64 `protobuf:"fixed64,8,opt,name=dcr_usd_rate,json=dcrUsdRate,proto3" json:"dcr_usd_rate,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,9,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *LedgerEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetFeeMatoms() int64 {
+	if x != nil {
+		return x.FeeMatoms
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetInvoiceHash() string {
+	if x != nil {
+		return x.InvoiceHash
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDcrUsdRate() float64 {
+	if x != nil {
+		return x.DcrUsdRate
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+// TipGroupChatRequest is the request to tip the members of a group chat.
+type TipGroupChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gc is the name or hex-encoded ID of the group chat.
+	Gc string `protobuf:"bytes,1,opt,name=gc,proto3" json:"gc,omitempty"`
+	// dcr_amount is the total DCR amount to split among the members.
+	DcrAmount float64 `protobuf:"fixed64,2,opt,name=dcr_amount,json=dcrAmount,proto3" json:"dcr_amount,omitempty"`
+	// split_mode is how the total is split: equal (among all members), shares
+	// (weighted by the shares defined by the admins) or admins (equally among
+	// the admins). Defaults to equal.
+	SplitMode string `protobuf:"bytes,3,opt,name=split_mode,json=splitMode,proto3" json:"split_mode,omitempty"`
+	// max_attempts is the number of max attempts to try to send the tip to each
+	// member.
+	MaxAttempts int32 `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+}
+
+func (x *TipGroupChatRequest) Reset() {
+	*x = TipGroupChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TipGroupChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipGroupChatRequest) ProtoMessage() {}
+
+func (x *TipGroupChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipGroupChatRequest.ProtoReflect.Descriptor instead.
+func (*TipGroupChatRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TipGroupChatRequest) GetGc() string {
+	if x != nil {
+		return x.Gc
+	}
+	return ""
+}
+
+func (x *TipGroupChatRequest) GetDcrAmount() float64 {
+	if x != nil {
+		return x.DcrAmount
+	}
+	return 0
+}
+
+func (x *TipGroupChatRequest) GetSplitMode() string {
+	if x != nil {
+		return x.SplitMode
+	}
+	return ""
+}
+
+func (x *TipGroupChatRequest) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+// TipGroupChatResponse is the response to a tip group chat request.
+type TipGroupChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tip is the newly started group chat tip.
+	Tip *GCTip `protobuf:"bytes,1,opt,name=tip,proto3" json:"tip,omitempty"`
+}
+
+func (x *TipGroupChatResponse) Reset() {
+	*x = TipGroupChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TipGroupChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipGroupChatResponse) ProtoMessage() {}
+
+func (x *TipGroupChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipGroupChatResponse.ProtoReflect.Descriptor instead.
+func (*TipGroupChatResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TipGroupChatResponse) GetTip() *GCTip {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+// GCTipMember is the payout to one member of a group chat tip.
+type GCTipMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the ID of the member.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// milli_atoms is the amount sent to the member.
+	MilliAtoms uint64 `protobuf:"varint,2,opt,name=milli_atoms,json=milliAtoms,proto3" json:"milli_atoms,omitempty"`
+	// status is the status of the payout (pending, completed or failed).
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// error is the error of a failed payout.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GCTipMember) Reset() {
+	*x = GCTipMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCTipMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTipMember) ProtoMessage() {}
+
+func (x *GCTipMember) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCTipMember.ProtoReflect.Descriptor instead.
+func (*GCTipMember) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GCTipMember) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *GCTipMember) GetMilliAtoms() uint64 {
+	if x != nil {
+		return x.MilliAtoms
+	}
+	return 0
+}
+
+func (x *GCTipMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GCTipMember) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GCTip is a tip to a whole group chat, split among its members.
+type GCTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the tip.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// gcid is the ID of the group chat.
+	Gcid []byte `protobuf:"bytes,2,opt,name=gcid,proto3" json:"gcid,omitempty"`
+	// split_mode is how the total was split among the members.
+	SplitMode string `protobuf:"bytes,3,opt,name=split_mode,json=splitMode,proto3" json:"split_mode,omitempty"`
+	// milli_atoms is the total amount of the tip.
+	MilliAtoms uint64 `protobuf:"varint,4,opt,name=milli_atoms,json=milliAtoms,proto3" json:"milli_atoms,omitempty"`
+	// created is the unix timestamp of when the tip was started.
+	Created int64 `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	// completed is the unix timestamp of when all payouts finished or zero if
+	// some are still pending.
+	Completed int64 `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
+	// members are the payouts to each member.
+	Members []*GCTipMember `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GCTip) Reset() {
+	*x = GCTip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTip) ProtoMessage() {}
+
+func (x *GCTip) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCTip.ProtoReflect.Descriptor instead.
+func (*GCTip) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GCTip) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GCTip) GetGcid() []byte {
+	if x != nil {
+		return x.Gcid
+	}
+	return nil
+}
+
+func (x *GCTip) GetSplitMode() string {
+	if x != nil {
+		return x.SplitMode
+	}
+	return ""
+}
+
+func (x *GCTip) GetMilliAtoms() uint64 {
+	if x != nil {
+		return x.MilliAtoms
+	}
+	return 0
+}
+
+func (x *GCTip) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *GCTip) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GCTip) GetMembers() []*GCTipMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GCTipProgressStreamRequest is the request to start a stream of events about
+// the progress of group chat tips.
+type GCTipProgressStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// event. Events received by the server that have a higher sequence_id will
+	// be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *GCTipProgressStreamRequest) Reset() {
+	*x = GCTipProgressStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCTipProgressStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTipProgressStreamRequest) ProtoMessage() {}
+
+func (x *GCTipProgressStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCTipProgressStreamRequest.ProtoReflect.Descriptor instead.
+func (*GCTipProgressStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GCTipProgressStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// GCTipProgressEvent is the progress of a group chat tip.
+type GCTipProgressEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tip is the current state of the group chat tip.
+	Tip *GCTip `protobuf:"bytes,1,opt,name=tip,proto3" json:"tip,omitempty"`
+	// completed is the number of members for which the payout completed.
+	Completed uint32 `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// failed is the number of members for which the payout failed.
+	Failed uint32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// pending is the number of members for which the payout is pending.
+	Pending uint32 `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	// paid_milli_atoms is the total amount paid so far.
+	PaidMilliAtoms uint64 `protobuf:"varint,5,opt,name=paid_milli_atoms,json=paidMilliAtoms,proto3" json:"paid_milli_atoms,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,6,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *GCTipProgressEvent) Reset() {
+	*x = GCTipProgressEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCTipProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTipProgressEvent) ProtoMessage() {}
+
+func (x *GCTipProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCTipProgressEvent.ProtoReflect.Descriptor instead.
+func (*GCTipProgressEvent) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GCTipProgressEvent) GetTip() *GCTip {
+	if x != nil {
+		return x.Tip
+	}
+	return nil
+}
+
+func (x *GCTipProgressEvent) GetCompleted() uint32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *GCTipProgressEvent) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GCTipProgressEvent) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GCTipProgressEvent) GetPaidMilliAtoms() uint64 {
+	if x != nil {
+		return x.PaidMilliAtoms
+	}
+	return 0
+}
+
+func (x *GCTipProgressEvent) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+// ResourceRequestsStreamRequest is the request for a stream to receive resource
+// requests.
+type ResourceRequestsStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResourceRequestsStreamRequest) Reset() {
+	*x = ResourceRequestsStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceRequestsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRequestsStreamRequest) ProtoMessage() {}
+
+func (x *ResourceRequestsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRequestsStreamRequest.ProtoReflect.Descriptor instead.
+func (*ResourceRequestsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{106}
+}
+
+// ResourceRequestsStreamResponse is the a request made by a remote client for
+// one of the local client's resources.
+type ResourceRequestsStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is an opaque ID to link requests and responses made through the clientrpc
+	// interface.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// uid is the User ID of the client that requested the resource.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the client that requested the resource.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// request is the actual request made by the remote client.
+	Request *RMFetchResource `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ResourceRequestsStreamResponse) Reset() {
+	*x = ResourceRequestsStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceRequestsStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRequestsStreamResponse) ProtoMessage() {}
+
+func (x *ResourceRequestsStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRequestsStreamResponse.ProtoReflect.Descriptor instead.
+func (*ResourceRequestsStreamResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ResourceRequestsStreamResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResourceRequestsStreamResponse) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *ResourceRequestsStreamResponse) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *ResourceRequestsStreamResponse) GetRequest() *RMFetchResource {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// FulfillResourceRequest fulfills a remote request for a local client resource.
+type FulfillResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id must be the corresponding request received by the clientrpc client.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// error_msg is an optional error message to fail the request with.
+	ErrorMsg string `protobuf:"bytes,2,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+	// response is the actual resource that should be sent to the remote client.
+	Response *RMFetchResourceReply `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *FulfillResourceRequest) Reset() {
+	*x = FulfillResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FulfillResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillResourceRequest) ProtoMessage() {}
+
+func (x *FulfillResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillResourceRequest.ProtoReflect.Descriptor instead.
+func (*FulfillResourceRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FulfillResourceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FulfillResourceRequest) GetErrorMsg() string {
+	if x != nil {
+		return x.ErrorMsg
+	}
+	return ""
+}
+
+func (x *FulfillResourceRequest) GetResponse() *RMFetchResourceReply {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// FulfillResourceRequestResponse is the response to a FulfillResourceResquest
+// call.
+type FulfillResourceRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FulfillResourceRequestResponse) Reset() {
+	*x = FulfillResourceRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FulfillResourceRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillResourceRequestResponse) ProtoMessage() {}
+
+func (x *FulfillResourceRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillResourceRequestResponse.ProtoReflect.Descriptor instead.
+func (*FulfillResourceRequestResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{109}
+}
+
+// DownloadsCompletedRequest is the request sent when obtaining a stream of
+// completed downloads notifications.
+type DownloadsCompletedStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last completed
+	// download. Downloads completed by the server that have a higher sequence_id
+	// will be streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *DownloadsCompletedStreamRequest) Reset() {
+	*x = DownloadsCompletedStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadsCompletedStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadsCompletedStreamRequest) ProtoMessage() {}
+
+func (x *DownloadsCompletedStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadsCompletedStreamRequest.ProtoReflect.Descriptor instead.
+func (*DownloadsCompletedStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DownloadsCompletedStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// DownloadCompletedResponse is the data about one completed file download.
+type DownloadCompletedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// uid is the User ID of the client that sent the file.
+	Uid []byte `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	// nick is the nick of the client that sent the resource.
+	Nick string `protobuf:"bytes,3,opt,name=nick,proto3" json:"nick,omitempty"`
+	// disk_path is the path of the file in the local client's disk.
+	DiskPath string `protobuf:"bytes,4,opt,name=disk_path,json=diskPath,proto3" json:"disk_path,omitempty"`
+	// file_metadata is the metadata about the file.
+	FileMetadata *FileMetadata `protobuf:"bytes,5,opt,name=file_metadata,json=fileMetadata,proto3" json:"file_metadata,omitempty"`
+}
+
+func (x *DownloadCompletedResponse) Reset() {
+	*x = DownloadCompletedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadCompletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCompletedResponse) ProtoMessage() {}
+
+func (x *DownloadCompletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCompletedResponse.ProtoReflect.Descriptor instead.
+func (*DownloadCompletedResponse) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DownloadCompletedResponse) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+func (x *DownloadCompletedResponse) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *DownloadCompletedResponse) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *DownloadCompletedResponse) GetDiskPath() string {
+	if x != nil {
+		return x.DiskPath
+	}
+	return ""
+}
+
+func (x *DownloadCompletedResponse) GetFileMetadata() *FileMetadata {
+	if x != nil {
+		return x.FileMetadata
+	}
+	return nil
+}
+
+// RMPrivateMessage is the network-level routed private message.
+type RMPrivateMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message is the private message payload.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// mode is the message mode.
+	Mode MessageMode `protobuf:"varint,2,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+}
+
+func (x *RMPrivateMessage) Reset() {
+	*x = RMPrivateMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMPrivateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMPrivateMessage) ProtoMessage() {}
+
+func (x *RMPrivateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMPrivateMessage.ProtoReflect.Descriptor instead.
+func (*RMPrivateMessage) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RMPrivateMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RMPrivateMessage) GetMode() MessageMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MessageMode_MESSAGE_MODE_NORMAL
+}
+
+// RMGroupMessage is the network-level routed group message.
+type RMGroupMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the group chat id where the message was sent.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// generation is the internal generation of the group chat metadata when the
+	// sender sent this message.
+	Generation uint64 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	// message is the textual content.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// mode is the mode of the message.
+	Mode MessageMode `protobuf:"varint,4,opt,name=mode,proto3,enum=MessageMode" json:"mode,omitempty"`
+}
+
+func (x *RMGroupMessage) Reset() {
+	*x = RMGroupMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupMessage) ProtoMessage() {}
+
+func (x *RMGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupMessage.ProtoReflect.Descriptor instead.
+func (*RMGroupMessage) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RMGroupMessage) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupMessage) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RMGroupMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RMGroupMessage) GetMode() MessageMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MessageMode_MESSAGE_MODE_NORMAL
+}
+
+// PostMetadata is the network-level post data.
+type PostMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version defines the available fields within attributes.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// attributes defines the available post attributes.
+	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PostMetadata) Reset() {
+	*x = PostMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMetadata) ProtoMessage() {}
+
+func (x *PostMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMetadata.ProtoReflect.Descriptor instead.
+func (*PostMetadata) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PostMetadata) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostMetadata) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PostMetadataStatus is the network-level post status update data.
+type PostMetadataStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version defines the available fields within attributes.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// from is the UID of the original status creator.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// link is the ID of the post.
+	Link string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	// attributes is the list of post update attributes.
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PostMetadataStatus) Reset() {
+	*x = PostMetadataStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostMetadataStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostMetadataStatus) ProtoMessage() {}
+
+func (x *PostMetadataStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostMetadataStatus.ProtoReflect.Descriptor instead.
+func (*PostMetadataStatus) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PostMetadataStatus) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostMetadataStatus) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PostMetadataStatus) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *PostMetadataStatus) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PublicIdentityReq is a request to get PublicIdentity.
+type PublicIdentityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublicIdentityReq) Reset() {
+	*x = PublicIdentityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIdentityReq) ProtoMessage() {}
+
+func (x *PublicIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIdentityReq.ProtoReflect.Descriptor instead.
+func (*PublicIdentityReq) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{116}
+}
+
+// PublicIdentity is the lowlevel public identity.
+type PublicIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the user.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// nick is the short name/alias of the user.
+	Nick string `protobuf:"bytes,2,opt,name=nick,proto3" json:"nick,omitempty"`
+	// sig_key is the signature key used to authenticate messages from the user.
+	SigKey []byte `protobuf:"bytes,3,opt,name=sig_key,json=sigKey,proto3" json:"sig_key,omitempty"`
+	// key is the NTRU public key of the user.
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// identity is the public ID of the user.
+	Identity []byte `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	// digest is a hash of the user's public information.
+	Digest []byte `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty"`
+	// signature is a signature of the user's public information.
+	Signature []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PublicIdentity) Reset() {
+	*x = PublicIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicIdentity) ProtoMessage() {}
+
+func (x *PublicIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicIdentity.ProtoReflect.Descriptor instead.
+func (*PublicIdentity) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PublicIdentity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PublicIdentity) GetNick() string {
+	if x != nil {
+		return x.Nick
+	}
+	return ""
+}
+
+func (x *PublicIdentity) GetSigKey() []byte {
+	if x != nil {
+		return x.SigKey
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetIdentity() []byte {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *PublicIdentity) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// InviteFunds is the lowlevel information about funds added to an invite.
+type InviteFunds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx is the hash of the transaction that includes the funds.
+	Tx string `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// index is the output index of the transaction that includes the funds.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// tree is the tree of the transaction that includes the funds.
+	Tree int32 `protobuf:"varint,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	// private_key is the private key needed to redeem the funds in the transaction.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,proto3" json:"private_key,omitempty"`
+	// height_hint is a height at or before the transaction that includes the funds
+	// is mined in.
+	HeightHint uint32 `protobuf:"varint,5,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
+	// address is the public address that the funds were sent to.
+	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *InviteFunds) Reset() {
+	*x = InviteFunds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteFunds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteFunds) ProtoMessage() {}
+
+func (x *InviteFunds) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteFunds.ProtoReflect.Descriptor instead.
+func (*InviteFunds) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *InviteFunds) GetTx() string {
+	if x != nil {
+		return x.Tx
+	}
+	return ""
+}
+
+func (x *InviteFunds) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetTree() int32 {
+	if x != nil {
+		return x.Tree
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *InviteFunds) GetHeightHint() uint32 {
+	if x != nil {
+		return x.HeightHint
+	}
+	return 0
+}
+
+func (x *InviteFunds) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// OOBPublicIdentityInvite is an out-of-band invite to perform a KX.
+type OOBPublicIdentityInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public is the public identity of the inviter.
+	Public *PublicIdentity `protobuf:"bytes,1,opt,name=public,proto3" json:"public,omitempty"`
+	// initial_rendezvous is the initial random RV where key exchange will happen.
+	InitialRendezvous []byte `protobuf:"bytes,2,opt,name=initial_rendezvous,json=initialrendezvous,proto3" json:"initial_rendezvous,omitempty"`
+	// reset_rendezvous is the inviter's reset RV in case of ratchet reset actions.
+	ResetRendezvous []byte `protobuf:"bytes,3,opt,name=reset_rendezvous,json=resetrendezvous,proto3" json:"reset_rendezvous,omitempty"`
+	// funds are funds that may be included in the invite and are redeemable by
+	// the invitee.
+	Funds *InviteFunds `protobuf:"bytes,4,opt,name=funds,proto3" json:"funds,omitempty"`
+}
+
+func (x *OOBPublicIdentityInvite) Reset() {
+	*x = OOBPublicIdentityInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OOBPublicIdentityInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OOBPublicIdentityInvite) ProtoMessage() {}
+
+func (x *OOBPublicIdentityInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OOBPublicIdentityInvite.ProtoReflect.Descriptor instead.
+func (*OOBPublicIdentityInvite) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *OOBPublicIdentityInvite) GetPublic() *PublicIdentity {
+	if x != nil {
+		return x.Public
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetInitialRendezvous() []byte {
+	if x != nil {
+		return x.InitialRendezvous
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetResetRendezvous() []byte {
+	if x != nil {
+		return x.ResetRendezvous
+	}
+	return nil
+}
+
+func (x *OOBPublicIdentityInvite) GetFunds() *InviteFunds {
+	if x != nil {
+		return x.Funds
+	}
+	return nil
+}
+
+// RMGroupInvite is the information about an invite to join a GC.
+type RMGroupInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the GC.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// token is a random number that must be sent back by the invitee to the inviter.
+	Token uint64 `protobuf:"varint,3,opt,name=token,proto3" json:"token,omitempty"`
+	// description is a description of the GC.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// expires is a timestamp for when the invitation expires.
+	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+	// version is the version of the current definition of the GC.
+	Version uint32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RMGroupInvite) Reset() {
+	*x = RMGroupInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupInvite) ProtoMessage() {}
+
+func (x *RMGroupInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupInvite.ProtoReflect.Descriptor instead.
+func (*RMGroupInvite) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RMGroupInvite) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupInvite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RMGroupInvite) GetToken() uint64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *RMGroupInvite) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RMGroupInvite) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *RMGroupInvite) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RMGroupList is the full definition of a GC.
+type RMGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the ID of the GC.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// generation is a monotonically increasing count of GC changes.
+	Generation uint64 `protobuf:"varint,3,opt,name=generation,proto3" json:"generation,omitempty"`
+	// timestamp is the timestamp for the last modification of the GC defintions.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// version is the GC rules version.
+	Version uint32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// members is the list of user IDs that are in the GC.
+	Members [][]byte `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	// extra_admins is the list of user IDs that are additional admins of the GC.
+	ExtraAdmins [][]byte `protobuf:"bytes,7,rep,name=extra_admins,proto3" json:"extra_admins,omitempty"`
+}
+
+func (x *RMGroupList) Reset() {
+	*x = RMGroupList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMGroupList) ProtoMessage() {}
+
+func (x *RMGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMGroupList.ProtoReflect.Descriptor instead.
+func (*RMGroupList) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RMGroupList) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *RMGroupList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RMGroupList) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RMGroupList) GetMembers() [][]byte {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RMGroupList) GetExtraAdmins() [][]byte {
+	if x != nil {
+		return x.ExtraAdmins
+	}
+	return nil
+}
+
+// RMFetchResource is the lowlevel request to fetch a resource.
+type RMFetchResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path is the resource's path (already split into segments).
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// meta is metadata associated with the request.
+	Meta map[string]string `protobuf:"bytes,2,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tag is a unique tag that should be relayed back on the reply.
+	Tag uint64 `protobuf:"varint,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// data is raw request data.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// index is used in chunked/multipart requests.
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// count is the total number of chunks in multipart requests.
+	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RMFetchResource) Reset() {
+	*x = RMFetchResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMFetchResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMFetchResource) ProtoMessage() {}
+
+func (x *RMFetchResource) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMFetchResource.ProtoReflect.Descriptor instead.
+func (*RMFetchResource) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RMFetchResource) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *RMFetchResource) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RMFetchResource) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RMFetchResource) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// RMFetchResourceReply is the lowlevel resource response.
+type RMFetchResourceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tag is the same as the request tag.
+	Tag uint64 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// status is the general status of the response.
+	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// meta is additional response metadata.
+	Meta map[string]string `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// data is the raw response data.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// index is used in chunked/multipart responses.
+	Index uint32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	// count is the total number of chunks in multipart responses.
+	Count uint32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RMFetchResourceReply) Reset() {
+	*x = RMFetchResourceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RMFetchResourceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMFetchResourceReply) ProtoMessage() {}
+
+func (x *RMFetchResourceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMFetchResourceReply.ProtoReflect.Descriptor instead.
+func (*RMFetchResourceReply) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RMFetchResourceReply) GetTag() uint64 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetMeta() map[string]string {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RMFetchResourceReply) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RMFetchResourceReply) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RMFetchResourceReply) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// FileManifest is metadata about a chunk of the file.
+type FileManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of this manifest item on the file metadata manifest.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// size is the size of this chunk.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// hash is the hash of the chunk data.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *FileManifest) Reset() {
+	*x = FileManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileManifest) ProtoMessage() {}
+
+func (x *FileManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileManifest.ProtoReflect.Descriptor instead.
+func (*FileManifest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FileManifest) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FileManifest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileManifest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// FileMetadata is metadata about a file in the file transfer subsystem.
+type FileMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version of the metadata.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// cost (in milliatoms) to download the file.
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// size (in bytes) of the file.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// directory where the file is stored in the source.
+	Directory string `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
+	// filename of the file.
+	Filename string `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	// description of the file.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// hash of the file contents.
+	Hash string `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	// manifest of the chunks that compose the file.
+	Manifest []*FileManifest `protobuf:"bytes,8,rep,name=manifest,proto3" json:"manifest,omitempty"`
+	// signature of the file by the host.
+	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attributes of the file.
+	Attributes map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FileMetadata) Reset() {
+	*x = FileMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMetadata) ProtoMessage() {}
+
+func (x *FileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
+func (*FileMetadata) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *FileMetadata) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileMetadata) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetManifest() []*FileManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *FileMetadata) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FileMetadata) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// TipStreamRequest is the request for a new tip reception stream.
+type TipStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unacked_from specifies to the server the sequence_id of the last processed
+	// PM. PMs received by the server that have a higher sequence_id will be
+	// streamed back to the client.
+	UnackedFrom uint64 `protobuf:"varint,1,opt,name=unacked_from,json=unackedFrom,proto3" json:"unacked_from,omitempty"`
+}
+
+func (x *TipStreamRequest) Reset() {
+	*x = TipStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TipStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipStreamRequest) ProtoMessage() {}
+
+func (x *TipStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipStreamRequest.ProtoReflect.Descriptor instead.
+func (*TipStreamRequest) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TipStreamRequest) GetUnackedFrom() uint64 {
+	if x != nil {
+		return x.UnackedFrom
+	}
+	return 0
+}
+
+// ReceivedPM is a private message received by the client.
+type ReceivedTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uid is the source user ID in raw format.
+	Uid []byte `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// amount_matoms is the amount being tipped in milli-atoms.
+	AmountMatoms int64 `protobuf:"varint,2,opt,name=amount_matoms,json=amountMatoms,proto3" json:"amount_matoms,omitempty"`
+	// sequence_id is an opaque sequential ID.
+	SequenceId uint64 `protobuf:"varint,3,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+}
+
+func (x *ReceivedTip) Reset() {
+	*x = ReceivedTip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceivedTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedTip) ProtoMessage() {}
+
+func (x *ReceivedTip) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedTip.ProtoReflect.Descriptor instead.
+func (*ReceivedTip) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ReceivedTip) GetUid() []byte {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *ReceivedTip) GetAmountMatoms() int64 {
+	if x != nil {
+		return x.AmountMatoms
+	}
+	return 0
+}
+
+func (x *ReceivedTip) GetSequenceId() uint64 {
+	if x != nil {
+		return x.SequenceId
+	}
+	return 0
+}
+
+// GCInfo is the summary info for a GC.
+type ListGCsResponse_GCInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique GC ID.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the local name/alias for the GC.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the current version the GC definition is on.
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// timestamp is the timestamp of the last modification to the GC definition.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// nb_members is the total number of members in the GC.
+	NbMembers uint32 `protobuf:"varint,5,opt,name=nb_members,json=nbMembers,proto3" json:"nb_members,omitempty"`
+}
+
+func (x *ListGCsResponse_GCInfo) Reset() {
+	*x = ListGCsResponse_GCInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientrpc_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGCsResponse_GCInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGCsResponse_GCInfo) ProtoMessage() {}
+
+func (x *ListGCsResponse_GCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_clientrpc_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGCsResponse_GCInfo.ProtoReflect.Descriptor instead.
+func (*ListGCsResponse_GCInfo) Descriptor() ([]byte, []int) {
+	return file_clientrpc_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *ListGCsResponse_GCInfo) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListGCsResponse_GCInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListGCsResponse_GCInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ListGCsResponse_GCInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ListGCsResponse_GCInfo) GetNbMembers() uint32 {
+	if x != nil {
+		return x.NbMembers
+	}
+	return 0
+}
+
+var File_clientrpc_proto protoreflect.FileDescriptor
+
+var file_clientrpc_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x34, 0x0a, 0x16, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x2e, 0x0a, 0x0e, 0x4b, 0x65, 0x65, 0x70, 0x61,
+	0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2d, 0x0a, 0x0a, 0x41, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x09, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x0c, 0x0a, 0x0a, 0x50,
+	0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0f, 0x50, 0x4d, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22,
+	0x9b, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x4d, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2e, 0x0a,
+	0x0a, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x0d, 0x0a,
+	0x0b, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x10,
+	0x47, 0x43, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x47, 0x43, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x63, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x63, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x2d, 0x0a,
+	0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x54, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
+	0x12, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x21, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x22, 0x3d, 0x0a, 0x18, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x22, 0xae, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e,
+	0x69, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0x3c, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x47, 0x0a, 0x17, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x48, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x09, 0x50,
+	0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x14,
+	0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x39, 0x0a, 0x15, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66,
+	0x74, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a,
+	0x17, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x66, 0x0a, 0x0e,
+	0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x63, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x63, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x13, 0x0a, 0x11, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0f, 0x4b, 0x58, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75,
+	0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x4b,
+	0x58, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63,
+	0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6e,
+	0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73,
+	0x22, 0x48, 0x0a, 0x15, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x4f, 0x42, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x38, 0x0a, 0x13, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x4f,
+	0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x37, 0x0a,
+	0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x67, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x15,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x0f,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x12, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x65, 0x78, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x78, 0x55, 0x69,
+	0x64, 0x22, 0x26, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x22, 0x4f, 0x0a, 0x11, 0x4b, 0x69, 0x63,
+	0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x4b, 0x69,
+	0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x67, 0x63,
+	0x22, 0x2d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22,
+	0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x67, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x67, 0x63, 0x73,
+	0x1a, 0x83, 0x01, 0x0a, 0x06, 0x47, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x62, 0x5f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x62, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x52,
+	0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e,
+	0x69, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22,
+	0x3a, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x83, 0x01, 0x0a, 0x13,
+	0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x67, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22,
+	0x85, 0x01, 0x0a, 0x15, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x67, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x63,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4e, 0x69, 0x63, 0x6b,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x65,
+	0x64, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x4e,
+	0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52,
+	0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x02, 0x67, 0x63, 0x22, 0x37,
+	0x0a, 0x12, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xf6, 0x01, 0x0a, 0x10, 0x54, 0x69, 0x70, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x69, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61,
+	0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x45, 0x72,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x22, 0xfb, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61,
+	0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8b,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x16,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4a, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3f, 0x0a, 0x1b, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x1c,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1c, 0x44, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x41, 0x0a, 0x1c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x7d, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x22, 0xdb, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xae, 0x01, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x63, 0x72, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x63,
+	0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x65,
+	0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x1e, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x22, 0x94, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x22, 0x99, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61,
+	0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f,
+	0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x65,
+	0x65, 0x4d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x63,
+	0x72, 0x5f, 0x75, 0x73, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x64, 0x63, 0x72, 0x55, 0x73, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x86, 0x01,
+	0x0a, 0x13, 0x54, 0x69, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x67, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x63, 0x72, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x63, 0x72, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x54, 0x69, 0x70, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x43,
+	0x54, 0x69, 0x70, 0x52, 0x03, 0x74, 0x69, 0x70, 0x22, 0x6e, 0x0a, 0x0b, 0x47, 0x43, 0x54, 0x69,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xcb, 0x01, 0x0a, 0x05, 0x47, 0x43, 0x54,
+	0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x67, 0x63, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x61,
+	0x74, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x41, 0x74, 0x6f, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x26,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x47, 0x43, 0x54, 0x69, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x1a, 0x47, 0x43, 0x54, 0x69, 0x70, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x47, 0x43, 0x54, 0x69,
+	0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x47, 0x43,
+	0x54, 0x69, 0x70, 0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x69, 0x64, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x41, 0x74, 0x6f,
+	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x2a, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x16, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x31, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xb3, 0x01, 0x0a, 0x19,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x6b,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a,
+	0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x4e, 0x0a, 0x10, 0x52, 0x4d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0xa6, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x69, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x4f, 0x4f,
+	0x42, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a,
+	0x76, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x72, 0x65,
+	0x6e, 0x64, 0x65, 0x7a, 0x76, 0x6f, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x9f, 0x01, 0x0a,
+	0x0d, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc7,
+	0x01, 0x0a, 0x0b, 0x52, 0x4d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0f, 0x52, 0x4d, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x14,
+	0x52, 0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x52,
+	0x4d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c,
+	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x87, 0x03, 0x0a, 0x0c, 0x46,
+	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a,
+	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x10, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x75, 0x6e, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x65, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x6f, 0x6d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x2a, 0x3b, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x10, 0x01, 0x32,
+	0x7d, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x2c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0f, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x17, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x4b, 0x65,
+	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0xb2,
+	0x05, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39,
+	0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x02, 0x50, 0x4d, 0x12,
+	0x0a, 0x2e, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x50, 0x4d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x50, 0x4d, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x50, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x4d, 0x30, 0x01, 0x12, 0x2a, 0x0a, 0x0d, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x50, 0x4d, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x03, 0x47, 0x43, 0x4d, 0x12, 0x0b, 0x2e, 0x47, 0x43, 0x4d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x47, 0x43, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x47, 0x43, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x11, 0x2e, 0x47, 0x43, 0x4d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x43, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x4d, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b, 0x58, 0x12,
+	0x11, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4b, 0x58, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x4b, 0x58, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x10, 0x2e, 0x4b, 0x58, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4b, 0x58, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x4b, 0x58, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x12, 0x10,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xc8, 0x05, 0x0a, 0x09, 0x47, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x43, 0x12,
+	0x12, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x47, 0x43,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x47, 0x43, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x4b,
+	0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x12, 0x12, 0x2e, 0x4b, 0x69, 0x63, 0x6b,
+	0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x4b, 0x69, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x47, 0x43, 0x12, 0x0d, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x43, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x14, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x47, 0x43, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x16, 0x2e,
+	0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2c, 0x0a,
+	0x0f, 0x41, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x2e,
+	0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x43, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x2e, 0x0a, 0x11, 0x41, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x12, 0x11,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x43, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x29, 0x0a, 0x0c, 0x41, 0x63, 0x6b, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x47, 0x43, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb1,
+	0x07, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x47, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x30, 0x01, 0x12, 0x2c, 0x0a, 0x0f,
+	0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x19, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x30,
+	0x01, 0x12, 0x32, 0x0a, 0x15, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10,
+	0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x15, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x73, 0x12, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x18, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xc4, 0x0b, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0f, 0x2e, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x54, 0x69, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x13, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2b, 0x0a,
+	0x0e, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x54, 0x69,
+	0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x70, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63,
+	0x6b, 0x54, 0x69, 0x70, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x44, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x16, 0x41, 0x63, 0x6b,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1f, 0x2e,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x18, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x0c, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x14, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x63, 0x6b, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x54, 0x69, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68,
+	0x61, 0x74, 0x12, 0x14, 0x2e, 0x54, 0x69, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x54, 0x69, 0x70, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x13, 0x47, 0x43, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x47, 0x43, 0x54, 0x69, 0x70, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x43, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x41, 0x63,
+	0x6b, 0x47, 0x43, 0x54, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0b,
+	0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb3, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53,
+	0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1e, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x9f, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x31,
+	0x0a, 0x14, 0x41, 0x63, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x7a, 0x65, 0x72, 0x6f, 0x2f, 0x62, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_clientrpc_proto_rawDescOnce sync.Once
+	file_clientrpc_proto_rawDescData = file_clientrpc_proto_rawDesc
+)
+
+func file_clientrpc_proto_rawDescGZIP() []byte {
+	file_clientrpc_proto_rawDescOnce.Do(func() {
+		file_clientrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_clientrpc_proto_rawDescData)
+	})
+	return file_clientrpc_proto_rawDescData
+}
+
+var file_clientrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_clientrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
+var file_clientrpc_proto_goTypes = []interface{}{
+	(MessageMode)(0),                        // 0: MessageMode
+	(*VersionRequest)(nil),                  // 1: VersionRequest
+	(*VersionResponse)(nil),                 // 2: VersionResponse
+	(*KeepaliveStreamRequest)(nil),          // 3: KeepaliveStreamRequest
+	(*KeepaliveEvent)(nil),                  // 4: KeepaliveEvent
+	(*AckRequest)(nil),                      // 5: AckRequest
+	(*AckResponse)(nil),                     // 6: AckResponse
+	(*PMRequest)(nil),                       // 7: PMRequest
+	(*PMResponse)(nil),                      // 8: PMResponse
+	(*PMStreamRequest)(nil),                 // 9: PMStreamRequest
+	(*ReceivedPM)(nil),                      // 10: ReceivedPM
+	(*GCMRequest)(nil),                      // 11: GCMRequest
+	(*GCMResponse)(nil),                     // 12: GCMResponse
+	(*GCMStreamRequest)(nil),                // 13: GCMStreamRequest
+	(*GCReceivedMsg)(nil),                   // 14: GCReceivedMsg
+	(*SubscribeToPostsRequest)(nil),         // 15: SubscribeToPostsRequest
+	(*SubscribeToPostsResponse)(nil),        // 16: SubscribeToPostsResponse
+	(*UnsubscribeToPostsRequest)(nil),       // 17: UnsubscribeToPostsRequest
+	(*UnsubscribeToPostsResponse)(nil),      // 18: UnsubscribeToPostsResponse
+	(*PostSummary)(nil),                     // 19: PostSummary
+	(*PostsStreamRequest)(nil),              // 20: PostsStreamRequest
+	(*ReceivedPost)(nil),                    // 21: ReceivedPost
+	(*PostsStatusStreamRequest)(nil),        // 22: PostsStatusStreamRequest
+	(*ReceivedPostStatus)(nil),              // 23: ReceivedPostStatus
+	(*CreatePostRequest)(nil),               // 24: CreatePostRequest
+	(*CreatePostResponse)(nil),              // 25: CreatePostResponse
+	(*PostAudience)(nil),                    // 26: PostAudience
+	(*SavePostAudienceRequest)(nil),         // 27: SavePostAudienceRequest
+	(*SavePostAudienceResponse)(nil),        // 28: SavePostAudienceResponse
+	(*RemovePostAudienceRequest)(nil),       // 29: RemovePostAudienceRequest
+	(*RemovePostAudienceResponse)(nil),      // 30: RemovePostAudienceResponse
+	(*ListPostAudiencesRequest)(nil),        // 31: ListPostAudiencesRequest
+	(*ListPostAudiencesResponse)(nil),       // 32: ListPostAudiencesResponse
+	(*PostDraft)(nil),                       // 33: PostDraft
+	(*SavePostDraftRequest)(nil),            // 34: SavePostDraftRequest
+	(*SavePostDraftResponse)(nil),           // 35: SavePostDraftResponse
+	(*ListPostDraftsRequest)(nil),           // 36: ListPostDraftsRequest
+	(*ListPostDraftsResponse)(nil),          // 37: ListPostDraftsResponse
+	(*RemovePostDraftRequest)(nil),          // 38: RemovePostDraftRequest
+	(*RemovePostDraftResponse)(nil),         // 39: RemovePostDraftResponse
+	(*PublishPostDraftRequest)(nil),         // 40: PublishPostDraftRequest
+	(*PublishPostDraftResponse)(nil),        // 41: PublishPostDraftResponse
+	(*TipUserRequest)(nil),                  // 42: TipUserRequest
+	(*TipUserResponse)(nil),                 // 43: TipUserResponse
+	(*MediateKXRequest)(nil),                // 44: MediateKXRequest
+	(*MediateKXResponse)(nil),               // 45: MediateKXResponse
+	(*KXStreamRequest)(nil),                 // 46: KXStreamRequest
+	(*KXCompleted)(nil),                     // 47: KXCompleted
+	(*WriteNewInviteRequest)(nil),           // 48: WriteNewInviteRequest
+	(*WriteNewInviteResponse)(nil),          // 49: WriteNewInviteResponse
+	(*AcceptInviteRequest)(nil),             // 50: AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),            // 51: AcceptInviteResponse
+	(*InviteToGCRequest)(nil),               // 52: InviteToGCRequest
+	(*InviteToGCResponse)(nil),              // 53: InviteToGCResponse
+	(*AcceptGCInviteRequest)(nil),           // 54: AcceptGCInviteRequest
+	(*AcceptGCInviteResponse)(nil),          // 55: AcceptGCInviteResponse
+	(*SendFileRequest)(nil),                 // 56: SendFileRequest
+	(*SendFileResponse)(nil),                // 57: SendFileResponse
+	(*UserNickRequest)(nil),                 // 58: UserNickRequest
+	(*UserNickResponse)(nil),                // 59: UserNickResponse
+	(*KickFromGCRequest)(nil),               // 60: KickFromGCRequest
+	(*KickFromGCResponse)(nil),              // 61: KickFromGCResponse
+	(*GetGCRequest)(nil),                    // 62: GetGCRequest
+	(*GetGCResponse)(nil),                   // 63: GetGCResponse
+	(*ListGCsRequest)(nil),                  // 64: ListGCsRequest
+	(*ListGCsResponse)(nil),                 // 65: ListGCsResponse
+	(*ReceivedGCInvitesRequest)(nil),        // 66: ReceivedGCInvitesRequest
+	(*ReceivedGCInvite)(nil),                // 67: ReceivedGCInvite
+	(*UserAndNick)(nil),                     // 68: UserAndNick
+	(*GCMembersAddedRequest)(nil),           // 69: GCMembersAddedRequest
+	(*GCMembersAddedEvent)(nil),             // 70: GCMembersAddedEvent
+	(*GCMembersRemovedRequest)(nil),         // 71: GCMembersRemovedRequest
+	(*GCMembersRemovedEvent)(nil),           // 72: GCMembersRemovedEvent
+	(*JoinedGCsRequest)(nil),                // 73: JoinedGCsRequest
+	(*JoinedGCEvent)(nil),                   // 74: JoinedGCEvent
+	(*TipProgressRequest)(nil),              // 75: TipProgressRequest
+	(*TipProgressEvent)(nil),                // 76: TipProgressEvent
+	(*PaymentRequest)(nil),                  // 77: PaymentRequest
+	(*RequestPaymentRequest)(nil),           // 78: RequestPaymentRequest
+	(*RequestPaymentResponse)(nil),          // 79: RequestPaymentResponse
+	(*ListPaymentRequestsRequest)(nil),      // 80: ListPaymentRequestsRequest
+	(*ListPaymentRequestsResponse)(nil),     // 81: ListPaymentRequestsResponse
+	(*AcceptPaymentRequestRequest)(nil),     // 82: AcceptPaymentRequestRequest
+	(*AcceptPaymentRequestResponse)(nil),    // 83: AcceptPaymentRequestResponse
+	(*DeclinePaymentRequestRequest)(nil),    // 84: DeclinePaymentRequestRequest
+	(*DeclinePaymentRequestResponse)(nil),   // 85: DeclinePaymentRequestResponse
+	(*PaymentRequestsStreamRequest)(nil),    // 86: PaymentRequestsStreamRequest
+	(*PaymentRequestEvent)(nil),             // 87: PaymentRequestEvent
+	(*RecurringPayment)(nil),                // 88: RecurringPayment
+	(*CreateRecurringPaymentRequest)(nil),   // 89: CreateRecurringPaymentRequest
+	(*CreateRecurringPaymentResponse)(nil),  // 90: CreateRecurringPaymentResponse
+	(*ListRecurringPaymentsRequest)(nil),    // 91: ListRecurringPaymentsRequest
+	(*ListRecurringPaymentsResponse)(nil),   // 92: ListRecurringPaymentsResponse
+	(*CancelRecurringPaymentRequest)(nil),   // 93: CancelRecurringPaymentRequest
+	(*CancelRecurringPaymentResponse)(nil),  // 94: CancelRecurringPaymentResponse
+	(*ListTierMembersRequest)(nil),          // 95: ListTierMembersRequest
+	(*ListTierMembersResponse)(nil),         // 96: ListTierMembersResponse
+	(*RecurringPaymentsStreamRequest)(nil),  // 97: RecurringPaymentsStreamRequest
+	(*RecurringPaymentEvent)(nil),           // 98: RecurringPaymentEvent
+	(*LedgerStreamRequest)(nil),             // 99: LedgerStreamRequest
+	(*LedgerEntry)(nil),                     // 100: LedgerEntry
+	(*TipGroupChatRequest)(nil),             // 101: TipGroupChatRequest
+	(*TipGroupChatResponse)(nil),            // 102: TipGroupChatResponse
+	(*GCTipMember)(nil),                     // 103: GCTipMember
+	(*GCTip)(nil),                           // 104: GCTip
+	(*GCTipProgressStreamRequest)(nil),      // 105: GCTipProgressStreamRequest
+	(*GCTipProgressEvent)(nil),              // 106: GCTipProgressEvent
+	(*ResourceRequestsStreamRequest)(nil),   // 107: ResourceRequestsStreamRequest
+	(*ResourceRequestsStreamResponse)(nil),  // 108: ResourceRequestsStreamResponse
+	(*FulfillResourceRequest)(nil),          // 109: FulfillResourceRequest
+	(*FulfillResourceRequestResponse)(nil),  // 110: FulfillResourceRequestResponse
+	(*DownloadsCompletedStreamRequest)(nil), // 111: DownloadsCompletedStreamRequest
+	(*DownloadCompletedResponse)(nil),       // 112: DownloadCompletedResponse
+	(*RMPrivateMessage)(nil),                // 113: RMPrivateMessage
+	(*RMGroupMessage)(nil),                  // 114: RMGroupMessage
+	(*PostMetadata)(nil),                    // 115: PostMetadata
+	(*PostMetadataStatus)(nil),              // 116: PostMetadataStatus
+	(*PublicIdentityReq)(nil),               // 117: PublicIdentityReq
+	(*PublicIdentity)(nil),                  // 118: PublicIdentity
+	(*InviteFunds)(nil),                     // 119: InviteFunds
+	(*OOBPublicIdentityInvite)(nil),         // 120: OOBPublicIdentityInvite
+	(*RMGroupInvite)(nil),                   // 121: RMGroupInvite
+	(*RMGroupList)(nil),                     // 122: RMGroupList
+	(*RMFetchResource)(nil),                 // 123: RMFetchResource
+	(*RMFetchResourceReply)(nil),            // 124: RMFetchResourceReply
+	(*FileManifest)(nil),                    // 125: FileManifest
+	(*FileMetadata)(nil),                    // 126: FileMetadata
+	(*TipStreamRequest)(nil),                // 127: TipStreamRequest
+	(*ReceivedTip)(nil),                     // 128: ReceivedTip
+	(*ListGCsResponse_GCInfo)(nil),          // 129: ListGCsResponse.GCInfo
+	nil,                                     // 130: PostMetadata.AttributesEntry
+	nil,                                     // 131: PostMetadataStatus.AttributesEntry
+	nil,                                     // 132: RMFetchResource.MetaEntry
+	nil,                                     // 133: RMFetchResourceReply.MetaEntry
+	nil,                                     // 134: FileMetadata.AttributesEntry
+}
+var file_clientrpc_proto_depIdxs = []int32{
+	113, // 0: PMRequest.msg:type_name -> RMPrivateMessage
+	113, // 1: ReceivedPM.msg:type_name -> RMPrivateMessage
+	114, // 2: GCReceivedMsg.msg:type_name -> RMGroupMessage
+	19,  // 3: ReceivedPost.summary:type_name -> PostSummary
+	115, // 4: ReceivedPost.post:type_name -> PostMetadata
+	116, // 5: ReceivedPostStatus.status:type_name -> PostMetadataStatus
+	19,  // 6: CreatePostResponse.summary:type_name -> PostSummary
+	26,  // 7: ListPostAudiencesResponse.audiences:type_name -> PostAudience
+	33,  // 8: SavePostDraftRequest.draft:type_name -> PostDraft
+	33,  // 9: SavePostDraftResponse.draft:type_name -> PostDraft
+	33,  // 10: ListPostDraftsResponse.drafts:type_name -> PostDraft
+	19,  // 11: PublishPostDraftResponse.summary:type_name -> PostSummary
+	120, // 12: WriteNewInviteResponse.invite:type_name -> OOBPublicIdentityInvite
+	120, // 13: AcceptInviteResponse.invite:type_name -> OOBPublicIdentityInvite
+	122, // 14: GetGCResponse.gc:type_name -> RMGroupList
+	129, // 15: ListGCsResponse.gcs:type_name -> ListGCsResponse.GCInfo
+	121, // 16: ReceivedGCInvite.invite:type_name -> RMGroupInvite
+	68,  // 17: GCMembersAddedEvent.users:type_name -> UserAndNick
+	68,  // 18: GCMembersRemovedEvent.users:type_name -> UserAndNick
+	122, // 19: JoinedGCEvent.gc:type_name -> RMGroupList
+	77,  // 20: RequestPaymentResponse.request:type_name -> PaymentRequest
+	77,  // 21: ListPaymentRequestsResponse.requests:type_name -> PaymentRequest
+	77,  // 22: AcceptPaymentRequestResponse.request:type_name -> PaymentRequest
+	77,  // 23: PaymentRequestEvent.request:type_name -> PaymentRequest
+	88,  // 24: CreateRecurringPaymentResponse.recurring_payment:type_name -> RecurringPayment
+	88,  // 25: ListRecurringPaymentsResponse.recurring_payments:type_name -> RecurringPayment
+	88,  // 26: ListTierMembersResponse.members:type_name -> RecurringPayment
+	88,  // 27: RecurringPaymentEvent.recurring_payment:type_name -> RecurringPayment
+	104, // 28: TipGroupChatResponse.tip:type_name -> GCTip
+	103, // 29: GCTip.members:type_name -> GCTipMember
+	104, // 30: GCTipProgressEvent.tip:type_name -> GCTip
+	123, // 31: ResourceRequestsStreamResponse.request:type_name -> RMFetchResource
+	124, // 32: FulfillResourceRequest.response:type_name -> RMFetchResourceReply
+	126, // 33: DownloadCompletedResponse.file_metadata:type_name -> FileMetadata
+	0,   // 34: RMPrivateMessage.mode:type_name -> MessageMode
+	0,   // 35: RMGroupMessage.mode:type_name -> MessageMode
+	130, // 36: PostMetadata.attributes:type_name -> PostMetadata.AttributesEntry
+	131, // 37: PostMetadataStatus.attributes:type_name -> PostMetadataStatus.AttributesEntry
+	118, // 38: OOBPublicIdentityInvite.public:type_name -> PublicIdentity
+	119, // 39: OOBPublicIdentityInvite.funds:type_name -> InviteFunds
+	132, // 40: RMFetchResource.meta:type_name -> RMFetchResource.MetaEntry
+	133, // 41: RMFetchResourceReply.meta:type_name -> RMFetchResourceReply.MetaEntry
+	125, // 42: FileMetadata.manifest:type_name -> FileManifest
+	134, // 43: FileMetadata.attributes:type_name -> FileMetadata.AttributesEntry
+	1,   // 44: VersionService.Version:input_type -> VersionRequest
+	3,   // 45: VersionService.KeepaliveStream:input_type -> KeepaliveStreamRequest
+	117, // 46: ChatService.UserPublicIdentity:input_type -> PublicIdentityReq
+	7,   // 47: ChatService.PM:input_type -> PMRequest
+	9,   // 48: ChatService.PMStream:input_type -> PMStreamRequest
+	5,   // 49: ChatService.AckReceivedPM:input_type -> AckRequest
+	11,  // 50: ChatService.GCM:input_type -> GCMRequest
+	13,  // 51: ChatService.GCMStream:input_type -> GCMStreamRequest
+	5,   // 52: ChatService.AckReceivedGCM:input_type -> AckRequest
+	44,  // 53: ChatService.MediateKX:input_type -> MediateKXRequest
+	46,  // 54: ChatService.KXStream:input_type -> KXStreamRequest
+	5,   // 55: ChatService.AckKXCompleted:input_type -> AckRequest
+	48,  // 56: ChatService.WriteNewInvite:input_type -> WriteNewInviteRequest
+	50,  // 57: ChatService.AcceptInvite:input_type -> AcceptInviteRequest
+	56,  // 58: ChatService.SendFile:input_type -> SendFileRequest
+	58,  // 59: ChatService.UserNick:input_type -> UserNickRequest
+	52,  // 60: GCService.InviteToGC:input_type -> InviteToGCRequest
+	54,  // 61: GCService.AcceptGCInvite:input_type -> AcceptGCInviteRequest
+	60,  // 62: GCService.KickFromGC:input_type -> KickFromGCRequest
+	62,  // 63: GCService.GetGC:input_type -> GetGCRequest
+	64,  // 64: GCService.List:input_type -> ListGCsRequest
+	66,  // 65: GCService.ReceivedGCInvites:input_type -> ReceivedGCInvitesRequest
+	5,   // 66: GCService.AckReceivedGCInvites:input_type -> AckRequest
+	69,  // 67: GCService.MembersAdded:input_type -> GCMembersAddedRequest
+	5,   // 68: GCService.AckMembersAdded:input_type -> AckRequest
+	71,  // 69: GCService.MembersRemoved:input_type -> GCMembersRemovedRequest
+	5,   // 70: GCService.AckMembersRemoved:input_type -> AckRequest
+	73,  // 71: GCService.JoinedGCs:input_type -> JoinedGCsRequest
+	5,   // 72: GCService.AckJoinedGCs:input_type -> AckRequest
+	15,  // 73: PostsService.SubscribeToPosts:input_type -> SubscribeToPostsRequest
+	17,  // 74: PostsService.UnsubscribeToPosts:input_type -> UnsubscribeToPostsRequest
+	20,  // 75: PostsService.PostsStream:input_type -> PostsStreamRequest
+	5,   // 76: PostsService.AckReceivedPost:input_type -> AckRequest
+	22,  // 77: PostsService.PostsStatusStream:input_type -> PostsStatusStreamRequest
+	5,   // 78: PostsService.AckReceivedPostStatus:input_type -> AckRequest
+	24,  // 79: PostsService.CreatePost:input_type -> CreatePostRequest
+	27,  // 80: PostsService.SavePostAudience:input_type -> SavePostAudienceRequest
+	29,  // 81: PostsService.RemovePostAudience:input_type -> RemovePostAudienceRequest
+	31,  // 82: PostsService.ListPostAudiences:input_type -> ListPostAudiencesRequest
+	34,  // 83: PostsService.SavePostDraft:input_type -> SavePostDraftRequest
+	36,  // 84: PostsService.ListPostDrafts:input_type -> ListPostDraftsRequest
+	38,  // 85: PostsService.RemovePostDraft:input_type -> RemovePostDraftRequest
+	40,  // 86: PostsService.PublishPostDraft:input_type -> PublishPostDraftRequest
+	42,  // 87: PaymentsService.TipUser:input_type -> TipUserRequest
+	75,  // 88: PaymentsService.TipProgress:input_type -> TipProgressRequest
+	5,   // 89: PaymentsService.AckTipProgress:input_type -> AckRequest
+	127, // 90: PaymentsService.TipStream:input_type -> TipStreamRequest
+	5,   // 91: PaymentsService.AckTipReceived:input_type -> AckRequest
+	78,  // 92: PaymentsService.RequestPayment:input_type -> RequestPaymentRequest
+	80,  // 93: PaymentsService.ListPaymentRequests:input_type -> ListPaymentRequestsRequest
+	82,  // 94: PaymentsService.AcceptPaymentRequest:input_type -> AcceptPaymentRequestRequest
+	84,  // 95: PaymentsService.DeclinePaymentRequest:input_type -> DeclinePaymentRequestRequest
+	86,  // 96: PaymentsService.PaymentRequestsStream:input_type -> PaymentRequestsStreamRequest
+	5,   // 97: PaymentsService.AckPaymentRequestEvent:input_type -> AckRequest
+	89,  // 98: PaymentsService.CreateRecurringPayment:input_type -> CreateRecurringPaymentRequest
+	91,  // 99: PaymentsService.ListRecurringPayments:input_type -> ListRecurringPaymentsRequest
+	93,  // 100: PaymentsService.CancelRecurringPayment:input_type -> CancelRecurringPaymentRequest
+	95,  // 101: PaymentsService.ListTierMembers:input_type -> ListTierMembersRequest
+	97,  // 102: PaymentsService.RecurringPaymentsStream:input_type -> RecurringPaymentsStreamRequest
+	5,   // 103: PaymentsService.AckRecurringPaymentEvent:input_type -> AckRequest
+	99,  // 104: PaymentsService.LedgerStream:input_type -> LedgerStreamRequest
+	5,   // 105: PaymentsService.AckLedgerEntry:input_type -> AckRequest
+	101, // 106: PaymentsService.TipGroupChat:input_type -> TipGroupChatRequest
+	105, // 107: PaymentsService.GCTipProgressStream:input_type -> GCTipProgressStreamRequest
+	5,   // 108: PaymentsService.AckGCTipProgress:input_type -> AckRequest
+	107, // 109: ResourcesService.RequestsStream:input_type -> ResourceRequestsStreamRequest
+	109, // 110: ResourcesService.FulfillRequest:input_type -> FulfillResourceRequest
+	111, // 111: ContentService.DownloadsCompletedStream:input_type -> DownloadsCompletedStreamRequest
+	5,   // 112: ContentService.AckDownloadCompleted:input_type -> AckRequest
+	2,   // 113: VersionService.Version:output_type -> VersionResponse
+	4,   // 114: VersionService.KeepaliveStream:output_type -> KeepaliveEvent
+	118, // 115: ChatService.UserPublicIdentity:output_type -> PublicIdentity
+	8,   // 116: ChatService.PM:output_type -> PMResponse
+	10,  // 117: ChatService.PMStream:output_type -> ReceivedPM
+	6,   // 118: ChatService.AckReceivedPM:output_type -> AckResponse
+	12,  // 119: ChatService.GCM:output_type -> GCMResponse
+	14,  // 120: ChatService.GCMStream:output_type -> GCReceivedMsg
+	6,   // 121: ChatService.AckReceivedGCM:output_type -> AckResponse
+	45,  // 122: ChatService.MediateKX:output_type -> MediateKXResponse
+	47,  // 123: ChatService.KXStream:output_type -> KXCompleted
+	6,   // 124: ChatService.AckKXCompleted:output_type -> AckResponse
+	49,  // 125: ChatService.WriteNewInvite:output_type -> WriteNewInviteResponse
+	51,  // 126: ChatService.AcceptInvite:output_type -> AcceptInviteResponse
+	57,  // 127: ChatService.SendFile:output_type -> SendFileResponse
+	59,  // 128: ChatService.UserNick:output_type -> UserNickResponse
+	53,  // 129: GCService.InviteToGC:output_type -> InviteToGCResponse
+	55,  // 130: GCService.AcceptGCInvite:output_type -> AcceptGCInviteResponse
+	61,  // 131: GCService.KickFromGC:output_type -> KickFromGCResponse
+	63,  // 132: GCService.GetGC:output_type -> GetGCResponse
+	65,  // 133: GCService.List:output_type -> ListGCsResponse
+	67,  // 134: GCService.ReceivedGCInvites:output_type -> ReceivedGCInvite
+	6,   // 135: GCService.AckReceivedGCInvites:output_type -> AckResponse
+	70,  // 136: GCService.MembersAdded:output_type -> GCMembersAddedEvent
+	6,   // 137: GCService.AckMembersAdded:output_type -> AckResponse
+	72,  // 138: GCService.MembersRemoved:output_type -> GCMembersRemovedEvent
+	6,   // 139: GCService.AckMembersRemoved:output_type -> AckResponse
+	74,  // 140: GCService.JoinedGCs:output_type -> JoinedGCEvent
+	6,   // 141: GCService.AckJoinedGCs:output_type -> AckResponse
+	16,  // 142: PostsService.SubscribeToPosts:output_type -> SubscribeToPostsResponse
+	18,  // 143: PostsService.UnsubscribeToPosts:output_type -> UnsubscribeToPostsResponse
+	21,  // 144: PostsService.PostsStream:output_type -> ReceivedPost
+	6,   // 145: PostsService.AckReceivedPost:output_type -> AckResponse
+	23,  // 146: PostsService.PostsStatusStream:output_type -> ReceivedPostStatus
+	6,   // 147: PostsService.AckReceivedPostStatus:output_type -> AckResponse
+	25,  // 148: PostsService.CreatePost:output_type -> CreatePostResponse
+	28,  // 149: PostsService.SavePostAudience:output_type -> SavePostAudienceResponse
+	30,  // 150: PostsService.RemovePostAudience:output_type -> RemovePostAudienceResponse
+	32,  // 151: PostsService.ListPostAudiences:output_type -> ListPostAudiencesResponse
+	35,  // 152: PostsService.SavePostDraft:output_type -> SavePostDraftResponse
+	37,  // 153: PostsService.ListPostDrafts:output_type -> ListPostDraftsResponse
+	39,  // 154: PostsService.RemovePostDraft:output_type -> RemovePostDraftResponse
+	41,  // 155: PostsService.PublishPostDraft:output_type -> PublishPostDraftResponse
+	43,  // 156: PaymentsService.TipUser:output_type -> TipUserResponse
+	76,  // 157: PaymentsService.TipProgress:output_type -> TipProgressEvent
+	6,   // 158: PaymentsService.AckTipProgress:output_type -> AckResponse
+	128, // 159: PaymentsService.TipStream:output_type -> ReceivedTip
+	6,   // 160: PaymentsService.AckTipReceived:output_type -> AckResponse
+	79,  // 161: PaymentsService.RequestPayment:output_type -> RequestPaymentResponse
+	81,  // 162: PaymentsService.ListPaymentRequests:output_type -> ListPaymentRequestsResponse
+	83,  // 163: PaymentsService.AcceptPaymentRequest:output_type -> AcceptPaymentRequestResponse
+	85,  // 164: PaymentsService.DeclinePaymentRequest:output_type -> DeclinePaymentRequestResponse
+	87,  // 165: PaymentsService.PaymentRequestsStream:output_type -> PaymentRequestEvent
+	6,   // 166: PaymentsService.AckPaymentRequestEvent:output_type -> AckResponse
+	90,  // 167: PaymentsService.CreateRecurringPayment:output_type -> CreateRecurringPaymentResponse
+	92,  // 168: PaymentsService.ListRecurringPayments:output_type -> ListRecurringPaymentsResponse
+	94,  // 169: PaymentsService.CancelRecurringPayment:output_type -> CancelRecurringPaymentResponse
+	96,  // 170: PaymentsService.ListTierMembers:output_type -> ListTierMembersResponse
+	98,  // 171: PaymentsService.RecurringPaymentsStream:output_type -> RecurringPaymentEvent
+	6,   // 172: PaymentsService.AckRecurringPaymentEvent:output_type -> AckResponse
+	100, // 173: PaymentsService.LedgerStream:output_type -> LedgerEntry
+	6,   // 174: PaymentsService.AckLedgerEntry:output_type -> AckResponse
+	102, // 175: PaymentsService.TipGroupChat:output_type -> TipGroupChatResponse
+	106, // 176: PaymentsService.GCTipProgressStream:output_type -> GCTipProgressEvent
+	6,   // 177: PaymentsService.AckGCTipProgress:output_type -> AckResponse
+	108, // 178: ResourcesService.RequestsStream:output_type -> ResourceRequestsStreamResponse
+	110, // 179: ResourcesService.FulfillRequest:output_type -> FulfillResourceRequestResponse
+	112, // 180: ContentService.DownloadsCompletedStream:output_type -> DownloadCompletedResponse
+	6,   // 181: ContentService.AckDownloadCompleted:output_type -> AckResponse
+	113, // [113:182] is the sub-list for method output_type
+	44,  // [44:113] is the sub-list for method input_type
+	44,  // [44:44] is the sub-list for extension type_name
+	44,  // [44:44] is the sub-list for extension extendee
+	0,   // [0:44] is the sub-list for field type_name
+}
+
+func init() { file_clientrpc_proto_init() }
+func file_clientrpc_proto_init() {
+	if File_clientrpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_clientrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepaliveStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepaliveEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PMStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCReceivedMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeToPostsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeToPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostsStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostsStatusStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedPostStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAudience); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostAudienceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePostAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePostAudienceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostAudiencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostAudiencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostDraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostDraftsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPostDraftsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePostDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePostDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishPostDraftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishPostDraftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediateKXRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5991,8 +10052,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+		file_clientrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediateKXResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6003,8 +10064,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeepaliveStreamRequest); i {
+		file_clientrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KXStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6015,8 +10076,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeepaliveEvent); i {
+		file_clientrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KXCompleted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6027,8 +10088,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckRequest); i {
+		file_clientrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteNewInviteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6039,8 +10100,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckResponse); i {
+		file_clientrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteNewInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6051,8 +10112,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMRequest); i {
+		file_clientrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6063,8 +10124,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMResponse); i {
+		file_clientrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6075,8 +10136,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PMStreamRequest); i {
+		file_clientrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6087,8 +10148,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPM); i {
+		file_clientrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteToGCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6099,8 +10160,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMRequest); i {
+		file_clientrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGCInviteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6111,8 +10172,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMResponse); i {
+		file_clientrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptGCInviteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6123,8 +10184,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMStreamRequest); i {
+		file_clientrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6135,8 +10196,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCReceivedMsg); i {
+		file_clientrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6147,8 +10208,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToPostsRequest); i {
+		file_clientrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserNickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6159,8 +10220,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToPostsResponse); i {
+		file_clientrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserNickResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6171,8 +10232,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeToPostsRequest); i {
+		file_clientrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickFromGCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6183,8 +10244,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeToPostsResponse); i {
+		file_clientrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickFromGCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6195,8 +10256,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostSummary); i {
+		file_clientrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGCRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6207,8 +10268,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostsStreamRequest); i {
+		file_clientrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGCResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6219,8 +10280,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPost); i {
+		file_clientrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGCsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6231,8 +10292,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PostsStatusStreamRequest); i {
+		file_clientrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGCsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6243,8 +10304,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedPostStatus); i {
+		file_clientrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedGCInvitesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6255,8 +10316,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipUserRequest); i {
+		file_clientrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedGCInvite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6267,8 +10328,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipUserResponse); i {
+		file_clientrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAndNick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6279,8 +10340,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediateKXRequest); i {
+		file_clientrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersAddedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6291,8 +10352,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediateKXResponse); i {
+		file_clientrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersAddedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6303,8 +10364,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KXStreamRequest); i {
+		file_clientrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersRemovedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6315,8 +10376,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KXCompleted); i {
+		file_clientrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCMembersRemovedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6327,8 +10388,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteNewInviteRequest); i {
+		file_clientrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinedGCsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6339,8 +10400,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteNewInviteResponse); i {
+		file_clientrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinedGCEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6351,8 +10412,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteRequest); i {
+		file_clientrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6363,8 +10424,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteResponse); i {
+		file_clientrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipProgressEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6375,8 +10436,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToGCRequest); i {
+		file_clientrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6387,8 +10448,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteToGCResponse); i {
+		file_clientrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6399,8 +10460,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptGCInviteRequest); i {
+		file_clientrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6411,8 +10472,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptGCInviteResponse); i {
+		file_clientrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6423,8 +10484,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFileRequest); i {
+		file_clientrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6435,8 +10496,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFileResponse); i {
+		file_clientrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPaymentRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6447,8 +10508,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserNickRequest); i {
+		file_clientrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPaymentRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6459,8 +10520,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserNickResponse); i {
+		file_clientrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePaymentRequestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6471,8 +10532,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickFromGCRequest); i {
+		file_clientrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePaymentRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6483,8 +10544,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickFromGCResponse); i {
+		file_clientrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentRequestsStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6495,8 +10556,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGCRequest); i {
+		file_clientrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentRequestEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6507,8 +10568,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGCResponse); i {
+		file_clientrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringPayment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6519,8 +10580,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGCsRequest); i {
+		file_clientrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6531,8 +10592,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGCsResponse); i {
+		file_clientrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecurringPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6543,8 +10604,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedGCInvitesRequest); i {
+		file_clientrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecurringPaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6555,8 +10616,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceivedGCInvite); i {
+		file_clientrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecurringPaymentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6567,8 +10628,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAndNick); i {
+		file_clientrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecurringPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6579,8 +10640,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersAddedRequest); i {
+		file_clientrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecurringPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6591,8 +10652,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersAddedEvent); i {
+		file_clientrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTierMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6603,8 +10664,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersRemovedRequest); i {
+		file_clientrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTierMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6615,8 +10676,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCMembersRemovedEvent); i {
+		file_clientrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringPaymentsStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6627,8 +10688,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinedGCsRequest); i {
+		file_clientrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringPaymentEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6639,8 +10700,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinedGCEvent); i {
+		file_clientrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6651,8 +10712,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipProgressRequest); i {
+		file_clientrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6663,8 +10724,8 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TipProgressEvent); i {
+		file_clientrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipGroupChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6675,7 +10736,67 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TipGroupChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCTipMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCTip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCTipProgressStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GCTipProgressEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourceRequestsStreamRequest); i {
 			case 0:
 				return &v.state
@@ -6687,7 +10808,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResourceRequestsStreamResponse); i {
 			case 0:
 				return &v.state
@@ -6699,7 +10820,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FulfillResourceRequest); i {
 			case 0:
 				return &v.state
@@ -6711,7 +10832,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FulfillResourceRequestResponse); i {
 			case 0:
 				return &v.state
@@ -6723,7 +10844,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadsCompletedStreamRequest); i {
 			case 0:
 				return &v.state
@@ -6735,7 +10856,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadCompletedResponse); i {
 			case 0:
 				return &v.state
@@ -6747,7 +10868,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMPrivateMessage); i {
 			case 0:
 				return &v.state
@@ -6759,7 +10880,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMGroupMessage); i {
 			case 0:
 				return &v.state
@@ -6771,7 +10892,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostMetadata); i {
 			case 0:
 				return &v.state
@@ -6783,7 +10904,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostMetadataStatus); i {
 			case 0:
 				return &v.state
@@ -6795,7 +10916,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublicIdentityReq); i {
 			case 0:
 				return &v.state
@@ -6807,7 +10928,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublicIdentity); i {
 			case 0:
 				return &v.state
@@ -6819,7 +10940,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InviteFunds); i {
 			case 0:
 				return &v.state
@@ -6831,7 +10952,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OOBPublicIdentityInvite); i {
 			case 0:
 				return &v.state
@@ -6843,7 +10964,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMGroupInvite); i {
 			case 0:
 				return &v.state
@@ -6855,7 +10976,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMGroupList); i {
 			case 0:
 				return &v.state
@@ -6867,7 +10988,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMFetchResource); i {
 			case 0:
 				return &v.state
@@ -6879,7 +11000,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RMFetchResourceReply); i {
 			case 0:
 				return &v.state
@@ -6891,7 +11012,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileManifest); i {
 			case 0:
 				return &v.state
@@ -6903,7 +11024,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileMetadata); i {
 			case 0:
 				return &v.state
@@ -6915,7 +11036,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TipStreamRequest); i {
 			case 0:
 				return &v.state
@@ -6927,7 +11048,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReceivedTip); i {
 			case 0:
 				return &v.state
@@ -6939,7 +11060,7 @@ func file_clientrpc_proto_init() {
 				return nil
 			}
 		}
-		file_clientrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_clientrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListGCsResponse_GCInfo); i {
 			case 0:
 				return &v.state
@@ -6958,7 +11079,7 @@ func file_clientrpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_clientrpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   86,
+			NumMessages:   134,
 			NumExtensions: 0,
 			NumServices:   7,
 		},
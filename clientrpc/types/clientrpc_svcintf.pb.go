@@ -933,6 +933,26 @@ type PostsServiceClient interface {
 	// AckReceivedPostStatus acknowledges post status received up to a given
 	// sequence_id have been processed.
 	AckReceivedPostStatus(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// CreatePost creates a new post and shares it with the post subscribers
+	// (or the subset of them in the specified audience).
+	CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error
+	// SavePostAudience creates or replaces a named audience of post
+	// subscribers.
+	SavePostAudience(ctx context.Context, in *SavePostAudienceRequest, out *SavePostAudienceResponse) error
+	// RemovePostAudience removes a post audience.
+	RemovePostAudience(ctx context.Context, in *RemovePostAudienceRequest, out *RemovePostAudienceResponse) error
+	// ListPostAudiences lists the existing post audiences.
+	ListPostAudiences(ctx context.Context, in *ListPostAudiencesRequest, out *ListPostAudiencesResponse) error
+	// SavePostDraft creates or updates a post draft. Drafts with a publish_at
+	// time are published by the client once that time is reached.
+	SavePostDraft(ctx context.Context, in *SavePostDraftRequest, out *SavePostDraftResponse) error
+	// ListPostDrafts lists the existing post drafts.
+	ListPostDrafts(ctx context.Context, in *ListPostDraftsRequest, out *ListPostDraftsResponse) error
+	// RemovePostDraft removes a post draft.
+	RemovePostDraft(ctx context.Context, in *RemovePostDraftRequest, out *RemovePostDraftResponse) error
+	// PublishPostDraft publishes a post draft as a new post and removes the
+	// draft.
+	PublishPostDraft(ctx context.Context, in *PublishPostDraftRequest, out *PublishPostDraftResponse) error
 }
 
 type client_PostsService struct {
@@ -986,6 +1006,46 @@ func (c *client_PostsService) AckReceivedPostStatus(ctx context.Context, in *Ack
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+func (c *client_PostsService) CreatePost(ctx context.Context, in *CreatePostRequest, out *CreatePostResponse) error {
+	const method = "CreatePost"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) SavePostAudience(ctx context.Context, in *SavePostAudienceRequest, out *SavePostAudienceResponse) error {
+	const method = "SavePostAudience"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) RemovePostAudience(ctx context.Context, in *RemovePostAudienceRequest, out *RemovePostAudienceResponse) error {
+	const method = "RemovePostAudience"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) ListPostAudiences(ctx context.Context, in *ListPostAudiencesRequest, out *ListPostAudiencesResponse) error {
+	const method = "ListPostAudiences"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) SavePostDraft(ctx context.Context, in *SavePostDraftRequest, out *SavePostDraftResponse) error {
+	const method = "SavePostDraft"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) ListPostDrafts(ctx context.Context, in *ListPostDraftsRequest, out *ListPostDraftsResponse) error {
+	const method = "ListPostDrafts"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) RemovePostDraft(ctx context.Context, in *RemovePostDraftRequest, out *RemovePostDraftResponse) error {
+	const method = "RemovePostDraft"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PostsService) PublishPostDraft(ctx context.Context, in *PublishPostDraftRequest, out *PublishPostDraftResponse) error {
+	const method = "PublishPostDraft"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewPostsServiceClient(c ClientConn) PostsServiceClient {
 	return &client_PostsService{c: c, defn: PostsServiceDefn()}
 }
@@ -1008,6 +1068,26 @@ type PostsServiceServer interface {
 	// AckReceivedPostStatus acknowledges post status received up to a given
 	// sequence_id have been processed.
 	AckReceivedPostStatus(context.Context, *AckRequest, *AckResponse) error
+	// CreatePost creates a new post and shares it with the post subscribers
+	// (or the subset of them in the specified audience).
+	CreatePost(context.Context, *CreatePostRequest, *CreatePostResponse) error
+	// SavePostAudience creates or replaces a named audience of post
+	// subscribers.
+	SavePostAudience(context.Context, *SavePostAudienceRequest, *SavePostAudienceResponse) error
+	// RemovePostAudience removes a post audience.
+	RemovePostAudience(context.Context, *RemovePostAudienceRequest, *RemovePostAudienceResponse) error
+	// ListPostAudiences lists the existing post audiences.
+	ListPostAudiences(context.Context, *ListPostAudiencesRequest, *ListPostAudiencesResponse) error
+	// SavePostDraft creates or updates a post draft. Drafts with a publish_at
+	// time are published by the client once that time is reached.
+	SavePostDraft(context.Context, *SavePostDraftRequest, *SavePostDraftResponse) error
+	// ListPostDrafts lists the existing post drafts.
+	ListPostDrafts(context.Context, *ListPostDraftsRequest, *ListPostDraftsResponse) error
+	// RemovePostDraft removes a post draft.
+	RemovePostDraft(context.Context, *RemovePostDraftRequest, *RemovePostDraftResponse) error
+	// PublishPostDraft publishes a post draft as a new post and removes the
+	// draft.
+	PublishPostDraft(context.Context, *PublishPostDraftRequest, *PublishPostDraftResponse) error
 }
 
 type PostsService_PostsStreamServer interface {
@@ -1120,6 +1200,138 @@ func PostsServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"CreatePost": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(CreatePostRequest) },
+				NewResponse:  func() proto.Message { return new(CreatePostResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(CreatePostRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(CreatePostResponse).ProtoReflect().Descriptor() },
+				Help:         "CreatePost creates a new post and shares it with the post subscribers (or the subset of them in the specified audience).",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).CreatePost(ctx, request.(*CreatePostRequest), response.(*CreatePostResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.CreatePost"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"SavePostAudience": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(SavePostAudienceRequest) },
+				NewResponse: func() proto.Message { return new(SavePostAudienceResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor { return new(SavePostAudienceRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(SavePostAudienceResponse).ProtoReflect().Descriptor()
+				},
+				Help: "SavePostAudience creates or replaces a named audience of post subscribers.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).SavePostAudience(ctx, request.(*SavePostAudienceRequest), response.(*SavePostAudienceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.SavePostAudience"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"RemovePostAudience": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(RemovePostAudienceRequest) },
+				NewResponse: func() proto.Message { return new(RemovePostAudienceResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(RemovePostAudienceRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(RemovePostAudienceResponse).ProtoReflect().Descriptor()
+				},
+				Help: "RemovePostAudience removes a post audience.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).RemovePostAudience(ctx, request.(*RemovePostAudienceRequest), response.(*RemovePostAudienceResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.RemovePostAudience"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListPostAudiences": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ListPostAudiencesRequest) },
+				NewResponse: func() proto.Message { return new(ListPostAudiencesResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ListPostAudiencesRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ListPostAudiencesResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ListPostAudiences lists the existing post audiences.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).ListPostAudiences(ctx, request.(*ListPostAudiencesRequest), response.(*ListPostAudiencesResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.ListPostAudiences"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"SavePostDraft": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(SavePostDraftRequest) },
+				NewResponse:  func() proto.Message { return new(SavePostDraftResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(SavePostDraftRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(SavePostDraftResponse).ProtoReflect().Descriptor() },
+				Help:         "SavePostDraft creates or updates a post draft. Drafts with a publish_at time are published by the client once that time is reached.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).SavePostDraft(ctx, request.(*SavePostDraftRequest), response.(*SavePostDraftResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.SavePostDraft"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListPostDrafts": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListPostDraftsRequest) },
+				NewResponse:  func() proto.Message { return new(ListPostDraftsResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListPostDraftsRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListPostDraftsResponse).ProtoReflect().Descriptor() },
+				Help:         "ListPostDrafts lists the existing post drafts.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).ListPostDrafts(ctx, request.(*ListPostDraftsRequest), response.(*ListPostDraftsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.ListPostDrafts"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"RemovePostDraft": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(RemovePostDraftRequest) },
+				NewResponse:  func() proto.Message { return new(RemovePostDraftResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(RemovePostDraftRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(RemovePostDraftResponse).ProtoReflect().Descriptor() },
+				Help:         "RemovePostDraft removes a post draft.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).RemovePostDraft(ctx, request.(*RemovePostDraftRequest), response.(*RemovePostDraftResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.RemovePostDraft"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"PublishPostDraft": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(PublishPostDraftRequest) },
+				NewResponse: func() proto.Message { return new(PublishPostDraftResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor { return new(PublishPostDraftRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(PublishPostDraftResponse).ProtoReflect().Descriptor()
+				},
+				Help: "PublishPostDraft publishes a post draft as a new post and removes the draft.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PostsServiceServer).PublishPostDraft(ctx, request.(*PublishPostDraftRequest), response.(*PublishPostDraftResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PostsService.PublishPostDraft"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -1141,6 +1353,57 @@ type PaymentsServiceClient interface {
 	// AckTipReceived acknowledges events received up to a given
 	// sequence_id have been processed.
 	AckTipReceived(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// RequestPayment sends a request for a payment to a remote user. The
+	// request includes an invoice generated by the local client.
+	RequestPayment(ctx context.Context, in *RequestPaymentRequest, out *RequestPaymentResponse) error
+	// ListPaymentRequests lists the payment requests sent to and received from
+	// remote users.
+	ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, out *ListPaymentRequestsResponse) error
+	// AcceptPaymentRequest pays the invoice of a payment request received from
+	// a remote user.
+	AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, out *AcceptPaymentRequestResponse) error
+	// DeclinePaymentRequest declines to pay a payment request received from a
+	// remote user.
+	DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, out *DeclinePaymentRequestResponse) error
+	// PaymentRequestsStream returns a stream that gets payment requests
+	// received by the client and changes to the status of payment requests.
+	PaymentRequestsStream(ctx context.Context, in *PaymentRequestsStreamRequest) (PaymentsService_PaymentRequestsStreamClient, error)
+	// AckPaymentRequestEvent acknowledges events received up to a given
+	// sequence_id have been processed.
+	AckPaymentRequestEvent(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// CreateRecurringPayment starts an agreement to pay a remote user a fixed
+	// amount every period.
+	CreateRecurringPayment(ctx context.Context, in *CreateRecurringPaymentRequest, out *CreateRecurringPaymentResponse) error
+	// ListRecurringPayments lists the recurring payment agreements made by the
+	// local client and by remote users.
+	ListRecurringPayments(ctx context.Context, in *ListRecurringPaymentsRequest, out *ListRecurringPaymentsResponse) error
+	// CancelRecurringPayment cancels a recurring payment agreement.
+	CancelRecurringPayment(ctx context.Context, in *CancelRecurringPaymentRequest, out *CancelRecurringPaymentResponse) error
+	// ListTierMembers lists the remote users that are currently members of a
+	// tier by making recurring payments to the local client.
+	ListTierMembers(ctx context.Context, in *ListTierMembersRequest, out *ListTierMembersResponse) error
+	// RecurringPaymentsStream returns a stream that gets events about
+	// recurring payment agreements.
+	RecurringPaymentsStream(ctx context.Context, in *RecurringPaymentsStreamRequest) (PaymentsService_RecurringPaymentsStreamClient, error)
+	// AckRecurringPaymentEvent acknowledges events received up to a given
+	// sequence_id have been processed.
+	AckRecurringPaymentEvent(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// LedgerStream returns a stream that gets the entries appended to the
+	// itemized payment ledger.
+	LedgerStream(ctx context.Context, in *LedgerStreamRequest) (PaymentsService_LedgerStreamClient, error)
+	// AckLedgerEntry acknowledges ledger entries received up to a given
+	// sequence_id have been processed.
+	AckLedgerEntry(ctx context.Context, in *AckRequest, out *AckResponse) error
+	// TipGroupChat tips the members of a group chat. The total amount is split
+	// among the members according to the split mode and one tip attempt is
+	// started for each member.
+	TipGroupChat(ctx context.Context, in *TipGroupChatRequest, out *TipGroupChatResponse) error
+	// GCTipProgressStream returns a stream that gets events about the progress
+	// of tips to group chats.
+	GCTipProgressStream(ctx context.Context, in *GCTipProgressStreamRequest) (PaymentsService_GCTipProgressStreamClient, error)
+	// AckGCTipProgress acknowledges events received up to a given sequence_id
+	// have been processed.
+	AckGCTipProgress(ctx context.Context, in *AckRequest, out *AckResponse) error
 }
 
 type client_PaymentsService struct {
@@ -1189,6 +1452,123 @@ func (c *client_PaymentsService) AckTipReceived(ctx context.Context, in *AckRequ
 	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
 }
 
+func (c *client_PaymentsService) RequestPayment(ctx context.Context, in *RequestPaymentRequest, out *RequestPaymentResponse) error {
+	const method = "RequestPayment"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, out *ListPaymentRequestsResponse) error {
+	const method = "ListPaymentRequests"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, out *AcceptPaymentRequestResponse) error {
+	const method = "AcceptPaymentRequest"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, out *DeclinePaymentRequestResponse) error {
+	const method = "DeclinePaymentRequest"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type PaymentsService_PaymentRequestsStreamClient interface {
+	Recv(*PaymentRequestEvent) error
+}
+
+func (c *client_PaymentsService) PaymentRequestsStream(ctx context.Context, in *PaymentRequestsStreamRequest) (PaymentsService_PaymentRequestsStreamClient, error) {
+	const method = "PaymentRequestsStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*PaymentRequestEvent]{c: inner}, nil
+}
+
+func (c *client_PaymentsService) AckPaymentRequestEvent(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckPaymentRequestEvent"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) CreateRecurringPayment(ctx context.Context, in *CreateRecurringPaymentRequest, out *CreateRecurringPaymentResponse) error {
+	const method = "CreateRecurringPayment"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) ListRecurringPayments(ctx context.Context, in *ListRecurringPaymentsRequest, out *ListRecurringPaymentsResponse) error {
+	const method = "ListRecurringPayments"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) CancelRecurringPayment(ctx context.Context, in *CancelRecurringPaymentRequest, out *CancelRecurringPaymentResponse) error {
+	const method = "CancelRecurringPayment"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) ListTierMembers(ctx context.Context, in *ListTierMembersRequest, out *ListTierMembersResponse) error {
+	const method = "ListTierMembers"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type PaymentsService_RecurringPaymentsStreamClient interface {
+	Recv(*RecurringPaymentEvent) error
+}
+
+func (c *client_PaymentsService) RecurringPaymentsStream(ctx context.Context, in *RecurringPaymentsStreamRequest) (PaymentsService_RecurringPaymentsStreamClient, error) {
+	const method = "RecurringPaymentsStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*RecurringPaymentEvent]{c: inner}, nil
+}
+
+func (c *client_PaymentsService) AckRecurringPaymentEvent(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckRecurringPaymentEvent"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type PaymentsService_LedgerStreamClient interface {
+	Recv(*LedgerEntry) error
+}
+
+func (c *client_PaymentsService) LedgerStream(ctx context.Context, in *LedgerStreamRequest) (PaymentsService_LedgerStreamClient, error) {
+	const method = "LedgerStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*LedgerEntry]{c: inner}, nil
+}
+
+func (c *client_PaymentsService) AckLedgerEntry(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckLedgerEntry"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+func (c *client_PaymentsService) TipGroupChat(ctx context.Context, in *TipGroupChatRequest, out *TipGroupChatResponse) error {
+	const method = "TipGroupChat"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
+type PaymentsService_GCTipProgressStreamClient interface {
+	Recv(*GCTipProgressEvent) error
+}
+
+func (c *client_PaymentsService) GCTipProgressStream(ctx context.Context, in *GCTipProgressStreamRequest) (PaymentsService_GCTipProgressStreamClient, error) {
+	const method = "GCTipProgressStream"
+	inner, err := c.defn.Methods[method].ClientStreamHandler(c.c, ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return streamerImpl[*GCTipProgressEvent]{c: inner}, nil
+}
+
+func (c *client_PaymentsService) AckGCTipProgress(ctx context.Context, in *AckRequest, out *AckResponse) error {
+	const method = "AckGCTipProgress"
+	return c.defn.Methods[method].ClientHandler(c.c, ctx, in, out)
+}
+
 func NewPaymentsServiceClient(c ClientConn) PaymentsServiceClient {
 	return &client_PaymentsService{c: c, defn: PaymentsServiceDefn()}
 }
@@ -1210,6 +1590,57 @@ type PaymentsServiceServer interface {
 	// AckTipReceived acknowledges events received up to a given
 	// sequence_id have been processed.
 	AckTipReceived(context.Context, *AckRequest, *AckResponse) error
+	// RequestPayment sends a request for a payment to a remote user. The
+	// request includes an invoice generated by the local client.
+	RequestPayment(context.Context, *RequestPaymentRequest, *RequestPaymentResponse) error
+	// ListPaymentRequests lists the payment requests sent to and received from
+	// remote users.
+	ListPaymentRequests(context.Context, *ListPaymentRequestsRequest, *ListPaymentRequestsResponse) error
+	// AcceptPaymentRequest pays the invoice of a payment request received from
+	// a remote user.
+	AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest, *AcceptPaymentRequestResponse) error
+	// DeclinePaymentRequest declines to pay a payment request received from a
+	// remote user.
+	DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest, *DeclinePaymentRequestResponse) error
+	// PaymentRequestsStream returns a stream that gets payment requests
+	// received by the client and changes to the status of payment requests.
+	PaymentRequestsStream(context.Context, *PaymentRequestsStreamRequest, PaymentsService_PaymentRequestsStreamServer) error
+	// AckPaymentRequestEvent acknowledges events received up to a given
+	// sequence_id have been processed.
+	AckPaymentRequestEvent(context.Context, *AckRequest, *AckResponse) error
+	// CreateRecurringPayment starts an agreement to pay a remote user a fixed
+	// amount every period.
+	CreateRecurringPayment(context.Context, *CreateRecurringPaymentRequest, *CreateRecurringPaymentResponse) error
+	// ListRecurringPayments lists the recurring payment agreements made by the
+	// local client and by remote users.
+	ListRecurringPayments(context.Context, *ListRecurringPaymentsRequest, *ListRecurringPaymentsResponse) error
+	// CancelRecurringPayment cancels a recurring payment agreement.
+	CancelRecurringPayment(context.Context, *CancelRecurringPaymentRequest, *CancelRecurringPaymentResponse) error
+	// ListTierMembers lists the remote users that are currently members of a
+	// tier by making recurring payments to the local client.
+	ListTierMembers(context.Context, *ListTierMembersRequest, *ListTierMembersResponse) error
+	// RecurringPaymentsStream returns a stream that gets events about
+	// recurring payment agreements.
+	RecurringPaymentsStream(context.Context, *RecurringPaymentsStreamRequest, PaymentsService_RecurringPaymentsStreamServer) error
+	// AckRecurringPaymentEvent acknowledges events received up to a given
+	// sequence_id have been processed.
+	AckRecurringPaymentEvent(context.Context, *AckRequest, *AckResponse) error
+	// LedgerStream returns a stream that gets the entries appended to the
+	// itemized payment ledger.
+	LedgerStream(context.Context, *LedgerStreamRequest, PaymentsService_LedgerStreamServer) error
+	// AckLedgerEntry acknowledges ledger entries received up to a given
+	// sequence_id have been processed.
+	AckLedgerEntry(context.Context, *AckRequest, *AckResponse) error
+	// TipGroupChat tips the members of a group chat. The total amount is split
+	// among the members according to the split mode and one tip attempt is
+	// started for each member.
+	TipGroupChat(context.Context, *TipGroupChatRequest, *TipGroupChatResponse) error
+	// GCTipProgressStream returns a stream that gets events about the progress
+	// of tips to group chats.
+	GCTipProgressStream(context.Context, *GCTipProgressStreamRequest, PaymentsService_GCTipProgressStreamServer) error
+	// AckGCTipProgress acknowledges events received up to a given sequence_id
+	// have been processed.
+	AckGCTipProgress(context.Context, *AckRequest, *AckResponse) error
 }
 
 type PaymentsService_TipProgressServer interface {
@@ -1220,6 +1651,22 @@ type PaymentsService_TipStreamServer interface {
 	Send(m *ReceivedTip) error
 }
 
+type PaymentsService_PaymentRequestsStreamServer interface {
+	Send(m *PaymentRequestEvent) error
+}
+
+type PaymentsService_RecurringPaymentsStreamServer interface {
+	Send(m *RecurringPaymentEvent) error
+}
+
+type PaymentsService_LedgerStreamServer interface {
+	Send(m *LedgerEntry) error
+}
+
+type PaymentsService_GCTipProgressStreamServer interface {
+	Send(m *GCTipProgressEvent) error
+}
+
 func PaymentsServiceDefn() ServiceDefn {
 	return ServiceDefn{
 		Name: "PaymentsService",
@@ -1299,6 +1746,291 @@ func PaymentsServiceDefn() ServiceDefn {
 					return conn.Request(ctx, method, request, response)
 				},
 			},
+			"RequestPayment": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(RequestPaymentRequest) },
+				NewResponse:  func() proto.Message { return new(RequestPaymentResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(RequestPaymentRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(RequestPaymentResponse).ProtoReflect().Descriptor() },
+				Help:         "RequestPayment sends a request for a payment to a remote user. The request includes an invoice generated by the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).RequestPayment(ctx, request.(*RequestPaymentRequest), response.(*RequestPaymentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.RequestPayment"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListPaymentRequests": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ListPaymentRequestsRequest) },
+				NewResponse: func() proto.Message { return new(ListPaymentRequestsResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ListPaymentRequestsRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ListPaymentRequestsResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ListPaymentRequests lists the payment requests sent to and received from remote users.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).ListPaymentRequests(ctx, request.(*ListPaymentRequestsRequest), response.(*ListPaymentRequestsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.ListPaymentRequests"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"AcceptPaymentRequest": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(AcceptPaymentRequestRequest) },
+				NewResponse: func() proto.Message { return new(AcceptPaymentRequestResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(AcceptPaymentRequestRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(AcceptPaymentRequestResponse).ProtoReflect().Descriptor()
+				},
+				Help: "AcceptPaymentRequest pays the invoice of a payment request received from a remote user.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).AcceptPaymentRequest(ctx, request.(*AcceptPaymentRequestRequest), response.(*AcceptPaymentRequestResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.AcceptPaymentRequest"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"DeclinePaymentRequest": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(DeclinePaymentRequestRequest) },
+				NewResponse: func() proto.Message { return new(DeclinePaymentRequestResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(DeclinePaymentRequestRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(DeclinePaymentRequestResponse).ProtoReflect().Descriptor()
+				},
+				Help: "DeclinePaymentRequest declines to pay a payment request received from a remote user.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).DeclinePaymentRequest(ctx, request.(*DeclinePaymentRequestRequest), response.(*DeclinePaymentRequestResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.DeclinePaymentRequest"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"PaymentRequestsStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(PaymentRequestsStreamRequest) },
+				NewResponse: func() proto.Message { return new(PaymentRequestEvent) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(PaymentRequestsStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(PaymentRequestEvent).ProtoReflect().Descriptor() },
+				Help:         "PaymentRequestsStream returns a stream that gets payment requests received by the client and changes to the status of payment requests.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(PaymentsServiceServer).PaymentRequestsStream(ctx, request.(*PaymentRequestsStreamRequest), streamerImpl[*PaymentRequestEvent]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "PaymentsService.PaymentRequestsStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckPaymentRequestEvent": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckPaymentRequestEvent acknowledges events received up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).AckPaymentRequestEvent(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.AckPaymentRequestEvent"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CreateRecurringPayment": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(CreateRecurringPaymentRequest) },
+				NewResponse: func() proto.Message { return new(CreateRecurringPaymentResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(CreateRecurringPaymentRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(CreateRecurringPaymentResponse).ProtoReflect().Descriptor()
+				},
+				Help: "CreateRecurringPayment starts an agreement to pay a remote user a fixed amount every period.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).CreateRecurringPayment(ctx, request.(*CreateRecurringPaymentRequest), response.(*CreateRecurringPaymentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.CreateRecurringPayment"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListRecurringPayments": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(ListRecurringPaymentsRequest) },
+				NewResponse: func() proto.Message { return new(ListRecurringPaymentsResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(ListRecurringPaymentsRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(ListRecurringPaymentsResponse).ProtoReflect().Descriptor()
+				},
+				Help: "ListRecurringPayments lists the recurring payment agreements made by the local client and by remote users.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).ListRecurringPayments(ctx, request.(*ListRecurringPaymentsRequest), response.(*ListRecurringPaymentsResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.ListRecurringPayments"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"CancelRecurringPayment": {
+				IsStreaming: false,
+				NewRequest:  func() proto.Message { return new(CancelRecurringPaymentRequest) },
+				NewResponse: func() proto.Message { return new(CancelRecurringPaymentResponse) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(CancelRecurringPaymentRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor {
+					return new(CancelRecurringPaymentResponse).ProtoReflect().Descriptor()
+				},
+				Help: "CancelRecurringPayment cancels a recurring payment agreement.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).CancelRecurringPayment(ctx, request.(*CancelRecurringPaymentRequest), response.(*CancelRecurringPaymentResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.CancelRecurringPayment"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"ListTierMembers": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(ListTierMembersRequest) },
+				NewResponse:  func() proto.Message { return new(ListTierMembersResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(ListTierMembersRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(ListTierMembersResponse).ProtoReflect().Descriptor() },
+				Help:         "ListTierMembers lists the remote users that are currently members of a tier by making recurring payments to the local client.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).ListTierMembers(ctx, request.(*ListTierMembersRequest), response.(*ListTierMembersResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.ListTierMembers"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"RecurringPaymentsStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(RecurringPaymentsStreamRequest) },
+				NewResponse: func() proto.Message { return new(RecurringPaymentEvent) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(RecurringPaymentsStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(RecurringPaymentEvent).ProtoReflect().Descriptor() },
+				Help:         "RecurringPaymentsStream returns a stream that gets events about recurring payment agreements.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(PaymentsServiceServer).RecurringPaymentsStream(ctx, request.(*RecurringPaymentsStreamRequest), streamerImpl[*RecurringPaymentEvent]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "PaymentsService.RecurringPaymentsStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckRecurringPaymentEvent": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckRecurringPaymentEvent acknowledges events received up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).AckRecurringPaymentEvent(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.AckRecurringPaymentEvent"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"LedgerStream": {
+				IsStreaming:  true,
+				NewRequest:   func() proto.Message { return new(LedgerStreamRequest) },
+				NewResponse:  func() proto.Message { return new(LedgerEntry) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(LedgerStreamRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(LedgerEntry).ProtoReflect().Descriptor() },
+				Help:         "LedgerStream returns a stream that gets the entries appended to the itemized payment ledger.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(PaymentsServiceServer).LedgerStream(ctx, request.(*LedgerStreamRequest), streamerImpl[*LedgerEntry]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "PaymentsService.LedgerStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckLedgerEntry": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckLedgerEntry acknowledges ledger entries received up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).AckLedgerEntry(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.AckLedgerEntry"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"TipGroupChat": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(TipGroupChatRequest) },
+				NewResponse:  func() proto.Message { return new(TipGroupChatResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(TipGroupChatRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(TipGroupChatResponse).ProtoReflect().Descriptor() },
+				Help:         "TipGroupChat tips the members of a group chat. The total amount is split among the members according to the split mode and one tip attempt is started for each member.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).TipGroupChat(ctx, request.(*TipGroupChatRequest), response.(*TipGroupChatResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.TipGroupChat"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
+			"GCTipProgressStream": {
+				IsStreaming: true,
+				NewRequest:  func() proto.Message { return new(GCTipProgressStreamRequest) },
+				NewResponse: func() proto.Message { return new(GCTipProgressEvent) },
+				RequestDefn: func() protoreflect.MessageDescriptor {
+					return new(GCTipProgressStreamRequest).ProtoReflect().Descriptor()
+				},
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(GCTipProgressEvent).ProtoReflect().Descriptor() },
+				Help:         "GCTipProgressStream returns a stream that gets events about the progress of tips to group chats.",
+				ServerStreamHandler: func(x interface{}, ctx context.Context, request proto.Message, stream ServerStream) error {
+					return x.(PaymentsServiceServer).GCTipProgressStream(ctx, request.(*GCTipProgressStreamRequest), streamerImpl[*GCTipProgressEvent]{s: stream})
+				},
+				ClientStreamHandler: func(conn ClientConn, ctx context.Context, request proto.Message) (ClientStream, error) {
+					method := "PaymentsService.GCTipProgressStream"
+					return conn.Stream(ctx, method, request)
+				},
+			},
+			"AckGCTipProgress": {
+				IsStreaming:  false,
+				NewRequest:   func() proto.Message { return new(AckRequest) },
+				NewResponse:  func() proto.Message { return new(AckResponse) },
+				RequestDefn:  func() protoreflect.MessageDescriptor { return new(AckRequest).ProtoReflect().Descriptor() },
+				ResponseDefn: func() protoreflect.MessageDescriptor { return new(AckResponse).ProtoReflect().Descriptor() },
+				Help:         "AckGCTipProgress acknowledges events received up to a given sequence_id have been processed.",
+				ServerHandler: func(x interface{}, ctx context.Context, request, response proto.Message) error {
+					return x.(PaymentsServiceServer).AckGCTipProgress(ctx, request.(*AckRequest), response.(*AckResponse))
+				},
+				ClientHandler: func(conn ClientConn, ctx context.Context, request, response proto.Message) error {
+					method := "PaymentsService.AckGCTipProgress"
+					return conn.Request(ctx, method, request, response)
+				},
+			},
 		},
 	}
 }
@@ -1581,6 +2313,8 @@ var help_messages = map[string]map[string]string{
 		"date":           "date is the unix timestamp of the post.",
 		"last_status_ts": "last_status_ts is the timestamp of the last recorded status update of the post.",
 		"title":          "title is either the included or suggested title of the post.",
+		"tags":           "tags are the tags defined by the author of the post.",
+		"expiration":     "expiration is the unix timestamp after which the post is deleted. Zero if the post does not expire.",
 	},
 	"PostsStreamRequest": {
 		"@":            "PostsStreamRequest is the request to establish a stream of received post events.",
@@ -1598,13 +2332,96 @@ var help_messages = map[string]map[string]string{
 		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed Post Status. Post Status received by the server that have a higher sequence_id will be streamed back to the client.",
 	},
 	"ReceivedPostStatus": {
-		"@":                "ReceivedPostStatus is a post status update received by the local client.",
-		"sequence_id":      "sequence_id is an opaque sequential ID.",
-		"relayer_id":       "relayer_id is the id of the sender of the client that sent the update.",
-		"post_id":          "post_id is the id of the corresponding post.",
-		"status_from":      "status_from is the original author of the status.",
-		"status":           "status is the full status data.",
-		"status_from_nick": "status_from_nick is the nick of the original author of the status.",
+		"@":                 "ReceivedPostStatus is a post status update received by the local client.",
+		"sequence_id":       "sequence_id is an opaque sequential ID.",
+		"relayer_id":        "relayer_id is the id of the sender of the client that sent the update.",
+		"post_id":           "post_id is the id of the corresponding post.",
+		"status_from":       "status_from is the original author of the status.",
+		"status":            "status is the full status data.",
+		"status_from_nick":  "status_from_nick is the nick of the original author of the status.",
+		"status_id":         "status_id is the id of the status update. Replies to a comment reference the status_id of the comment in their parent_comment_id.",
+		"parent_comment_id": "parent_comment_id is the id of the comment this status update replies to. It is empty for top-level comments and other status updates.",
+	},
+	"CreatePostRequest": {
+		"@":           "CreatePostRequest is a request to create a new post.",
+		"content":     "content is the main content of the post.",
+		"description": "description is an optional description of the post.",
+		"audience":    "audience is the optional name of the audience the post is restricted to. Restricted posts are only shared with subscribers in the audience and cannot be relayed.",
+		"tags":        "tags is the optional list of tags of the post. Tags are normalized to lower case and may only contain letters, digits, '-' and '_'.",
+		"expiration":  "expiration is the optional unix timestamp after which the post is deleted by the local client and its subscribers.",
+	},
+	"CreatePostResponse": {
+		"@":       "CreatePostResponse is the response to a create post request.",
+		"summary": "summary is the summary information about the new post.",
+	},
+	"PostAudience": {
+		"@":       "PostAudience is a named list of post subscribers.",
+		"name":    "name is the name of the audience.",
+		"members": "members are the ids of the members of the audience.",
+	},
+	"SavePostAudienceRequest": {
+		"@":       "SavePostAudienceRequest is a request to create or replace a post audience.",
+		"name":    "name is the name of the audience.",
+		"members": "members are the nicks or hex-encoded IDs of the members of the audience.",
+	},
+	"SavePostAudienceResponse": {
+		"@": "SavePostAudienceResponse is the response to a save post audience request.",
+	},
+	"RemovePostAudienceRequest": {
+		"@":    "RemovePostAudienceRequest is a request to remove a post audience.",
+		"name": "name is the name of the audience.",
+	},
+	"RemovePostAudienceResponse": {
+		"@": "RemovePostAudienceResponse is the response to a remove post audience request.",
+	},
+	"ListPostAudiencesRequest": {
+		"@": "ListPostAudiencesRequest is a request to list the post audiences.",
+	},
+	"ListPostAudiencesResponse": {
+		"@":         "ListPostAudiencesResponse is the list of post audiences.",
+		"audiences": "audiences is the list of post audiences.",
+	},
+	"PostDraft": {
+		"@":             "PostDraft is a post that was not yet published.",
+		"id":            "id is the id of the draft.",
+		"content":       "content is the main content of the post.",
+		"description":   "description is an optional description of the post.",
+		"audience":      "audience is the optional name of the audience the post will be restricted to.",
+		"tags":          "tags is the optional list of tags of the post.",
+		"created":       "created is the unix timestamp of when the draft was created.",
+		"updated":       "updated is the unix timestamp of the last update of the draft.",
+		"publish_at":    "publish_at is the unix timestamp of when the draft is scheduled to be published. Zero if the draft is not scheduled.",
+		"publish_error": "publish_error is the error of the last attempt to publish the draft at its scheduled time. Failed drafts are unscheduled.",
+	},
+	"SavePostDraftRequest": {
+		"@":     "SavePostDraftRequest is a request to create or update a post draft.",
+		"draft": "draft is the draft to save. If its id is empty, a new draft is created. The created, updated and publish_error fields are ignored.",
+	},
+	"SavePostDraftResponse": {
+		"@":     "SavePostDraftResponse is the response to a save post draft request.",
+		"draft": "draft is the saved draft.",
+	},
+	"ListPostDraftsRequest": {
+		"@": "ListPostDraftsRequest is a request to list the post drafts.",
+	},
+	"ListPostDraftsResponse": {
+		"@":      "ListPostDraftsResponse is the list of post drafts.",
+		"drafts": "drafts is the list of drafts, most recently updated first.",
+	},
+	"RemovePostDraftRequest": {
+		"@":  "RemovePostDraftRequest is a request to remove a post draft.",
+		"id": "id is the id of the draft.",
+	},
+	"RemovePostDraftResponse": {
+		"@": "RemovePostDraftResponse is the response to a remove post draft request.",
+	},
+	"PublishPostDraftRequest": {
+		"@":  "PublishPostDraftRequest is a request to publish a post draft.",
+		"id": "id is the id of the draft.",
+	},
+	"PublishPostDraftResponse": {
+		"@":       "PublishPostDraftResponse is the response to a publish post draft request.",
+		"summary": "summary is the summary information about the new post.",
 	},
 	"TipUserRequest": {
 		"@":            "TipUserRequest is a request to tip a remote user.",
@@ -1773,6 +2590,183 @@ var help_messages = map[string]map[string]string{
 		"attempt_err":   "attempt_err is filled when the attempt to fetch an invoice or perform the payment for a received invoice failed.",
 		"will_retry":    "will_retry flags whether a new attempt to request an invoice and perform a payment will be done or if no more attempts will happen.",
 	},
+	"PaymentRequest": {
+		"@":             "PaymentRequest is a request for a payment sent to or received from a remote user.",
+		"id":            "id is the id of the request.",
+		"uid":           "uid is the id of the remote user.",
+		"sent":          "sent is true if the request was sent by the local client.",
+		"invoice":       "invoice is the invoice to pay.",
+		"amount_matoms": "amount_matoms is the requested amount in milli-atoms.",
+		"memo":          "memo is the description of the request.",
+		"created":       "created is the unix timestamp of when the request was created or received.",
+		"expires":       "expires is the unix timestamp after which the request can no longer be paid.",
+		"status":        "status is one of pending, paying, paid, declined or expired.",
+		"error":         "error is the error of the last attempt to pay the request or the reason it was declined.",
+	},
+	"RequestPaymentRequest": {
+		"@":              "RequestPaymentRequest is a request to send a payment request to a remote user.",
+		"user":           "user is the remote user nick or hex-encoded ID.",
+		"amount_matoms":  "amount_matoms is the requested amount in milli-atoms.",
+		"memo":           "memo is an optional description of the request.",
+		"expiry_seconds": "expiry_seconds is the number of seconds after which the request expires. If zero, a default of 24 hours is used.",
+	},
+	"RequestPaymentResponse": {
+		"@":       "RequestPaymentResponse is the response to a request payment request.",
+		"request": "request is the sent payment request.",
+	},
+	"ListPaymentRequestsRequest": {
+		"@": "ListPaymentRequestsRequest is a request to list payment requests.",
+	},
+	"ListPaymentRequestsResponse": {
+		"@":        "ListPaymentRequestsResponse is the list of payment requests.",
+		"requests": "requests is the list of payment requests, newest first.",
+	},
+	"AcceptPaymentRequestRequest": {
+		"@":   "AcceptPaymentRequestRequest is a request to pay a received payment request.",
+		"uid": "uid is the id of the user that sent the request.",
+		"id":  "id is the id of the request.",
+	},
+	"AcceptPaymentRequestResponse": {
+		"@":       "AcceptPaymentRequestResponse is the response to an accept payment request request.",
+		"request": "request is the updated payment request.",
+	},
+	"DeclinePaymentRequestRequest": {
+		"@":      "DeclinePaymentRequestRequest is a request to decline a received payment request.",
+		"uid":    "uid is the id of the user that sent the request.",
+		"id":     "id is the id of the request.",
+		"reason": "reason is an optional reason sent to the user.",
+	},
+	"DeclinePaymentRequestResponse": {
+		"@": "DeclinePaymentRequestResponse is the response to a decline payment request request.",
+	},
+	"PaymentRequestsStreamRequest": {
+		"@":            "PaymentRequestsStreamRequest is the request to start a stream of payment request events.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events received by the server that have a higher sequence_id will be streamed back to the client.",
+	},
+	"PaymentRequestEvent": {
+		"@":           "PaymentRequestEvent is sent when a payment request is received or when the status of a payment request changes.",
+		"request":     "request is the received or updated payment request.",
+		"received":    "received is true if the request was just received from a remote user.",
+		"sequence_id": "sequence_id is an opaque sequential ID.",
+	},
+	"RecurringPayment": {
+		"@":              "RecurringPayment is an agreement to pay a fixed amount every period.",
+		"id":             "id is the id of the agreement.",
+		"uid":            "uid is the id of the remote user.",
+		"paying":         "paying is true if the local client makes the payments.",
+		"tier":           "tier is the name of the tier of the agreement.",
+		"amount_matoms":  "amount_matoms is the amount paid every period in milli-atoms.",
+		"period_seconds": "period_seconds is the duration of each period.",
+		"max_periods":    "max_periods is the max number of periods to pay. If zero, periods are paid until the agreement is canceled.",
+		"periods_paid":   "periods_paid is the number of periods paid so far.",
+		"created":        "created is the unix timestamp of when the agreement was created.",
+		"next_payment":   "next_payment is the unix timestamp of when the next payment is due.",
+		"status":         "status is one of active, completed, canceled or lapsed.",
+		"error":          "error is the error of the last payment or the reason the agreement was canceled.",
+	},
+	"CreateRecurringPaymentRequest": {
+		"@":              "CreateRecurringPaymentRequest is a request to start a recurring payment agreement.",
+		"user":           "user is the remote user nick or hex-encoded ID.",
+		"dcr_amount":     "dcr_amount is the amount to pay every period.",
+		"period_seconds": "period_seconds is the duration of each period.",
+		"max_periods":    "max_periods is the max number of periods to pay. If zero, periods are paid until the agreement is canceled.",
+		"tier":           "tier is an optional name of the tier of the agreement.",
+	},
+	"CreateRecurringPaymentResponse": {
+		"@":                 "CreateRecurringPaymentResponse is the response to a create recurring payment request.",
+		"recurring_payment": "recurring_payment is the created agreement.",
+	},
+	"ListRecurringPaymentsRequest": {
+		"@": "ListRecurringPaymentsRequest is a request to list recurring payments.",
+	},
+	"ListRecurringPaymentsResponse": {
+		"@":                  "ListRecurringPaymentsResponse is the list of recurring payments.",
+		"recurring_payments": "recurring_payments is the list of agreements, oldest first.",
+	},
+	"CancelRecurringPaymentRequest": {
+		"@":      "CancelRecurringPaymentRequest is a request to cancel a recurring payment agreement.",
+		"uid":    "uid is the id of the remote user of the agreement.",
+		"id":     "id is the id of the agreement.",
+		"reason": "reason is an optional reason sent to the remote user.",
+	},
+	"CancelRecurringPaymentResponse": {
+		"@": "CancelRecurringPaymentResponse is the response to a cancel recurring payment request.",
+	},
+	"ListTierMembersRequest": {
+		"@":    "ListTierMembersRequest is a request to list the members of a tier.",
+		"tier": "tier is the name of the tier.",
+	},
+	"ListTierMembersResponse": {
+		"@":       "ListTierMembersResponse is the list of members of a tier.",
+		"members": "members is the list of agreements of the members of the tier.",
+	},
+	"RecurringPaymentsStreamRequest": {
+		"@":            "RecurringPaymentsStreamRequest is the request to start a stream of recurring payment events.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events received by the server that have a higher sequence_id will be streamed back to the client.",
+	},
+	"RecurringPaymentEvent": {
+		"@":                 "RecurringPaymentEvent is sent when a recurring payment agreement is received from a remote user, when a period is paid or when the agreement is completed, canceled or lapses.",
+		"recurring_payment": "recurring_payment is the received or updated agreement.",
+		"received":          "received is true if the agreement was just received from a remote user.",
+		"sequence_id":       "sequence_id is an opaque sequential ID.",
+	},
+	"LedgerStreamRequest": {
+		"@":            "LedgerStreamRequest is the request to start a stream of payment ledger entries.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed entry. Entries received by the server that have a higher sequence_id will be streamed back to the client.",
+	},
+	"LedgerEntry": {
+		"@":             "LedgerEntry is an itemized entry of the payment ledger.",
+		"timestamp":     "timestamp is the unix timestamp of the payment.",
+		"uid":           "uid is the user the payment was made to, received from or made on behalf of (in the case of server fees).",
+		"event":         "event identifies what the payment was for.",
+		"category":      "category is the payment category (serverfees, downloads, tips, rtdt or other).",
+		"amount_matoms": "amount_matoms is the amount of the payment in milliatoms. It is negative for payments made and positive for payments received.",
+		"fee_matoms":    "fee_matoms is the fee paid in milliatoms. It is zero or negative.",
+		"invoice_hash":  "invoice_hash is the hex-encoded payment hash of the invoice, if known.",
+		"dcr_usd_rate":  "dcr_usd_rate is the DCR/USD exchange rate at the time of the payment or zero if unknown.",
+		"sequence_id":   "sequence_id is an opaque sequential ID.",
+	},
+	"TipGroupChatRequest": {
+		"@":            "TipGroupChatRequest is the request to tip the members of a group chat.",
+		"gc":           "gc is the name or hex-encoded ID of the group chat.",
+		"dcr_amount":   "dcr_amount is the total DCR amount to split among the members.",
+		"split_mode":   "split_mode is how the total is split: equal (among all members), shares (weighted by the shares defined by the admins) or admins (equally among the admins). Defaults to equal.",
+		"max_attempts": "max_attempts is the number of max attempts to try to send the tip to each member.",
+	},
+	"TipGroupChatResponse": {
+		"@":   "TipGroupChatResponse is the response to a tip group chat request.",
+		"tip": "tip is the newly started group chat tip.",
+	},
+	"GCTipMember": {
+		"@":           "GCTipMember is the payout to one member of a group chat tip.",
+		"uid":         "uid is the ID of the member.",
+		"milli_atoms": "milli_atoms is the amount sent to the member.",
+		"status":      "status is the status of the payout (pending, completed or failed).",
+		"error":       "error is the error of a failed payout.",
+	},
+	"GCTip": {
+		"@":           "GCTip is a tip to a whole group chat, split among its members.",
+		"id":          "id is the ID of the tip.",
+		"gcid":        "gcid is the ID of the group chat.",
+		"split_mode":  "split_mode is how the total was split among the members.",
+		"milli_atoms": "milli_atoms is the total amount of the tip.",
+		"created":     "created is the unix timestamp of when the tip was started.",
+		"completed":   "completed is the unix timestamp of when all payouts finished or zero if some are still pending.",
+		"members":     "members are the payouts to each member.",
+	},
+	"GCTipProgressStreamRequest": {
+		"@":            "GCTipProgressStreamRequest is the request to start a stream of events about the progress of group chat tips.",
+		"unacked_from": "unacked_from specifies to the server the sequence_id of the last processed event. Events received by the server that have a higher sequence_id will be streamed back to the client.",
+	},
+	"GCTipProgressEvent": {
+		"@":                "GCTipProgressEvent is the progress of a group chat tip.",
+		"tip":              "tip is the current state of the group chat tip.",
+		"completed":        "completed is the number of members for which the payout completed.",
+		"failed":           "failed is the number of members for which the payout failed.",
+		"pending":          "pending is the number of members for which the payout is pending.",
+		"paid_milli_atoms": "paid_milli_atoms is the total amount paid so far.",
+		"sequence_id":      "sequence_id is an opaque sequential ID.",
+	},
 	"ResourceRequestsStreamRequest": {
 		"@": "ResourceRequestsStreamRequest is the request for a stream to receive resource requests.",
 	},
@@ -0,0 +1,200 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// oggMaxPageSize is the max size of an ogg page: the header, a full segment
+// table and the max size of every segment.
+const oggMaxPageSize = 27 + 255 + 255*255
+
+// oggReader reads ogg pages from an underlying reader.
+type oggReader struct {
+	r *bufio.Reader
+}
+
+func newOggReader(in io.Reader) *oggReader {
+	return &oggReader{r: bufio.NewReaderSize(in, oggMaxPageSize)}
+}
+
+// reset discards any buffered data. This must be called after the underlying
+// reader is seeked.
+func (o *oggReader) reset(in io.Reader) {
+	o.r.Reset(in)
+}
+
+// ReadPage reads the next page. When the reader is not positioned at the start
+// of a page (for example, after seeking to an arbitrary position of the
+// underlying reader), data is skipped until the start of the next valid page.
+func (o *oggReader) ReadPage() (OggPage, error) {
+	var p OggPage
+	for {
+		sig, err := o.r.Peek(len(oggSig))
+		if err != nil {
+			return p, err
+		}
+		if string(sig) != oggSig {
+			// Not at the start of a page. Skip to the next
+			// possible one.
+			if i := bytes.IndexByte(sig[1:], oggSig[0]); i > -1 {
+				o.r.Discard(i + 1)
+			} else {
+				o.r.Discard(len(sig))
+			}
+			continue
+		}
+
+		header, err := o.r.Peek(27)
+		if err != nil {
+			return p, unexpectedEOF(err)
+		}
+		nbSegs := int(header[26])
+		header, err = o.r.Peek(27 + nbSegs)
+		if err != nil {
+			return p, unexpectedEOF(err)
+		}
+		var total int
+		for _, l := range header[27:] {
+			total += int(l)
+		}
+		buf, err := o.r.Peek(27 + nbSegs + total)
+		if err != nil {
+			return p, unexpectedEOF(err)
+		}
+
+		// Verify the checksum (which is computed with the checksum
+		// field zeroed). Pages with an invalid checksum are assumed
+		// to be a false capture of the page signature.
+		wantChecksum := binary.LittleEndian.Uint32(buf[22:])
+		var checksum uint32
+		for i := range buf {
+			b := buf[i]
+			if i >= 22 && i < 26 {
+				b = 0
+			}
+			checksum = (checksum << 8) ^ checksumTable[byte(checksum>>24)^b]
+		}
+		if checksum != wantChecksum {
+			o.r.Discard(1)
+			continue
+		}
+
+		headerType := buf[5]
+		p = OggPage{
+			OggHeader: OggHeader{
+				Version:         buf[4],
+				IsContinued:     headerType&0x1 != 0,
+				IsFirstPage:     headerType&0x2 != 0,
+				IsLastPage:      headerType&0x4 != 0,
+				GranulePosition: binary.LittleEndian.Uint64(buf[6:]),
+				BitstreamSerial: binary.LittleEndian.Uint32(buf[14:]),
+				PageSequence:    binary.LittleEndian.Uint32(buf[18:]),
+				CrcChecksum:     wantChecksum,
+				PageSegments:    uint8(nbSegs),
+				SegmentTable:    append([]uint8(nil), buf[27:27+nbSegs]...),
+			},
+			Segments:     make([][]byte, nbSegs),
+			SegmentTotal: total,
+		}
+		data := append([]byte(nil), buf[27+nbSegs:]...)
+		for i, l := range p.SegmentTable {
+			p.Segments[i] = data[:l]
+			data = data[l:]
+		}
+		o.r.Discard(len(buf))
+		return p, nil
+	}
+}
+
+// unexpectedEOF converts io.EOF errors into io.ErrUnexpectedEOF.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// opusReader reads opus packets from an opusfile (an ogg file with
+// opus-encoded audio data).
+type opusReader struct {
+	ogg *oggReader
+
+	page    OggPage
+	segIdx  int
+	partial []byte
+	granule uint64
+	resync  bool
+}
+
+func newOpusReader(in io.Reader) *opusReader {
+	return &opusReader{ogg: newOggReader(in)}
+}
+
+// reset discards any buffered data. This must be called after the underlying
+// reader is seeked.
+func (r *opusReader) reset(in io.Reader) {
+	r.ogg.reset(in)
+	r.page = OggPage{}
+	r.segIdx = 0
+	r.partial = nil
+	r.resync = true
+}
+
+// Granule returns the granule position (i.e. the number of PCM samples at
+// 48kHz) of the last page read.
+func (r *opusReader) Granule() uint64 {
+	return r.granule
+}
+
+// ReadPacket returns the next opus audio packet. Header packets are skipped.
+func (r *opusReader) ReadPacket() ([]byte, error) {
+	for {
+		if r.segIdx >= len(r.page.Segments) {
+			page, err := r.ogg.ReadPage()
+			if err != nil {
+				if errors.Is(err, io.EOF) && len(r.partial) > 0 {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return nil, err
+			}
+			r.page, r.segIdx = page, 0
+			r.granule = page.GranulePosition
+			if r.resync && page.IsContinued {
+				// The start of the packet continued in this
+				// page was skipped. Drop the rest of it.
+				for r.segIdx < len(page.Segments) {
+					l := page.SegmentTable[r.segIdx]
+					r.segIdx++
+					if l < 255 {
+						break
+					}
+				}
+			}
+			r.resync = false
+			continue
+		}
+
+		l := r.page.SegmentTable[r.segIdx]
+		r.partial = append(r.partial, r.page.Segments[r.segIdx]...)
+		r.segIdx++
+		if l == 255 {
+			// Packet continues in the next segment.
+			continue
+		}
+
+		pkt := r.partial
+		r.partial = nil
+		if bytes.HasPrefix(pkt, []byte(opusIdSig)) ||
+			bytes.HasPrefix(pkt, []byte(opusCommentSig)) {
+			continue
+		}
+		if len(pkt) == 0 {
+			continue
+		}
+		return pkt, nil
+	}
+}
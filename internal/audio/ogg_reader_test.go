@@ -0,0 +1,102 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// testOpusFile returns an opusfile with nbPackets random packets of varying
+// sizes.
+func testOpusFile(t testing.TB, nbPackets int) ([]byte, [][]byte) {
+	rng := rand.New(rand.NewChaCha8([32]byte{31: 0x01}))
+	packets := make([][]byte, nbPackets)
+	buf := bytes.NewBuffer(nil)
+	w, err := newOpusWriter(buf)
+	assert.NilErr(t, err)
+	for i := range packets {
+		packets[i] = make([]byte, 1+rng.IntN(600))
+		for j := range packets[i] {
+			packets[i][j] = byte(rng.Uint32())
+		}
+		isLast := i == len(packets)-1
+		err := w.WritePacket(packets[i], sampleRate/1000*periodSizeMS, isLast)
+		assert.NilErr(t, err)
+	}
+	return buf.Bytes(), packets
+}
+
+// TestOpusReader tests reading the packets of an opusfile.
+func TestOpusReader(t *testing.T) {
+	t.Parallel()
+
+	const nbPackets = 50
+	data, packets := testOpusFile(t, nbPackets)
+
+	r := newOpusReader(bytes.NewReader(data))
+	for i := range packets {
+		pkt, err := r.ReadPacket()
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, pkt, packets[i])
+	}
+	wantGranule := uint64(nbPackets * sampleRate / 1000 * periodSizeMS)
+	assert.DeepEqual(t, r.Granule(), wantGranule)
+	_, err := r.ReadPacket()
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("unexpected error: got %v, want %v", err, io.EOF)
+	}
+}
+
+// TestOpusReaderResync tests that the reader resyncs to the next page after the
+// underlying reader is seeked to arbitrary positions.
+func TestOpusReaderResync(t *testing.T) {
+	t.Parallel()
+
+	data, packets := testOpusFile(t, 50)
+	br := bytes.NewReader(data)
+	r := newOpusReader(br)
+
+	// Read the first few packets.
+	for i := 0; i < 5; i++ {
+		pkt, err := r.ReadPacket()
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, pkt, packets[i])
+	}
+
+	// Seek to different positions. The next packet must be one of the
+	// original packets, after the seeked position.
+	for _, offset := range []int64{int64(len(data) / 2), 1, int64(len(data) / 3)} {
+		_, err := br.Seek(offset, io.SeekStart)
+		assert.NilErr(t, err)
+		r.reset(br)
+
+		pkt, err := r.ReadPacket()
+		assert.NilErr(t, err)
+		idx := -1
+		for i := range packets {
+			if bytes.Equal(pkt, packets[i]) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			t.Fatalf("packet after seeking to %d is not one of the "+
+				"original packets", offset)
+		}
+		if i := bytes.Index(data, pkt); int64(i) < offset {
+			t.Fatalf("packet at %d is before seeked offset %d", i,
+				offset)
+		}
+
+		// The following packets are read in sequence.
+		for i := idx + 1; i < idx+5 && i < len(packets); i++ {
+			pkt, err := r.ReadPacket()
+			assert.NilErr(t, err)
+			assert.DeepEqual(t, pkt, packets[i])
+		}
+	}
+}
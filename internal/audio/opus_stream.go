@@ -0,0 +1,180 @@
+package audio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/decred/slog"
+)
+
+// OpusFilePlayback plays back an opusfile (a .ogg file with opus-encoded audio
+// data) while it is read from an underlying reader.
+//
+// Reads from the underlying reader may block (for example, while the file is
+// being downloaded), in which case playback resumes once more data is
+// available.
+type OpusFilePlayback struct {
+	ps       *PlaybackStream
+	r        io.ReadSeeker
+	or       *opusReader
+	size     int64
+	duration time.Duration
+	log      slog.Logger
+	done     chan struct{}
+
+	mtx      sync.Mutex
+	seekedTo int64
+	granule  uint64
+	err      error
+}
+
+// Done is closed when the playback finishes.
+func (p *OpusFilePlayback) Done() <-chan struct{} {
+	return p.done
+}
+
+// Err returns the playback error. It is only set after playback is done.
+func (p *OpusFilePlayback) Err() error {
+	select {
+	case <-p.done:
+	default:
+		return nil
+	}
+	p.mtx.Lock()
+	err := p.err
+	p.mtx.Unlock()
+	return err
+}
+
+// Position returns the approximate current position of the playback.
+func (p *OpusFilePlayback) Position() time.Duration {
+	p.mtx.Lock()
+	granule := p.granule
+	p.mtx.Unlock()
+	return time.Duration(granule) * time.Second / sampleRate
+}
+
+// Seek changes the playback position to the given one. This requires the
+// duration of the file to be known.
+//
+// The position in the file is estimated from the file size and duration, so
+// the playback resumes on the page closest to the estimated position.
+//
+// Seek is called concurrently with reads from the underlying reader, so the
+// reader must support that.
+func (p *OpusFilePlayback) Seek(pos time.Duration) error {
+	if p.duration <= 0 {
+		return errors.New("cannot seek file of unknown duration")
+	}
+	if pos < 0 || pos > p.duration {
+		return fmt.Errorf("position %s out of bounds", pos)
+	}
+	offset := int64(float64(p.size) * (float64(pos) / float64(p.duration)))
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if _, err := p.r.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	p.seekedTo = offset
+	p.log.Debugf("Seeking opus file playback to %s (offset %d)", pos, offset)
+	return nil
+}
+
+// run reads the opus packets from the file and sends them to the playback
+// stream.
+func (p *OpusFilePlayback) run(ctx context.Context) {
+	var err error
+	defer func() {
+		p.ps.MarkInputDone(ctx)
+		select {
+		case <-p.ps.PlaybackDone():
+		case <-ctx.Done():
+		}
+		if err == nil {
+			err = p.ps.Err()
+		}
+		p.mtx.Lock()
+		p.err = err
+		p.mtx.Unlock()
+		close(p.done)
+	}()
+
+	ticker := time.NewTicker(periodSizeMS * time.Millisecond)
+	defer ticker.Stop()
+	var pkt []byte
+	var ts uint32
+	for i := 0; ctx.Err() == nil; i++ {
+		pkt, err = p.or.ReadPacket()
+
+		// Reset the reader after seeking.
+		p.mtx.Lock()
+		if p.seekedTo > -1 {
+			p.or.reset(p.r)
+			p.seekedTo = -1
+			p.mtx.Unlock()
+			err = nil
+			continue
+		}
+		p.granule = p.or.Granule()
+		p.mtx.Unlock()
+
+		if errors.Is(err, io.EOF) {
+			err = nil
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		p.ps.inputBlocking(ctx, pkt, ts)
+		ts += periodSizeMS
+
+		// After sending enough to fill queues, send on a schedule.
+		if i > cap(p.ps.inputChan) {
+			select {
+			case <-ctx.Done():
+			case <-ticker.C:
+			}
+		}
+	}
+	err = ctx.Err()
+}
+
+// PlaybackOpusFile plays back the opusfile read from r. Size and duration are
+// the total size and duration of the file, and are used to seek the playback.
+// Duration may be zero if unknown, in which case the playback cannot be
+// seeked.
+//
+// This playback is independent of other operations.
+func (ar *NoteRecorder) PlaybackOpusFile(ctx context.Context, r io.ReadSeeker,
+	size int64, duration time.Duration) *OpusFilePlayback {
+
+	ar.mtx.Lock()
+	ps := newPlaybackStream(ar.audioCtx, ar.playbackDeviceID)
+	ps.log = ar.log
+	playGain := ar.playGain
+	ar.mtx.Unlock()
+
+	go ps.run(ctx)
+	if playGain != 0 {
+		ps.SetVolumeGain(playGain)
+	}
+
+	p := &OpusFilePlayback{
+		ps:       ps,
+		r:        r,
+		or:       newOpusReader(r),
+		size:     size,
+		duration: duration,
+		log:      ar.log,
+		done:     make(chan struct{}),
+		seekedTo: -1,
+	}
+	go p.run(ctx)
+	return p
+}
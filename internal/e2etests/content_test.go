@@ -1,16 +1,24 @@
 package e2etests
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/mediainfo"
 	"github.com/companyzero/bisonrelay/internal/testutils"
 	"github.com/companyzero/bisonrelay/ratchet"
 	"github.com/companyzero/bisonrelay/rpc"
@@ -136,6 +144,292 @@ func TestFtDownloadFile(t *testing.T) {
 	assert.EqualFiles(t, fGlobal, completedPath1)
 }
 
+// TestFtListMediaAttributes tests that listing shared image files includes
+// their media attributes and thumbnails.
+func TestFtListMediaAttributes(t *testing.T) {
+	t.Parallel()
+
+	// Setup Alice and Bob.
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	listedFilesChan := make(chan []clientdb.RemoteFile, 10)
+	bob.handle(client.OnContentListReceived(func(user *client.RemoteUser, files []clientdb.RemoteFile, listErr error) {
+		assert.NilErr(t, listErr)
+		listedFilesChan <- files
+	}))
+
+	// Alice shares an image.
+	img := image.NewNRGBA(image.Rect(0, 0, 800, 600))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i % 251)
+	}
+	var b bytes.Buffer
+	assert.NilErr(t, png.Encode(&b, img))
+	fname := filepath.Join(testutils.TempTestDir(t, "e2e-ftimg-"), "image.png")
+	assert.NilErr(t, os.WriteFile(fname, b.Bytes(), 0o600))
+	_, md, err := alice.ShareFile(fname, nil, 0, "image")
+	assert.NilErr(t, err)
+
+	// Bob lists the file and receives the media attributes.
+	assert.NilErr(t, bob.ListUserContent(alice.PublicID(), []string{rpc.RMFTDGlobal}, ""))
+	files := assert.ChanWritten(t, listedFilesChan)
+	assert.DeepEqual(t, len(files), 1)
+	assert.DeepEqual(t, files[0].Metadata, md)
+	info := mediainfo.FromAttributes(files[0].Metadata.Attributes)
+	assert.DeepEqual(t, info.MimeType, "image/png")
+	assert.DeepEqual(t, info.Width, 800)
+	assert.DeepEqual(t, info.Height, 600)
+	thumb, err := mediainfo.DecodeThumbnail(info.Thumbnail)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, thumb.Bounds().Dx(), mediainfo.DefaultThumbnailMaxDim)
+}
+
+// TestFtGCShares tests that files shared with a GC are accessible only to the
+// current members of the GC.
+func TestFtGCShares(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	dave := ts.newClient("dave")
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+	ts.kxUsers(alice, dave)
+
+	// Alice creates a GC and invites Bob and Charlie. Dave is not a
+	// member.
+	gcID, err := alice.NewGroupChat("test_gc")
+	assert.NilErr(t, err)
+	assertClientJoinsGC(t, gcID, alice, bob)
+	assertClientJoinsGC(t, gcID, alice, charlie)
+	assertClientsKXd(t, bob, charlie)
+
+	// Handlers.
+	bobSharedChan := make(chan clientdb.RemoteFile, 10)
+	bob.handle(client.OnGCFileSharedNtfn(func(ru *client.RemoteUser, gcid client.GCID, file clientdb.RemoteFile, ts time.Time) {
+		if ru.ID() == alice.PublicID() && gcid == gcID {
+			bobSharedChan <- file
+		}
+	}))
+	bobListChan := make(chan []clientdb.RemoteFile, 10)
+	bob.handle(client.OnGCContentListReceived(func(user *client.RemoteUser, gcid client.GCID, files []clientdb.RemoteFile, listErr error) {
+		assert.NilErr(t, listErr)
+		if user.ID() == alice.PublicID() {
+			bobListChan <- files
+		}
+	}))
+
+	// Hooks to handle chunk payment.
+	type hookedInvoice struct {
+		amt int64
+		cb  func(int64)
+	}
+	var invoicesMtx sync.Mutex
+	invoices := map[string]hookedInvoice{}
+	alice.mpc.HookGetInvoice(func(amt int64, cb func(int64)) (string, error) {
+		invoicesMtx.Lock()
+		id := fmt.Sprintf("hooked-inv-%03d", len(invoices))
+		invoices[id] = hookedInvoice{amt: amt, cb: cb}
+		invoicesMtx.Unlock()
+		return id, nil
+	})
+	payHookedInvoice := func(id string) (int64, error) {
+		invoicesMtx.Lock()
+		inv, ok := invoices[id]
+		invoicesMtx.Unlock()
+		if !ok {
+			// Not a hooked invoice.
+			return 0, nil
+		}
+		inv.cb(inv.amt)
+		return inv.amt, nil
+	}
+
+	completedChans := make(map[*testClient]chan string)
+	for _, c := range []*testClient{bob, charlie, dave} {
+		c := c
+		c.mpc.HookPayInvoice(payHookedInvoice)
+		completedChans[c] = make(chan string, 10)
+		c.handle(client.OnFileDownloadCompleted(func(user *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+			completedChans[c] <- diskPath
+		}))
+	}
+
+	// Alice shares a file with the GC. Bob is notified of it.
+	fname := testutils.RandomFile(t, defaultChunkSize*2)
+	sf, md, err := alice.ShareFileWithGC(fname, gcID, 0, "gc file")
+	assert.NilErr(t, err)
+	shared := assert.ChanWritten(t, bobSharedChan)
+	assert.DeepEqual(t, shared.FID, sf.FID)
+	assert.DeepEqual(t, shared.Metadata.Filename, md.Filename)
+
+	// The file is not listed as a global or user-shared file.
+	localFiles, err := alice.ListLocalSharedFiles()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(localFiles), 1)
+	assert.DeepEqual(t, localFiles[0].Global, false)
+	assert.DeepEqual(t, localFiles[0].GCShares, []clientdb.UserID{gcID})
+
+	// Bob lists the GC files.
+	assert.NilErr(t, bob.ListGCContent(gcID, ""))
+	files := assert.ChanWritten(t, bobListChan)
+	assert.DeepEqual(t, len(files), 1)
+	assert.DeepEqual(t, files[0].Metadata, md)
+
+	// Bob and Charlie can download the file.
+	for _, c := range []*testClient{bob, charlie} {
+		assert.NilErr(t, c.GetUserContent(alice.PublicID(), sf.FID))
+		completedPath := assert.ChanWritten(t, completedChans[c])
+		assert.EqualFiles(t, fname, completedPath)
+	}
+
+	// Dave, who is not a member, cannot download it.
+	assert.NilErr(t, dave.GetUserContent(alice.PublicID(), sf.FID))
+	assert.ChanNotWritten(t, completedChans[dave], time.Second)
+
+	// Charlie is kicked and can no longer download the file.
+	assert.NilErr(t, alice.GCKick(gcID, charlie.PublicID(), "reasons"))
+	assert.NilErr(t, charlie.GetUserContent(alice.PublicID(), sf.FID))
+	assert.ChanNotWritten(t, completedChans[charlie], time.Second)
+
+	// Dave joins the GC and is now able to download the file.
+	assertClientJoinsGC(t, gcID, alice, dave)
+	assert.NilErr(t, dave.GetUserContent(alice.PublicID(), sf.FID))
+	completedPath := assert.ChanWritten(t, completedChans[dave])
+	assert.EqualFiles(t, fname, completedPath)
+
+	// After unsharing, Bob no longer lists the file.
+	assert.NilErr(t, alice.UnshareFileWithGC(sf.FID, gcID))
+	assert.NilErr(t, bob.ListGCContent(gcID, ""))
+	files = assert.ChanWritten(t, bobListChan)
+	assert.DeepEqual(t, len(files), 0)
+}
+
+// TestFtStreamFile tests streaming a file while it is downloaded.
+func TestFtStreamFile(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Hooks to handle chunk payment.
+	type hookedInvoice struct {
+		amt int64
+		cb  func(int64)
+	}
+	var invoicesMtx sync.Mutex
+	invoices := map[string]hookedInvoice{}
+	alice.mpc.HookGetInvoice(func(amt int64, cb func(int64)) (string, error) {
+		invoicesMtx.Lock()
+		id := fmt.Sprintf("hooked-inv-%03d", len(invoices))
+		invoices[id] = hookedInvoice{amt: amt, cb: cb}
+		invoicesMtx.Unlock()
+		return id, nil
+	})
+	var failPayments atomic.Bool
+	bob.mpc.HookPayInvoice(func(id string) (int64, error) {
+		if failPayments.Load() {
+			return 0, fmt.Errorf("payment failed")
+		}
+		invoicesMtx.Lock()
+		inv, ok := invoices[id]
+		invoicesMtx.Unlock()
+		if !ok {
+			return 0, nil
+		}
+		inv.cb(inv.amt)
+		return inv.amt, nil
+	})
+	completedFileChan := make(chan string, 10)
+	bob.handle(client.OnFileDownloadCompleted(func(user *client.RemoteUser, fm rpc.FileMetadata, diskPath string) {
+		completedFileChan <- diskPath
+	}))
+
+	// Alice shares a file with many chunks.
+	const nbChunks = 12
+	fname := testutils.RandomFile(t, defaultChunkSize*nbChunks)
+	wantData, err := os.ReadFile(fname)
+	assert.NilErr(t, err)
+	sf, _, err := alice.ShareFile(fname, nil, 1, "streamed file")
+	assert.NilErr(t, err)
+
+	// Bob starts streaming the file.
+	fs, err := bob.StreamUserContent(alice.PublicID(), sf.FID)
+	assert.NilErr(t, err)
+
+	// Opening a second stream for the same file fails.
+	_, err = bob.StreamUserContent(alice.PublicID(), sf.FID)
+	if err == nil {
+		t.Fatalf("unexpected success opening second stream")
+	}
+
+	// Read the start of the file.
+	buf := make([]byte, defaultChunkSize+3)
+	_, err = io.ReadFull(fs, buf)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, buf, wantData[:len(buf)])
+
+	// Seek near the end of the file and read it.
+	offset := int64(defaultChunkSize*(nbChunks-2) + 3)
+	_, err = fs.Seek(offset, io.SeekStart)
+	assert.NilErr(t, err)
+	tail, err := io.ReadAll(fs)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tail, wantData[offset:])
+
+	// Seek back and read the full file.
+	_, err = fs.Seek(0, io.SeekStart)
+	assert.NilErr(t, err)
+	all, err := io.ReadAll(fs)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, all, wantData)
+	assert.NilErr(t, fs.Close())
+
+	// The download completes.
+	completedPath := assert.ChanWritten(t, completedFileChan)
+	assert.EqualFiles(t, fname, completedPath)
+
+	// Streaming the completed file reads it from disk.
+	fs, err = bob.StreamUserContent(alice.PublicID(), sf.FID)
+	assert.NilErr(t, err)
+	_, err = fs.Seek(-5, io.SeekEnd)
+	assert.NilErr(t, err)
+	tail, err = io.ReadAll(fs)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tail, wantData[len(wantData)-5:])
+	assert.NilErr(t, fs.Close())
+
+	// Closing a stream unblocks a read waiting for a chunk that is not
+	// downloaded, and allows streaming the file again.
+	failPayments.Store(true)
+	fname2 := testutils.RandomFile(t, defaultChunkSize*2)
+	sf2, _, err := alice.ShareFile(fname2, nil, 1, "unpaid file")
+	assert.NilErr(t, err)
+	fs, err = bob.StreamUserContent(alice.PublicID(), sf2.FID)
+	assert.NilErr(t, err)
+	readErrChan := make(chan error, 1)
+	go func() {
+		_, err := fs.Read(buf)
+		readErrChan <- err
+	}()
+	assert.ChanNotWritten(t, readErrChan, 500*time.Millisecond)
+	assert.NilErr(t, fs.Close())
+	assert.NonNilErr(t, assert.ChanWritten(t, readErrChan))
+	fs, err = bob.StreamUserContent(alice.PublicID(), sf2.FID)
+	assert.NilErr(t, err)
+	assert.NilErr(t, fs.Close())
+}
+
 // TestFtSendFile tests that the send file feature works.
 func TestFtSendFile(t *testing.T) {
 	t.Parallel()
@@ -125,8 +125,12 @@ type clientCfg struct {
 	disableAutoUnsubIdle bool
 	disableAutoHandshake bool
 	gcInviteExpiration   time.Duration
+	tipOnchainFallback   bool
 
 	recentMediateIDThreshold time.Duration
+
+	feedBridgeSources  []string
+	feedBridgeInterval time.Duration
 }
 
 const defaultAutoUnsubIdleUserInterval = 14 * time.Second
@@ -212,12 +216,25 @@ func withID(id *zkidentity.FullIdentity) newClientOpt {
 	}
 }
 
+func withFeedBridge(interval time.Duration, sources ...string) newClientOpt {
+	return func(cfg *clientCfg) {
+		cfg.feedBridgeSources = sources
+		cfg.feedBridgeInterval = interval
+	}
+}
+
 func withGCInviteExpiration(d time.Duration) newClientOpt {
 	return func(cfg *clientCfg) {
 		cfg.gcInviteExpiration = d
 	}
 }
 
+func withTipOnchainFallback() newClientOpt {
+	return func(cfg *clientCfg) {
+		cfg.tipOnchainFallback = true
+	}
+}
+
 func withLogName(s string) newClientOpt {
 	return func(cfg *clientCfg) {
 		cfg.logName = s
@@ -524,6 +541,7 @@ func (ts *testScaffold) newClientWithCfg(nccfg *clientCfg, opts ...newClientOpt)
 		TipUserReRequestInvoiceDelay: time.Second,
 		TipUserMaxLifetime:           20 * time.Second,
 		TipUserPayRetryDelayFactor:   100 * time.Millisecond,
+		TipOnchainFallback:           nccfg.tipOnchainFallback,
 
 		GCMQUpdtDelay:    100 * time.Millisecond,
 		GCMQMaxLifetime:  time.Second,
@@ -542,6 +560,13 @@ func (ts *testScaffold) newClientWithCfg(nccfg *clientCfg, opts ...newClientOpt)
 
 		RTDTRandomStreamHandler: nccfg.rtdtRandomStreamHandler,
 
+		FeedBridgeSources:         nccfg.feedBridgeSources,
+		FeedBridgeInterval:        nccfg.feedBridgeInterval,
+		FeedBridgeMaxPostsPerPoll: 2,
+		PostsJanitorInterval:      250 * time.Millisecond,
+		ScheduledPostsInterval:    250 * time.Millisecond,
+		RecurringPaymentsInterval: 250 * time.Millisecond,
+
 		ResourcesProvider: resources.ProviderFunc(func(ctx context.Context,
 			uid clientintf.UserID,
 			request *rpc.RMFetchResource) (*rpc.RMFetchResourceReply, error) {
@@ -639,7 +664,7 @@ func (ts *testScaffold) kxUsersWithInvite(inviter, invitee *testClient, gcID zki
 	assert.NilErr(ts.t, err)
 	assert.NilErr(ts.t, inviter.AddInviteOnKX(invite.InitialRendezvous, gcID))
 	errChan := make(chan error, 1)
-	go func() { errChan <- invitee.AcceptInvite(invite) }()
+	go func() { errChan <- invitee.AcceptInvite(invite, 0) }()
 	assert.NilErrFromChan(ts.t, errChan)
 	assertClientsKXd(ts.t, inviter, invitee)
 }
@@ -651,7 +676,7 @@ func (ts *testScaffold) kxUsers(inviter, invitee *testClient) {
 	invite, err := inviter.WriteNewInvite(io.Discard, nil)
 	assert.NilErr(ts.t, err)
 	errChan := make(chan error, 1)
-	go func() { errChan <- invitee.AcceptInvite(invite) }()
+	go func() { errChan <- invitee.AcceptInvite(invite, 0) }()
 	assert.NilErrFromChan(ts.t, errChan)
 	assertClientsKXd(ts.t, inviter, invitee)
 }
@@ -0,0 +1,124 @@
+package e2etests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+// testFeedServer is a local stand-in for a server of an external RSS feed.
+type testFeedServer struct {
+	*httptest.Server
+
+	mtx   sync.Mutex
+	items []string
+}
+
+func newTestFeedServer(t testing.TB) *testFeedServer {
+	fs := &testFeedServer{}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fs.mtx.Lock()
+		defer fs.mtx.Unlock()
+		w.Header().Set("Content-Type", "application/rss+xml")
+		fmt.Fprintf(w, `<?xml version="1.0"?>
+<rss version="2.0"><channel><title>Test News</title><link>%s</link>`, fs.URL)
+		// Newest items first.
+		for i := len(fs.items) - 1; i >= 0; i-- {
+			fmt.Fprint(w, fs.items[i])
+		}
+		fmt.Fprint(w, `</channel></rss>`)
+	}))
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+// addItem adds an item to the feed.
+func (fs *testFeedServer) addItem(id int) {
+	fs.mtx.Lock()
+	fs.items = append(fs.items, fmt.Sprintf(`<item><guid>item-%d</guid>
+<title>Item %d</title><link>%s/item/%d</link><author>reporter</author>
+<pubDate>%s</pubDate><description>&lt;p&gt;Summary of item %d&lt;/p&gt;</description></item>`,
+		id, id, fs.URL, id, time.Now().Add(time.Duration(id)*time.Second).Format(time.RFC1123Z), id))
+	fs.mtx.Unlock()
+}
+
+// TestFeedBridge tests republishing items of an external feed as posts.
+func TestFeedBridge(t *testing.T) {
+	t.Parallel()
+
+	feedSrv := newTestFeedServer(t)
+	feedSrv.addItem(1)
+	feedSrv.addItem(2)
+	feedSrv.addItem(3)
+
+	// Track feed items imported by Alice. The notification manager is
+	// setup before the client runs to avoid missing the first poll.
+	importedChan := make(chan clientdb.ImportedFeedItem, 10)
+	ntfns := client.NewNotificationManager()
+	ntfns.Register(client.OnFeedItemImportedNtfn(func(source string,
+		item clientdb.ImportedFeedItem, summ clientdb.PostSummary) {
+		importedChan <- item
+	}))
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	interval := 250 * time.Millisecond
+	alice := ts.newClient("alice", withNtfns(ntfns),
+		withFeedBridge(interval, feedSrv.URL))
+	bob := ts.newClient("bob")
+
+	// The client is configured to publish at most 2 items per poll, so only
+	// the newest two items are published on the first poll.
+	gotItems := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		item := assert.ChanWritten(t, importedChan)
+		gotItems[item.ItemID] = true
+	}
+	assert.DeepEqual(t, gotItems, map[string]bool{"item-2": true, "item-3": true})
+	assert.ChanNotWritten(t, importedChan, interval*3)
+	posts, err := alice.ListPosts()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 2)
+
+	// Bob subscribes to Alice's posts.
+	ts.kxUsers(alice, bob)
+	assertSubscribeToPosts(t, alice, bob)
+	bobPostsChan := make(chan rpc.PostMetadata, 10)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser,
+		summ clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobPostsChan <- pm
+	}))
+
+	// A new item is published in the feed. Bob receives it as a post from
+	// Alice with the attribution to the original feed.
+	feedSrv.addItem(4)
+	item := assert.ChanWritten(t, importedChan)
+	assert.DeepEqual(t, item.ItemID, "item-4")
+	pm := assert.ChanWritten(t, bobPostsChan)
+	content := pm.Attributes[rpc.RMPMain]
+	wantContains := []string{
+		"# Item 4",
+		"Summary of item 4",
+		feedSrv.URL + "/item/4",
+		"Originally published by reporter on Test News",
+	}
+	for _, want := range wantContains {
+		if !strings.Contains(content, want) {
+			t.Fatalf("post does not contain %q: %q", want, content)
+		}
+	}
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPDescription], "Summary of item 4")
+
+	// The item is not republished on the next polls.
+	assert.ChanNotWritten(t, importedChan, interval*3)
+	assert.ChanNotWritten(t, bobPostsChan, time.Second)
+}
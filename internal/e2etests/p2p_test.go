@@ -1,7 +1,14 @@
 package e2etests
 
 import (
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/rpc"
 )
 
 // TestCanPM performs a simple E2E KX and PM test.
@@ -14,3 +21,50 @@ func TestCanPM(t *testing.T) {
 	ts.kxUsers(alice, bob)
 	assertClientsCanPM(t, alice, bob)
 }
+
+// TestFragmentedMsgs tests that PMs and posts larger than the max message size
+// are sent as fragments and reassembled by the receiver.
+func TestFragmentedMsgs(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+
+	bobPMs := make(chan string, 1)
+	bob.handle(client.OnPMNtfn(func(user *client.RemoteUser, msg rpc.RMPrivateMessage, ts time.Time) {
+		bobPMs <- msg.Message
+	}))
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+
+	ts.kxUsers(alice, bob)
+
+	// Alice sends a PM larger than the max message size. Bob receives the
+	// full PM.
+	maxPayloadSize := alice.MaxMsgPayloadSize()
+	bigMsg := strings.Repeat("a", maxPayloadSize*5/2)
+	assert.NilErr(t, alice.PM(bob.PublicID(), bigMsg))
+	gotMsg := assert.ChanWritten(t, bobPMs)
+	if gotMsg != bigMsg {
+		t.Fatalf("unexpected PM of len %d, want len %d", len(gotMsg),
+			len(bigMsg))
+	}
+
+	// Small messages still work.
+	assertClientsCanPM(t, alice, bob)
+
+	// Alice creates a post larger than the max message size. Bob receives
+	// it.
+	assertSubscribeToPosts(t, alice, bob)
+	_, err := alice.CreatePost(bigMsg, "")
+	assert.NilErr(t, err)
+	pm := assert.ChanWritten(t, bobRecvPosts)
+	if pm.Attributes[rpc.RMPMain] != bigMsg {
+		t.Fatalf("unexpected post of len %d, want len %d",
+			len(pm.Attributes[rpc.RMPMain]), len(bigMsg))
+	}
+}
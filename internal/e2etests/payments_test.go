@@ -1,18 +1,24 @@
 package e2etests
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
+	"github.com/companyzero/bisonrelay/client/clientdb"
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/internal/assert"
 	"github.com/companyzero/bisonrelay/internal/testutils"
 	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
 )
 
 // TestTipUserExceedsLifetime asserts that if the max lifetime of the tip
@@ -1098,3 +1104,558 @@ func TestRecvTipExpired(t *testing.T) {
 	// Bob should not be attempting to track an expired invoice.
 	assert.ChanNotWritten(t, trackInvoiceChan, time.Second)
 }
+
+// TestPaymentRequests tests requesting payments from a remote user and the
+// remote user accepting or declining them.
+func TestPaymentRequests(t *testing.T) {
+	t.Parallel()
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Invoices are settled on Alice's side once Bob pays them.
+	const payMAtoms = 50000000
+	var mtx sync.Mutex
+	settled := make(map[string]chan struct{})
+	settledChan := func(inv string) chan struct{} {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if settled[inv] == nil {
+			settled[inv] = make(chan struct{})
+		}
+		return settled[inv]
+	}
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	var invoiceCount int
+	alice.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		mtx.Lock()
+		invoiceCount++
+		inv := fmt.Sprintf("invoice %d", invoiceCount)
+		mtx.Unlock()
+		return inv, nil
+	})
+	alice.mpc.HookTrackInvoice(func(inv string, amt int64) (int64, error) {
+		select {
+		case <-settledChan(inv):
+			return amt, nil
+		case <-done:
+			return 0, context.Canceled
+		}
+	})
+	paidInvoices := make(chan string, 3)
+	bob.mpc.HookDecodeInvoice(func(inv string) (clientintf.DecodedInvoice, error) {
+		decoded, err := bob.mpc.DefaultDecodeInvoice(inv)
+		decoded.MAtoms = payMAtoms
+		return decoded, err
+	})
+	bob.mpc.HookPayInvoice(func(inv string) (int64, error) {
+		close(settledChan(inv))
+		paidInvoices <- inv
+		return 0, nil
+	})
+
+	bobRecvReqs := make(chan clientdb.PaymentRequest, 3)
+	bob.handle(client.OnPaymentRequestReceivedNtfn(func(ru *client.RemoteUser, pr clientdb.PaymentRequest) {
+		bobRecvReqs <- pr
+	}))
+	aliceUpdatedReqs := make(chan clientdb.PaymentRequest, 3)
+	alice.handle(client.OnPaymentRequestUpdatedNtfn(func(ru *client.RemoteUser, pr clientdb.PaymentRequest) {
+		aliceUpdatedReqs <- pr
+	}))
+
+	// Alice requests a payment from Bob. Bob accepts and pays it.
+	sentReq, err := alice.RequestPayment(bob.PublicID(), payMAtoms, "lunch", time.Hour)
+	assert.NilErr(t, err)
+	recvReq := assert.ChanWritten(t, bobRecvReqs)
+	assert.DeepEqual(t, recvReq.ID, sentReq.ID)
+	assert.DeepEqual(t, recvReq.Memo, "lunch")
+	assert.DeepEqual(t, recvReq.MilliAtoms, uint64(payMAtoms))
+	assert.DeepEqual(t, recvReq.Status, clientdb.PaymentRequestPending)
+	recvReq, err = bob.AcceptPaymentRequest(alice.PublicID(), recvReq.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, recvReq.Status, clientdb.PaymentRequestPaid)
+	assert.DeepEqual(t, assert.ChanWritten(t, paidInvoices), sentReq.Invoice)
+	updatedReq := assert.ChanWritten(t, aliceUpdatedReqs)
+	assert.DeepEqual(t, updatedReq.ID, sentReq.ID)
+	assert.DeepEqual(t, updatedReq.Status, clientdb.PaymentRequestPaid)
+
+	// Bob cannot pay the same request twice.
+	_, err = bob.AcceptPaymentRequest(alice.PublicID(), recvReq.ID)
+	assert.ErrorIs(t, err, client.ErrPaymentRequestNotPending)
+
+	// Alice requests a new payment, which Bob declines.
+	sentReq, err = alice.RequestPayment(bob.PublicID(), payMAtoms, "dinner", time.Hour)
+	assert.NilErr(t, err)
+	recvReq = assert.ChanWritten(t, bobRecvReqs)
+	assert.NilErr(t, bob.DeclinePaymentRequest(alice.PublicID(), recvReq.ID, "not today"))
+	updatedReq = assert.ChanWritten(t, aliceUpdatedReqs)
+	assert.DeepEqual(t, updatedReq.ID, sentReq.ID)
+	assert.DeepEqual(t, updatedReq.Status, clientdb.PaymentRequestDeclined)
+	assert.DeepEqual(t, updatedReq.Error, "not today")
+
+	// Bob cannot pay an expired request.
+	_, err = alice.RequestPayment(bob.PublicID(), payMAtoms, "", time.Second)
+	assert.NilErr(t, err)
+	recvReq = assert.ChanWritten(t, bobRecvReqs)
+	time.Sleep(time.Until(recvReq.Expires) + time.Second)
+	_, err = bob.AcceptPaymentRequest(alice.PublicID(), recvReq.ID)
+	assert.ErrorIs(t, err, client.ErrPaymentRequestExpired)
+	assert.ChanNotWritten(t, paidInvoices, 100*time.Millisecond)
+
+	// Both sides list the requests.
+	aliceReqs, err := alice.ListPaymentRequests()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(aliceReqs), 3)
+	bobReqs, err := bob.ListPaymentRequests()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(bobReqs), 3)
+}
+
+// TestRecurringPayments asserts that recurring payments are made every period
+// until completed, canceled or lapsed, and that the creator tracks the tier
+// members.
+func TestRecurringPayments(t *testing.T) {
+	t.Parallel()
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Bob's invoices are settled as soon as they are generated, except for
+	// the amount used to force Alice's payments to lapse.
+	const payDCR, lapseDCR = 0.001, 0.002
+	bob.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		if amt == int64(lapseDCR*1e11) {
+			return "", fmt.Errorf("no invoices for you")
+		}
+		return fmt.Sprintf("invoice for %d", amt), nil
+	})
+	bob.mpc.HookTrackInvoice(func(inv string, amt int64) (int64, error) {
+		return amt, nil
+	})
+	alice.mpc.HookDecodeInvoice(func(inv string) (clientintf.DecodedInvoice, error) {
+		decoded, err := alice.mpc.DefaultDecodeInvoice(inv)
+		_, _ = fmt.Sscanf(inv, "invoice for %d", &decoded.MAtoms)
+		return decoded, err
+	})
+
+	bobRecv := make(chan clientdb.RecurringPayment, 3)
+	bob.handle(client.OnRecurringPaymentReceivedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		bobRecv <- rp
+	}))
+	bobUpdated := make(chan clientdb.RecurringPayment, 5)
+	bob.handle(client.OnRecurringPaymentUpdatedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		bobUpdated <- rp
+	}))
+	aliceUpdated := make(chan clientdb.RecurringPayment, 5)
+	alice.handle(client.OnRecurringPaymentUpdatedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		aliceUpdated <- rp
+	}))
+	aliceLapsed := make(chan clientdb.RecurringPayment, 1)
+	alice.handle(client.OnRecurringPaymentLapsedNtfn(func(ru *client.RemoteUser, rp clientdb.RecurringPayment) {
+		aliceLapsed <- rp
+	}))
+
+	// Bob offers a single tier.
+	_, err := bob.SetRecurringPaymentTier("gold", payDCR, time.Minute)
+	assert.NilErr(t, err)
+
+	// Agreements for tiers Bob does not offer or that do not meet the
+	// tier requirements are rejected.
+	assertRejected := func(dcrAmount float64, period time.Duration, tier string) {
+		t.Helper()
+		rp, err := alice.CreateRecurringPayment(bob.PublicID(), dcrAmount, period, 0, tier)
+		assert.NilErr(t, err)
+		sent := assert.ChanWritten(t, aliceUpdated)
+		assert.DeepEqual(t, sent.ID, rp.ID)
+		assert.DeepEqual(t, sent.Status, clientdb.RecurringPaymentCanceled)
+		assert.ChanNotWritten(t, bobRecv, 100*time.Millisecond)
+	}
+	assertRejected(payDCR, time.Second, "silver")
+	assertRejected(payDCR/2, time.Second, "gold")
+	assertRejected(payDCR, time.Hour, "gold")
+
+	// Alice supports Bob for two periods.
+	rp, err := alice.CreateRecurringPayment(bob.PublicID(), payDCR, time.Second, 2, "gold")
+	assert.NilErr(t, err)
+	recv := assert.ChanWritten(t, bobRecv)
+	assert.DeepEqual(t, recv.ID, rp.ID)
+	assert.DeepEqual(t, recv.Tier, "gold")
+	assert.DeepEqual(t, recv.MilliAtoms, uint64(payDCR*1e11))
+
+	// The first period is paid and Alice becomes a member of the tier.
+	recv = assert.ChanWritten(t, bobUpdated)
+	assert.DeepEqual(t, recv.PeriodsPaid, uint32(1))
+	members, err := bob.ListTierMembers("gold")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(members), 1)
+	assert.DeepEqual(t, members[0].UID, alice.PublicID())
+
+	// Agreements that no longer meet the tier requirements do not count
+	// as members.
+	_, err = bob.SetRecurringPaymentTier("gold", payDCR*2, time.Minute)
+	assert.NilErr(t, err)
+	members, err = bob.ListTierMembers("gold")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(members), 0)
+	sent := assert.ChanWritten(t, aliceUpdated)
+	assert.DeepEqual(t, sent.PeriodsPaid, uint32(1))
+
+	// The second period completes the agreement on both sides.
+	recv = assert.ChanWritten(t, bobUpdated)
+	assert.DeepEqual(t, recv.PeriodsPaid, uint32(2))
+	assert.DeepEqual(t, recv.Status, clientdb.RecurringPaymentCompleted)
+	sent = assert.ChanWritten(t, aliceUpdated)
+	assert.DeepEqual(t, sent.Status, clientdb.RecurringPaymentCompleted)
+	assert.ChanNotWritten(t, bobUpdated, 2*time.Second)
+
+	// Alice starts an unlimited agreement, which Bob cancels.
+	rp, err = alice.CreateRecurringPayment(bob.PublicID(), payDCR, time.Hour, 0, "")
+	assert.NilErr(t, err)
+	recv = assert.ChanWritten(t, bobRecv)
+	assert.NilErr(t, bob.CancelRecurringPayment(alice.PublicID(), recv.ID, "closing shop"))
+	for sent.ID != rp.ID || sent.Status != clientdb.RecurringPaymentCanceled {
+		sent = assert.ChanWritten(t, aliceUpdated)
+	}
+	assert.DeepEqual(t, sent.Error, "closing shop")
+	err = alice.CancelRecurringPayment(bob.PublicID(), rp.ID, "")
+	assert.ErrorIs(t, err, client.ErrRecurringPaymentNotActive)
+
+	// Alice starts an agreement that Bob cannot generate invoices for, so
+	// it lapses.
+	rp, err = alice.CreateRecurringPayment(bob.PublicID(), lapseDCR, time.Hour, 0, "")
+	assert.NilErr(t, err)
+	lapsed := assert.ChanWritten(t, aliceLapsed)
+	assert.DeepEqual(t, lapsed.ID, rp.ID)
+	assert.DeepEqual(t, lapsed.Status, clientdb.RecurringPaymentLapsed)
+
+	// Both sides list the agreements.
+	aliceRPs, err := alice.ListRecurringPayments()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(aliceRPs), 6)
+	bobRPs, err := bob.ListRecurringPayments()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(bobRPs), 3)
+}
+
+// TestPaymentLedger asserts that tips are recorded in the payment ledger of
+// both the payer and the payee, and that the ledger can be exported and
+// summarized.
+func TestPaymentLedger(t *testing.T) {
+	t.Parallel()
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	const payMAtoms = int64(4321000)
+	bob.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		return fmt.Sprintf("invoice for %d", amt), nil
+	})
+	bob.mpc.HookTrackInvoice(func(inv string, amt int64) (int64, error) {
+		return amt, nil
+	})
+	alice.mpc.HookDecodeInvoice(func(inv string) (clientintf.DecodedInvoice, error) {
+		decoded, err := alice.mpc.DefaultDecodeInvoice(inv)
+		_, _ = fmt.Sscanf(inv, "invoice for %d", &decoded.MAtoms)
+		return decoded, err
+	})
+
+	tipEntry := func(c *testClient) chan clientdb.LedgerEntry {
+		entryChan := make(chan clientdb.LedgerEntry, 1)
+		c.handle(client.OnLedgerEntryNtfn(func(entry clientdb.LedgerEntry) {
+			if entry.Category == clientintf.PaymentCategoryTips {
+				entryChan <- entry
+			}
+		}))
+		return entryChan
+	}
+	aliceEntryChan, bobEntryChan := tipEntry(alice), tipEntry(bob)
+
+	// Alice tips Bob. Both should record the tip in their ledgers, with the
+	// same invoice hash.
+	assert.NilErr(t, alice.TipUser(bob.PublicID(), float64(payMAtoms)/1e11, 1))
+	aliceEntry := assert.ChanWritten(t, aliceEntryChan)
+	bobEntry := assert.ChanWritten(t, bobEntryChan)
+	assert.DeepEqual(t, aliceEntry.UID, bob.PublicID())
+	assert.DeepEqual(t, aliceEntry.Amount, -payMAtoms)
+	assert.DeepEqual(t, bobEntry.UID, alice.PublicID())
+	assert.DeepEqual(t, bobEntry.Amount, payMAtoms)
+	assert.DeepEqual(t, aliceEntry.InvoiceHash, bobEntry.InvoiceHash)
+	if aliceEntry.InvoiceHash == "" {
+		t.Fatal("empty invoice hash")
+	}
+
+	// The tip is listed in Bob's ledger.
+	entries, err := bob.ListLedgerEntries(time.Time{}, time.Time{})
+	assert.NilErr(t, err)
+	var found bool
+	for _, entry := range entries {
+		found = found || (entry.Event == bobEntry.Event &&
+			entry.InvoiceHash == bobEntry.InvoiceHash &&
+			entry.Amount == bobEntry.Amount)
+	}
+	assert.BoolIs(t, found, true)
+
+	// The tip is included in Bob's summary.
+	summaries, err := bob.SummarizeLedger(time.Time{}, time.Time{}, client.LedgerPeriodMonth)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(summaries), 1)
+	assert.DeepEqual(t, summaries[0].Received, payMAtoms)
+	assert.DeepEqual(t, summaries[0].Categories[clientintf.PaymentCategoryTips], payMAtoms)
+
+	// The tip is exported in Alice's ledger.
+	var b strings.Builder
+	assert.NilErr(t, alice.ExportLedger(&b, client.LedgerExportCSV, time.Time{}, time.Time{}))
+	wantLine := regexp.MustCompile(`(?m)^.*,bob,paytip,tips,-0\.00004321000,.*,` +
+		aliceEntry.InvoiceHash + ",")
+	if !wantLine.MatchString(b.String()) {
+		t.Fatalf("tip not found in exported ledger:\n%s", b.String())
+	}
+}
+
+// TestTipGroupChat asserts that tips to a GC are split among its members and
+// that the progress of the payouts is reported.
+func TestTipGroupChat(t *testing.T) {
+	t.Parallel()
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+
+	gcID, err := alice.NewGroupChat("test gc")
+	assert.NilErr(t, err)
+	assertJoinsGC(t, alice, bob, gcID)
+	assertJoinsGC(t, alice, charlie, gcID)
+	assertClientSeesInGC(t, alice, gcID, bob.PublicID())
+	assertClientSeesInGC(t, alice, gcID, charlie.PublicID())
+
+	// Charlie never generates invoices, so payouts to them fail.
+	bob.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		return fmt.Sprintf("invoice for %d", amt), nil
+	})
+	charlie.mpc.HookGetInvoice(func(amt int64, _ func(int64)) (string, error) {
+		return "", fmt.Errorf("no invoices for you")
+	})
+	alice.mpc.HookDecodeInvoice(func(inv string) (clientintf.DecodedInvoice, error) {
+		decoded, err := alice.mpc.DefaultDecodeInvoice(inv)
+		_, _ = fmt.Sscanf(inv, "invoice for %d", &decoded.MAtoms)
+		return decoded, err
+	})
+
+	progressChan := make(chan clientdb.GCTip, 10)
+	alice.handle(client.OnGCTipProgressNtfn(func(gt clientdb.GCTip) {
+		progressChan <- gt
+	}))
+	waitCompleted := func(id zkidentity.ShortID) clientdb.GCTip {
+		t.Helper()
+		for {
+			gt := assert.ChanWritten(t, progressChan)
+			if gt.ID == id && gt.Completed != nil {
+				return gt
+			}
+		}
+	}
+	memberAmount := func(gt clientdb.GCTip, uid clientintf.UserID) (uint64, clientdb.GCTipMemberStatus) {
+		t.Helper()
+		for _, m := range gt.Members {
+			if m.UID == uid {
+				return m.MilliAtoms, m.Status
+			}
+		}
+		t.Fatalf("user %s is not a member of gc tip", uid)
+		return 0, ""
+	}
+
+	// Tip split equally among Bob and Charlie (the local client is not
+	// tipped). Bob is paid and Charlie's payout fails.
+	const maxAttempts = 1
+	gt, err := alice.TipGroupChat(gcID, 0.003, clientdb.GCTipSplitEqual, maxAttempts)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(gt.Members), 2)
+	gt = waitCompleted(gt.ID)
+	amt, status := memberAmount(gt, bob.PublicID())
+	assert.DeepEqual(t, amt, uint64(0.0015*1e11))
+	assert.DeepEqual(t, status, clientdb.GCTipMemberCompleted)
+	_, status = memberAmount(gt, charlie.PublicID())
+	assert.DeepEqual(t, status, clientdb.GCTipMemberFailed)
+	completed, failed, pending, paid := gt.Progress()
+	assert.DeepEqual(t, []int{completed, failed, pending}, []int{1, 1, 0})
+	assert.DeepEqual(t, paid, uint64(0.0015*1e11))
+
+	// Tipping by shares fails without shares. Only admins may set them.
+	_, err = alice.TipGroupChat(gcID, 0.004, clientdb.GCTipSplitShares, maxAttempts)
+	assert.NonNilErr(t, err)
+	shares := []rpc.RMGroupTipShare{
+		{UID: bob.PublicID(), Share: 3},
+		{UID: charlie.PublicID(), Share: 1},
+	}
+	assert.NonNilErr(t, bob.SetGCTipShares(gcID, shares))
+	assert.NilErr(t, alice.SetGCTipShares(gcID, shares))
+	for i := 0; ; i++ {
+		gc, err := bob.GetGC(gcID)
+		assert.NilErr(t, err)
+		if len(gc.TipShares) == 2 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Bob did not see the tip shares")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Tip split by shares.
+	gt, err = alice.TipGroupChat(gcID, 0.004, clientdb.GCTipSplitShares, maxAttempts)
+	assert.NilErr(t, err)
+	gt = waitCompleted(gt.ID)
+	amt, status = memberAmount(gt, bob.PublicID())
+	assert.DeepEqual(t, amt, uint64(0.003*1e11))
+	assert.DeepEqual(t, status, clientdb.GCTipMemberCompleted)
+	amt, _ = memberAmount(gt, charlie.PublicID())
+	assert.DeepEqual(t, amt, uint64(0.001*1e11))
+
+	// Alice is the only admin, so there is no one to tip with the admins
+	// split mode.
+	_, err = alice.TipGroupChat(gcID, 0.004, clientdb.GCTipSplitAdmins, maxAttempts)
+	assert.NonNilErr(t, err)
+
+	gcTips, err := alice.ListGCTips()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(gcTips), 2)
+}
+
+// TestTipUserOnchainFallback tests that tips that cannot be paid through LN are
+// sent on-chain to an address provided by the payee.
+func TestTipUserOnchainFallback(t *testing.T) {
+	t.Parallel()
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice", withTipOnchainFallback())
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Bob never generates invoices, so LN payments to them fail.
+	bob.mpc.HookGetInvoice(func(int64, func(int64)) (string, error) {
+		return "", fmt.Errorf("no route to bob")
+	})
+
+	sentChan := make(chan string, 1)
+	alice.mpc.HookSendOnchain(func(addr string, amt dcrutil.Amount) (chainhash.Hash, error) {
+		sentChan <- fmt.Sprintf("%s %d", addr, amt)
+		return chainhash.Hash{0x01}, nil
+	})
+	confirmChan := make(chan struct{})
+	alice.mpc.HookWaitTxConfirmed(func(chainhash.Hash) error {
+		<-confirmChan
+		return nil
+	})
+
+	aliceTips := make(chan clientdb.OnchainTip, 10)
+	alice.handle(client.OnOnchainTipNtfn(func(_ *client.RemoteUser, ot clientdb.OnchainTip) {
+		aliceTips <- ot
+	}))
+	type verifiedTx struct {
+		tx   chainhash.Hash
+		addr string
+		amt  dcrutil.Amount
+	}
+	verifyChan := make(chan verifiedTx, 1)
+	verifyDone := make(chan struct{})
+	bob.mpc.HookVerifyTxPayment(func(tx chainhash.Hash, addr string, amt dcrutil.Amount) error {
+		verifyChan <- verifiedTx{tx: tx, addr: addr, amt: amt}
+		<-verifyDone
+		return nil
+	})
+	bobTips := make(chan clientdb.OnchainTip, 10)
+	bob.handle(client.OnOnchainTipNtfn(func(_ *client.RemoteUser, ot clientdb.OnchainTip) {
+		bobTips <- ot
+	}))
+	tipProgress := make(chan bool, 10)
+	alice.handle(client.OnTipAttemptProgressNtfn(func(ru *client.RemoteUser, amtMAtoms int64, completed bool, attempt int, attemptErr error, willRetry bool) {
+		if !willRetry {
+			tipProgress <- completed
+		}
+	}))
+
+	// Alice tips Bob. LN fails, so Alice requests an address from Bob.
+	const maxAttempts = 1
+	assert.NilErr(t, alice.TipUser(bob.PublicID(), 0.001, maxAttempts))
+	ot := assert.ChanWritten(t, aliceTips)
+	assert.DeepEqual(t, ot.Status, clientdb.OnchainTipAddrRequested)
+	assert.DeepEqual(t, ot.MilliAtoms, uint64(0.001*1e11))
+	if ot.LNError == "" {
+		t.Fatalf("LN error was not recorded")
+	}
+
+	// Alice sends the tip to the address provided by Bob.
+	bobAddr, err := bob.OnchainRecvAddrForUser(alice.PublicID(), "")
+	assert.NilErr(t, err)
+	sent := assert.ChanWritten(t, sentChan)
+	assert.DeepEqual(t, sent, fmt.Sprintf("%s %d", bobAddr, 100000))
+	ot = assert.ChanWritten(t, aliceTips)
+	assert.DeepEqual(t, ot.Status, clientdb.OnchainTipSent)
+	assert.DeepEqual(t, ot.TxID, chainhash.Hash{0x01}.String())
+	assert.ChanNotWritten(t, tipProgress, 250*time.Millisecond)
+
+	// The tx is confirmed. Both Alice and Bob are notified.
+	close(confirmChan)
+	ot = assert.ChanWritten(t, aliceTips)
+	assert.DeepEqual(t, ot.Status, clientdb.OnchainTipConfirmed)
+	assert.DeepEqual(t, assert.ChanWritten(t, tipProgress), true)
+	recv := assert.ChanWritten(t, bobTips)
+	assert.DeepEqual(t, recv.ID, ot.ID)
+	assert.DeepEqual(t, recv.Sent, false)
+	assert.DeepEqual(t, recv.Status, clientdb.OnchainTipUnverified)
+	assert.DeepEqual(t, recv.TxID, ot.TxID)
+	assert.DeepEqual(t, recv.MilliAtoms, ot.MilliAtoms)
+
+	// Bob verifies the tx with their wallet.
+	verified := assert.ChanWritten(t, verifyChan)
+	assert.DeepEqual(t, verified, verifiedTx{tx: chainhash.Hash{0x01},
+		addr: bobAddr, amt: 100000})
+	close(verifyDone)
+	recv = assert.ChanWritten(t, bobTips)
+	assert.DeepEqual(t, recv.Status, clientdb.OnchainTipConfirmed)
+
+	// Both sides list the tip.
+	aliceList, err := alice.ListOnchainTips()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(aliceList), 1)
+	assert.DeepEqual(t, aliceList[0].Status, clientdb.OnchainTipConfirmed)
+	bobList, err := bob.ListOnchainTips()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(bobList), 1)
+	assert.DeepEqual(t, bobList[0].Address, bobAddr)
+	assert.DeepEqual(t, bobList[0].Status, clientdb.OnchainTipConfirmed)
+
+	// The tip is recorded as received in Bob's ledger.
+	entries, err := bob.ListLedgerEntries(time.Time{}, time.Time{})
+	assert.NilErr(t, err)
+	var found bool
+	for _, entry := range entries {
+		found = found || (entry.Event == "tiponchain" &&
+			entry.UID == alice.PublicID() &&
+			entry.Amount == int64(ot.MilliAtoms))
+	}
+	assert.BoolIs(t, found, true)
+
+	// A receipt for a different tip on the same tx is rejected.
+	rm := rpc.RMOnchainTipReceipt{
+		ID:         zkidentity.RandomShortID(),
+		TxID:       ot.TxID,
+		MilliAtoms: ot.MilliAtoms,
+	}
+	assert.NilErr(t, alice.testInterface().SendUserRM(bob.PublicID(), rm))
+	assert.ChanNotWritten(t, bobTips, 250*time.Millisecond)
+	bobList, err = bob.ListOnchainTips()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(bobList), 1)
+}
@@ -2,6 +2,9 @@ package e2etests
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -507,3 +510,724 @@ func TestResubscribeToPosts(t *testing.T) {
 	assertPostSubscription(t, bob, alice, false)
 	assertReceivesNewPost(t, alice, []*testClient{bob})
 }
+
+// TestPostRevisions tests editing posts and that revisions are verified by
+// subscribers and recipients of relayed posts.
+func TestPostRevisions(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	dave := ts.newClient("dave")
+
+	// Setup handlers.
+	recvPostsChan := func(c *testClient) chan rpc.PostMetadata {
+		ch := make(chan rpc.PostMetadata, 1)
+		c.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+			ch <- pm
+		}))
+		return ch
+	}
+	recvRevsChan := func(c *testClient) chan rpc.PostMetadataStatus {
+		ch := make(chan rpc.PostMetadataStatus, 3)
+		c.handle(client.OnPostStatusRcvdNtfn(func(user *client.RemoteUser, pid clientintf.PostID,
+			statusFrom client.UserID, status rpc.PostMetadataStatus) {
+			if rpc.IsPostRevision(status.Attributes) {
+				ch <- status
+			}
+		}))
+		return ch
+	}
+	bobRecvPosts, bobRecvRevs := recvPostsChan(bob), recvRevsChan(bob)
+	charlieRecvPosts, charlieRecvRevs := recvPostsChan(charlie), recvRevsChan(charlie)
+	daveRecvPosts, daveRecvRevs := recvPostsChan(dave), recvRevsChan(dave)
+
+	// Bob subscribes to Alice. Charlie and Dave subscribe to Bob. Only
+	// Charlie knows Alice.
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(bob, charlie)
+	ts.kxUsers(bob, dave)
+	ts.kxUsers(alice, charlie)
+	assertSubscribeToPosts(t, alice, bob)
+	assertSubscribeToPosts(t, bob, charlie)
+	assertSubscribeToPosts(t, bob, dave)
+
+	// Alice creates a post.
+	post, err := alice.CreatePost("first version", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+
+	// Alice edits the post. Bob receives the revision.
+	_, err = alice.EditPost(post.ID, "second version", "")
+	assert.NilErr(t, err)
+	rev := assert.ChanWritten(t, bobRecvRevs)
+	assert.DeepEqual(t, rev.Attributes[rpc.RMPMain], "second version")
+	assert.DeepEqual(t, clientdb.PostRevisionNumber(&rev), uint32(1))
+
+	// Alice edits the post again.
+	_, err = alice.EditPost(post.ID, "third version", "")
+	assert.NilErr(t, err)
+	rev = assert.ChanWritten(t, bobRecvRevs)
+	assert.DeepEqual(t, clientdb.PostRevisionNumber(&rev), uint32(2))
+
+	// Bob has the full revision history and the post summary shows the
+	// latest version.
+	revs, err := bob.ListPostRevisions(alice.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(revs), 2)
+	assert.DeepEqual(t, revs[0].Attributes[rpc.RMPMain], "second version")
+	assert.DeepEqual(t, revs[1].Attributes[rpc.RMPMain], "third version")
+	orig, err := bob.ReadPost(alice.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, orig.Attributes[rpc.RMPMain], "first version")
+	posts, err := bob.ListPosts()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 1)
+	assert.DeepEqual(t, posts[0].LastRevision, uint32(2))
+	assert.DeepEqual(t, posts[0].Title, "third version")
+
+	// Bob cannot edit Alice's post.
+	_, err = bob.EditPost(post.ID, "bob version", "")
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+
+	// Bob relays the post. Charlie knows Alice and verifies and accepts
+	// the revisions. Dave cannot verify them, so Dave only has the original
+	// post.
+	assert.NilErr(t, bob.RelayPostToSubscribers(alice.PublicID(), post.ID))
+	assert.ChanWritten(t, charlieRecvPosts)
+	assert.ChanWritten(t, daveRecvPosts)
+	assert.ChanWritten(t, charlieRecvRevs)
+	assert.ChanWritten(t, charlieRecvRevs)
+	assertEmptyRMQ(t, bob)
+	assert.ChanNotWritten(t, daveRecvRevs, 250*time.Millisecond)
+
+	revs, err = charlie.ListPostRevisions(bob.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(revs), 2)
+	revs, err = dave.ListPostRevisions(bob.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(revs), 0)
+}
+
+// TestRestrictedPosts tests that posts restricted to an audience are only
+// shared with subscribers in the audience.
+func TestRestrictedPosts(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+
+	// Setup handlers.
+	recvPostsChan := func(c *testClient) chan rpc.PostMetadata {
+		ch := make(chan rpc.PostMetadata, 1)
+		c.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+			ch <- pm
+		}))
+		return ch
+	}
+	recvCommentsChan := func(c *testClient) chan string {
+		ch := make(chan string, 1)
+		c.handle(client.OnPostStatusRcvdNtfn(func(user *client.RemoteUser, pid clientintf.PostID,
+			statusFrom client.UserID, status rpc.PostMetadataStatus) {
+			ch <- status.Attributes[rpc.RMPSComment]
+		}))
+		return ch
+	}
+	bobRecvPosts, bobRecvComments := recvPostsChan(bob), recvCommentsChan(bob)
+	charlieRecvPosts, charlieRecvComments := recvPostsChan(charlie), recvCommentsChan(charlie)
+	charlieListPosts := make(chan rpc.RMListPostsReply, 1)
+	charlie.handle(client.OnPostsListReceived(func(ru *client.RemoteUser, postList rpc.RMListPostsReply) {
+		charlieListPosts <- postList
+	}))
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(alice, charlie)
+	ts.kxUsers(bob, charlie)
+	assertSubscribeToPosts(t, alice, bob)
+	assertSubscribeToPosts(t, alice, charlie)
+	assertSubscribeToPosts(t, bob, charlie)
+
+	// Creating a post for an unknown audience fails.
+	_, err := alice.CreatePostForAudience("secret", "", "team")
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+
+	// Alice creates an audience with only Bob and a post restricted to it.
+	// Only Bob receives it.
+	assert.NilErr(t, alice.SavePostAudience("team", []clientintf.UserID{bob.PublicID()}))
+	post, err := alice.CreatePostForAudience("secret", "", "team")
+	assert.NilErr(t, err)
+	pm := assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPMain], "secret")
+	assertEmptyRMQ(t, alice)
+	assert.ChanNotWritten(t, charlieRecvPosts, 250*time.Millisecond)
+
+	// Comments are only shared with the audience.
+	_, err = bob.CommentPost(alice.PublicID(), post.ID, "bob comment", nil)
+	assert.NilErr(t, err)
+	assert.ChanWrittenWithVal(t, bobRecvComments, "bob comment")
+	assertEmptyRMQ(t, alice)
+	assert.ChanNotWritten(t, charlieRecvComments, 250*time.Millisecond)
+
+	// The post cannot be relayed, neither by Alice nor Bob.
+	err = alice.RelayPostToSubscribers(alice.PublicID(), post.ID)
+	assert.ErrorIs(t, err, client.ErrRestrictedPost)
+	err = bob.RelayPost(alice.PublicID(), post.ID, charlie.PublicID())
+	assert.ErrorIs(t, err, client.ErrRestrictedPost)
+
+	// Charlie does not accept copies of the post sent directly by Bob,
+	// either as-is or with the restriction removed.
+	assert.True(t, rpc.IsPostRestricted(&pm))
+	err = bob.testInterface().SendUserRM(charlie.PublicID(), rpc.RMPostShare(pm))
+	assert.NilErr(t, err)
+	stripped := rpc.PostMetadata{Version: pm.Version, Attributes: maps.Clone(pm.Attributes)}
+	delete(stripped.Attributes, rpc.RMPRestricted)
+	err = bob.testInterface().SendUserRM(charlie.PublicID(), rpc.RMPostShare(stripped))
+	assert.NilErr(t, err)
+	assert.ChanNotWritten(t, charlieRecvPosts, 250*time.Millisecond)
+
+	// Charlie cannot fetch or list the post.
+	assert.NilErr(t, charlie.GetUserPost(alice.PublicID(), post.ID, true))
+	assert.NilErr(t, charlie.ListUserPosts(alice.PublicID()))
+	postList := assert.ChanWritten(t, charlieListPosts)
+	assert.DeepEqual(t, len(postList.Posts), 0)
+	assert.ChanNotWritten(t, charlieRecvPosts, 250*time.Millisecond)
+
+	// Unrestricted posts are received by everyone.
+	_, err = alice.CreatePost("public", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+	assert.ChanWritten(t, charlieRecvPosts)
+}
+
+// TestPaidPosts asserts that the full content of a paid post is only sent
+// to subscribers after they pay for it.
+func TestPaidPosts(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+
+	ts.kxUsers(alice, bob)
+	assertSubscribeToPosts(t, alice, bob)
+
+	// Hook Alice's and Bob's payment clients so that the invoice paid by
+	// Bob is the one tracked by Alice. Bob's first payment attempt fails.
+	price := uint64(1e8)
+	invoice := "paid post invoice"
+	paidChan := make(chan struct{}, 1)
+	aliceGenInvoice := make(chan struct{}, 2)
+	alice.mpc.HookGetInvoice(func(amt int64, cb func(int64)) (string, error) {
+		if amt != int64(price) {
+			return "", fmt.Errorf("unexpected amount %d", amt)
+		}
+		aliceGenInvoice <- struct{}{}
+		return invoice, nil
+	})
+	alice.mpc.HookTrackInvoice(func(inv string, amt int64) (int64, error) {
+		if inv != invoice {
+			return 0, fmt.Errorf("unexpected invoice %q", inv)
+		}
+		select {
+		case <-paidChan:
+			return amt, nil
+		case <-alice.ctx.Done():
+			return 0, alice.ctx.Err()
+		}
+	})
+	bob.mpc.HookDecodeInvoice(func(inv string) (clientintf.DecodedInvoice, error) {
+		decoded, err := bob.mpc.DefaultDecodeInvoice(inv)
+		decoded.MAtoms = int64(price)
+		return decoded, err
+	})
+	var bobPayAttempts atomic.Int32
+	bob.mpc.HookPayInvoice(func(inv string) (int64, error) {
+		if inv != invoice {
+			return 0, fmt.Errorf("unexpected invoice %q", inv)
+		}
+		if bobPayAttempts.Add(1) == 1 {
+			return 0, fmt.Errorf("first attempt fails")
+		}
+		paidChan <- struct{}{}
+		return 0, nil
+	})
+	bobPurchaseFailed := make(chan error, 1)
+	bob.handle(client.OnPaidPostPurchaseFailedNtfn(func(ru *client.RemoteUser, pid clientintf.PostID, err error) {
+		bobPurchaseFailed <- err
+	}))
+
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+	bobUnlocked := make(chan clientintf.PostID, 1)
+	bob.handle(client.OnPaidPostUnlockedNtfn(func(ru *client.RemoteUser, pid clientintf.PostID) {
+		bobUnlocked <- pid
+	}))
+	aliceSold := make(chan int64, 1)
+	alice.handle(client.OnPostSoldNtfn(func(ru *client.RemoteUser, pid clientintf.PostID, amountMAtoms int64) {
+		aliceSold <- amountMAtoms
+	}))
+
+	// Alice creates a paid post. Bob only receives the preview.
+	content := "full content of the post"
+	post, err := alice.CreatePaidPost("preview", content, "", price)
+	assert.NilErr(t, err)
+	pm := assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPMain], "preview")
+	gotPrice, err := clientdb.PaidPostPrice(&pm)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, gotPrice, price)
+	_, err = bob.PaidPostContent(alice.PublicID(), post.ID)
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+
+	// Bob's first attempt to buy the post fails. Alice keeps the invoice
+	// pending.
+	assert.NilErr(t, bob.BuyPost(alice.PublicID(), post.ID))
+	assert.ChanWritten(t, aliceGenInvoice)
+	assert.NonNilErr(t, assert.ChanWritten(t, bobPurchaseFailed))
+	stats, err := alice.PostSalesStats(post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, stats.Pending, uint64(1))
+
+	// Bob buys the post again and receives the full content. Alice reuses
+	// the pending invoice.
+	assert.NilErr(t, bob.BuyPost(alice.PublicID(), post.ID))
+	assert.ChanWrittenWithVal(t, aliceSold, int64(price))
+	assert.ChanWrittenWithVal(t, bobUnlocked, post.ID)
+	gotContent, err := bob.PaidPostContent(alice.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, gotContent, content)
+
+	assert.ChanNotWritten(t, aliceGenInvoice, 100*time.Millisecond)
+
+	// Alice's sales stats include the sale.
+	stats, err = alice.PostSalesStats(post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, stats.Sales, uint64(1))
+	assert.DeepEqual(t, stats.TotalMAtoms, price)
+	assert.DeepEqual(t, stats.Pending, uint64(0))
+
+	// Buying the post again or buying a free post fails.
+	err = bob.BuyPost(alice.PublicID(), post.ID)
+	assert.ErrorIs(t, err, client.ErrPaidPostUnlocked)
+	freePost, err := alice.CreatePost("free post", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+	err = bob.BuyPost(alice.PublicID(), freePost.ID)
+	assert.ErrorIs(t, err, client.ErrNotPaidPost)
+}
+
+// TestPostCommentModeration tests that the author of a post can hold comments
+// for approval, remove comments and lock the comments of a post.
+func TestPostCommentModeration(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+
+	ts.kxUsers(alice, bob)
+	assertSubscribeToPosts(t, alice, bob)
+
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+	bobRecvStatus := make(chan rpc.PostMetadataStatus, 3)
+	bob.handle(client.OnPostStatusRcvdNtfn(func(user *client.RemoteUser, pid clientintf.PostID,
+		statusFrom client.UserID, status rpc.PostMetadataStatus) {
+		bobRecvStatus <- status
+	}))
+	aliceHeld := make(chan rpc.PostMetadataStatus, 1)
+	alice.handle(client.OnPostCommentHeldNtfn(func(ru *client.RemoteUser, pid clientintf.PostID, status rpc.PostMetadataStatus) {
+		aliceHeld <- status
+	}))
+
+	// Alice holds comments from unknown users.
+	assert.NilErr(t, alice.SetCommentHoldMode(clientdb.CommentHoldUnknown))
+	post, err := alice.CreatePost("test post", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+
+	// Bob's first comment is held.
+	_, err = bob.CommentPost(alice.PublicID(), post.ID, "first comment", nil)
+	assert.NilErr(t, err)
+	held := assert.ChanWritten(t, aliceHeld)
+	assert.DeepEqual(t, held.Attributes[rpc.RMPSComment], "first comment")
+	assert.ChanNotWritten(t, bobRecvStatus, 250*time.Millisecond)
+	heldList, err := alice.ListHeldPostComments()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(heldList), 1)
+
+	// Alice approves the comment. Bob receives it.
+	assert.NilErr(t, alice.ApproveHeldPostComment(post.ID, held.Hash()))
+	status := assert.ChanWritten(t, bobRecvStatus)
+	assert.DeepEqual(t, status.Attributes[rpc.RMPSComment], "first comment")
+	heldList, err = alice.ListHeldPostComments()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(heldList), 0)
+
+	// Bob is now known, so his second comment is not held.
+	_, err = bob.CommentPost(alice.PublicID(), post.ID, "second comment", nil)
+	assert.NilErr(t, err)
+	status = assert.ChanWritten(t, bobRecvStatus)
+	assert.DeepEqual(t, status.Attributes[rpc.RMPSComment], "second comment")
+	assert.ChanNotWritten(t, aliceHeld, 250*time.Millisecond)
+
+	// Alice removes the first comment. Bob no longer lists it.
+	_, err = alice.RemovePostComment(post.ID, held.Hash())
+	assert.NilErr(t, err)
+	status = assert.ChanWritten(t, bobRecvStatus)
+	assert.DeepEqual(t, status.Attributes[rpc.RMPSModeration], rpc.RMPSModRemove)
+	updates, err := bob.ListPostStatusUpdates(alice.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	var comments []string
+	for _, u := range updates {
+		if c, ok := u.Attributes[rpc.RMPSComment]; ok {
+			comments = append(comments, c)
+		}
+	}
+	assert.DeepEqual(t, comments, []string{"second comment"})
+
+	// Alice locks the comments. Bob can no longer comment.
+	_, err = alice.LockPostComments(post.ID, true)
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvStatus)
+	_, err = bob.CommentPost(alice.PublicID(), post.ID, "third comment", nil)
+	assert.ErrorIs(t, err, client.ErrPostCommentsLocked)
+
+	// Alice unlocks the comments. Bob can comment again.
+	_, err = alice.LockPostComments(post.ID, false)
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvStatus)
+	_, err = bob.CommentPost(alice.PublicID(), post.ID, "third comment", nil)
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvStatus)
+}
+
+// TestPostCommentThreads tests that replies to comments are assembled into
+// threads.
+func TestPostCommentThreads(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+
+	ts.kxUsers(alice, bob)
+	assertSubscribeToPosts(t, alice, bob)
+
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+	bobRecvComments := make(chan rpc.PostMetadataStatus, 5)
+	bobRecvModeration := make(chan rpc.PostMetadataStatus, 1)
+	bob.handle(client.OnPostStatusRcvdNtfn(func(user *client.RemoteUser, pid clientintf.PostID,
+		statusFrom client.UserID, status rpc.PostMetadataStatus) {
+		switch {
+		case rpc.IsPostComment(status.Attributes):
+			bobRecvComments <- status
+		case rpc.IsPostModeration(status.Attributes):
+			bobRecvModeration <- status
+		}
+	}))
+
+	post, err := alice.CreatePost("test post", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+
+	// Create the following threads:
+	//
+	// first
+	//   reply 1
+	//     reply 1.1
+	//   reply 2
+	// second
+	comment := func(c *testClient, text string, parent *clientintf.ID) clientintf.ID {
+		t.Helper()
+		id, err := c.CommentPost(alice.PublicID(), post.ID, text, parent)
+		assert.NilErr(t, err)
+		status := assert.ChanWritten(t, bobRecvComments)
+		assert.DeepEqual(t, status.Attributes[rpc.RMPSComment], text)
+		return id
+	}
+	first := comment(bob, "first", nil)
+	reply1 := comment(alice, "reply 1", &first)
+	comment(bob, "reply 1.1", &reply1)
+	comment(bob, "second", nil)
+	comment(alice, "reply 2", &first)
+
+	assertTree := func(c *testClient) {
+		t.Helper()
+		roots, err := c.ListPostComments(alice.PublicID(), post.ID)
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, len(roots), 2)
+		assert.DeepEqual(t, roots[0].ID, first)
+		assert.DeepEqual(t, roots[0].NumReplies(), 3)
+		assert.DeepEqual(t, len(roots[0].Children), 2)
+		assert.DeepEqual(t, roots[0].Children[0].ID, reply1)
+		assert.DeepEqual(t, roots[0].Children[0].Depth, 1)
+		assert.DeepEqual(t, roots[0].Children[0].Children[0].Status.Attributes[rpc.RMPSComment], "reply 1.1")
+		assert.DeepEqual(t, roots[0].Children[0].Children[0].Depth, 2)
+		assert.DeepEqual(t, roots[0].Children[1].Status.Attributes[rpc.RMPSComment], "reply 2")
+		assert.DeepEqual(t, roots[1].Status.Attributes[rpc.RMPSComment], "second")
+		assert.DeepEqual(t, roots[1].NumReplies(), 0)
+	}
+	assertTree(alice)
+	assertTree(bob)
+
+	// When a comment is removed, its replies become top-level comments.
+	_, err = alice.RemovePostComment(post.ID, reply1)
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvModeration)
+	roots, err := bob.ListPostComments(alice.PublicID(), post.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(roots), 3)
+	assert.DeepEqual(t, roots[0].NumReplies(), 1)
+	assert.DeepEqual(t, roots[1].Status.Attributes[rpc.RMPSComment], "reply 1.1")
+	assert.DeepEqual(t, roots[1].Depth, 0)
+}
+
+// TestPostTags tests querying the feed by post tags and filtering posts with
+// post tag rules.
+func TestPostTags(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(charlie, bob)
+	assertSubscribeToPosts(t, alice, bob)
+	assertSubscribeToPosts(t, charlie, bob)
+
+	bobRecvPosts := make(chan clientdb.PostSummary, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summary clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- summary
+	}))
+	bobFiltered := make(chan uint64, 1)
+	bob.handle(client.OnMsgContentFilteredNtfn(func(e client.MsgContentFilteredEvent) {
+		bobFiltered <- e.Rule.ID
+	}))
+
+	// Invalid tags are rejected.
+	_, err := alice.CreateTaggedPost("bad post", "", "", []string{"bad tag"})
+	assert.NonNilErr(t, err)
+
+	// Alice and Charlie create tagged posts. Bob receives them.
+	alicePost, err := alice.CreateTaggedPost("go post", "", "", []string{"Go", "#dev"})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, alicePost.Tags, []string{"go", "dev"})
+	summ := assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, summ.Tags, []string{"go", "dev"})
+	_, err = charlie.CreateTaggedPost("rust post", "", "", []string{"rust", "dev"})
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+	_, err = alice.CreatePost("untagged post", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+
+	// Query Bob's feed.
+	tags, err := bob.ListPostTags()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, tags, map[string]int{"go": 1, "dev": 2, "rust": 1})
+	posts, err := bob.QueryPosts(clientdb.PostsQuery{Tag: "dev"})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 2)
+	aliceID := alice.PublicID()
+	posts, err = bob.QueryPosts(clientdb.PostsQuery{Tag: "dev", Author: &aliceID})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 1)
+	assert.DeepEqual(t, posts[0].ID, alicePost.ID)
+	posts, err = bob.QueryPosts(clientdb.PostsQuery{Author: &aliceID})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 2)
+	posts, err = bob.QueryPosts(clientdb.PostsQuery{Since: time.Now().Add(time.Hour)})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(posts), 0)
+
+	// Bob mutes the rust tag. Charlie's next rust post is filtered.
+	muteRule := &clientdb.ContentFilter{PostTag: "rust"}
+	assert.NilErr(t, bob.StoreContentFilter(muteRule))
+	_, err = charlie.CreateTaggedPost("second rust post", "", "", []string{"rust"})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, assert.ChanWritten(t, bobFiltered), muteRule.ID)
+	assert.ChanNotWritten(t, bobRecvPosts, 250*time.Millisecond)
+
+	// Bob subscribes to the go tag of Alice. Only Alice's posts with the
+	// tag are received. Charlie's posts are not affected.
+	subRule := &clientdb.ContentFilter{UID: &aliceID, PostTag: "go", SubscribeTag: true}
+	assert.NilErr(t, bob.StoreContentFilter(subRule))
+	_, err = alice.CreatePost("second untagged post", "")
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, assert.ChanWritten(t, bobFiltered), subRule.ID)
+	assert.ChanNotWritten(t, bobRecvPosts, 250*time.Millisecond)
+	_, err = alice.CreateTaggedPost("second go post", "", "", []string{"go"})
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+	_, err = charlie.CreatePost("charlie untagged post", "")
+	assert.NilErr(t, err)
+	assert.ChanWritten(t, bobRecvPosts)
+}
+
+// TestExpiringPosts tests that expired posts are deleted by both their author
+// and their subscribers.
+func TestExpiringPosts(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	charlie := ts.newClient("charlie")
+	ts.kxUsers(alice, bob)
+	ts.kxUsers(bob, charlie)
+	assertSubscribeToPosts(t, alice, bob)
+	assertSubscribeToPosts(t, bob, charlie)
+
+	bobRecvPosts := make(chan clientdb.PostSummary, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summ clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- summ
+	}))
+	aliceExpiredPosts := make(chan clientdb.PostSummary, 1)
+	alice.handle(client.OnPostExpiredNtfn(func(summ clientdb.PostSummary) {
+		aliceExpiredPosts <- summ
+	}))
+	bobExpiredPosts := make(chan clientdb.PostSummary, 1)
+	bob.handle(client.OnPostExpiredNtfn(func(summ clientdb.PostSummary) {
+		bobExpiredPosts <- summ
+	}))
+
+	// Posts cannot be created already expired.
+	_, err := alice.CreatePostWithOpts("expired", "", client.CreatePostOpts{
+		Expiration: time.Now().Add(-time.Second),
+	})
+	assert.NonNilErr(t, err)
+
+	// Alice creates an expiring post. Bob receives it.
+	expiration := time.Now().Add(3 * time.Second)
+	alicePost, err := alice.CreatePostWithOpts("ephemeral", "", client.CreatePostOpts{
+		Expiration: expiration,
+	})
+	assert.NilErr(t, err)
+	summ := assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, summ.ID, alicePost.ID)
+	assert.DeepEqual(t, summ.Expiration.Unix(), expiration.Unix())
+	assert.ChanNotWritten(t, bobExpiredPosts, time.Second)
+
+	// After the expiration, both Alice and Bob delete the post.
+	assert.DeepEqual(t, assert.ChanWritten(t, aliceExpiredPosts).ID, alicePost.ID)
+	assert.DeepEqual(t, assert.ChanWritten(t, bobExpiredPosts).ID, alicePost.ID)
+	_, err = alice.ReadPost(alice.PublicID(), alicePost.ID)
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+	_, err = bob.ReadPost(alice.PublicID(), alicePost.ID)
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+
+	// Bob can no longer relay the post to Charlie.
+	err = bob.RelayPost(alice.PublicID(), alicePost.ID, charlie.PublicID())
+	assert.NonNilErr(t, err)
+}
+
+// TestScheduledPostDrafts tests saving post drafts and publishing them at their
+// scheduled time.
+func TestScheduledPostDrafts(t *testing.T) {
+	t.Parallel()
+
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+	assertSubscribeToPosts(t, alice, bob)
+
+	bobRecvPosts := make(chan rpc.PostMetadata, 1)
+	bob.handle(client.OnPostRcvdNtfn(func(ru *client.RemoteUser, summ clientdb.PostSummary, pm rpc.PostMetadata) {
+		bobRecvPosts <- pm
+	}))
+	type publishedDraft struct {
+		draft clientdb.PostDraft
+		summ  clientdb.PostSummary
+		err   error
+	}
+	alicePublished := make(chan publishedDraft, 1)
+	alice.handle(client.OnScheduledPostPublishedNtfn(func(draft clientdb.PostDraft, summ clientdb.PostSummary, err error) {
+		alicePublished <- publishedDraft{draft, summ, err}
+	}))
+
+	// Alice creates and updates a draft. Bob does not receive anything.
+	draft, err := alice.SavePostDraft(clientdb.PostDraft{Content: "first version"})
+	assert.NilErr(t, err)
+	draft.Content = "second version"
+	draft.Tags = []string{"news"}
+	draft, err = alice.SavePostDraft(draft)
+	assert.NilErr(t, err)
+	drafts, err := alice.ListPostDrafts()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(drafts), 1)
+	assert.DeepEqual(t, drafts[0].ID, draft.ID)
+	assert.DeepEqual(t, drafts[0].Content, "second version")
+	assert.ChanNotWritten(t, bobRecvPosts, 500*time.Millisecond)
+
+	// Updating a missing draft fails.
+	_, err = alice.SavePostDraft(clientdb.PostDraft{ID: clientintf.RandomID()})
+	assert.ErrorIs(t, err, clientdb.ErrNotFound)
+
+	// Alice schedules the draft. It's only published after the scheduled
+	// time.
+	_, err = alice.SchedulePostDraft(draft.ID, time.Now().Add(2*time.Second))
+	assert.NilErr(t, err)
+	assert.ChanNotWritten(t, bobRecvPosts, time.Second)
+	pub := assert.ChanWritten(t, alicePublished)
+	assert.NilErr(t, pub.err)
+	pm := assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPMain], "second version")
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPTags], "news")
+	assert.DeepEqual(t, pub.summ.ID, pm.Hash())
+	drafts, err = alice.ListPostDrafts()
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(drafts), 0)
+
+	// Alice schedules a draft restricted to an audience that is removed
+	// before the draft is published. The draft is kept unscheduled.
+	assert.NilErr(t, alice.SavePostAudience("friends", []clientintf.UserID{bob.PublicID()}))
+	draft, err = alice.SavePostDraft(clientdb.PostDraft{
+		Content:   "for friends",
+		Audience:  "friends",
+		PublishAt: time.Now().Add(time.Second),
+	})
+	assert.NilErr(t, err)
+	assert.NilErr(t, alice.RemovePostAudience("friends"))
+	pub = assert.ChanWritten(t, alicePublished)
+	assert.NonNilErr(t, pub.err)
+	assert.ChanNotWritten(t, bobRecvPosts, 500*time.Millisecond)
+	draft, err = alice.GetPostDraft(draft.ID)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, draft.PublishAt.IsZero(), true)
+	assert.DeepEqual(t, draft.Publishing, false)
+	if draft.PublishError == "" {
+		t.Fatalf("draft does not have publish error")
+	}
+
+	// The restored draft may be published again.
+	draft.Audience = ""
+	_, err = alice.SavePostDraft(draft)
+	assert.NilErr(t, err)
+	_, err = alice.PublishPostDraft(draft.ID)
+	assert.NilErr(t, err)
+	pm = assert.ChanWritten(t, bobRecvPosts)
+	assert.DeepEqual(t, pm.Attributes[rpc.RMPMain], "for friends")
+}
@@ -34,7 +34,7 @@ func TestResendsUnackedRM(t *testing.T) {
 	bob := ts.newClient("bob")
 	invite, err := alice.WriteNewInvite(io.Discard, nil)
 	assert.NilErr(t, err)
-	assert.NilErr(t, bob.AcceptInvite(invite))
+	assert.NilErr(t, bob.AcceptInvite(invite, 0))
 	assertClientsKXd(t, alice, bob)
 
 	// Hook into Alice's and Bob's onPM event.
@@ -240,7 +240,7 @@ func TestLongOfflineClientResetsAllKX(t *testing.T) {
 	bob := ts.newClient("bob")
 	invite, err := alice.WriteNewInvite(io.Discard, nil)
 	assert.NilErr(t, err)
-	assert.NilErr(t, bob.AcceptInvite(invite))
+	assert.NilErr(t, bob.AcceptInvite(invite, 0))
 	assertClientsKXd(t, alice, bob)
 
 	// Shutdown bob.
@@ -460,7 +460,7 @@ func TestPrepaidInvites(t *testing.T) {
 	}
 
 	// Attempt to KX using the fetched invite.
-	assert.NilErr(t, bob.AcceptInvite(decodedInvite))
+	assert.NilErr(t, bob.AcceptInvite(decodedInvite, 0))
 	assertClientsKXd(t, alice, bob)
 }
 
@@ -1280,7 +1280,7 @@ func TestCancelsKX(t *testing.T) {
 	assertGoesOffline(t, bob)
 
 	// Alice begins the acceptance procedure.
-	assert.NilErr(t, alice.AcceptInvite(bobInvite))
+	assert.NilErr(t, alice.AcceptInvite(bobInvite, 0))
 	time.Sleep(time.Second)
 	assertEmptyRMQ(t, alice)
 
@@ -1,12 +1,15 @@
 package e2etests
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client"
 	"github.com/companyzero/bisonrelay/client/clientdb"
+	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/client/resources"
+	"github.com/companyzero/bisonrelay/client/resources/postsfeed"
 	"github.com/companyzero/bisonrelay/internal/assert"
 	"github.com/companyzero/bisonrelay/internal/strescape"
 	"github.com/companyzero/bisonrelay/ratchet"
@@ -300,3 +303,81 @@ func TestResourceBundle(t *testing.T) {
 	resStatic := assert.ChanWritten(t, chanResReply)
 	assert.DeepEqual(t, resStatic.Response.Data, staticData)
 }
+
+// TestPostsFeedResource tests fetching the feed of posts of a remote user.
+func TestPostsFeedResource(t *testing.T) {
+	// Setup Alice and Bob and have them KX.
+	tcfg := testScaffoldCfg{}
+	ts := newTestScaffold(t, tcfg)
+	alice := ts.newClient("alice")
+	bob := ts.newClient("bob")
+	ts.kxUsers(alice, bob)
+
+	// Alice creates a public post and one restricted to an audience.
+	post, err := alice.CreateTaggedPost("# Feed title\n\nfeed **content**",
+		"", "", []string{"news"})
+	assert.NilErr(t, err)
+	err = alice.SavePostAudience("friends", []clientintf.UserID{bob.PublicID()})
+	assert.NilErr(t, err)
+	_, err = alice.CreatePostForAudience("restricted post", "", "friends")
+	assert.NilErr(t, err)
+
+	// Setup Alice's feed provider.
+	alice.modifyHandlers(func() {
+		r := resources.NewRouter()
+		p := postsfeed.New(postsfeed.Config{Client: alice.Client})
+		r.BindPrefixPath(p.Prefix(), p)
+		alice.resourcesProvider = r
+	})
+
+	// Setup Bob's fetched resource handler.
+	chanResReply := make(chan rpc.RMFetchResourceReply, 1)
+	bob.handle(client.OnResourceFetchedNtfn(func(user *client.RemoteUser,
+		fr clientdb.FetchedResource, sess clientdb.PageSessionOverview) {
+		chanResReply <- fr.Response
+	}))
+	fetch := func(path string) rpc.RMFetchResourceReply {
+		t.Helper()
+		_, err := bob.FetchResource(alice.PublicID(),
+			resources.SplitPath(path), nil, 0, 0, nil, "")
+		assert.NilErr(t, err)
+		return assert.ChanWritten(t, chanResReply)
+	}
+
+	// The atom feed includes the public post and its tags, but not the
+	// restricted one.
+	res := fetch("feed/atom.xml")
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+	assert.DeepEqual(t, res.Meta["Content-Type"], "application/atom+xml")
+	wantContains := []string{
+		"urn:bisonrelay:post:" + post.ID.String(),
+		"&lt;strong&gt;content&lt;/strong&gt;",
+		`<category term="news"></category>`,
+	}
+	for _, want := range wantContains {
+		if !bytes.Contains(res.Data, []byte(want)) {
+			t.Fatalf("atom feed does not contain %q: %s", want, res.Data)
+		}
+	}
+	if bytes.Contains(res.Data, []byte("restricted post")) {
+		t.Fatalf("atom feed contains restricted post: %s", res.Data)
+	}
+
+	// The RSS feed includes the public post.
+	res = fetch("feed/rss.xml")
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+	if !bytes.Contains(res.Data, []byte("<title>Feed title</title>")) {
+		t.Fatalf("rss feed does not contain post: %s", res.Data)
+	}
+
+	// The post can be fetched as HTML.
+	res = fetch("feed/posts/" + post.ID.String() + ".html")
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusOk)
+	if !bytes.Contains(res.Data, []byte("<h1>Feed title</h1>")) {
+		t.Fatalf("post page does not contain post: %s", res.Data)
+	}
+
+	// Unknown posts are not found.
+	res = fetch("feed/posts/" + alice.PublicID().String())
+	assert.DeepEqual(t, res.Status, rpc.ResourceStatusNotFound)
+}
@@ -0,0 +1,274 @@
+// Package feeds parses external RSS and Atom feeds.
+package feeds
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Item is an entry of a feed.
+type Item struct {
+	// ID is the unique identifier of the item. This is the RSS guid or
+	// the Atom id of the entry. If the feed does not specify an id, it is
+	// derived from the link or the title and date of the item.
+	ID string
+
+	Title     string
+	Link      string
+	Author    string
+	Published time.Time
+
+	// Summary is the description of the item as plain text.
+	Summary string
+}
+
+// Feed is a parsed RSS or Atom feed.
+type Feed struct {
+	Title string
+	Link  string
+
+	// Items are the items of the feed, in the order they were listed.
+	Items []Item
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	Author      string `xml:"author"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate     string `xml:"pubDate"`
+	Description string `xml:"description"`
+	Content     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+}
+
+type rssFeed struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Link  string    `xml:"link"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",innerxml"`
+}
+
+// text returns the contents of an Atom text construct as HTML.
+func (t *atomText) text() string {
+	if t.Type == "xhtml" {
+		return t.Body
+	}
+
+	// Text and HTML constructs have their contents escaped.
+	var s string
+	if err := xml.Unmarshal([]byte("<t>"+t.Body+"</t>"), &s); err != nil {
+		return t.Body
+	}
+	if t.Type == "" || t.Type == "text" {
+		return html.EscapeString(s)
+	}
+	return s
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     atomText   `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Author    string     `xml:"author>name"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Summary   atomText   `xml:"summary"`
+	Content   atomText   `xml:"content"`
+}
+
+type atomFeed struct {
+	Title   atomText    `xml:"title"`
+	Links   []atomLink  `xml:"link"`
+	Author  string      `xml:"author>name"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// alternateLink returns the link to the alternate representation of an Atom
+// feed or entry.
+func alternateLink(links []atomLink) string {
+	for _, l := range links {
+		if l.Rel == "" || l.Rel == "alternate" {
+			return l.Href
+		}
+	}
+	return ""
+}
+
+var dateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// parseDate parses the date of a feed item. A zero time is returned if the
+// date is not in any of the commonly used formats.
+func parseDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// fillID fills the id of the item if the feed did not specify one.
+func (item *Item) fillID() {
+	item.ID = strings.TrimSpace(item.ID)
+	switch {
+	case item.ID != "":
+	case item.Link != "":
+		item.ID = item.Link
+	default:
+		h := sha256.Sum256([]byte(item.Title + "\x00" +
+			item.Published.Format(time.RFC3339) + "\x00" + item.Summary))
+		item.ID = hex.EncodeToString(h[:])
+	}
+}
+
+// Parse parses an RSS 2.0 or Atom feed.
+func Parse(data []byte) (*Feed, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+
+	// Find the root element to determine the kind of feed.
+	var root xml.StartElement
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("unable to find feed root element: %w", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			root = se
+			break
+		}
+	}
+
+	switch root.Name.Local {
+	case "rss":
+		var rf rssFeed
+		if err := dec.DecodeElement(&rf, &root); err != nil {
+			return nil, fmt.Errorf("unable to decode RSS feed: %w", err)
+		}
+		feed := &Feed{
+			Title: strings.TrimSpace(rf.Channel.Title),
+			Link:  strings.TrimSpace(rf.Channel.Link),
+			Items: make([]Item, 0, len(rf.Channel.Items)),
+		}
+		for _, ri := range rf.Channel.Items {
+			content := ri.Description
+			if content == "" {
+				content = ri.Content
+			}
+			author := ri.Creator
+			if author == "" {
+				author = ri.Author
+			}
+			item := Item{
+				ID:        ri.GUID,
+				Title:     HTMLToText(ri.Title),
+				Link:      strings.TrimSpace(ri.Link),
+				Author:    strings.TrimSpace(author),
+				Published: parseDate(ri.PubDate),
+				Summary:   HTMLToText(content),
+			}
+			item.fillID()
+			feed.Items = append(feed.Items, item)
+		}
+		return feed, nil
+
+	case "feed":
+		var af atomFeed
+		if err := dec.DecodeElement(&af, &root); err != nil {
+			return nil, fmt.Errorf("unable to decode Atom feed: %w", err)
+		}
+		feed := &Feed{
+			Title: HTMLToText(af.Title.text()),
+			Link:  alternateLink(af.Links),
+			Items: make([]Item, 0, len(af.Entries)),
+		}
+		for _, ae := range af.Entries {
+			content := ae.Summary.text()
+			if content == "" {
+				content = ae.Content.text()
+			}
+			author := ae.Author
+			if author == "" {
+				author = af.Author
+			}
+			published := ae.Published
+			if published == "" {
+				published = ae.Updated
+			}
+			item := Item{
+				ID:        ae.ID,
+				Title:     HTMLToText(ae.Title.text()),
+				Link:      alternateLink(ae.Links),
+				Author:    strings.TrimSpace(author),
+				Published: parseDate(published),
+				Summary:   HTMLToText(content),
+			}
+			item.fillID()
+			feed.Items = append(feed.Items, item)
+		}
+		return feed, nil
+
+	default:
+		return nil, errors.New("document is not an RSS or Atom feed")
+	}
+}
+
+var (
+	htmlBreakRegex  = regexp.MustCompile(`(?i)<\s*(br|/li|/tr)\b[^>]*>`)
+	htmlBlockRegex  = regexp.MustCompile(`(?i)<\s*(/p|/div|/h[1-6]|/blockquote|/ul|/ol|/table)\b[^>]*>`)
+	htmlScriptRegex = regexp.MustCompile(`(?is)<\s*(script|style)\b.*?<\s*/\s*(script|style)\s*>`)
+	htmlTagRegex    = regexp.MustCompile(`(?s)<[^>]*>`)
+	spacesRegex     = regexp.MustCompile(`[ \t\f\v]+`)
+	newlinesRegex   = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLToText converts the HTML contents of a feed to plain text. Tags are
+// removed, line breaks and block elements are converted to new lines and
+// entities are unescaped.
+func HTMLToText(s string) string {
+	s = htmlScriptRegex.ReplaceAllString(s, "")
+	s = htmlBreakRegex.ReplaceAllString(s, "\n")
+	s = htmlBlockRegex.ReplaceAllString(s, "\n\n")
+	s = htmlTagRegex.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = strings.ReplaceAll(s, "\r", "")
+
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(spacesRegex.ReplaceAllString(lines[i], " "))
+	}
+	s = strings.Join(lines, "\n")
+	s = newlinesRegex.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
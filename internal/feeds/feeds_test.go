@@ -0,0 +1,95 @@
+package feeds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// TestParseRSS tests parsing an RSS feed.
+func TestParseRSS(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<channel>
+  <title>Example News</title>
+  <link>https://example.com/</link>
+  <item>
+    <title>First &amp; foremost</title>
+    <link>https://example.com/1</link>
+    <guid>item-1</guid>
+    <dc:creator>Jane</dc:creator>
+    <pubDate>Mon, 02 Jan 2006 15:04:05 +0000</pubDate>
+    <description><![CDATA[<p>Hello <b>world</b>.</p><p>Second&nbsp;paragraph.</p>]]></description>
+  </item>
+  <item>
+    <title>No guid</title>
+    <link>https://example.com/2</link>
+    <description>plain text</description>
+  </item>
+</channel>
+</rss>`)
+
+	feed, err := Parse(data)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, feed.Title, "Example News")
+	assert.DeepEqual(t, feed.Link, "https://example.com/")
+	assert.DeepEqual(t, len(feed.Items), 2)
+
+	item := feed.Items[0]
+	assert.DeepEqual(t, item.ID, "item-1")
+	assert.DeepEqual(t, item.Title, "First & foremost")
+	assert.DeepEqual(t, item.Author, "Jane")
+	assert.DeepEqual(t, item.Summary, "Hello world.\n\nSecond paragraph.")
+	wantDate := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !item.Published.Equal(wantDate) {
+		t.Fatalf("unexpected date: got %v, want %v", item.Published, wantDate)
+	}
+
+	// Items without a guid are identified by their link.
+	assert.DeepEqual(t, feed.Items[1].ID, "https://example.com/2")
+	assert.DeepEqual(t, feed.Items[1].Published.IsZero(), true)
+}
+
+// TestParseAtom tests parsing an Atom feed.
+func TestParseAtom(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example Blog</title>
+  <link href="https://example.com/feed.xml" rel="self"/>
+  <link href="https://example.com/"/>
+  <author><name>John</name></author>
+  <entry>
+    <id>urn:entry:1</id>
+    <title type="text">Fish &amp; &lt;chips&gt;</title>
+    <link href="https://example.com/entry1" rel="alternate"/>
+    <updated>2006-01-02T15:04:05Z</updated>
+    <content type="html">&lt;p&gt;Some&lt;br&gt;content&lt;/p&gt;</content>
+  </entry>
+</feed>`)
+
+	feed, err := Parse(data)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, feed.Title, "Example Blog")
+	assert.DeepEqual(t, feed.Link, "https://example.com/")
+	assert.DeepEqual(t, len(feed.Items), 1)
+
+	item := feed.Items[0]
+	assert.DeepEqual(t, item.ID, "urn:entry:1")
+	assert.DeepEqual(t, item.Title, "Fish & <chips>")
+	assert.DeepEqual(t, item.Link, "https://example.com/entry1")
+	assert.DeepEqual(t, item.Author, "John")
+	assert.DeepEqual(t, item.Summary, "Some\ncontent")
+	wantDate := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !item.Published.Equal(wantDate) {
+		t.Fatalf("unexpected date: got %v, want %v", item.Published, wantDate)
+	}
+}
+
+// TestParseNotFeed tests that documents that are not feeds fail to parse.
+func TestParseNotFeed(t *testing.T) {
+	_, err := Parse([]byte(`<html><body>not a feed</body></html>`))
+	assert.NonNilErr(t, err)
+	_, err = Parse([]byte(`not xml`))
+	assert.NonNilErr(t, err)
+}
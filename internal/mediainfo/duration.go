@@ -0,0 +1,297 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// oggTailLen is how many bytes from the end of an ogg file are read
+	// when looking for the last page.
+	oggTailLen = 64 * 1024
+
+	// opusSampleRate is the sample rate used to encode granule positions in
+	// ogg/opus streams.
+	opusSampleRate = 48000
+)
+
+var (
+	errInvalidOgg   = errors.New("invalid ogg file")
+	errInvalidWav   = errors.New("invalid wav file")
+	errInvalidMP4   = errors.New("invalid mp4 file")
+	oggPageMagic    = []byte("OggS")
+	opusHeadMagic   = []byte("OpusHead")
+	vorbisHeadMagic = []byte("\x01vorbis")
+)
+
+// unitsDuration returns the duration of n units, when there are perSec units
+// per second.
+func unitsDuration(n, perSec uint64) time.Duration {
+	secs := n / perSec
+	rem := n % perSec
+	return time.Duration(secs)*time.Second +
+		time.Duration(rem)*time.Second/time.Duration(perSec)
+}
+
+// oggPageHeader is the fixed-size header of an ogg page.
+type oggPageHeader struct {
+	granule  int64
+	serial   uint32
+	nbSegs   int
+	segTable []byte
+}
+
+// parseOggPage parses an ogg page header from b.
+func parseOggPage(b []byte) (oggPageHeader, []byte, error) {
+	var h oggPageHeader
+	if len(b) < 27 || !bytes.Equal(b[:4], oggPageMagic) {
+		return h, nil, errInvalidOgg
+	}
+	h.granule = int64(binary.LittleEndian.Uint64(b[6:]))
+	h.serial = binary.LittleEndian.Uint32(b[14:])
+	h.nbSegs = int(b[26])
+	if len(b) < 27+h.nbSegs {
+		return h, nil, errInvalidOgg
+	}
+	h.segTable = b[27 : 27+h.nbSegs]
+	var payloadLen int
+	for _, s := range h.segTable {
+		payloadLen += int(s)
+	}
+	payload := b[27+h.nbSegs:]
+	if len(payload) > payloadLen {
+		payload = payload[:payloadLen]
+	}
+	return h, payload, nil
+}
+
+// oggDuration determines the duration of an ogg/opus or ogg/vorbis file by
+// reading the granule position of its last page.
+func oggDuration(r io.ReadSeeker, size int64) (time.Duration, error) {
+	first := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, first)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, err
+	}
+	first = first[:n]
+	h, payload, err := parseOggPage(first)
+	if err != nil {
+		return 0, err
+	}
+
+	// Determine the sample rate and number of samples to skip based on
+	// the codec of the stream.
+	var rate, preSkip int64
+	switch {
+	case bytes.HasPrefix(payload, opusHeadMagic) && len(payload) >= 16:
+		rate = opusSampleRate
+		preSkip = int64(binary.LittleEndian.Uint16(payload[10:]))
+	case bytes.HasPrefix(payload, vorbisHeadMagic) && len(payload) >= 16:
+		rate = int64(binary.LittleEndian.Uint32(payload[12:]))
+	default:
+		// Unsupported codec.
+		return 0, nil
+	}
+	if rate == 0 {
+		return 0, errInvalidOgg
+	}
+
+	// Read the tail of the file and find the last page of the same
+	// stream that has a valid granule position.
+	tailLen := int64(oggTailLen)
+	if tailLen > size {
+		tailLen = size
+	}
+	if _, err := r.Seek(-tailLen, io.SeekEnd); err != nil {
+		return 0, err
+	}
+	tail := make([]byte, tailLen)
+	if _, err := io.ReadFull(r, tail); err != nil {
+		return 0, err
+	}
+	for i := bytes.LastIndex(tail, oggPageMagic); i > -1; i = bytes.LastIndex(tail[:i], oggPageMagic) {
+		last, _, err := parseOggPage(tail[i:])
+		if err != nil || last.serial != h.serial || last.granule < 0 {
+			continue
+		}
+		samples := last.granule - preSkip
+		if samples < 0 {
+			return 0, nil
+		}
+		return unitsDuration(uint64(samples), uint64(rate)), nil
+	}
+	return 0, errInvalidOgg
+}
+
+// wavDuration determines the duration of a RIFF/WAVE file.
+func wavDuration(r io.Reader) (time.Duration, error) {
+	var hdr [12]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, err
+	}
+	if string(hdr[:4]) != "RIFF" || string(hdr[8:]) != "WAVE" {
+		return 0, errInvalidWav
+	}
+
+	var byteRate uint32
+	var chunkHdr [8]byte
+	for {
+		if _, err := io.ReadFull(r, chunkHdr[:]); err != nil {
+			return 0, errInvalidWav
+		}
+		id := string(chunkHdr[:4])
+		size := int64(binary.LittleEndian.Uint32(chunkHdr[4:]))
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return 0, errInvalidWav
+			}
+			fmtData := make([]byte, size+size%2)
+			if _, err := io.ReadFull(r, fmtData); err != nil {
+				return 0, err
+			}
+			byteRate = binary.LittleEndian.Uint32(fmtData[8:])
+		case "data":
+			if byteRate == 0 {
+				return 0, errInvalidWav
+			}
+			return unitsDuration(uint64(size), uint64(byteRate)), nil
+		default:
+			if _, err := io.CopyN(io.Discard, r, size+size%2); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
+
+// mp4Box is the header of an ISO base media file format box.
+type mp4Box struct {
+	typ     string
+	start   int64 // Offset of the box contents.
+	dataLen int64 // Length of the box contents.
+}
+
+// readMP4Box reads the header of the box at the current position of r.
+// limit is the offset where the parent box ends.
+func readMP4Box(r io.ReadSeeker, pos, limit int64) (mp4Box, error) {
+	var box mp4Box
+	var hdr [16]byte
+	if limit-pos < 8 {
+		return box, io.EOF
+	}
+	if _, err := r.Seek(pos, io.SeekStart); err != nil {
+		return box, err
+	}
+	if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+		return box, err
+	}
+	size := int64(binary.BigEndian.Uint32(hdr[:]))
+	box.typ = string(hdr[4:8])
+	hdrLen := int64(8)
+	switch size {
+	case 0:
+		size = limit - pos
+	case 1:
+		if _, err := io.ReadFull(r, hdr[8:16]); err != nil {
+			return box, err
+		}
+		size = int64(binary.BigEndian.Uint64(hdr[8:]))
+		hdrLen = 16
+	}
+	if size < hdrLen || pos+size > limit {
+		return box, errInvalidMP4
+	}
+	box.start = pos + hdrLen
+	box.dataLen = size - hdrLen
+	return box, nil
+}
+
+// readMP4BoxData reads the contents of a (small) box.
+func readMP4BoxData(r io.ReadSeeker, box mp4Box, maxLen int64) ([]byte, error) {
+	if box.dataLen > maxLen {
+		return nil, fmt.Errorf("%s box too large: %w", box.typ, errInvalidMP4)
+	}
+	if _, err := r.Seek(box.start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	b := make([]byte, box.dataLen)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
+
+// mp4Info fills the duration and dimensions of an mp4/quicktime file, based on
+// its movie header (mvhd) and track header (tkhd) boxes.
+func mp4Info(r io.ReadSeeker, size int64, info *Info) error {
+	var walk func(start, end int64, depth int) error
+	walk = func(start, end int64, depth int) error {
+		if depth > 4 {
+			return nil
+		}
+		for pos := start; pos < end; {
+			box, err := readMP4Box(r, pos, end)
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			pos = box.start + box.dataLen
+
+			switch box.typ {
+			case "moov", "trak":
+				if err := walk(box.start, pos, depth+1); err != nil {
+					return err
+				}
+
+			case "mvhd":
+				b, err := readMP4BoxData(r, box, 256)
+				if err != nil {
+					return err
+				}
+				var timescale, duration uint64
+				switch {
+				case len(b) >= 32 && b[0] == 1:
+					timescale = uint64(binary.BigEndian.Uint32(b[20:]))
+					duration = binary.BigEndian.Uint64(b[24:])
+				case len(b) >= 20:
+					timescale = uint64(binary.BigEndian.Uint32(b[12:]))
+					duration = uint64(binary.BigEndian.Uint32(b[16:]))
+				default:
+					return errInvalidMP4
+				}
+				if timescale > 0 {
+					info.Duration = unitsDuration(duration, timescale)
+				}
+
+			case "tkhd":
+				b, err := readMP4BoxData(r, box, 256)
+				if err != nil {
+					return err
+				}
+				// Width and height are the last two 16.16
+				// fixed point fields of the box.
+				if len(b) < 84 {
+					return errInvalidMP4
+				}
+				w := int(binary.BigEndian.Uint32(b[len(b)-8:]) >> 16)
+				h := int(binary.BigEndian.Uint32(b[len(b)-4:]) >> 16)
+				if w*h > info.Width*info.Height {
+					info.Width, info.Height = w, h
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := walk(0, size, 0); err != nil {
+		return err
+	}
+	if info.Width > 0 && info.MimeType == "audio/mp4" {
+		info.MimeType = "video/mp4"
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+// Package mediainfo extracts basic metadata (mime type, dimensions, duration)
+// from media files and generates small thumbnails for images.
+//
+// Only pure-Go decoders are used, so that this package may be used in every
+// platform supported by the client.
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	// Register the image formats supported by thumbnailing.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/companyzero/bisonrelay/rpc"
+)
+
+const (
+	// DefaultThumbnailMaxDim is the default max width and height of
+	// generated thumbnails.
+	DefaultThumbnailMaxDim = 128
+
+	// DefaultThumbnailMaxSize is the default max size (in bytes) of the
+	// encoded thumbnail.
+	DefaultThumbnailMaxSize = 16 * 1024
+
+	// MaxThumbnailDim is the max width and height of thumbnails that may
+	// be decoded with DecodeThumbnail.
+	MaxThumbnailDim = 1024
+
+	// maxDecodePixels is the max number of pixels an image may have in
+	// order for it to be decoded. This prevents decompression bombs from
+	// exhausting memory.
+	maxDecodePixels = 64 * 1024 * 1024
+
+	// sniffLen is the number of bytes used to sniff the content type.
+	sniffLen = 512
+)
+
+// ErrImageTooLarge is returned when attempting to decode an image that has
+// more than the max allowed number of pixels.
+var ErrImageTooLarge = errors.New("image has too many pixels")
+
+// Config is the configuration for extracting info from a media file.
+type Config struct {
+	// ThumbnailMaxDim is the max width and height of the generated
+	// thumbnail. If zero, no thumbnail is generated.
+	ThumbnailMaxDim int
+
+	// ThumbnailMaxSize is the max size of the JPEG-encoded thumbnail. If
+	// the thumbnail cannot be encoded within this size, it is discarded.
+	ThumbnailMaxSize int
+}
+
+// DefaultConfig returns the default config for extracting media info.
+func DefaultConfig() Config {
+	return Config{
+		ThumbnailMaxDim:  DefaultThumbnailMaxDim,
+		ThumbnailMaxSize: DefaultThumbnailMaxSize,
+	}
+}
+
+// Info is the basic information about a media file.
+type Info struct {
+	// MimeType is the detected mime type of the file.
+	MimeType string
+
+	// Width and Height are the dimensions of images and videos. These are
+	// zero when unknown.
+	Width  int
+	Height int
+
+	// Duration is the duration of audio and video files. This is zero when
+	// unknown.
+	Duration time.Duration
+
+	// Thumbnail is a JPEG-encoded thumbnail of the media. This is nil when
+	// a thumbnail could not be generated.
+	Thumbnail []byte
+}
+
+// IsImage returns true if the info is of an image file.
+func (info *Info) IsImage() bool {
+	return strings.HasPrefix(info.MimeType, "image/")
+}
+
+// IsAudio returns true if the info is of an audio file.
+func (info *Info) IsAudio() bool {
+	return strings.HasPrefix(info.MimeType, "audio/")
+}
+
+// IsVideo returns true if the info is of a video file.
+func (info *Info) IsVideo() bool {
+	return strings.HasPrefix(info.MimeType, "video/")
+}
+
+// Attributes returns the info encoded as file metadata attributes.
+func (info *Info) Attributes() map[string]string {
+	attrs := make(map[string]string)
+	if info.MimeType != "" {
+		attrs[rpc.FileAttrMimeType] = info.MimeType
+	}
+	if info.Width > 0 && info.Height > 0 {
+		attrs[rpc.FileAttrWidth] = strconv.Itoa(info.Width)
+		attrs[rpc.FileAttrHeight] = strconv.Itoa(info.Height)
+	}
+	if info.Duration > 0 {
+		attrs[rpc.FileAttrDuration] = strconv.FormatInt(info.Duration.Milliseconds(), 10)
+	}
+	if len(info.Thumbnail) > 0 {
+		attrs[rpc.FileAttrThumbnail] = base64.StdEncoding.EncodeToString(info.Thumbnail)
+	}
+	return attrs
+}
+
+// FromAttributes decodes the media info from the attributes of a file
+// metadata. Invalid attributes are ignored.
+func FromAttributes(attrs map[string]string) Info {
+	var info Info
+	info.MimeType = attrs[rpc.FileAttrMimeType]
+	info.Width, _ = strconv.Atoi(attrs[rpc.FileAttrWidth])
+	info.Height, _ = strconv.Atoi(attrs[rpc.FileAttrHeight])
+	if ms, err := strconv.ParseInt(attrs[rpc.FileAttrDuration], 10, 64); err == nil && ms > 0 {
+		info.Duration = time.Duration(ms) * time.Millisecond
+	}
+	if s := attrs[rpc.FileAttrThumbnail]; s != "" {
+		info.Thumbnail, _ = base64.StdEncoding.DecodeString(s)
+	}
+	return info
+}
+
+// detectMimeType detects the mime type of a file, based on its contents and
+// name.
+func detectMimeType(fname string, head []byte) string {
+	sniffed := http.DetectContentType(head)
+	if i := strings.Index(sniffed, ";"); i > -1 {
+		sniffed = sniffed[:i]
+	}
+
+	// Ogg files are sniffed as application/ogg. Refine based on the codec
+	// of the first stream.
+	if sniffed == "application/ogg" {
+		switch {
+		case bytes.Contains(head, []byte("OpusHead")),
+			bytes.Contains(head, []byte("\x01vorbis")):
+			return "audio/ogg"
+		case bytes.Contains(head, []byte("\x80theora")):
+			return "video/ogg"
+		}
+	}
+
+	switch sniffed {
+	case "application/octet-stream", "text/plain":
+		// Generic sniffed types. Use the extension, if it has a
+		// known mime type.
+		byExt := mime.TypeByExtension(filepath.Ext(fname))
+		if i := strings.Index(byExt, ";"); i > -1 {
+			byExt = byExt[:i]
+		}
+		if byExt != "" {
+			return byExt
+		}
+	}
+	return sniffed
+}
+
+// FromFile extracts media info from the given file.
+func FromFile(fname string, cfg Config) (*Info, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+
+	info := &Info{MimeType: detectMimeType(fname, head)}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case info.IsImage():
+		err = imageInfo(f, info, cfg)
+	case info.MimeType == "audio/ogg" || info.MimeType == "video/ogg":
+		info.Duration, err = oggDuration(f, fi.Size())
+	case info.MimeType == "audio/wave":
+		info.Duration, err = wavDuration(f)
+	case info.MimeType == "video/mp4" || info.MimeType == "video/quicktime" ||
+		info.MimeType == "audio/mp4":
+		err = mp4Info(f, fi.Size(), info)
+	}
+	if err != nil {
+		return info, fmt.Errorf("unable to decode %s file: %w",
+			info.MimeType, err)
+	}
+
+	return info, nil
+}
+
+// imageInfo fills the image-related fields of info.
+func imageInfo(r io.ReadSeeker, info *Info, cfg Config) error {
+	imgCfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		// Unsupported image format. Not an error.
+		return nil
+	}
+	info.Width, info.Height = imgCfg.Width, imgCfg.Height
+	if cfg.ThumbnailMaxDim <= 0 {
+		return nil
+	}
+	if imgCfg.Width*imgCfg.Height > maxDecodePixels {
+		return ErrImageTooLarge
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	info.Thumbnail, err = EncodeThumbnail(img, cfg.ThumbnailMaxDim,
+		cfg.ThumbnailMaxSize)
+	return err
+}
+
+// EncodeThumbnail resizes the image to fit within maxDim and encodes it as a
+// JPEG. The quality is progressively reduced until the encoded thumbnail fits
+// within maxSize bytes. If maxSize is zero, no size limit is imposed.
+//
+// Returns nil if the thumbnail could not be encoded within maxSize.
+func EncodeThumbnail(img image.Image, maxDim, maxSize int) ([]byte, error) {
+	thumb := Resize(img, maxDim, maxDim)
+	var b bytes.Buffer
+	for _, quality := range []int{80, 60, 40, 20} {
+		b.Reset()
+		err := jpeg.Encode(&b, thumb, &jpeg.Options{Quality: quality})
+		if err != nil {
+			return nil, err
+		}
+		if maxSize <= 0 || b.Len() <= maxSize {
+			return b.Bytes(), nil
+		}
+	}
+	return nil, nil
+}
+
+// DecodeThumbnail decodes a thumbnail previously encoded by EncodeThumbnail.
+// Thumbnails larger than MaxThumbnailDim in either dimension are rejected
+// before being fully decoded.
+func DecodeThumbnail(b []byte) (image.Image, error) {
+	imgCfg, err := jpeg.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if imgCfg.Width > MaxThumbnailDim || imgCfg.Height > MaxThumbnailDim ||
+		imgCfg.Width*imgCfg.Height > maxDecodePixels {
+		return nil, fmt.Errorf("%w: thumbnail is %dx%d", ErrImageTooLarge,
+			imgCfg.Width, imgCfg.Height)
+	}
+	return jpeg.Decode(bytes.NewReader(b))
+}
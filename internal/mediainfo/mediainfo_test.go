@@ -0,0 +1,209 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/companyzero/bisonrelay/internal/testutils"
+)
+
+// TestResize tests resizing images preserves the aspect ratio and the average
+// color of the source image.
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h         int
+		maxW, maxH   int
+		wantW, wantH int
+	}{
+		{"already fits", 10, 10, 20, 20, 10, 10},
+		{"wide", 400, 100, 100, 100, 100, 25},
+		{"tall", 100, 400, 100, 100, 25, 100},
+		{"square", 300, 300, 50, 50, 50, 50},
+		{"extreme", 10000, 1, 100, 100, 100, 1},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := image.NewNRGBA(image.Rect(0, 0, tc.w, tc.h))
+			c := color.NRGBA{R: 200, G: 100, B: 50, A: 255}
+			for y := 0; y < tc.h; y++ {
+				for x := 0; x < tc.w; x++ {
+					src.SetNRGBA(x, y, c)
+				}
+			}
+			dst := Resize(src, tc.maxW, tc.maxH)
+			assert.DeepEqual(t, dst.Bounds().Dx(), tc.wantW)
+			assert.DeepEqual(t, dst.Bounds().Dy(), tc.wantH)
+			got := color.NRGBAModel.Convert(dst.At(0, 0)).(color.NRGBA)
+			assert.DeepEqual(t, got, c)
+		})
+	}
+}
+
+// TestImageInfo tests extracting info and thumbnails from image files.
+func TestImageInfo(t *testing.T) {
+	dir := testutils.TempTestDir(t, "mediainfo-")
+	img := image.NewNRGBA(image.Rect(0, 0, 640, 480))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i)
+	}
+	var b bytes.Buffer
+	assert.NilErr(t, png.Encode(&b, img))
+	fname := filepath.Join(dir, "test.png")
+	assert.NilErr(t, os.WriteFile(fname, b.Bytes(), 0o600))
+
+	info, err := FromFile(fname, DefaultConfig())
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, info.MimeType, "image/png")
+	assert.DeepEqual(t, info.Width, 640)
+	assert.DeepEqual(t, info.Height, 480)
+	if len(info.Thumbnail) == 0 || len(info.Thumbnail) > DefaultThumbnailMaxSize {
+		t.Fatalf("unexpected thumbnail size %d", len(info.Thumbnail))
+	}
+
+	thumb, err := DecodeThumbnail(info.Thumbnail)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, thumb.Bounds().Dx(), DefaultThumbnailMaxDim)
+	assert.DeepEqual(t, thumb.Bounds().Dy(), DefaultThumbnailMaxDim*480/640)
+
+	// Round trip through attributes.
+	gotInfo := FromAttributes(info.Attributes())
+	assert.DeepEqual(t, &gotInfo, info)
+
+	// Thumbnails larger than the max thumbnail dimensions are rejected.
+	var big bytes.Buffer
+	bigImg := image.NewGray(image.Rect(0, 0, MaxThumbnailDim+1, 1))
+	assert.NilErr(t, jpeg.Encode(&big, bigImg, nil))
+	_, err = DecodeThumbnail(big.Bytes())
+	assert.ErrorIs(t, err, ErrImageTooLarge)
+}
+
+// TestWavDuration tests extracting the duration of wav files.
+func TestWavDuration(t *testing.T) {
+	dir := testutils.TempTestDir(t, "mediainfo-")
+	const byteRate = 44100 * 2 * 2
+	data := make([]byte, byteRate*3/2)
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(36+len(data)))
+	b.WriteString("WAVEfmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16))
+	binary.Write(&b, binary.LittleEndian, uint16(1))     // PCM
+	binary.Write(&b, binary.LittleEndian, uint16(2))     // Channels
+	binary.Write(&b, binary.LittleEndian, uint32(44100)) // Sample rate
+	binary.Write(&b, binary.LittleEndian, uint32(byteRate))
+	binary.Write(&b, binary.LittleEndian, uint16(4))  // Block align
+	binary.Write(&b, binary.LittleEndian, uint16(16)) // Bits per sample
+	b.WriteString("data")
+	binary.Write(&b, binary.LittleEndian, uint32(len(data)))
+	b.Write(data)
+	fname := filepath.Join(dir, "test.wav")
+	assert.NilErr(t, os.WriteFile(fname, b.Bytes(), 0o600))
+
+	info, err := FromFile(fname, DefaultConfig())
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, info.MimeType, "audio/wave")
+	assert.DeepEqual(t, info.Duration, 1500*time.Millisecond)
+}
+
+// oggPage builds an ogg page with the given granule and payload.
+func oggPage(granule int64, serial uint32, payload []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.Write([]byte{0, 0})
+	binary.Write(&b, binary.LittleEndian, granule)
+	binary.Write(&b, binary.LittleEndian, serial)
+	b.Write(make([]byte, 8)) // Sequence and CRC.
+	var segs []byte
+	for l := len(payload); l >= 0; l -= 255 {
+		if l >= 255 {
+			segs = append(segs, 255)
+		} else {
+			segs = append(segs, byte(l))
+		}
+	}
+	b.WriteByte(byte(len(segs)))
+	b.Write(segs)
+	b.Write(payload)
+	return b.Bytes()
+}
+
+// TestOggDuration tests extracting the duration of ogg/opus files.
+func TestOggDuration(t *testing.T) {
+	dir := testutils.TempTestDir(t, "mediainfo-")
+	const serial = 0x1234
+	const preSkip = 312
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1 // Version
+	head[9] = 2 // Channels
+	binary.LittleEndian.PutUint16(head[10:], preSkip)
+	binary.LittleEndian.PutUint32(head[12:], 48000)
+
+	var b bytes.Buffer
+	b.Write(oggPage(0, serial, head))
+	b.Write(oggPage(0, serial, []byte("OpusTags")))
+	b.Write(oggPage(48000+preSkip, serial, make([]byte, 1000)))
+	b.Write(oggPage(48000*5/2+preSkip, serial, make([]byte, 1000)))
+	fname := filepath.Join(dir, "test.opus")
+	assert.NilErr(t, os.WriteFile(fname, b.Bytes(), 0o600))
+
+	info, err := FromFile(fname, DefaultConfig())
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, info.MimeType, "audio/ogg")
+	assert.DeepEqual(t, info.Duration, 2500*time.Millisecond)
+}
+
+// mp4BoxBytes builds an mp4 box.
+func mp4BoxBytes(typ string, data ...[]byte) []byte {
+	var l int
+	for _, d := range data {
+		l += len(d)
+	}
+	b := make([]byte, 8, 8+l)
+	binary.BigEndian.PutUint32(b, uint32(8+l))
+	copy(b[4:], typ)
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	return b
+}
+
+// TestMP4Info tests extracting the duration and dimensions of mp4 files.
+func TestMP4Info(t *testing.T) {
+	dir := testutils.TempTestDir(t, "mediainfo-")
+
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)  // Timescale
+	binary.BigEndian.PutUint32(mvhd[16:], 90500) // Duration
+
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], 1280<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 720<<16)
+
+	ftyp := mp4BoxBytes("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	moov := mp4BoxBytes("moov", mp4BoxBytes("mvhd", mvhd),
+		mp4BoxBytes("trak", mp4BoxBytes("tkhd", tkhd)))
+	mdat := mp4BoxBytes("mdat", make([]byte, 1024))
+	fname := filepath.Join(dir, "test.mp4")
+	content := append(append(ftyp, moov...), mdat...)
+	assert.NilErr(t, os.WriteFile(fname, content, 0o600))
+
+	info, err := FromFile(fname, DefaultConfig())
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, info.MimeType, "video/mp4")
+	assert.DeepEqual(t, info.Duration, 90500*time.Millisecond)
+	assert.DeepEqual(t, info.Width, 1280)
+	assert.DeepEqual(t, info.Height, 720)
+}
@@ -0,0 +1,81 @@
+package mediainfo
+
+import (
+	"image"
+	"image/draw"
+)
+
+// Resize scales the image down (preserving its aspect ratio) so that it fits
+// within maxW x maxH. Images that already fit are returned unmodified.
+//
+// Scaling is done by averaging the source pixels that map to each destination
+// pixel (box filter), which yields reasonable quality for downscaling.
+func Resize(src image.Image, maxW, maxH int) image.Image {
+	sb := src.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+	if sw <= maxW && sh <= maxH {
+		return src
+	}
+	if sw == 0 || sh == 0 || maxW <= 0 || maxH <= 0 {
+		return src
+	}
+
+	// Determine the final dimensions, preserving aspect ratio.
+	dw, dh := maxW, sh*maxW/sw
+	if dh > maxH {
+		dw, dh = sw*maxH/sh, maxH
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+
+	// Convert the source to NRGBA to allow direct access to the pixels.
+	nsrc, ok := src.(*image.NRGBA)
+	if !ok {
+		nsrc = image.NewNRGBA(image.Rect(0, 0, sw, sh))
+		draw.Draw(nsrc, nsrc.Bounds(), src, sb.Min, draw.Src)
+	} else {
+		sb = nsrc.Bounds()
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		sy0, sy1 := dy*sh/dh, (dy+1)*sh/dh
+		if sy1 <= sy0 {
+			sy1 = sy0 + 1
+		}
+		for dx := 0; dx < dw; dx++ {
+			sx0, sx1 := dx*sw/dw, (dx+1)*sw/dw
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				i := nsrc.PixOffset(sb.Min.X+sx0, sb.Min.Y+sy)
+				for sx := sx0; sx < sx1; sx++ {
+					pa := uint64(nsrc.Pix[i+3])
+					r += uint64(nsrc.Pix[i]) * pa
+					g += uint64(nsrc.Pix[i+1]) * pa
+					b += uint64(nsrc.Pix[i+2]) * pa
+					a += pa
+					n++
+					i += 4
+				}
+			}
+
+			j := dst.PixOffset(dx, dy)
+			if a > 0 {
+				dst.Pix[j] = uint8(r / a)
+				dst.Pix[j+1] = uint8(g / a)
+				dst.Pix[j+2] = uint8(b / a)
+			}
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
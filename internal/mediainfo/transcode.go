@@ -0,0 +1,265 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+)
+
+// DefaultJPEGQuality is the default quality used when re-encoding JPEG images.
+const DefaultJPEGQuality = 85
+
+// TranscodeConfig is the configuration for transcoding images.
+type TranscodeConfig struct {
+	// MaxWidth and MaxHeight are the max dimensions of the transcoded
+	// image. Images larger than this are resized, preserving their aspect
+	// ratio. Zero means no limit.
+	MaxWidth  int
+	MaxHeight int
+
+	// JPEGQuality is the quality (1-100) used when re-encoding JPEG
+	// images. If zero, DefaultJPEGQuality is used.
+	JPEGQuality int
+
+	// StripMetadata indicates whether to strip metadata (EXIF, text
+	// chunks, etc) from images. Go's encoders do not write any metadata,
+	// so images that have metadata are always re-encoded.
+	StripMetadata bool
+}
+
+// TranscodeResult is the result of a transcode operation.
+type TranscodeResult struct {
+	// Data is the final image data. This is the original data when the
+	// image was not transcoded.
+	Data []byte
+
+	// MimeType is the mime type of the final image.
+	MimeType string
+
+	// Width and Height are the dimensions of the final image.
+	Width  int
+	Height int
+
+	// Transcoded is true if the image was re-encoded.
+	Transcoded bool
+}
+
+// TranscodeImage resizes, re-encodes and strips metadata from JPEG and PNG
+// images, according to the passed config.
+//
+// Data that is not a JPEG or PNG image, or that does not need any change, is
+// returned unmodified (with Transcoded set to false).
+func TranscodeImage(data []byte, cfg TranscodeConfig) (TranscodeResult, error) {
+	res := TranscodeResult{Data: data}
+	imgCfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") {
+		// Not a supported image.
+		return res, nil
+	}
+	res.MimeType = "image/" + format
+	res.Width, res.Height = imgCfg.Width, imgCfg.Height
+
+	var orientation int
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+		if orientation >= 5 {
+			// Image is rotated by 90 or 270 degrees.
+			res.Width, res.Height = res.Height, res.Width
+		}
+	}
+
+	needsResize := (cfg.MaxWidth > 0 && res.Width > cfg.MaxWidth) ||
+		(cfg.MaxHeight > 0 && res.Height > cfg.MaxHeight)
+	needsStrip := cfg.StripMetadata && hasImageMetadata(data, format)
+	if !needsResize && !needsStrip {
+		return res, nil
+	}
+	if imgCfg.Width*imgCfg.Height > maxDecodePixels {
+		return res, ErrImageTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return res, err
+	}
+	if orientation > 1 {
+		// The orientation metadata will be lost, so apply it directly
+		// to the pixels.
+		img = applyOrientation(img, orientation)
+	}
+	if needsResize {
+		maxW, maxH := cfg.MaxWidth, cfg.MaxHeight
+		if maxW <= 0 {
+			maxW = res.Width
+		}
+		if maxH <= 0 {
+			maxH = res.Height
+		}
+		img = Resize(img, maxW, maxH)
+	}
+
+	var b bytes.Buffer
+	switch format {
+	case "jpeg":
+		quality := cfg.JPEGQuality
+		if quality <= 0 || quality > 100 {
+			quality = DefaultJPEGQuality
+		}
+		err = jpeg.Encode(&b, img, &jpeg.Options{Quality: quality})
+	case "png":
+		enc := png.Encoder{CompressionLevel: png.BestCompression}
+		err = enc.Encode(&b, img)
+	}
+	if err != nil {
+		return res, err
+	}
+
+	res.Data = b.Bytes()
+	res.Width, res.Height = img.Bounds().Dx(), img.Bounds().Dy()
+	res.Transcoded = true
+	return res, nil
+}
+
+// hasImageMetadata returns true if the JPEG or PNG image has metadata
+// segments/chunks.
+func hasImageMetadata(data []byte, format string) bool {
+	switch format {
+	case "jpeg":
+		found := false
+		walkJPEGSegments(data, func(marker byte, _ []byte) bool {
+			// APP1-APP15 (EXIF, XMP, etc) and COM segments. APP0
+			// (JFIF) is written by Go's encoder.
+			found = (marker >= 0xe1 && marker <= 0xef) || marker == 0xfe
+			return !found
+		})
+		return found
+
+	case "png":
+		const sigLen = 8
+		for i := sigLen; i+8 <= len(data); {
+			l := int(binary.BigEndian.Uint32(data[i:]))
+			switch string(data[i+4 : i+8]) {
+			case "tEXt", "zTXt", "iTXt", "eXIf", "tIME":
+				return true
+			case "IEND":
+				return false
+			}
+			if l < 0 || l > len(data) {
+				return false
+			}
+			i += 12 + l // Length + type + data + CRC.
+		}
+	}
+	return false
+}
+
+// walkJPEGSegments calls f for every marker segment of the JPEG image, until
+// the start of scan segment or until f returns false.
+func walkJPEGSegments(data []byte, f func(marker byte, payload []byte) bool) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return
+		}
+		marker := data[i+1]
+		if marker == 0xda || marker == 0xd9 {
+			// Start of scan or end of image.
+			return
+		}
+		l := int(binary.BigEndian.Uint16(data[i+2:]))
+		if l < 2 || i+2+l > len(data) {
+			return
+		}
+		if !f(marker, data[i+4:i+2+l]) {
+			return
+		}
+		i += 2 + l
+	}
+}
+
+// jpegOrientation returns the EXIF orientation (1-8) of the JPEG image or zero
+// if the image does not have an orientation tag.
+func jpegOrientation(data []byte) int {
+	var orientation int
+	walkJPEGSegments(data, func(marker byte, payload []byte) bool {
+		if marker != 0xe1 || !bytes.HasPrefix(payload, []byte("Exif\x00\x00")) {
+			return true
+		}
+		tiff := payload[6:]
+		if len(tiff) < 8 {
+			return false
+		}
+		var bo binary.ByteOrder
+		switch string(tiff[:2]) {
+		case "II":
+			bo = binary.LittleEndian
+		case "MM":
+			bo = binary.BigEndian
+		default:
+			return false
+		}
+		ifd := int(bo.Uint32(tiff[4:]))
+		if ifd < 8 || ifd+2 > len(tiff) {
+			return false
+		}
+		n := int(bo.Uint16(tiff[ifd:]))
+		for i := 0; i < n; i++ {
+			entry := ifd + 2 + i*12
+			if entry+12 > len(tiff) {
+				break
+			}
+			if bo.Uint16(tiff[entry:]) != 0x0112 {
+				continue
+			}
+			o := int(bo.Uint16(tiff[entry+8:]))
+			if o >= 1 && o <= 8 {
+				orientation = o
+			}
+			break
+		}
+		return false
+	})
+	return orientation
+}
+
+// applyOrientation returns a copy of the image, transformed so that it is
+// displayed correctly given the EXIF orientation.
+func applyOrientation(src image.Image, orientation int) image.Image {
+	sb := src.Bounds()
+	w, h := sb.Dx(), sb.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		for dx := 0; dx < dw; dx++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-dx, dy
+			case 3:
+				sx, sy = w-1-dx, h-1-dy
+			case 4:
+				sx, sy = dx, h-1-dy
+			case 5:
+				sx, sy = dy, dx
+			case 6:
+				sx, sy = dy, h-1-dx
+			case 7:
+				sx, sy = w-1-dy, h-1-dx
+			case 8:
+				sx, sy = w-1-dy, dx
+			default:
+				sx, sy = dx, dy
+			}
+			dst.Set(dx, dy, src.At(sb.Min.X+sx, sb.Min.Y+sy))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,154 @@
+package mediainfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+)
+
+// testImage returns a test image where the top-left quadrant is red and the
+// rest is blue.
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	red := color.NRGBA{R: 255, A: 255}
+	blue := color.NRGBA{B: 255, A: 255}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 && y < h/2 {
+				img.SetNRGBA(x, y, red)
+			} else {
+				img.SetNRGBA(x, y, blue)
+			}
+		}
+	}
+	return img
+}
+
+// jpegWithOrientation encodes the image as a JPEG that includes an EXIF
+// segment with the given orientation.
+func jpegWithOrientation(t *testing.T, img image.Image, orientation uint16) []byte {
+	var b bytes.Buffer
+	assert.NilErr(t, jpeg.Encode(&b, img, nil))
+	data := b.Bytes()
+
+	var exif bytes.Buffer
+	exif.WriteString("Exif\x00\x00")
+	exif.WriteString("MM")
+	binary.Write(&exif, binary.BigEndian, uint16(42))
+	binary.Write(&exif, binary.BigEndian, uint32(8))
+	binary.Write(&exif, binary.BigEndian, uint16(1))      // Nb of entries
+	binary.Write(&exif, binary.BigEndian, uint16(0x0112)) // Orientation
+	binary.Write(&exif, binary.BigEndian, uint16(3))      // SHORT
+	binary.Write(&exif, binary.BigEndian, uint32(1))      // Count
+	binary.Write(&exif, binary.BigEndian, orientation)
+	binary.Write(&exif, binary.BigEndian, uint16(0))
+	binary.Write(&exif, binary.BigEndian, uint32(0)) // Next IFD
+
+	seg := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(exif.Len()+2))
+	seg = append(seg, exif.Bytes()...)
+
+	res := append([]byte{}, data[:2]...)
+	res = append(res, seg...)
+	return append(res, data[2:]...)
+}
+
+// pngWithText encodes the image as a PNG that includes a tEXt chunk.
+func pngWithText(t *testing.T, img image.Image) []byte {
+	var b bytes.Buffer
+	assert.NilErr(t, png.Encode(&b, img))
+	data := b.Bytes()
+
+	text := []byte("Comment\x00secret location")
+	chunk := make([]byte, 8, 12+len(text))
+	binary.BigEndian.PutUint32(chunk, uint32(len(text)))
+	copy(chunk[4:], "tEXt")
+	chunk = append(chunk, text...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	// Insert after the signature and IHDR chunk.
+	const ihdrEnd = 8 + 12 + 13
+	res := append([]byte{}, data[:ihdrEnd]...)
+	res = append(res, chunk...)
+	return append(res, data[ihdrEnd:]...)
+}
+
+// TestTranscodeImage tests the image transcoding behavior.
+func TestTranscodeImage(t *testing.T) {
+	t.Run("not an image", func(t *testing.T) {
+		data := []byte("not an image")
+		res, err := TranscodeImage(data, TranscodeConfig{MaxWidth: 10, StripMetadata: true})
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, res.Transcoded, false)
+		assert.DeepEqual(t, res.Data, data)
+	})
+
+	t.Run("unmodified", func(t *testing.T) {
+		var b bytes.Buffer
+		assert.NilErr(t, png.Encode(&b, testImage(100, 50)))
+		data := b.Bytes()
+		res, err := TranscodeImage(data, TranscodeConfig{MaxWidth: 100, StripMetadata: true})
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, res.Transcoded, false)
+		assert.DeepEqual(t, res.Data, data)
+		assert.DeepEqual(t, res.MimeType, "image/png")
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		var b bytes.Buffer
+		assert.NilErr(t, jpeg.Encode(&b, testImage(1000, 500), nil))
+		res, err := TranscodeImage(b.Bytes(), TranscodeConfig{MaxWidth: 200, MaxHeight: 200})
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, res.Transcoded, true)
+		assert.DeepEqual(t, res.MimeType, "image/jpeg")
+		assert.DeepEqual(t, res.Width, 200)
+		assert.DeepEqual(t, res.Height, 100)
+		if len(res.Data) >= b.Len() {
+			t.Fatalf("transcoded image is not smaller (%d >= %d)",
+				len(res.Data), b.Len())
+		}
+	})
+
+	t.Run("strip png text", func(t *testing.T) {
+		data := pngWithText(t, testImage(40, 20))
+		_, err := png.Decode(bytes.NewReader(data))
+		assert.NilErr(t, err)
+		res, err := TranscodeImage(data, TranscodeConfig{StripMetadata: true})
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, res.Transcoded, true)
+		assert.DeepEqual(t, bytes.Contains(res.Data, []byte("secret")), false)
+		assert.DeepEqual(t, hasImageMetadata(res.Data, "png"), false)
+	})
+
+	t.Run("strip exif and apply orientation", func(t *testing.T) {
+		data := jpegWithOrientation(t, testImage(80, 40), 6)
+		assert.DeepEqual(t, jpegOrientation(data), 6)
+		res, err := TranscodeImage(data, TranscodeConfig{StripMetadata: true})
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, res.Transcoded, true)
+		assert.DeepEqual(t, hasImageMetadata(res.Data, "jpeg"), false)
+		assert.DeepEqual(t, jpegOrientation(res.Data), 0)
+
+		// Rotated 90 degrees clockwise, so the red quadrant is now
+		// on the top right.
+		img, err := jpeg.Decode(bytes.NewReader(res.Data))
+		assert.NilErr(t, err)
+		assert.DeepEqual(t, img.Bounds().Dx(), 40)
+		assert.DeepEqual(t, img.Bounds().Dy(), 80)
+		r, _, b, _ := img.At(35, 5).RGBA()
+		if r < b {
+			t.Fatalf("top right pixel is not red")
+		}
+		r, _, b, _ = img.At(5, 5).RGBA()
+		if r > b {
+			t.Fatalf("top left pixel is not blue")
+		}
+	})
+}
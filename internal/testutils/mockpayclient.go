@@ -2,16 +2,22 @@ package testutils
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/companyzero/bisonrelay/client/clientintf"
 	"github.com/companyzero/bisonrelay/rpc"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
 )
 
-// MockPayClient fulfills the [clientintf.PaymentClient] interface, while
-// allowing to fail certain calls. It is used for tests.
+// MockPayClient fulfills the [clientintf.PaymentClient] and
+// [clientintf.OnchainPaymentClient] interfaces, while allowing to fail certain
+// calls. It is used for tests.
 //
 // It behaves as the free payment client, except for hooked calls.
 type MockPayClient struct {
@@ -21,6 +27,10 @@ type MockPayClient struct {
 	getInvoice     func(int64, func(int64)) (string, error)
 	decodeInvoice  func(string) (clientintf.DecodedInvoice, error)
 	trackInvoice   func(string, int64) (int64, error)
+	isInvoicePaid  func(int64, string) error
+	sendOnchain    func(string, dcrutil.Amount) (chainhash.Hash, error)
+	waitTxConfirm  func(chainhash.Hash) error
+	verifyTxPay    func(chainhash.Hash, string, dcrutil.Amount) error
 }
 
 func (pc *MockPayClient) PayScheme() string {
@@ -70,7 +80,19 @@ func (pc *MockPayClient) GetInvoice(ctx context.Context, mat int64, cb func(int6
 	return fmt.Sprintf("free invoice for %d milliatoms", mat), nil
 }
 
-func (pc *MockPayClient) IsInvoicePaid(context.Context, int64, string) error {
+func (pc *MockPayClient) HookIsInvoicePaid(hook func(int64, string) error) {
+	pc.mtx.Lock()
+	pc.isInvoicePaid = hook
+	pc.mtx.Unlock()
+}
+
+func (pc *MockPayClient) IsInvoicePaid(_ context.Context, minMAtoms int64, invoice string) error {
+	pc.mtx.Lock()
+	hook := pc.isInvoicePaid
+	pc.mtx.Unlock()
+	if hook != nil {
+		return hook(minMAtoms, invoice)
+	}
 	return nil
 }
 
@@ -136,3 +158,63 @@ func (pc *MockPayClient) TrackInvoice(ctx context.Context, inv string, minMAtoms
 	}
 	return 0, nil
 }
+
+// NewReceiveAddress returns a random simnet address.
+func (pc *MockPayClient) NewReceiveAddress(context.Context, string) (stdaddr.Address, error) {
+	var hash [20]byte
+	if _, err := rand.Read(hash[:]); err != nil {
+		return nil, err
+	}
+	return stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash[:], chaincfg.SimNetParams())
+}
+
+func (pc *MockPayClient) HookSendOnchain(hook func(string, dcrutil.Amount) (chainhash.Hash, error)) {
+	pc.mtx.Lock()
+	pc.sendOnchain = hook
+	pc.mtx.Unlock()
+}
+
+func (pc *MockPayClient) SendOnchain(_ context.Context, addr string, amount dcrutil.Amount) (chainhash.Hash, error) {
+	pc.mtx.Lock()
+	hook := pc.sendOnchain
+	pc.mtx.Unlock()
+	if hook != nil {
+		return hook(addr, amount)
+	}
+
+	var txh chainhash.Hash
+	_, err := rand.Read(txh[:])
+	return txh, err
+}
+
+func (pc *MockPayClient) HookWaitTxConfirmed(hook func(chainhash.Hash) error) {
+	pc.mtx.Lock()
+	pc.waitTxConfirm = hook
+	pc.mtx.Unlock()
+}
+
+func (pc *MockPayClient) WaitTxConfirmed(_ context.Context, tx chainhash.Hash) error {
+	pc.mtx.Lock()
+	hook := pc.waitTxConfirm
+	pc.mtx.Unlock()
+	if hook != nil {
+		return hook(tx)
+	}
+	return nil
+}
+
+func (pc *MockPayClient) HookVerifyTxPayment(hook func(chainhash.Hash, string, dcrutil.Amount) error) {
+	pc.mtx.Lock()
+	pc.verifyTxPay = hook
+	pc.mtx.Unlock()
+}
+
+func (pc *MockPayClient) VerifyTxPayment(_ context.Context, tx chainhash.Hash, addr string, amount dcrutil.Amount) error {
+	pc.mtx.Lock()
+	hook := pc.verifyTxPay
+	pc.mtx.Unlock()
+	if hook != nil {
+		return hook(tx, addr, amount)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package testutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/companyzero/bisonrelay/client/clientintf"
+)
+
+// MockRemotePayServer is a reference implementation of a remote payment
+// server. It serves the remote payment protocol over HTTP by delegating every
+// call to a [clientintf.PaymentClient] (for example, a [MockPayClient]). It is
+// used for tests.
+type MockRemotePayServer struct {
+	pc    clientintf.PaymentClient
+	token string
+	svr   *httptest.Server
+}
+
+// NewMockRemotePayServer starts a new remote payment server that delegates
+// calls to pc. If token is not empty, requests must be authenticated with it.
+// The server is closed when the test ends.
+func NewMockRemotePayServer(t testing.TB, pc clientintf.PaymentClient, token string) *MockRemotePayServer {
+	s := &MockRemotePayServer{pc: pc, token: token}
+	s.svr = httptest.NewServer(s)
+	t.Cleanup(s.svr.Close)
+	return s
+}
+
+// URL returns the base URL of the server.
+func (s *MockRemotePayServer) URL() string {
+	return s.svr.URL
+}
+
+func (s *MockRemotePayServer) reply(w http.ResponseWriter, status int, res clientintf.RemotePayResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *MockRemotePayServer) replyErr(w http.ResponseWriter, status int, err error) {
+	s.reply(w, status, clientintf.RemotePayResponse{
+		Error:     err.Error(),
+		ErrorCode: clientintf.RemotePayErrorCode(err),
+	})
+}
+
+// ServeHTTP serves the remote payment protocol.
+func (s *MockRemotePayServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.replyErr(w, http.StatusMethodNotAllowed,
+			fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		s.replyErr(w, http.StatusUnauthorized, fmt.Errorf("unauthorized"))
+		return
+	}
+
+	var req clientintf.RemotePayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.replyErr(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := r.Context()
+	var res clientintf.RemotePayResponse
+	var err error
+	switch method := strings.TrimPrefix(r.URL.Path, "/"); method {
+	case clientintf.RemotePayMethodPayScheme:
+		res.PayScheme = s.pc.PayScheme()
+	case clientintf.RemotePayMethodPayInvoice:
+		if req.MAtoms > 0 {
+			res.FeesMAtoms, err = s.pc.PayInvoiceAmount(ctx, req.Invoice, req.MAtoms)
+		} else {
+			res.FeesMAtoms, err = s.pc.PayInvoice(ctx, req.Invoice)
+		}
+	case clientintf.RemotePayMethodGetInvoice:
+		res.Invoice, err = s.pc.GetInvoice(ctx, req.MAtoms, nil)
+	case clientintf.RemotePayMethodDecodeInvoice:
+		var inv clientintf.DecodedInvoice
+		inv, err = s.pc.DecodeInvoice(ctx, req.Invoice)
+		res.ID, res.MAtoms, res.ExpiryTime = inv.ID, inv.MAtoms, inv.ExpiryTime
+	case clientintf.RemotePayMethodIsInvoicePaid:
+		err = s.pc.IsInvoicePaid(ctx, req.MAtoms, req.Invoice)
+	case clientintf.RemotePayMethodTrackInvoice:
+		res.MAtoms, err = s.pc.TrackInvoice(ctx, req.Invoice, req.MAtoms)
+	case clientintf.RemotePayMethodIsPaymentCompleted:
+		res.FeesMAtoms, err = s.pc.IsPaymentCompleted(ctx, req.Invoice)
+	default:
+		s.replyErr(w, http.StatusNotFound, fmt.Errorf("unknown method %q", method))
+		return
+	}
+	if err != nil {
+		s.replyErr(w, http.StatusOK, err)
+		return
+	}
+	s.reply(w, http.StatusOK, res)
+}
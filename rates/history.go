@@ -0,0 +1,84 @@
+package rates
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// appendHistory appends the rate to the history file.
+func (r *Rates) appendHistory(rate Rate) error {
+	if r.cfg.HistoryFile == "" {
+		return nil
+	}
+
+	r.histMtx.Lock()
+	defer r.histMtx.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(r.cfg.HistoryFile), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.cfg.HistoryFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(f).Encode(rate)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// History returns the persisted rates fetched in the interval [start, end), in
+// the order they were fetched. A zero start or end time means the interval is
+// unbounded on that side.
+func (r *Rates) History(start, end time.Time) ([]Rate, error) {
+	if r.cfg.HistoryFile == "" {
+		return nil, nil
+	}
+
+	r.histMtx.Lock()
+	defer r.histMtx.Unlock()
+
+	f, err := os.Open(r.cfg.HistoryFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res []Rate
+	dec := json.NewDecoder(f)
+	for {
+		var rate Rate
+		if err := dec.Decode(&rate); err != nil {
+			if !errors.Is(err, io.EOF) {
+				r.cfg.Log.Warnf("Unable to decode rate history "+
+					"entry: %v", err)
+			}
+			break
+		}
+		if !start.IsZero() && rate.Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && !rate.Time.Before(end) {
+			continue
+		}
+		res = append(res, rate)
+	}
+	return res, nil
+}
+
+// RateAt returns the last persisted rate fetched at or before the given time.
+// It returns false if no such rate exists.
+func (r *Rates) RateAt(t time.Time) (Rate, bool, error) {
+	hist, err := r.History(time.Time{}, t.Add(time.Nanosecond))
+	if err != nil || len(hist) == 0 {
+		return Rate{}, false, err
+	}
+	return hist[len(hist)-1], true, nil
+}
@@ -0,0 +1,196 @@
+package rates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Rate is the USD price of DCR and BTC at a given time.
+type Rate struct {
+	DCRPrice float64   `json:"dcr_usd"`
+	BTCPrice float64   `json:"btc_usd"`
+	Time     time.Time `json:"time"`
+}
+
+// Provider is a source of exchange rates.
+type Provider interface {
+	// Name identifies the provider in logs.
+	Name() string
+
+	// Fetch returns the current exchange rate. A zero BTC price means the
+	// provider does not offer the BTC price.
+	Fetch(ctx context.Context) (Rate, error)
+}
+
+const (
+	dcrAPIURL        = "https://api.decred.org/?c=price"
+	dcrAPIOnionURL   = "http://uhzsyccm5uobnd2mzzwp765vdqveampfacvbarl7xaopkdd3hfrfqqad.onion/?c=price"
+	dcrDataURL       = "https://explorer.dcrdata.org/api/exchangerate"
+	dcrDataOnionURL  = "http://dcrdata5oppwcotlxkrlsp6afncnxvw54sw6jqftc4bjytm4rn27j3ad.onion/api/exchangerate"
+	dcrAPIMaxDataAge = 24 * time.Hour
+)
+
+// DcrAPIProvider fetches the rates from api.decred.org.
+type DcrAPIProvider struct {
+	HTTPClient *http.Client
+	URL        string
+}
+
+// NewDcrAPIProvider returns a provider that fetches the rates from
+// api.decred.org (through its hidden service if onion is true).
+func NewDcrAPIProvider(httpClient *http.Client, onion bool) *DcrAPIProvider {
+	url := dcrAPIURL
+	if onion {
+		url = dcrAPIOnionURL
+	}
+	return &DcrAPIProvider{HTTPClient: httpClient, URL: url}
+}
+
+func (p *DcrAPIProvider) Name() string { return "api.decred.org" }
+
+func (p *DcrAPIProvider) Fetch(ctx context.Context) (Rate, error) {
+	dcrAPIExchange := struct {
+		DCRPrice    float64 `json:"decred_usd"`
+		BTCPrice    float64 `json:"bitcoin_usd"`
+		LastUpdated int64   `json:"lastupdated"`
+	}{}
+
+	b, err := getRaw(ctx, p.HTTPClient, p.URL)
+	if err != nil {
+		return Rate{}, err
+	}
+	if err = json.Unmarshal(b, &dcrAPIExchange); err != nil {
+		return Rate{}, fmt.Errorf("failed to decode exchange rate: %w", err)
+	}
+
+	now := time.Now()
+	last := now.Sub(time.Unix(dcrAPIExchange.LastUpdated, 0))
+	if last > dcrAPIMaxDataAge {
+		return Rate{}, fmt.Errorf("api.decred.org data is stale")
+	}
+
+	return Rate{
+		DCRPrice: dcrAPIExchange.DCRPrice,
+		BTCPrice: dcrAPIExchange.BTCPrice,
+		Time:     now,
+	}, nil
+}
+
+// DcrDataProvider fetches the rates from dcrdata.
+type DcrDataProvider struct {
+	HTTPClient *http.Client
+	URL        string
+}
+
+// NewDcrDataProvider returns a provider that fetches the rates from the
+// dcrdata explorer (through its hidden service if onion is true).
+func NewDcrDataProvider(httpClient *http.Client, onion bool) *DcrDataProvider {
+	url := dcrDataURL
+	if onion {
+		url = dcrDataOnionURL
+	}
+	return &DcrDataProvider{HTTPClient: httpClient, URL: url}
+}
+
+func (p *DcrDataProvider) Name() string { return "dcrdata" }
+
+func (p *DcrDataProvider) Fetch(ctx context.Context) (Rate, error) {
+	dcrDataExchange := struct {
+		DCRPrice float64 `json:"dcrPrice"`
+		BTCPrice float64 `json:"btcPrice"`
+	}{}
+
+	b, err := getRaw(ctx, p.HTTPClient, p.URL)
+	if err != nil {
+		return Rate{}, err
+	}
+	if err = json.Unmarshal(b, &dcrDataExchange); err != nil {
+		return Rate{}, fmt.Errorf("failed to decode exchange rate: %v", err)
+	}
+
+	return Rate{
+		DCRPrice: dcrDataExchange.DCRPrice,
+		BTCPrice: dcrDataExchange.BTCPrice,
+		Time:     time.Now(),
+	}, nil
+}
+
+// StaticProvider is a provider that always returns the same, manually
+// specified, rates.
+type StaticProvider struct {
+	DCRPrice float64
+	BTCPrice float64
+}
+
+func (p *StaticProvider) Name() string { return "static" }
+
+func (p *StaticProvider) Fetch(context.Context) (Rate, error) {
+	return Rate{DCRPrice: p.DCRPrice, BTCPrice: p.BTCPrice, Time: time.Now()}, nil
+}
+
+// FileProvider reads the rates from a JSON file in the format of [Rate]. This
+// is useful for air-gapped nodes, where the file is updated by some external
+// process.
+//
+// If the time field is not specified in the file, the modification time of the
+// file is used as the time of the rate.
+type FileProvider struct {
+	Path string
+
+	// MaxAge is the max age of the rate in the file. If the rate is older,
+	// fetching fails. Zero means the rate is accepted regardless of age.
+	MaxAge time.Duration
+}
+
+func (p *FileProvider) Name() string { return "file " + p.Path }
+
+func (p *FileProvider) Fetch(context.Context) (Rate, error) {
+	b, err := os.ReadFile(p.Path)
+	if err != nil {
+		return Rate{}, err
+	}
+	var rate Rate
+	if err := json.Unmarshal(b, &rate); err != nil {
+		return Rate{}, fmt.Errorf("failed to decode exchange rate: %v", err)
+	}
+	if rate.Time.IsZero() {
+		fi, err := os.Stat(p.Path)
+		if err != nil {
+			return Rate{}, err
+		}
+		rate.Time = fi.ModTime()
+	}
+	if p.MaxAge > 0 && time.Since(rate.Time) > p.MaxAge {
+		return Rate{}, fmt.Errorf("rate in %s is stale (from %s)", p.Path,
+			rate.Time.Format(time.RFC3339))
+	}
+	return rate, nil
+}
+
+func getRaw(ctx context.Context, httpClient *http.Client, exchangeAPI string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		exchangeAPI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new http request: %v", err)
+	}
+	req.Header.Del("User-Agent")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate: %v", err)
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exchange rate response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s", http.StatusText(resp.StatusCode))
+	}
+	return b, nil
+}
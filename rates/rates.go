@@ -2,10 +2,9 @@ package rates
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"errors"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,20 +16,67 @@ type Config struct {
 	Log        slog.Logger
 
 	OnionEnable bool
+
+	// Providers are the sources of exchange rates. The current rate is the
+	// median of the rates returned by the providers. If empty, the rates
+	// are fetched from api.decred.org and dcrdata.
+	//
+	// Providers that fetch rates through HTTP and do not have an HTTP
+	// client set use HTTPClient.
+	Providers []Provider
+
+	// HistoryFile is the file where fetched rates are persisted. If empty,
+	// the history of rates is not persisted.
+	HistoryFile string
+
+	// StaleAfter is the duration after the last successful update at which
+	// the rates are considered stale. Zero disables staleness alarms.
+	StaleAfter time.Duration
+
+	// OnStale is called when the rates become stale. It is called once
+	// until the rates are updated again.
+	OnStale func(lastUpdated time.Time)
 }
 
 type Rates struct {
-	cfg Config
+	cfg       Config
+	providers []Provider
 
 	mtx         sync.Mutex
 	dcrPrice    float64
 	btcPrice    float64
 	lastUpdated time.Time
+	staleSince  time.Time
+	started     time.Time
+
+	// histMtx serializes access to the history file.
+	histMtx sync.Mutex
 }
 
 func New(cfg Config) *Rates {
+	providers := cfg.Providers
+	if len(providers) == 0 {
+		providers = []Provider{
+			NewDcrAPIProvider(cfg.HTTPClient, cfg.OnionEnable),
+			NewDcrDataProvider(cfg.HTTPClient, cfg.OnionEnable),
+		}
+	}
+	for _, p := range providers {
+		switch p := p.(type) {
+		case *DcrAPIProvider:
+			if p.HTTPClient == nil {
+				p.HTTPClient = cfg.HTTPClient
+			}
+		case *DcrDataProvider:
+			if p.HTTPClient == nil {
+				p.HTTPClient = cfg.HTTPClient
+			}
+		}
+	}
 	return &Rates{
-		cfg: cfg,
+		cfg:       cfg,
+		providers: providers,
+		started:   time.Now(),
 	}
 }
 
@@ -38,12 +84,10 @@ func (r *Rates) Run(ctx context.Context) {
 	const shortTimeout = time.Second * 30
 	const longTimeout = time.Minute * 10
 	const triesBeforeErr = 20
-	const requestTimeout = shortTimeout
 
 	t := time.NewTicker(1)
 
 	var failedTries int
-	var err error
 	for {
 		select {
 		case <-ctx.Done():
@@ -52,34 +96,19 @@ func (r *Rates) Run(ctx context.Context) {
 		case <-t.C:
 			t.Stop()
 
-			// Try from api.decred.org.
-			rctx, cancel := context.WithTimeout(ctx, requestTimeout)
-			if err = r.dcrAPI(rctx); err == nil {
-				cancel()
-				failedTries = 0
-				t.Reset(longTimeout)
-				continue
-			}
-			cancel()
-			r.cfg.Log.Debugf("Unable to fetch rate from api.decred.org: %v", err)
-
-			// Try from dcrdata.
-			rctx, cancel = context.WithTimeout(ctx, requestTimeout)
-			if err = r.dcrData(rctx); err == nil {
-				cancel()
+			if err := r.update(ctx, shortTimeout); err == nil {
 				failedTries = 0
 				t.Reset(longTimeout)
 				continue
 			}
-			cancel()
-			r.cfg.Log.Debugf("Unable to fetch rate from dcrdata: %v", err)
+			r.checkStale(time.Now())
 
 			// Only log these at a higher warning level once after
 			// the rate has been successfully fetched. This prevents
 			// spam in the UI.
 			failedTries++
 			if failedTries == triesBeforeErr {
-				r.Set(0, 0) // Unset rates
+				r.unset()
 
 				r.cfg.Log.Errorf("Unable to fetch recent exchange rate. Will keep retrying.")
 			}
@@ -88,117 +117,144 @@ func (r *Rates) Run(ctx context.Context) {
 	}
 }
 
-// Get returns the last fetched USD/DCR and USD/BTC prices.
-func (r *Rates) Get() (float64, float64) {
-	r.mtx.Lock()
-	dcrPrice, btcPrice := r.dcrPrice, r.btcPrice
-	r.mtx.Unlock()
-
-	return dcrPrice, btcPrice
+// median returns the median of the given values or zero if there are no
+// values.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
 }
 
-// Set manually sets the USD/DCR and USD/BTC prices.
-func (r *Rates) Set(dcrPrice, btcPrice float64) {
-	r.cfg.Log.Infof("Exchange rate set manually: DCR:%0.2f BTC:%0.2f",
-		dcrPrice, btcPrice)
-
-	r.mtx.Lock()
-	r.dcrPrice = dcrPrice
-	r.btcPrice = btcPrice
-	r.lastUpdated = time.Now()
-	r.mtx.Unlock()
-}
+// update fetches the rates from all providers and sets the current rates to
+// the median of the fetched rates.
+func (r *Rates) update(ctx context.Context, requestTimeout time.Duration) error {
+	var dcrPrices, btcPrices []float64
+	for _, p := range r.providers {
+		rctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		rate, err := p.Fetch(rctx)
+		cancel()
+		if err != nil {
+			r.cfg.Log.Debugf("Unable to fetch rate from %s: %v", p.Name(), err)
+			continue
+		}
+		if rate.DCRPrice <= 0 {
+			r.cfg.Log.Debugf("Provider %s returned invalid DCR price %f",
+				p.Name(), rate.DCRPrice)
+			continue
+		}
 
-func (r *Rates) dcrData(ctx context.Context) error {
-	dcrDataExchange := struct {
-		DCRPrice float64 `json:"dcrPrice"`
-		BTCPrice float64 `json:"btcPrice"`
-	}{}
-
-	var apiURL string
-	if r.cfg.OnionEnable {
-		apiURL = "http://dcrdata5oppwcotlxkrlsp6afncnxvw54sw6jqftc4bjytm4rn27j3ad.onion/api/exchangerate"
-	} else {
-		apiURL = "https://explorer.dcrdata.org/api/exchangerate"
-	}
-	b, err := r.getRaw(ctx, apiURL)
-	if err != nil {
-		return err
+		r.cfg.Log.Debugf("Exchange rate via %s: DCR:%0.2f BTC:%0.2f",
+			p.Name(), rate.DCRPrice, rate.BTCPrice)
+		dcrPrices = append(dcrPrices, rate.DCRPrice)
+		if rate.BTCPrice > 0 {
+			btcPrices = append(btcPrices, rate.BTCPrice)
+		}
 	}
-	if err = json.Unmarshal(b, &dcrDataExchange); err != nil {
-		return fmt.Errorf("failed to decode exchange rate: %v", err)
+	if len(dcrPrices) == 0 {
+		return errors.New("no provider returned an exchange rate")
 	}
 
-	r.cfg.Log.Infof("Current exchange rate via dcrdata: DCR:%0.2f BTC:%0.2f",
-		dcrDataExchange.DCRPrice, dcrDataExchange.BTCPrice)
+	rate := Rate{
+		DCRPrice: median(dcrPrices),
+		BTCPrice: median(btcPrices),
+		Time:     time.Now(),
+	}
+	r.cfg.Log.Infof("Current exchange rate via %d provider(s): DCR:%0.2f BTC:%0.2f",
+		len(dcrPrices), rate.DCRPrice, rate.BTCPrice)
 
 	r.mtx.Lock()
-	r.dcrPrice = dcrDataExchange.DCRPrice
-	r.btcPrice = dcrDataExchange.BTCPrice
-	r.lastUpdated = time.Now()
+	r.dcrPrice = rate.DCRPrice
+	r.btcPrice = rate.BTCPrice
+	r.lastUpdated = rate.Time
+	r.staleSince = time.Time{}
 	r.mtx.Unlock()
 
+	if err := r.appendHistory(rate); err != nil {
+		r.cfg.Log.Warnf("Unable to persist exchange rate: %v", err)
+	}
 	return nil
 }
 
-func (r *Rates) dcrAPI(ctx context.Context) error {
-	dcrAPIExchange := struct {
-		DCRPrice    float64 `json:"decred_usd"`
-		BTCPrice    float64 `json:"bitcoin_usd"`
-		LastUpdated int64   `json:"lastupdated"`
-	}{}
-
-	var apiURL string
-	if r.cfg.OnionEnable {
-		apiURL = "http://uhzsyccm5uobnd2mzzwp765vdqveampfacvbarl7xaopkdd3hfrfqqad.onion/?c=price"
-	} else {
-		apiURL = "https://api.decred.org/?c=price"
+// checkStale calls the staleness alarm if the rates have not been updated
+// within the configured duration.
+func (r *Rates) checkStale(now time.Time) {
+	if r.cfg.StaleAfter <= 0 {
+		return
 	}
-	b, err := r.getRaw(ctx, apiURL)
-	if err != nil {
-		return err
+
+	r.mtx.Lock()
+	lastUpdated := r.lastUpdated
+	ref := lastUpdated
+	if ref.IsZero() {
+		ref = r.started
 	}
-	if err = json.Unmarshal(b, &dcrAPIExchange); err != nil {
-		return fmt.Errorf("failed to decode exchange rate: %w", err)
+	alarm := r.staleSince.IsZero() && now.Sub(ref) > r.cfg.StaleAfter
+	if alarm {
+		r.staleSince = now
 	}
+	r.mtx.Unlock()
 
-	now := time.Now()
-	last := now.Sub(time.Unix(dcrAPIExchange.LastUpdated, 0))
-	if last > 24*time.Hour {
-		return fmt.Errorf("api.decred.org data is stale")
+	if !alarm {
+		return
+	}
+	r.cfg.Log.Warnf("Exchange rate is stale (last updated: %s)",
+		lastUpdated.Format(time.RFC3339))
+	if r.cfg.OnStale != nil {
+		r.cfg.OnStale(lastUpdated)
 	}
+}
 
-	r.cfg.Log.Infof("Current exchange rate via API: DCR:%0.2f BTC:%0.2f",
-		dcrAPIExchange.DCRPrice, dcrAPIExchange.BTCPrice)
+// unset clears the current rates, without modifying the time they were last
+// updated.
+func (r *Rates) unset() {
+	r.mtx.Lock()
+	r.dcrPrice = 0
+	r.btcPrice = 0
+	r.mtx.Unlock()
+}
 
+// Get returns the last fetched USD/DCR and USD/BTC prices.
+func (r *Rates) Get() (float64, float64) {
 	r.mtx.Lock()
-	r.dcrPrice = dcrAPIExchange.DCRPrice
-	r.btcPrice = dcrAPIExchange.BTCPrice
-	r.lastUpdated = now
+	dcrPrice, btcPrice := r.dcrPrice, r.btcPrice
 	r.mtx.Unlock()
 
-	return nil
+	return dcrPrice, btcPrice
 }
 
-func (r *Rates) getRaw(ctx context.Context, exchangeAPI string) ([]byte, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
-		exchangeAPI, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new http request: %v", err)
-	}
-	req.Header.Del("User-Agent")
+// LastUpdated returns the time the rates were last updated or a zero time if
+// they were never updated.
+func (r *Rates) LastUpdated() time.Time {
+	r.mtx.Lock()
+	lastUpdated := r.lastUpdated
+	r.mtx.Unlock()
+	return lastUpdated
+}
 
-	resp, err := r.cfg.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get exchange rate: %v", err)
-	}
-	b, err := io.ReadAll(resp.Body)
-	resp.Body.Close()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read exchange rate response: %v", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%s", http.StatusText(resp.StatusCode))
-	}
-	return b, nil
+// IsStale returns true if the rates have not been updated within the configured
+// staleness duration.
+func (r *Rates) IsStale() bool {
+	r.mtx.Lock()
+	stale := !r.staleSince.IsZero()
+	r.mtx.Unlock()
+	return stale
+}
+
+// Set manually sets the USD/DCR and USD/BTC prices.
+func (r *Rates) Set(dcrPrice, btcPrice float64) {
+	r.cfg.Log.Infof("Exchange rate set manually: DCR:%0.2f BTC:%0.2f",
+		dcrPrice, btcPrice)
+
+	r.mtx.Lock()
+	r.dcrPrice = dcrPrice
+	r.btcPrice = btcPrice
+	r.lastUpdated = time.Now()
+	r.staleSince = time.Time{}
+	r.mtx.Unlock()
 }
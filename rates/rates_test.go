@@ -0,0 +1,164 @@
+package rates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/internal/assert"
+	"github.com/decred/slog"
+)
+
+// newTestServer returns a local HTTP server that stands in for api.decred.org
+// (under /dcrapi) and dcrdata (under /dcrdata).
+func newTestServer(t *testing.T, dcrAPIPrice, dcrDataPrice float64) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/dcrapi", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"decred_usd":%f,"bitcoin_usd":60000,"lastupdated":%d}`,
+			dcrAPIPrice, time.Now().Unix())
+	})
+	mux.HandleFunc("/dcrdata", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"dcrPrice":%f,"btcPrice":62000}`, dcrDataPrice)
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestProviders tests fetching rates from the different providers.
+func TestProviders(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, 20, 21)
+	ctx := context.Background()
+
+	dcrAPI := &DcrAPIProvider{HTTPClient: srv.Client(), URL: srv.URL + "/dcrapi"}
+	rate, err := dcrAPI.Fetch(ctx)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, rate.DCRPrice, 20.0)
+	assert.DeepEqual(t, rate.BTCPrice, 60000.0)
+
+	dcrData := &DcrDataProvider{HTTPClient: srv.Client(), URL: srv.URL + "/dcrdata"}
+	rate, err = dcrData.Fetch(ctx)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, rate.DCRPrice, 21.0)
+	assert.DeepEqual(t, rate.BTCPrice, 62000.0)
+
+	broken := &DcrDataProvider{HTTPClient: srv.Client(), URL: srv.URL + "/broken"}
+	_, err = broken.Fetch(ctx)
+	assert.NonNilErr(t, err)
+
+	static := &StaticProvider{DCRPrice: 15, BTCPrice: 50000}
+	rate, err = static.Fetch(ctx)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, rate.DCRPrice, 15.0)
+
+	// File without time uses the modification time of the file.
+	fname := filepath.Join(t.TempDir(), "rate.json")
+	err = os.WriteFile(fname, []byte(`{"dcr_usd":18,"btc_usd":55000}`), 0o600)
+	assert.NilErr(t, err)
+	file := &FileProvider{Path: fname, MaxAge: time.Hour}
+	rate, err = file.Fetch(ctx)
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, rate.DCRPrice, 18.0)
+	assert.DeepEqual(t, rate.BTCPrice, 55000.0)
+
+	// Rates older than the max age are rejected.
+	err = os.WriteFile(fname, []byte(`{"dcr_usd":18,"time":"2020-01-01T00:00:00Z"}`), 0o600)
+	assert.NilErr(t, err)
+	_, err = file.Fetch(ctx)
+	assert.NonNilErr(t, err)
+}
+
+// TestMedianAndHistory tests that the current rate is the median of the rates
+// of the providers and that fetched rates are persisted.
+func TestMedianAndHistory(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t, 20, 22)
+	histFile := filepath.Join(t.TempDir(), "rates", "history.json")
+	r := New(Config{
+		Log: slog.Disabled,
+		Providers: []Provider{
+			&DcrAPIProvider{HTTPClient: srv.Client(), URL: srv.URL + "/dcrapi"},
+			&DcrDataProvider{HTTPClient: srv.Client(), URL: srv.URL + "/dcrdata"},
+			&DcrDataProvider{HTTPClient: srv.Client(), URL: srv.URL + "/broken"},
+			&StaticProvider{DCRPrice: 100},
+		},
+		HistoryFile: histFile,
+	})
+
+	// Median of 20, 22 and 100 (the broken provider is ignored). The BTC
+	// price is the median of the providers that return it.
+	assert.NilErr(t, r.update(context.Background(), time.Second))
+	dcrPrice, btcPrice := r.Get()
+	assert.DeepEqual(t, dcrPrice, 22.0)
+	assert.DeepEqual(t, btcPrice, 61000.0)
+
+	r.providers = r.providers[:2]
+	assert.NilErr(t, r.update(context.Background(), time.Second))
+	dcrPrice, _ = r.Get()
+	assert.DeepEqual(t, dcrPrice, 21.0)
+
+	hist, err := r.History(time.Time{}, time.Time{})
+	assert.NilErr(t, err)
+	assert.DeepEqual(t, len(hist), 2)
+	assert.DeepEqual(t, hist[0].DCRPrice, 22.0)
+	assert.DeepEqual(t, hist[1].DCRPrice, 21.0)
+
+	rate, ok, err := r.RateAt(hist[0].Time)
+	assert.NilErr(t, err)
+	assert.BoolIs(t, ok, true)
+	assert.DeepEqual(t, rate.DCRPrice, 22.0)
+	_, ok, err = r.RateAt(hist[0].Time.Add(-time.Second))
+	assert.NilErr(t, err)
+	assert.BoolIs(t, ok, false)
+
+	// Failing to fetch from all providers does not change the rate.
+	r.providers = r.providers[2:]
+	assert.NonNilErr(t, r.update(context.Background(), time.Second))
+	dcrPrice, _ = r.Get()
+	assert.DeepEqual(t, dcrPrice, 21.0)
+}
+
+// TestStaleAlarm tests the staleness alarm of rates.
+func TestStaleAlarm(t *testing.T) {
+	t.Parallel()
+
+	staleChan := make(chan time.Time, 5)
+	r := New(Config{
+		Log:        slog.Disabled,
+		Providers:  []Provider{&StaticProvider{DCRPrice: 20}},
+		StaleAfter: time.Hour,
+		OnStale:    func(lastUpdated time.Time) { staleChan <- lastUpdated },
+	})
+
+	// Not stale before an hour.
+	assert.NilErr(t, r.update(context.Background(), time.Second))
+	lastUpdated := r.LastUpdated()
+	r.checkStale(lastUpdated.Add(time.Minute))
+	assert.ChanNotWritten(t, staleChan, 10*time.Millisecond)
+	assert.BoolIs(t, r.IsStale(), false)
+
+	// Alarm only once after becoming stale.
+	r.checkStale(lastUpdated.Add(2 * time.Hour))
+	assert.ChanWrittenWithVal(t, staleChan, lastUpdated)
+	assert.BoolIs(t, r.IsStale(), true)
+	r.checkStale(lastUpdated.Add(3 * time.Hour))
+	assert.ChanNotWritten(t, staleChan, 10*time.Millisecond)
+
+	// Updating clears the stale status and alarms again when stale.
+	assert.NilErr(t, r.update(context.Background(), time.Second))
+	assert.BoolIs(t, r.IsStale(), false)
+	lastUpdated = r.LastUpdated()
+	r.checkStale(lastUpdated.Add(2 * time.Hour))
+	assert.ChanWrittenWithVal(t, staleChan, lastUpdated)
+}
@@ -35,6 +35,11 @@ type OOBPublicIdentityInvite struct {
 	InitialRendezvous zkidentity.ShortID        `json:"initialrendezvous"`
 	ResetRendezvous   zkidentity.ShortID        `json:"resetrendezvous"`
 	Funds             *InviteFunds              `json:"funds,omitempty"`
+
+	// KXPaymentMAtoms is the amount the creator of the invite requires to
+	// be paid before completing the KX. When set, the creator replies to
+	// the RMOHalfKX with an RMOKXPaymentRequired.
+	KXPaymentMAtoms int64 `json:"kx_payment_matoms,omitempty"`
 }
 
 const OOBCPublicIdentityInvite = "oobpublicidentityinvite"
@@ -280,6 +285,16 @@ type RMOFullKX struct {
 
 const RMOCFullKX = "ofullkx"
 
+// RMOKXPaymentRequired is sent in reply to an RMOHalfKX by the creator of an
+// invite that requires a payment to complete the KX. After paying the invoice,
+// the target user must send the RMOHalfKX again to PayRendezvous.
+type RMOKXPaymentRequired struct {
+	Invoice       string          `json:"invoice"`
+	PayRendezvous ratchet.RVPoint `json:"payrendezvous"`
+}
+
+const RMOCKXPaymentRequired = "okxpaymentrequired"
+
 // XXX see if we can combine this with the regular code path (ComposeRM)
 
 // ComposeRMO creates a blobified oob message that has a header and a
@@ -299,6 +314,9 @@ func ComposeRMO(rm interface{}, zlibLevel int) ([]byte, error) {
 	case RMOFullKX:
 		h.Command = RMOCFullKX
 
+	case RMOKXPaymentRequired:
+		h.Command = RMOCKXPaymentRequired
+
 	default:
 		return nil, fmt.Errorf("unknown oob routed message "+
 			"type: %T", rm)
@@ -364,6 +382,11 @@ func DecomposeRMO(mb []byte, maxDecompressSize uint) (*RMOHeader, interface{}, e
 		err = pmd.Decode(&fkx)
 		payload = fkx
 
+	case RMOCKXPaymentRequired:
+		var kxpr RMOKXPaymentRequired
+		err = pmd.Decode(&kxpr)
+		payload = kxpr
+
 	default:
 		return nil, nil, fmt.Errorf("unknown oob "+
 			"message command: %v", h.Command)
@@ -5,9 +5,12 @@ import (
 	"compress/zlib"
 	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/companyzero/bisonrelay/ratchet"
@@ -120,6 +123,14 @@ type RMGetInvoice struct {
 	PayScheme  string
 	MilliAtoms uint64
 	Tag        uint32
+
+	// PostID is set when the invoice is requested to unlock the full
+	// content of a paid post, instead of for a tip.
+	PostID *zkidentity.ShortID `json:"post_id,omitempty"`
+
+	// RecurringPaymentID is set when the invoice is requested to pay for
+	// one period of a recurring payment agreement.
+	RecurringPaymentID *zkidentity.ShortID `json:"recurring_payment_id,omitempty"`
 }
 
 const RMCInvoice = "invoice"
@@ -128,6 +139,94 @@ type RMInvoice struct {
 	Invoice string
 	Tag     uint32
 	Error   *string `json:"error,omitempty"`
+
+	// PostID is set when the invoice was generated to unlock the full
+	// content of a paid post.
+	PostID *zkidentity.ShortID `json:"post_id,omitempty"`
+}
+
+const RMCPaidPostContent = "paidpostcontent"
+
+// RMPaidPostContent is sent by the author of a paid post after the invoice
+// to unlock it has been settled. Content is the full post body, which must
+// hash to the RMPContentHash attribute of the post.
+type RMPaidPostContent struct {
+	ID      zkidentity.ShortID `json:"id"`
+	Content string             `json:"content"`
+}
+
+const RMCPaymentRequest = "paymentrequest"
+
+// RMPaymentRequest is sent to ask the remote user to pay the included invoice.
+// Expiry is the unix timestamp after which the request should no longer be
+// paid.
+type RMPaymentRequest struct {
+	ID         zkidentity.ShortID `json:"id"`
+	Invoice    string             `json:"invoice"`
+	MilliAtoms uint64             `json:"milliatoms"`
+	Memo       string             `json:"memo,omitempty"`
+	Expiry     int64              `json:"expiry"`
+}
+
+const RMCPaymentRequestReply = "paymentrequestreply"
+
+// RMPaymentRequestReply is sent by the target of a payment request when it
+// declines to pay it.
+type RMPaymentRequestReply struct {
+	ID       zkidentity.ShortID `json:"id"`
+	Declined bool               `json:"declined"`
+	Reason   string             `json:"reason,omitempty"`
+}
+
+const RMCRecurringPayment = "recurringpayment"
+
+// RMRecurringPayment is sent by a supporter to inform a creator that it will
+// pay MilliAtoms every Period seconds, for at most MaxPeriods periods (or
+// indefinitely, if MaxPeriods is zero). Each payment is made as a tip with
+// RMGetInvoice.RecurringPaymentID set to ID.
+type RMRecurringPayment struct {
+	ID         zkidentity.ShortID `json:"id"`
+	Tier       string             `json:"tier,omitempty"`
+	MilliAtoms uint64             `json:"milli_atoms"`
+	Period     int64              `json:"period"`
+	MaxPeriods uint32             `json:"max_periods,omitempty"`
+}
+
+const RMCRecurringPaymentCancel = "recurringpaymentcancel"
+
+// RMRecurringPaymentCancel is sent by either party of a recurring payment
+// agreement to cancel it.
+type RMRecurringPaymentCancel struct {
+	ID     zkidentity.ShortID `json:"id"`
+	Reason string             `json:"reason,omitempty"`
+}
+
+const RMCGetOnchainTipAddr = "getonchaintipaddr"
+
+// RMGetOnchainTipAddr is sent by a client that was unable to pay a tip through
+// LN to request an on-chain address to send the tip to instead.
+type RMGetOnchainTipAddr struct {
+	ID         zkidentity.ShortID `json:"id"`
+	MilliAtoms uint64             `json:"milli_atoms"`
+}
+
+const RMCOnchainTipAddr = "onchaintipaddr"
+
+// RMOnchainTipAddr is the reply to an RMGetOnchainTipAddr.
+type RMOnchainTipAddr struct {
+	ID      zkidentity.ShortID `json:"id"`
+	Address string             `json:"address,omitempty"`
+	Error   *string            `json:"error,omitempty"`
+}
+
+const RMCOnchainTipReceipt = "onchaintipreceipt"
+
+// RMOnchainTipReceipt is sent by the payer of an on-chain tip after the
+// transaction that pays it is confirmed.
+type RMOnchainTipReceipt struct {
+	ID         zkidentity.ShortID `json:"id"`
+	TxID       string             `json:"txid"`
+	MilliAtoms uint64             `json:"milli_atoms"`
 }
 
 const RMCKXSuggestion = "kxsuggestion"
@@ -216,6 +315,91 @@ type RMHandshakeSYN struct{}
 type RMHandshakeSYNACK struct{}
 type RMHandshakeACK struct{}
 
+const (
+	// RMCFragment is the command for RMFragment.
+	RMCFragment = "fragment"
+
+	// MaxFragmentedRMSize is the max size of a composed RM that may be
+	// split into fragments.
+	MaxFragmentedRMSize = 32 * 1024 * 1024
+
+	// MaxRMFragments is the max number of fragments a single RM may be
+	// split into.
+	MaxRMFragments = 64
+)
+
+// RMFragment is one fragment of a composed (and signed) RM that is too large
+// to be sent as a single message. Each fragment is sent as an individual RM and
+// the receiver decomposes the original RM once all fragments are received.
+type RMFragment struct {
+	// ID is the SHA256 hash of the full composed RM.
+	ID    zkidentity.ShortID `json:"id"`
+	Index uint32             `json:"index"`
+	Count uint32             `json:"count"`
+	Size  uint32             `json:"size"` // Size of the full composed RM
+	Data  []byte             `json:"data"`
+}
+
+// Valid returns an error if the fragment has inconsistent fields.
+func (frag *RMFragment) Valid() error {
+	switch {
+	case frag.Count < 2 || frag.Count > MaxRMFragments:
+		return fmt.Errorf("invalid fragment count %d", frag.Count)
+	case frag.Index >= frag.Count:
+		return fmt.Errorf("invalid fragment index %d >= %d", frag.Index,
+			frag.Count)
+	case frag.Size > MaxFragmentedRMSize:
+		return fmt.Errorf("fragmented RM size %d > max %d", frag.Size,
+			MaxFragmentedRMSize)
+	case len(frag.Data) == 0 || len(frag.Data) > int(frag.Size):
+		return fmt.Errorf("invalid fragment data length %d", len(frag.Data))
+	}
+	return nil
+}
+
+// MaxRMFragmentDataSize returns the max amount of data that can be included in
+// a single RMFragment such that the composed fragment fits in a message of the
+// given max size.
+func MaxRMFragmentDataSize(maxMsgSize int) int {
+	// Overhead of the RM header and of the remaining fields of the
+	// fragment.
+	const fragOverhead = 2048
+
+	// Reverse the estimation done in EstimateRoutedRMWireSize, then
+	// account for the base64 encoding of the data within the json payload.
+	maxComposed := (maxMsgSize - 512) / 4 * 3
+	return (maxComposed - fragOverhead) / 4 * 3
+}
+
+// SplitRM splits a composed RM into fragments of up to fragSize bytes.
+func SplitRM(rm []byte, fragSize int) ([]RMFragment, error) {
+	if len(rm) > MaxFragmentedRMSize {
+		return nil, fmt.Errorf("RM size %d > max fragmented RM size %d",
+			len(rm), MaxFragmentedRMSize)
+	}
+	if fragSize <= 0 {
+		return nil, fmt.Errorf("invalid fragment size %d", fragSize)
+	}
+	count := (len(rm) + fragSize - 1) / fragSize
+	if count < 2 || count > MaxRMFragments {
+		return nil, fmt.Errorf("invalid number of fragments %d", count)
+	}
+
+	id := zkidentity.ShortID(sha256.Sum256(rm))
+	frags := make([]RMFragment, count)
+	for i := range frags {
+		end := min((i+1)*fragSize, len(rm))
+		frags[i] = RMFragment{
+			ID:    id,
+			Index: uint32(i),
+			Count: uint32(count),
+			Size:  uint32(len(rm)),
+			Data:  rm[i*fragSize : end],
+		}
+	}
+	return frags, nil
+}
+
 type MessageSigner func(message []byte) zkidentity.FixedSizeSignature
 
 // ComposeCompressedRM creates a blobified message that has a header and a
@@ -254,6 +438,30 @@ func ComposeCompressedRM(fromSigner MessageSigner, rm interface{}, zlibLevel int
 	case RMInvoice:
 		h.Command = RMCInvoice
 
+	case RMPaidPostContent:
+		h.Command = RMCPaidPostContent
+
+	case RMPaymentRequest:
+		h.Command = RMCPaymentRequest
+
+	case RMPaymentRequestReply:
+		h.Command = RMCPaymentRequestReply
+
+	case RMRecurringPayment:
+		h.Command = RMCRecurringPayment
+
+	case RMRecurringPaymentCancel:
+		h.Command = RMCRecurringPaymentCancel
+
+	case RMGetOnchainTipAddr:
+		h.Command = RMCGetOnchainTipAddr
+
+	case RMOnchainTipAddr:
+		h.Command = RMCOnchainTipAddr
+
+	case RMOnchainTipReceipt:
+		h.Command = RMCOnchainTipReceipt
+
 	case RMTransitiveMessage:
 		h.Command = RMCTransitiveMessage
 
@@ -283,6 +491,9 @@ func ComposeCompressedRM(fromSigner MessageSigner, rm interface{}, zlibLevel int
 	case RMHandshakeACK:
 		h.Command = RMCHandshakeACK
 
+	case RMFragment:
+		h.Command = RMCFragment
+
 	// Group chat
 	case RMGroupInvite:
 		h.Command = RMCGroupInvite
@@ -311,6 +522,9 @@ func ComposeCompressedRM(fromSigner MessageSigner, rm interface{}, zlibLevel int
 	case RMGroupMessage:
 		h.Command = RMCGroupMessage
 
+	case RMGroupFileShared:
+		h.Command = RMCGroupFileShared
+
 	// File transfer
 	case RMFTList:
 		h.Command = RMCFTList
@@ -554,6 +768,46 @@ func DecomposeRM(msgVerifier MessageVerifier, mb []byte, maxDecompressSize uint)
 		err = pmd.Decode(&inv)
 		payload = inv
 
+	case RMCPaidPostContent:
+		var ppc RMPaidPostContent
+		err = pmd.Decode(&ppc)
+		payload = ppc
+
+	case RMCPaymentRequest:
+		var pr RMPaymentRequest
+		err = pmd.Decode(&pr)
+		payload = pr
+
+	case RMCPaymentRequestReply:
+		var prr RMPaymentRequestReply
+		err = pmd.Decode(&prr)
+		payload = prr
+
+	case RMCRecurringPayment:
+		var rp RMRecurringPayment
+		err = pmd.Decode(&rp)
+		payload = rp
+
+	case RMCRecurringPaymentCancel:
+		var rpCancel RMRecurringPaymentCancel
+		err = pmd.Decode(&rpCancel)
+		payload = rpCancel
+
+	case RMCGetOnchainTipAddr:
+		var getAddr RMGetOnchainTipAddr
+		err = pmd.Decode(&getAddr)
+		payload = getAddr
+
+	case RMCOnchainTipAddr:
+		var addr RMOnchainTipAddr
+		err = pmd.Decode(&addr)
+		payload = addr
+
+	case RMCOnchainTipReceipt:
+		var receipt RMOnchainTipReceipt
+		err = pmd.Decode(&receipt)
+		payload = receipt
+
 	case RMCTransitiveMessage:
 		var transitiveMessage RMTransitiveMessage
 		err = pmd.Decode(&transitiveMessage)
@@ -605,6 +859,11 @@ func DecomposeRM(msgVerifier MessageVerifier, mb []byte, maxDecompressSize uint)
 		err = pmd.Decode(&hshk)
 		payload = hshk
 
+	case RMCFragment:
+		var frag RMFragment
+		err = pmd.Decode(&frag)
+		payload = frag
+
 	// Group vhat
 	case RMCGroupInvite:
 		var groupInvite RMGroupInvite
@@ -692,6 +951,11 @@ func DecomposeRM(msgVerifier MessageVerifier, mb []byte, maxDecompressSize uint)
 		err = pmd.Decode(&groupMessage)
 		payload = groupMessage
 
+	case RMCGroupFileShared:
+		var gcFileShared RMGroupFileShared
+		err = pmd.Decode(&gcFileShared)
+		payload = gcFileShared
+
 	// User
 	case RMCUser:
 		var user RMUser
@@ -956,6 +1220,15 @@ type RMGroupUpdateAdmins struct {
 
 const RMGCGroupUpdateAdmins = "groupupdateadmins"
 
+// RMGroupFileShared is sent to GC members when a GC member shares a file with
+// the GC.
+type RMGroupFileShared struct {
+	ID   zkidentity.ShortID `json:"id"` // GC ID
+	File FileMetadata       `json:"file"`
+}
+
+const RMCGroupFileShared = "groupfileshared"
+
 // RMGroupList defines a Group Chat channel.
 type RMGroupList struct {
 	ID         zkidentity.ShortID `json:"id"` // group id
@@ -973,6 +1246,16 @@ type RMGroupList struct {
 	// ExtraAdmins are additional admins. Members[0] is still considered
 	// an admin in version 1 GCs.
 	ExtraAdmins []zkidentity.ShortID `json:"extra_admins"`
+
+	// TipShares are the relative shares of the members, defined by the
+	// admins, used when the GC is tipped with split payouts by shares.
+	TipShares []RMGroupTipShare `json:"tip_shares,omitempty"`
+}
+
+// RMGroupTipShare is the relative share of a GC member in tips made to the GC.
+type RMGroupTipShare struct {
+	UID   zkidentity.ShortID `json:"uid"`
+	Share uint32             `json:"share"`
 }
 
 const RMCGroupList = "grouplist"
@@ -994,13 +1277,18 @@ type RMFTList struct {
 	Directories []string `json:"directories"`      // Which directories to obtain
 	Filter      string   `json:"filter,omitempty"` // Filter list by this regex
 	Tag         uint32   `json:"tag"`              // Tag to copy in replies
+
+	// GC is the GC to list files from when Directories includes
+	// RMFTDGroupChat.
+	GC *zkidentity.ShortID `json:"gc,omitempty"`
 }
 
 const (
 	RMCFTList = "ftls"
 
-	RMFTDGlobal = "global" // Globally accessible files
-	RMFTDShared = "shared" // Files shared between two users
+	RMFTDGlobal    = "global"    // Globally accessible files
+	RMFTDShared    = "shared"    // Files shared between two users
+	RMFTDGroupChat = "groupchat" // Files shared with members of a GC
 )
 
 type FileManifest struct {
@@ -1024,6 +1312,25 @@ type FileMetadata struct {
 
 const FileMetadataVersion = 1
 
+// The following are the keys of well-known file metadata attributes. Note that
+// attributes are not included in the metadata hash.
+const (
+	// FileAttrMimeType is the mime type of the file.
+	FileAttrMimeType = "mimetype"
+
+	// FileAttrWidth and FileAttrHeight are the dimensions (in pixels) of
+	// image and video files.
+	FileAttrWidth  = "width"
+	FileAttrHeight = "height"
+
+	// FileAttrDuration is the duration (in milliseconds) of audio and
+	// video files.
+	FileAttrDuration = "duration"
+
+	// FileAttrThumbnail is a base64-encoded JPEG thumbnail of the file.
+	FileAttrThumbnail = "thumbnail"
+)
+
 // MetadataHash calculates the hash of the metadata info. Note that the specific
 // information that is hashed depends on the version of the metadata.
 func (fm *FileMetadata) MetadataHash() [32]byte {
@@ -1053,10 +1360,12 @@ func (fm *FileMetadata) MetadataHash() [32]byte {
 }
 
 type RMFTListReply struct {
-	Global []FileMetadata `json:"global,omitempty"`
-	Shared []FileMetadata `json:"shared,omitempty"`
-	Tag    uint32
-	Error  *string `json:"error,omitempty"`
+	Global    []FileMetadata      `json:"global,omitempty"`
+	Shared    []FileMetadata      `json:"shared,omitempty"`
+	GroupChat []FileMetadata      `json:"groupchat,omitempty"`
+	GC        *zkidentity.ShortID `json:"gc,omitempty"` // Copied from RMFTList
+	Tag       uint32
+	Error     *string `json:"error,omitempty"`
 }
 
 const RMCFTListReply = "ftlsreply"
@@ -1174,10 +1483,18 @@ const RMCPostStatus = "poststatus"
 const RMCPostStatusReply = "poststatusreply"
 
 const (
-	RMPSHeart    = "heart"   // Heart a post
-	RMPSComment  = "comment" // Comment on a post
-	RMPSHeartYes = "1"       // +1 heart
-	RMPSHeartNo  = "0"       // -1 heart
+	RMPSHeart    = "heart"    // Heart a post
+	RMPSComment  = "comment"  // Comment on a post
+	RMPSRevision = "revision" // Revision number of an edited post
+	RMPSHeartYes = "1"        // +1 heart
+	RMPSHeartNo  = "0"        // -1 heart
+
+	// RMPSModeration is a moderation action taken by the post author.
+	// Removals target the comment referenced by RMPParent.
+	RMPSModeration     = "moderation"
+	RMPSModRemove      = "remove" // Remove a comment
+	RMPSModLockComms   = "lock"   // Stop accepting comments
+	RMPSModUnlockComms = "unlock" // Accept comments again
 )
 
 // RMPostSubscribe subscribes to new posts from a user.
@@ -1231,19 +1548,24 @@ type RMPostGetReply struct {
 const RMCPostGetReply = "postgetreply"
 
 const (
-	RMPVersion     = "version"     // Post version
-	RMPIdentifier  = "identifier"  // Post identifier
-	RMPDescription = "description" // Post description
-	RMPMain        = "main"        // Main post body
-	RMPTitle       = "title"       // Title of the post
-	RMPAttachment  = "attachment"  // Attached file to the post
-	RMPStatusFrom  = "statusfrom"  // Status/post update from (author)
-	RMPSignature   = "signature"   // Signature for the post/status
-	RMPParent      = "parent"      // Parent status/post
-	RMPStatusID    = "statusid"    // Status ID in status updates
-	RMPNonce       = "nonce"       // Random nonce to avoid equal hashes
-	RMPFromNick    = "from_nick"   // Nick of origin for post/status
-	RMPTimestamp   = "timestamp"   // Timestamp of the status update
+	RMPVersion     = "version"      // Post version
+	RMPIdentifier  = "identifier"   // Post identifier
+	RMPDescription = "description"  // Post description
+	RMPMain        = "main"         // Main post body
+	RMPTitle       = "title"        // Title of the post
+	RMPAttachment  = "attachment"   // Attached file to the post
+	RMPStatusFrom  = "statusfrom"   // Status/post update from (author)
+	RMPSignature   = "signature"    // Signature for the post/status
+	RMPParent      = "parent"       // Parent status/post
+	RMPStatusID    = "statusid"     // Status ID in status updates
+	RMPNonce       = "nonce"        // Random nonce to avoid equal hashes
+	RMPFromNick    = "from_nick"    // Nick of origin for post/status
+	RMPTimestamp   = "timestamp"    // Timestamp of the status update
+	RMPRestricted  = "restricted"   // Post restricted to an audience
+	RMPPrice       = "price"        // Price (in milliatoms) to unlock a paid post
+	RMPContentHash = "content_hash" // Hash of the full content of a paid post
+	RMPTags        = "tags"         // Comma-separated list of post tags
+	RMPExpiration  = "expiration"   // Timestamp after which the post expires
 )
 
 type PostMetadata struct {
@@ -1263,6 +1585,17 @@ func (pm *PostMetadata) Hash() [32]byte {
 		h.Write([]byte(pm.Attributes[key]))
 	}
 
+	// wframedAttr writes the key and value of an attribute, prefixed by
+	// their lengths, so that their contents cannot be shifted into the
+	// neighbouring attributes without changing the hash.
+	wframedAttr := func(key string) {
+		v := pm.Attributes[key]
+		writeUint64(uint64(len(key)))
+		h.Write([]byte(key))
+		writeUint64(uint64(len(v)))
+		h.Write([]byte(v))
+	}
+
 	writeUint64(pm.Version)
 	wattr(RMPDescription)
 	wattr(RMPMain)
@@ -1273,11 +1606,118 @@ func (pm *PostMetadata) Hash() [32]byte {
 
 	// Gate newer fields with a version check to ensure older copies of the
 	// metadata still hash to the same value.
+	if pm.Version >= PostMetadataVersionTags && pm.Version < PostMetadataVersionExpiration {
+		wattr(RMPTags)
+	}
+	if pm.Version >= PostMetadataVersionExpiration {
+		wframedAttr(RMPTags)
+		wframedAttr(RMPRestricted)
+		wframedAttr(RMPPrice)
+		wframedAttr(RMPContentHash)
+		wframedAttr(RMPExpiration)
+	}
 	copy(b[:], h.Sum(nil))
 	return b
 }
 
-const PostMetadataVersion = 1
+const (
+	PostMetadataVersion = 3
+
+	// PostMetadataVersionTags is the first version of post metadata that
+	// hashes the post tags.
+	PostMetadataVersionTags = 2
+
+	// PostMetadataVersionExpiration is the first version of post metadata
+	// that hashes the post expiration. From this version on, the tags
+	// and the attributes added afterwards are hashed prefixed by their
+	// lengths.
+	PostMetadataVersionExpiration = 3
+
+	// MaxPostTags is the max number of tags in a post.
+	MaxPostTags = 10
+
+	// MaxPostTagLen is the max length of an individual post tag.
+	MaxPostTagLen = 32
+)
+
+// NormalizePostTag returns the canonical form of a post tag: lower case,
+// without surrounding spaces and a leading '#'. Tags may only contain letters,
+// digits, '-' and '_'.
+func NormalizePostTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	tag = strings.TrimPrefix(tag, "#")
+	if tag == "" {
+		return "", fmt.Errorf("empty post tag")
+	}
+	if len(tag) > MaxPostTagLen {
+		return "", fmt.Errorf("post tag %q is longer than %d chars", tag,
+			MaxPostTagLen)
+	}
+	for _, r := range tag {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return "", fmt.Errorf("post tag %q has invalid char %q", tag, r)
+		}
+	}
+	return tag, nil
+}
+
+// NormalizePostTags normalizes the given tags, removing duplicates, and
+// returns them in the format of the RMPTags attribute.
+func NormalizePostTags(tags []string) (string, error) {
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag, err := NormalizePostTag(tag)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(res, tag) {
+			res = append(res, tag)
+		}
+	}
+	if len(res) > MaxPostTags {
+		return "", fmt.Errorf("post has more than %d tags", MaxPostTags)
+	}
+	return strings.Join(res, ","), nil
+}
+
+// PostTags returns the tags of the given post. Tags are only returned for
+// posts where they are part of the hash (and thus of the signature) of the
+// post. Invalid tags are ignored.
+func PostTags(pm *PostMetadata) []string {
+	if pm.Version < PostMetadataVersionTags || pm.Attributes[RMPTags] == "" {
+		return nil
+	}
+	split := strings.Split(pm.Attributes[RMPTags], ",")
+	res := make([]string, 0, len(split))
+	for _, tag := range split {
+		tag, err := NormalizePostTag(tag)
+		if err != nil || slices.Contains(res, tag) {
+			continue
+		}
+		res = append(res, tag)
+		if len(res) == MaxPostTags {
+			break
+		}
+	}
+	return res
+}
+
+// IsPostRestricted returns true if the post is restricted to an audience
+// selected by its author. Restricted posts are only sent by their author and
+// must not be relayed. The restriction is only considered on posts where it is
+// part of the hash (and thus of the signature) of the post.
+func IsPostRestricted(pm *PostMetadata) bool {
+	return pm.Version >= PostMetadataVersionExpiration && pm.Attributes[RMPRestricted] != ""
+}
+
+// PaidPostContentHash returns the hash of the full content of a paid post, as
+// stored in the RMPContentHash attribute.
+func PaidPostContentHash(content string) string {
+	h := blake256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
 
 type PostMetadataStatus struct {
 	Version    uint64            `json:"version"`
@@ -1309,6 +1749,14 @@ func (pm *PostMetadataStatus) Hash() [32]byte {
 	wattr(RMPSComment)
 	wattr(RMPNonce)
 
+	// RMPSRevision is empty on status updates that are not revisions, thus
+	// it does not change the hash of older status updates.
+	wattr(RMPSRevision)
+
+	// RMPSModeration is empty on status updates that are not moderation
+	// actions.
+	wattr(RMPSModeration)
+
 	// RMPFromNick is not added because it's filled by post sharer.
 
 	// RMPTimestamp is not added because it's undecided which timestamp
@@ -1327,9 +1775,29 @@ const PostMetadataStatusVersion = 1
 func IsPostStatus(attrs map[string]string) bool {
 	// The current version of post status does not have a differentiating
 	// entry between status and post, so we infer based on the presence of
-	// either a comment or heart entry, which are the currently supported
-	// status updates.
-	return attrs[RMPSComment] != "" || attrs[RMPSHeart] != ""
+	// either a comment, heart, revision or moderation entry, which are the
+	// currently supported status updates.
+	return attrs[RMPSComment] != "" || attrs[RMPSHeart] != "" ||
+		attrs[RMPSRevision] != "" || attrs[RMPSModeration] != ""
+}
+
+// IsPostRevision returns true when the map of attributes corresponds to a
+// revision (i.e. an edit by the author) of a post.
+func IsPostRevision(attrs map[string]string) bool {
+	return attrs[RMPSRevision] != ""
+}
+
+// IsPostComment returns true when the map of attributes corresponds to a
+// comment (possibly a reply to another comment) on a post.
+func IsPostComment(attrs map[string]string) bool {
+	_, ok := attrs[RMPSComment]
+	return ok
+}
+
+// IsPostModeration returns true when the map of attributes corresponds to a
+// moderation action taken by the author of a post.
+func IsPostModeration(attrs map[string]string) bool {
+	return attrs[RMPSModeration] != ""
 }
 
 // RMReceiptDomain are the valid read receipt domains.
@@ -1375,3 +1843,29 @@ type RMProfileUpdate struct {
 
 // RMCProfileUpdate is the command for a RMProfileUpdate.
 const RMCProfileUpdate = "profileupdt"
+
+// PostExpiration returns the time after which the given post expires or the
+// zero time if the post does not expire. Expiring posts should be deleted by
+// their author and subscribers after this time.
+//
+// The expiration is only returned for posts where it is part of the hash (and
+// thus of the signature) of the post.
+func PostExpiration(pm *PostMetadata) time.Time {
+	v := pm.Attributes[RMPExpiration]
+	if v == "" || pm.Version < PostMetadataVersionExpiration {
+		return time.Time{}
+	}
+	ts, err := strconv.ParseInt(v, 16, 64)
+	if err != nil || ts <= 0 {
+		// Invalid expiration timestamps are considered already
+		// expired, so that the post is never kept indefinitely.
+		return time.Unix(1, 0)
+	}
+	return time.Unix(ts, 0)
+}
+
+// IsPostExpired returns true if the given post has expired at the passed time.
+func IsPostExpired(pm *PostMetadata, now time.Time) bool {
+	exp := PostExpiration(pm)
+	return !exp.IsZero() && !now.Before(exp)
+}
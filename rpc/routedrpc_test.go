@@ -3,9 +3,16 @@ package rpc
 import (
 	"bytes"
 	"compress/zlib"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"slices"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
 )
 
 //func TestComposeRM(t *testing.T) {
@@ -370,3 +377,219 @@ func TestMetadataStatusHash(t *testing.T) {
 		})
 	}
 }
+
+// TestPostMetadataTags tests that post tags are only hashed and returned on
+// posts with a version that supports them.
+func TestPostMetadataTags(t *testing.T) {
+	attrs := map[string]string{RMPMain: "post", RMPTags: "go,Rust,#go,bad tag"}
+	v1 := PostMetadata{Version: 1, Attributes: attrs}
+	v1NoTags := PostMetadata{Version: 1, Attributes: map[string]string{RMPMain: "post"}}
+	if v1.Hash() != v1NoTags.Hash() {
+		t.Fatalf("tags changed the hash of a v1 post")
+	}
+	if tags := PostTags(&v1); tags != nil {
+		t.Fatalf("unexpected tags in v1 post: %v", tags)
+	}
+
+	v2 := PostMetadata{Version: PostMetadataVersionTags, Attributes: attrs}
+	v2NoTags := PostMetadata{Version: PostMetadataVersionTags, Attributes: v1NoTags.Attributes}
+	if v2.Hash() == v2NoTags.Hash() {
+		t.Fatalf("tags did not change the hash of a v2 post")
+	}
+	gotTags := PostTags(&v2)
+	wantTags := []string{"go", "rust"}
+	if !slices.Equal(gotTags, wantTags) {
+		t.Fatalf("unexpected tags: got %v, want %v", gotTags, wantTags)
+	}
+
+	// On newer versions, the tags cannot be moved into the previous
+	// attribute.
+	shifted := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick: "alicego",
+	}}
+	framed := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick: "alice",
+		RMPTags:     "go",
+	}}
+	if shifted.Hash() == framed.Hash() {
+		t.Fatalf("shifted tags did not change the hash of the post")
+	}
+
+	norm, err := NormalizePostTags([]string{" Go", "#rust", "go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if norm != "go,rust" {
+		t.Fatalf("unexpected normalized tags: %q", norm)
+	}
+	if _, err := NormalizePostTags([]string{"bad,tag"}); err == nil {
+		t.Fatalf("expected error on tag with invalid char")
+	}
+}
+
+// TestPostMetadataRestricted tests that the restriction of a post is part of
+// its hash on newer post versions and that it cannot be shifted into another
+// attribute.
+func TestPostMetadataRestricted(t *testing.T) {
+	restricted := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick:   "alice",
+		RMPRestricted: "1",
+	}}
+	shifted := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick: "alice1",
+	}}
+	if restricted.Hash() == shifted.Hash() {
+		t.Fatalf("shifted restriction did not change the hash of the post")
+	}
+	if !IsPostRestricted(&restricted) || IsPostRestricted(&shifted) {
+		t.Fatalf("unexpected restriction of posts")
+	}
+
+	// The restriction is ignored on older versions, where it is not
+	// part of the hash.
+	v2 := PostMetadata{Version: PostMetadataVersionTags, Attributes: restricted.Attributes}
+	if IsPostRestricted(&v2) {
+		t.Fatalf("v2 post is restricted")
+	}
+}
+
+// TestPostMetadataPaid tests that the price and content hash of paid posts
+// are part of their hash and cannot be shifted between attributes.
+func TestPostMetadataPaid(t *testing.T) {
+	paid := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick:    "alice",
+		RMPPrice:       "1000",
+		RMPContentHash: "abcd",
+	}}
+	tests := []map[string]string{{
+		RMPFromNick:    "alice1000",
+		RMPContentHash: "abcd",
+	}, {
+		RMPFromNick: "alice",
+		RMPPrice:    "1000abcd",
+	}, {
+		RMPFromNick:    "alice",
+		RMPPrice:       "100",
+		RMPContentHash: "0abcd",
+	}}
+	for _, attrs := range tests {
+		shifted := PostMetadata{Version: PostMetadataVersion, Attributes: attrs}
+		if paid.Hash() == shifted.Hash() {
+			t.Fatalf("shifted attributes %v did not change the hash", attrs)
+		}
+	}
+}
+
+// TestPostMetadataExpiration tests that the expiration of a post is part of its
+// hash and is correctly decoded.
+func TestPostMetadataExpiration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	exp := now.Add(time.Hour)
+	noExp := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{RMPMain: "post"}}
+	withExp := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPMain:       "post",
+		RMPExpiration: strconv.FormatInt(exp.Unix(), 16),
+	}}
+	if noExp.Hash() == withExp.Hash() {
+		t.Fatalf("expiration did not change the hash of the post")
+	}
+
+	// The expiration cannot be moved into the previous attribute.
+	shifted := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick: "alice6a000000",
+	}}
+	framed := PostMetadata{Version: PostMetadataVersion, Attributes: map[string]string{
+		RMPFromNick:   "alice",
+		RMPExpiration: "6a000000",
+	}}
+	if shifted.Hash() == framed.Hash() {
+		t.Fatalf("shifted expiration did not change the hash of the post")
+	}
+
+	// The expiration is ignored on posts with an older version, where it
+	// is not part of the hash.
+	v2 := PostMetadata{Version: PostMetadataVersionTags, Attributes: withExp.Attributes}
+	v2NoExp := PostMetadata{Version: PostMetadataVersionTags, Attributes: noExp.Attributes}
+	if v2.Hash() != v2NoExp.Hash() {
+		t.Fatalf("expiration changed the hash of a v2 post")
+	}
+	if got := PostExpiration(&v2); !got.IsZero() {
+		t.Fatalf("unexpected expiration of v2 post: %v", got)
+	}
+
+	if got := PostExpiration(&noExp); !got.IsZero() {
+		t.Fatalf("unexpected expiration of non-expiring post: %v", got)
+	}
+	if got := PostExpiration(&withExp); !got.Equal(exp) {
+		t.Fatalf("unexpected expiration: got %v, want %v", got, exp)
+	}
+
+	if IsPostExpired(&noExp, now) {
+		t.Fatalf("non-expiring post is expired")
+	}
+	if IsPostExpired(&withExp, now) {
+		t.Fatalf("post expired before its deadline")
+	}
+	if !IsPostExpired(&withExp, exp) {
+		t.Fatalf("post did not expire at its deadline")
+	}
+
+	// Invalid expirations are considered expired.
+	withExp.Attributes[RMPExpiration] = "invalid"
+	if !IsPostExpired(&withExp, now) {
+		t.Fatalf("post with invalid expiration is not expired")
+	}
+}
+
+// TestSplitRM tests that splitting an RM into fragments generates fragments
+// that fit into a single message and that can be reassembled.
+func TestSplitRM(t *testing.T) {
+	signer := func([]byte) zkidentity.FixedSizeSignature {
+		return zkidentity.FixedSizeSignature{}
+	}
+
+	for _, v := range []MaxMsgSizeVersion{MaxMsgSizeV0, MaxMsgSizeV1} {
+		maxMsgSize := int(MaxMsgSizeForVersion(v))
+		fragSize := MaxRMFragmentDataSize(maxMsgSize)
+
+		rm := make([]byte, fragSize*3+1)
+		if _, err := rand.Read(rm); err != nil {
+			t.Fatal(err)
+		}
+		frags, err := SplitRM(rm, fragSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(frags) != 4 {
+			t.Fatalf("unexpected nb of fragments: got %d, want 4", len(frags))
+		}
+
+		var reassembled []byte
+		for i, frag := range frags {
+			if err := frag.Valid(); err != nil {
+				t.Fatalf("fragment %d is not valid: %v", i, err)
+			}
+			me, err := ComposeCompressedRM(signer, frag, zlib.NoCompression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			estSize := EstimateRoutedRMWireSize(len(me))
+			if estSize > maxMsgSize {
+				t.Fatalf("fragment %d is too large: %d > %d", i,
+					estSize, maxMsgSize)
+			}
+			reassembled = append(reassembled, frag.Data...)
+		}
+		if !bytes.Equal(reassembled, rm) {
+			t.Fatalf("reassembled RM is not equal to original RM")
+		}
+		if frags[0].ID != zkidentity.ShortID(sha256.Sum256(rm)) {
+			t.Fatalf("unexpected fragment ID")
+		}
+	}
+
+	// RMs that fit in a single fragment are not split.
+	if _, err := SplitRM(make([]byte, 10), 10); err == nil {
+		t.Fatalf("expected error when splitting RM into a single fragment")
+	}
+}